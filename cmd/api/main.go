@@ -3,8 +3,11 @@ package main
 import (
 	"context"
 	"log"
+	"net/http"
 	"os"
+	"strconv"
 
+	"entgo.io/ent/dialect"
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/gin-gonic/gin"
@@ -14,6 +17,13 @@ import (
 )
 
 func main() {
+	dsn := datastore.PostgresDSN(
+		os.Getenv("DB_HOST"),
+		os.Getenv("DB_PORT"),
+		os.Getenv("DB_USER"),
+		os.Getenv("DB_PASS"),
+		os.Getenv("DB_NAME"),
+	)
 	entClient, err := datastore.NewEntClient(
 		os.Getenv("DB_HOST"),
 		os.Getenv("DB_PORT"),
@@ -22,11 +32,25 @@ func main() {
 		os.Getenv("DB_NAME"),
 	)
 	if err != nil {
-		log.Fatalf("error connecting to database: %w", err)
+		log.Fatalf("error connecting to database: %v", err)
 	}
 	defer entClient.Close()
 
 	taskStore := datastore.NewTaskStore(entClient)
+	workflowStore := datastore.NewWorkflowStore(entClient)
+	projectStore := datastore.NewProjectStore(entClient)
+	userStore := datastore.NewUserStore(entClient)
+	commentStore := datastore.NewCommentStore(entClient)
+	taskEventStore := datastore.NewTaskEventStore(entClient)
+	savedViewStore := datastore.NewSavedViewStore(entClient)
+	idempotencyStore := datastore.NewIdempotencyStore(entClient)
+	webhookStore := datastore.NewWebhookStore(entClient)
+	outboxStore := datastore.NewOutboxStore(entClient)
+	exportJobStore := datastore.NewExportJobStore(entClient)
+	searchStore, err := datastore.NewSearchStore(context.Background(), entClient, taskStore, dialect.Postgres)
+	if err != nil {
+		log.Fatalf("error setting up search: %v", err)
+	}
 
 	sdkConfig, err := config.LoadDefaultConfig(context.Background(), config.WithRegion(os.Getenv("S3_BUCKET_REGION")))
 	if err != nil {
@@ -35,20 +59,121 @@ func main() {
 	}
 	s3Client := s3.NewFromConfig(sdkConfig)
 
-	taskSvc := service.NewTaskService(taskStore, s3Client)
-	handler := handler.NewHandler(taskSvc)
+	webhookSvc := service.NewWebhookService(webhookStore, service.NewWebhookClient())
+	taskSvc := service.NewTaskService(taskStore, workflowStore, projectStore, userStore, s3Client, outboxStore)
+	projectSvc := service.NewProjectService(projectStore, workflowStore)
+	workflowSvc := service.NewWorkflowService(workflowStore)
+	userSvc := service.NewUserService(userStore)
+	commentSvc := service.NewCommentService(commentStore, taskStore, userStore)
+	auditSvc := service.NewAuditService(taskEventStore, taskStore)
+	searchSvc := service.NewSearchService(searchStore)
+	savedViewSvc := service.NewSavedViewService(savedViewStore, taskStore)
+	idempotencySvc := service.NewIdempotencyService(idempotencyStore)
+	exportSvc := service.NewExportService(taskStore, projectStore, exportJobStore, s3Client)
+	graphSvc := service.NewGraphService(datastore.NewGraphStore(entClient))
+	go taskSvc.RunTrashPurger(context.Background())
+	go idempotencySvc.RunKeyPurger(context.Background())
+	go webhookSvc.RunDispatcher(context.Background())
+	go exportSvc.RunWorker(context.Background())
+	streamSvc := service.NewStreamService(outboxStore)
+	publishers := service.Publishers{webhookSvc}
+	if logEvents, _ := strconv.ParseBool(os.Getenv("LOG_EVENTS")); logEvents {
+		publishers = append(publishers, service.LogPublisher{})
+	}
+	outboxRelay := service.NewOutboxRelay(outboxStore, publishers, datastore.NewEventNotifier(entClient))
+	go outboxRelay.Run(context.Background())
+	go func() {
+		if err := streamSvc.RunListener(context.Background(), dsn); err != nil {
+			log.Fatalf("error listening for events: %v", err)
+		}
+	}()
+	caldav, davPrefix := handler.NewCalDAVHandler(taskSvc, projectSvc, userSvc), handler.DAVPrefix
+	handler := handler.NewHandler(taskSvc, projectSvc, workflowSvc, userSvc, commentSvc, auditSvc, searchSvc, savedViewSvc, idempotencySvc, webhookSvc, streamSvc, exportSvc, graphSvc)
 	router := gin.New()
 
 	apiV1RouterGroup := router.Group("/api/v1/")
+	apiV1RouterGroup.Use(handler.RequestID, handler.Authenticate)
 	apiV1RouterGroup.GET("/health/", handler.Health)
-	apiV1RouterGroup.POST("/tasks/", handler.CreateTask)
+	apiV1RouterGroup.POST("/tasks/", handler.Idempotency, handler.CreateTask)
 	apiV1RouterGroup.GET("/tasks/", handler.ListTasks)
+	apiV1RouterGroup.POST("/tasks/bulk/", handler.BulkTasks)
+	apiV1RouterGroup.GET("/tasks/export/", handler.ExportTasks)
+	apiV1RouterGroup.POST("/tasks/export/jobs/", handler.CreateExportJob)
+	apiV1RouterGroup.GET("/tasks/export/jobs/:job_id/", handler.GetExportJob)
+	apiV1RouterGroup.GET("/tasks/export/jobs/:job_id/download/", handler.DownloadExport)
+	apiV1RouterGroup.POST("/tasks/import/", handler.ImportTasks)
 	apiV1RouterGroup.GET("/meta/", handler.GetMetaInfo)
 	apiV1RouterGroup.GET("/tasks/:task_id/", handler.GetTask)
 	apiV1RouterGroup.PATCH("/tasks/:task_id/", handler.UpdateTask)
 	apiV1RouterGroup.DELETE("/tasks/:task_id/", handler.DeleteTask)
-	apiV1RouterGroup.POST("/tasks/:task_id/attach/", handler.AddAttachment)
+	apiV1RouterGroup.POST("/tasks/:task_id/attach/", handler.Idempotency, handler.AddAttachment)
 	apiV1RouterGroup.DELETE("/tasks/:task_id/attach/", handler.DeleteAttachment)
+	apiV1RouterGroup.GET("/tasks/:task_id/children/", handler.ListChildren)
+	apiV1RouterGroup.PUT("/tasks/:task_id/children/", handler.ReorderChildren)
+	apiV1RouterGroup.POST("/tasks/:task_id/move/", handler.MoveTask)
+	apiV1RouterGroup.POST("/tasks/:task_id/blockers/", handler.AddBlocker)
+	apiV1RouterGroup.DELETE("/tasks/:task_id/blockers/:blocker_id/", handler.RemoveBlocker)
+	apiV1RouterGroup.GET("/tasks/:task_id/dependencies/", handler.GetDependencyGraph)
+	apiV1RouterGroup.GET("/dependencies/", handler.GetFullDependencyGraph)
+	apiV1RouterGroup.POST("/projects/", handler.CreateProject)
+	apiV1RouterGroup.GET("/projects/", handler.ListProjects)
+	apiV1RouterGroup.GET("/projects/:project_id/", handler.GetProject)
+	apiV1RouterGroup.PATCH("/projects/:project_id/", handler.UpdateProject)
+	apiV1RouterGroup.GET("/projects/:project_id/board/", handler.GetBoard)
+	apiV1RouterGroup.POST("/workflows/", handler.CreateWorkflow)
+	apiV1RouterGroup.GET("/workflows/", handler.ListWorkflows)
+	apiV1RouterGroup.GET("/workflows/:workflow_id/", handler.GetWorkflow)
+	apiV1RouterGroup.POST("/users/", handler.CreateUser)
+	apiV1RouterGroup.GET("/users/me/", handler.GetCurrentUser)
+	apiV1RouterGroup.GET("/users/me/mentions/", handler.ListMentionedTasks)
+	apiV1RouterGroup.GET("/users/me/tasks/", handler.ListMyTasks)
+	apiV1RouterGroup.POST("/users/me/calendar-token/", handler.CreateCalendarToken)
+	apiV1RouterGroup.DELETE("/users/me/calendar-token/", handler.RevokeCalendarToken)
+	// Feed URLs end in .ics for calendar apps, so this route has no
+	// trailing slash.
+	apiV1RouterGroup.GET("/calendar/:feed", handler.CalendarFeed)
+	apiV1RouterGroup.POST("/tasks/:task_id/assignees/", handler.AssignUser)
+	apiV1RouterGroup.DELETE("/tasks/:task_id/assignees/:user_id/", handler.UnassignUser)
+	apiV1RouterGroup.POST("/tasks/:task_id/watchers/", handler.WatchTask)
+	apiV1RouterGroup.DELETE("/tasks/:task_id/watchers/", handler.UnwatchTask)
+	apiV1RouterGroup.GET("/tasks/:task_id/history/", handler.GetTaskHistory)
+	apiV1RouterGroup.GET("/audit/", handler.ListAuditEvents)
+	apiV1RouterGroup.GET("/search/", handler.Search)
+	apiV1RouterGroup.POST("/views/", handler.CreateSavedView)
+	apiV1RouterGroup.GET("/views/", handler.ListSavedViews)
+	apiV1RouterGroup.GET("/views/:view_id/", handler.GetSavedView)
+	apiV1RouterGroup.PATCH("/views/:view_id/", handler.UpdateSavedView)
+	apiV1RouterGroup.DELETE("/views/:view_id/", handler.DeleteSavedView)
+	apiV1RouterGroup.GET("/views/:view_id/tasks/", handler.ListViewTasks)
+	apiV1RouterGroup.GET("/trash/", handler.ListTrash)
+	apiV1RouterGroup.DELETE("/trash/:task_id/", handler.PurgeTask)
+	apiV1RouterGroup.POST("/tasks/:task_id/restore/", handler.RestoreTask)
+	apiV1RouterGroup.GET("/tasks/:task_id/comments/", handler.ListComments)
+	apiV1RouterGroup.POST("/tasks/:task_id/comments/", handler.CreateComment)
+	apiV1RouterGroup.PATCH("/tasks/:task_id/comments/:comment_id/", handler.UpdateComment)
+	apiV1RouterGroup.DELETE("/tasks/:task_id/comments/:comment_id/", handler.DeleteComment)
+	apiV1RouterGroup.GET("/events/", handler.StreamEvents)
+	apiV1RouterGroup.GET("/events/ws/", handler.StreamEventsWS)
+	apiV1RouterGroup.GET("/sync/", handler.SyncTasks)
+	apiV1RouterGroup.POST("/sync/", handler.PushSync)
+	apiV1RouterGroup.POST("/webhooks/", handler.CreateWebhook)
+	apiV1RouterGroup.GET("/webhooks/", handler.ListWebhooks)
+	apiV1RouterGroup.GET("/webhooks/:webhook_id/", handler.GetWebhook)
+	apiV1RouterGroup.PATCH("/webhooks/:webhook_id/", handler.UpdateWebhook)
+	apiV1RouterGroup.DELETE("/webhooks/:webhook_id/", handler.DeleteWebhook)
+	apiV1RouterGroup.GET("/webhooks/:webhook_id/deliveries/", handler.ListWebhookDeliveries)
+	apiV1RouterGroup.POST("/webhooks/:webhook_id/deliveries/:delivery_id/redeliver/", handler.RedeliverWebhook)
+
+	apiRouterGroup := router.Group("/api/")
+	apiRouterGroup.Use(handler.RequestID, handler.Authenticate)
+	apiRouterGroup.GET("/graphql", handler.GraphQL)
+	apiRouterGroup.POST("/graphql", handler.GraphQL)
 
-	router.Run(":" + os.Getenv("APP_PORT"))
+	// CalDAV clients are served next to the router, since WebDAV methods
+	// and paths do not fit its routing.
+	mux := http.NewServeMux()
+	mux.Handle(davPrefix, caldav)
+	mux.Handle("/.well-known/caldav", caldav)
+	mux.Handle("/", router)
+	log.Fatal(http.ListenAndServe(":"+os.Getenv("APP_PORT"), mux))
 }
@@ -0,0 +1,287 @@
+package datastore
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/ent"
+	"github.com/localopsco/go-sample/ent/hook"
+	"github.com/localopsco/go-sample/ent/task"
+	"github.com/localopsco/go-sample/ent/taskevent"
+	"github.com/localopsco/go-sample/ent/workflowstatus"
+	"github.com/localopsco/go-sample/models"
+)
+
+// auditedEdges are the task edges whose changes are recorded in task
+// events. Edges backed by a field, like status or parent, are recorded
+// through that field instead.
+var auditedEdges = []string{
+	task.EdgeAssignees,
+	task.EdgeWatchers,
+	task.EdgeBlocks,
+	task.EdgeBlockedBy,
+}
+
+// derivedFields are task fields that follow from other changes, such as the
+// status category of a status, and are left out of task events.
+var derivedFields = map[string]bool{
+	task.FieldStatusCategory: true,
+	task.FieldCreatedAt:      true,
+	task.FieldCompletedAt:    true,
+	task.FieldRank:           true,
+	task.FieldVersion:        true,
+	task.FieldChangeSeq:      true,
+}
+
+// RegisterHooks installs the hooks that write an audit event for every task
+// mutation and keep those events immutable, the hooks that track when tasks
+// are completed, rank them in their column and number their changes, and
+// the interceptor that hides soft-deleted tasks.
+func RegisterHooks(client *ent.Client) {
+	client.Task.Use(auditTaskMutations, trackCompletion, assignRanks, trackChanges)
+	client.Task.Intercept(excludeDeletedTasks)
+	client.TaskEvent.Use(hook.Reject(ent.OpUpdate | ent.OpUpdateOne | ent.OpDelete | ent.OpDeleteOne))
+}
+
+// auditTaskMutations records a TaskEvent for each task a mutation touches.
+// Events are written with the mutation's client, so inside a transaction
+// they commit or roll back together with the change they describe.
+func auditTaskMutations(next ent.Mutator) ent.Mutator {
+	return hook.TaskFunc(func(ctx context.Context, m *ent.TaskMutation) (ent.Value, error) {
+		if m.Op().Is(ent.OpCreate) {
+			v, err := next.Mutate(ctx, m)
+			if err != nil {
+				return nil, err
+			}
+			created, ok := v.(*ent.Task)
+			if !ok {
+				return v, nil
+			}
+			return v, recordTaskEvents(ctx, m, map[uuid.UUID]*ent.Task{created.ID: nil}, created)
+		}
+
+		ids, err := m.IDs(ctx)
+		if err != nil {
+			return nil, err
+		}
+		before := make(map[uuid.UUID]*ent.Task, len(ids))
+		if len(ids) > 0 {
+			entTasks, err := m.Client().Task.Query().Where(task.IDIn(ids...)).All(withDeleted(ctx))
+			if err != nil {
+				return nil, err
+			}
+			for _, entTask := range entTasks {
+				before[entTask.ID] = entTask
+			}
+		}
+		v, err := next.Mutate(ctx, m)
+		if err != nil {
+			return nil, err
+		}
+		return v, recordTaskEvents(ctx, m, before, nil)
+	})
+}
+
+// recordTaskEvents writes one event per task in before, which maps task IDs
+// to their state prior to the mutation. created is the new task for creates.
+func recordTaskEvents(ctx context.Context, m *ent.TaskMutation, before map[uuid.UUID]*ent.Task, created *ent.Task) error {
+	builders := make([]*ent.TaskEventCreate, 0, len(before))
+	for id, old := range before {
+		var action string
+		var changes []models.FieldChange
+		switch {
+		case created != nil:
+			action, changes = models.TaskEventCreated, fieldChanges(m, nil)
+		case m.Op().Is(ent.OpDelete | ent.OpDeleteOne):
+			action, changes = models.TaskEventPurged, deletedFields(old)
+		default:
+			action, changes = models.TaskEventUpdated, fieldChanges(m, old)
+			if len(changes) == 0 {
+				continue
+			}
+			if len(changes) == 1 {
+				action = singleChangeAction(changes[0])
+			}
+		}
+		if err := describeStatuses(ctx, m.Client(), changes); err != nil {
+			return err
+		}
+
+		title, projectID := "", (*uuid.UUID)(nil)
+		if created != nil {
+			title, projectID = created.Title, created.ProjectID
+		} else {
+			title, projectID = old.Title, old.ProjectID
+		}
+		if newTitle, ok := m.Title(); ok {
+			title = newTitle
+		}
+		if newProjectID, ok := m.ProjectID(); ok {
+			projectID = &newProjectID
+		} else if m.ProjectIDCleared() {
+			projectID = nil
+		}
+
+		builders = append(builders, m.Client().TaskEvent.Create().
+			SetTaskID(id).
+			SetTaskTitle(title).
+			SetNillableProjectID(projectID).
+			SetAction(taskevent.Action(action)).
+			SetNillableActorID(models.ActorFrom(ctx)).
+			SetChanges(changes).
+			SetRequestID(models.RequestIDFrom(ctx)))
+	}
+	if len(builders) == 0 {
+		return nil
+	}
+	return m.Client().TaskEvent.CreateBulk(builders...).Exec(ctx)
+}
+
+// singleChangeAction names updates that only move a task in or out of the
+// trash or only change its attachment.
+func singleChangeAction(change models.FieldChange) string {
+	switch {
+	case change.Field == task.FieldDeletedAt && change.New != nil:
+		return models.TaskEventDeleted
+	case change.Field == task.FieldDeletedAt:
+		return models.TaskEventRestored
+	case change.Field == task.FieldAttachmentURL && change.New != nil:
+		return models.TaskEventAttachmentAdded
+	case change.Field == task.FieldAttachmentURL:
+		return models.TaskEventAttachmentRemoved
+	}
+	return models.TaskEventUpdated
+}
+
+// fieldChanges lists the fields and audited edges m changes on a task whose
+// previous state is old, or that is being created if old is nil.
+func fieldChanges(m *ent.TaskMutation, old *ent.Task) []models.FieldChange {
+	oldValues := snapshot(old)
+	changes := []models.FieldChange{}
+	fields := m.Fields()
+	sort.Strings(fields)
+	for _, name := range fields {
+		if derivedFields[name] {
+			continue
+		}
+		value, _ := m.Field(name)
+		oldValue, newValue := oldValues[name], normalize(value)
+		if reflect.DeepEqual(oldValue, newValue) {
+			continue
+		}
+		// Snapshots omit zero values, so a missing old value only
+		// differs from a new zero value in representation.
+		if oldValue == nil && reflect.ValueOf(value).IsZero() {
+			continue
+		}
+		changes = append(changes, models.FieldChange{Field: name, Old: oldValue, New: newValue})
+	}
+	for _, name := range m.ClearedFields() {
+		if oldValue := oldValues[name]; oldValue != nil && !derivedFields[name] {
+			changes = append(changes, models.FieldChange{Field: name, Old: oldValue})
+		}
+	}
+	for _, name := range auditedEdges {
+		added, removed := m.AddedIDs(name), m.RemovedIDs(name)
+		if len(added) == 0 && len(removed) == 0 {
+			continue
+		}
+		changes = append(changes, models.FieldChange{Field: name, Added: edgeIDs(added), Removed: edgeIDs(removed)})
+	}
+	return changes
+}
+
+// deletedFields lists every field a deleted task had set.
+func deletedFields(old *ent.Task) []models.FieldChange {
+	oldValues := snapshot(old)
+	names := make([]string, 0, len(oldValues))
+	for name := range oldValues {
+		if name != task.FieldID && name != task.FieldDeletedAt && !derivedFields[name] {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	changes := make([]models.FieldChange, 0, len(names))
+	for _, name := range names {
+		changes = append(changes, models.FieldChange{Field: name, Old: oldValues[name]})
+	}
+	return changes
+}
+
+// describeStatuses replaces status ID changes with changes of the status
+// keys, which are what clients see.
+func describeStatuses(ctx context.Context, client *ent.Client, changes []models.FieldChange) error {
+	for i, change := range changes {
+		if change.Field != task.FieldStatusID {
+			continue
+		}
+		var ids []uuid.UUID
+		for _, value := range []any{change.Old, change.New} {
+			if s, ok := value.(string); ok {
+				if id, err := uuid.Parse(s); err == nil {
+					ids = append(ids, id)
+				}
+			}
+		}
+		statuses, err := client.WorkflowStatus.Query().
+			Where(workflowstatus.IDIn(ids...)).
+			Select(workflowstatus.FieldID, workflowstatus.FieldKey).
+			All(ctx)
+		if err != nil {
+			return err
+		}
+		keys := make(map[string]string, len(statuses))
+		for _, status := range statuses {
+			keys[status.ID.String()] = status.Key
+		}
+		changes[i] = models.FieldChange{Field: "status"}
+		if s, ok := change.Old.(string); ok {
+			changes[i].Old = keys[s]
+		}
+		if s, ok := change.New.(string); ok {
+			changes[i].New = keys[s]
+		}
+	}
+	return nil
+}
+
+// snapshot returns the JSON representation of a task's fields, which is how
+// values are stored in task events.
+func snapshot(entTask *ent.Task) map[string]any {
+	values := map[string]any{}
+	if entTask == nil {
+		return values
+	}
+	b, err := json.Marshal(entTask)
+	if err != nil {
+		return values
+	}
+	_ = json.Unmarshal(b, &values)
+	delete(values, "edges")
+	return values
+}
+
+func edgeIDs(values []ent.Value) []string {
+	ids := make([]string, 0, len(values))
+	for _, value := range values {
+		ids = append(ids, fmt.Sprint(value))
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+func normalize(value any) any {
+	b, err := json.Marshal(value)
+	if err != nil {
+		return value
+	}
+	var normalized any
+	if err := json.Unmarshal(b, &normalized); err != nil {
+		return value
+	}
+	return normalized
+}
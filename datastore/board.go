@@ -0,0 +1,60 @@
+package datastore
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/ent"
+	"github.com/localopsco/go-sample/ent/predicate"
+	"github.com/localopsco/go-sample/ent/task"
+	"github.com/localopsco/go-sample/models"
+)
+
+// ErrWIPLimitExceeded is returned when a move would put more unfinished
+// tasks in a column than its enforced WIP limit allows.
+var ErrWIPLimitExceeded = errors.New("column is at its WIP limit")
+
+// ListBoardEntries returns what is needed to place the tasks of a project
+// on its board, in the order of their ranks.
+func (store *TaskStore) ListBoardEntries(ctx context.Context, projectID uuid.UUID) ([]models.BoardEntry, error) {
+	entTasks, err := store.client.Task.Query().
+		Where(task.ProjectID(projectID)).
+		Order(task.ByRank(), task.ByCreatedAt(), task.ByID()).
+		Select(task.FieldID, task.FieldStatusID, task.FieldStatusCategory, task.FieldLabels).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]models.BoardEntry, 0, len(entTasks))
+	for _, entTask := range entTasks {
+		entries = append(entries, models.BoardEntry{
+			ID:       entTask.ID,
+			StatusID: entTask.StatusID,
+			Open:     !models.IsClosedCategory(string(entTask.StatusCategory)),
+			Labels:   entTask.Labels,
+		})
+	}
+	return entries, nil
+}
+
+// CountColumnWIP counts the unfinished tasks in the column of a project
+// that target moves tasks into.
+func (store *TaskStore) CountColumnWIP(ctx context.Context, projectID uuid.UUID, target models.ColumnTarget) (int, error) {
+	return countColumnWIP(ctx, store.client, projectID, target)
+}
+
+func countColumnWIP(ctx context.Context, client *ent.Client, projectID uuid.UUID, target models.ColumnTarget) (int, error) {
+	var column predicate.Task
+	switch {
+	case target.Status != nil:
+		column = task.StatusID(target.Status.ID)
+	case target.Label != "":
+		column = hasLabel(target.Label)
+	default:
+		return 0, nil
+	}
+	return client.Task.Query().
+		Where(task.ProjectID(projectID), column, task.StatusCategoryIn(openStatusCategories...)).
+		Count(ctx)
+}
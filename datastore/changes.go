@@ -0,0 +1,260 @@
+package datastore
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/ent"
+	"github.com/localopsco/go-sample/ent/changesequence"
+	"github.com/localopsco/go-sample/ent/hook"
+	"github.com/localopsco/go-sample/ent/task"
+	"github.com/localopsco/go-sample/ent/taskevent"
+	"github.com/localopsco/go-sample/ent/tasktombstone"
+	"github.com/localopsco/go-sample/models"
+)
+
+// taskChanges names the change sequence that numbers task changes.
+const taskChanges = "task_changes"
+
+// trackChanges stamps every task a mutation touches with the next number of
+// the change sequence and bumps its version, and leaves a tombstone for
+// each task that is purged. The counter row is updated inside the
+// mutation's transaction and stays locked until it commits, so sequence
+// numbers become visible in order: a client that has seen number n will
+// never later find a change numbered n or below that it missed. Mutations
+// outside a transaction only get that guarantee for their own statement.
+func trackChanges(next ent.Mutator) ent.Mutator {
+	return hook.TaskFunc(func(ctx context.Context, m *ent.TaskMutation) (ent.Value, error) {
+		if m.Op().Is(ent.OpDelete | ent.OpDeleteOne) {
+			ids, err := m.IDs(withDeleted(ctx))
+			if err != nil {
+				return nil, err
+			}
+			v, err := next.Mutate(ctx, m)
+			if err != nil || len(ids) == 0 {
+				return v, err
+			}
+			seq, err := nextChangeSeq(ctx, m.Client())
+			if err != nil {
+				return nil, err
+			}
+			builders := make([]*ent.TaskTombstoneCreate, 0, len(ids))
+			for _, id := range ids {
+				builders = append(builders, m.Client().TaskTombstone.Create().
+					SetTaskID(id).
+					SetChangeSeq(seq))
+			}
+			return v, m.Client().TaskTombstone.CreateBulk(builders...).Exec(ctx)
+		}
+		seq, err := nextChangeSeq(ctx, m.Client())
+		if err != nil {
+			return nil, err
+		}
+		m.SetChangeSeq(seq)
+		if !m.Op().Is(ent.OpCreate) {
+			m.AddVersion(1)
+		}
+		return next.Mutate(ctx, m)
+	})
+}
+
+// nextChangeSeq increments the task change sequence and returns its new
+// value.
+func nextChangeSeq(ctx context.Context, client *ent.Client) (int64, error) {
+	n, err := client.ChangeSequence.Update().
+		Where(changesequence.Name(taskChanges)).
+		AddValue(1).
+		Save(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if n == 0 {
+		created, err := client.ChangeSequence.Create().
+			SetName(taskChanges).
+			SetValue(1).
+			Save(ctx)
+		if err != nil {
+			return 0, err
+		}
+		return created.Value, nil
+	}
+	counter, err := client.ChangeSequence.Query().
+		Where(changesequence.Name(taskChanges)).
+		Only(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return counter.Value, nil
+}
+
+// migrateChangeSequence creates the counter of the task change sequence,
+// so that the first changes do not race to create it.
+func migrateChangeSequence(ctx context.Context, client *ent.Client) error {
+	exists, err := client.ChangeSequence.Query().
+		Where(changesequence.Name(taskChanges)).
+		Exist(ctx)
+	if err != nil || exists {
+		return err
+	}
+	return client.ChangeSequence.Create().
+		SetName(taskChanges).
+		Exec(ctx)
+}
+
+// LockChanges keeps other transactions from changing tasks until the
+// transaction of the store ends, so that the tasks it reads stay current
+// while it acts on them.
+func (store *TaskStore) LockChanges(ctx context.Context) error {
+	_, err := store.client.ChangeSequence.Query().
+		Where(changesequence.Name(taskChanges)).
+		Modify(forUpdate).
+		All(ctx)
+	return err
+}
+
+// ListChanges returns up to limit task changes after cursor, in the order
+// of the change sequence. Tasks in the trash are reported as deleted, along
+// with the tombstones of purged tasks. Tasks changed before the change
+// sequence existed all have sequence number 0, so a pull from the zero
+// cursor returns every task.
+func (store *TaskStore) ListChanges(ctx context.Context, cursor models.SyncCursor, limit int) (*models.SyncChanges, error) {
+	var entTasks []*ent.Task
+	var entTombstones []*ent.TaskTombstone
+	err := withTx(ctx, store.client, func(client *ent.Client) error {
+		var err error
+		entTasks, err = NewTaskStore(client).query().
+			Where(task.Or(
+				task.ChangeSeqGT(cursor.Seq),
+				task.And(task.ChangeSeq(cursor.Seq), task.IDGT(cursor.ID)),
+			)).
+			Order(task.ByChangeSeq(), task.ByID()).
+			Limit(limit + 1).
+			All(withDeleted(ctx))
+		if err != nil {
+			return err
+		}
+		entTombstones, err = client.TaskTombstone.Query().
+			Where(tasktombstone.Or(
+				tasktombstone.ChangeSeqGT(cursor.Seq),
+				tasktombstone.And(tasktombstone.ChangeSeq(cursor.Seq), tasktombstone.TaskIDGT(cursor.ID)),
+			)).
+			Order(tasktombstone.ByChangeSeq(), tasktombstone.ByTaskID()).
+			Limit(limit + 1).
+			All(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	type change struct {
+		cursor    models.SyncCursor
+		task      *ent.Task
+		tombstone *ent.TaskTombstone
+	}
+	changes := make([]change, 0, len(entTasks)+len(entTombstones))
+	for _, entTask := range entTasks {
+		changes = append(changes, change{cursor: models.SyncCursor{Seq: entTask.ChangeSeq, ID: entTask.ID}, task: entTask})
+	}
+	for _, entTombstone := range entTombstones {
+		changes = append(changes, change{cursor: models.SyncCursor{Seq: entTombstone.ChangeSeq, ID: entTombstone.TaskID}, tombstone: entTombstone})
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return cursorLess(changes[i].cursor, changes[j].cursor)
+	})
+
+	result := &models.SyncChanges{Tasks: []*models.Task{}, Deleted: []models.Tombstone{}, Next: cursor}
+	if len(changes) > limit {
+		changes, result.HasMore = changes[:limit], true
+	}
+	var tasks []*ent.Task
+	for _, c := range changes {
+		switch {
+		case c.tombstone != nil:
+			result.Deleted = append(result.Deleted, models.Tombstone{ID: c.tombstone.TaskID, DeletedAt: c.tombstone.DeletedAt, Purged: true})
+		case c.task.DeletedAt != nil:
+			result.Deleted = append(result.Deleted, models.Tombstone{ID: c.task.ID, DeletedAt: *c.task.DeletedAt})
+		default:
+			tasks = append(tasks, c.task)
+		}
+		result.Next = c.cursor
+	}
+	if len(tasks) > 0 {
+		result.Tasks = convertEntTasks(tasks)
+		if err := store.withComputedFields(ctx, result.Tasks...); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// LatestChange returns the cursor of the latest task change, or the zero
+// cursor if there is none.
+func (store *TaskStore) LatestChange(ctx context.Context) (models.SyncCursor, error) {
+	var latest models.SyncCursor
+	entTasks, err := store.client.Task.Query().
+		Order(task.ByChangeSeq(sql.OrderDesc()), task.ByID(sql.OrderDesc())).
+		Limit(1).
+		Select(task.FieldID, task.FieldChangeSeq).
+		All(withDeleted(ctx))
+	if err != nil {
+		return latest, err
+	}
+	if len(entTasks) > 0 {
+		latest = models.SyncCursor{Seq: entTasks[0].ChangeSeq, ID: entTasks[0].ID}
+	}
+	entTombstones, err := store.client.TaskTombstone.Query().
+		Order(tasktombstone.ByChangeSeq(sql.OrderDesc()), tasktombstone.ByTaskID(sql.OrderDesc())).
+		Limit(1).
+		All(ctx)
+	if err != nil {
+		return latest, err
+	}
+	if len(entTombstones) > 0 {
+		if cursor := (models.SyncCursor{Seq: entTombstones[0].ChangeSeq, ID: entTombstones[0].TaskID}); cursorLess(latest, cursor) {
+			latest = cursor
+		}
+	}
+	return latest, nil
+}
+
+// LastFieldChanges returns when each field of a task was last changed,
+// going by its task events. Fields are named as in task events.
+func (store *TaskStore) LastFieldChanges(ctx context.Context, taskID uuid.UUID) (map[string]time.Time, error) {
+	entEvents, err := store.client.TaskEvent.Query().
+		Where(taskevent.TaskID(taskID)).
+		Select(taskevent.FieldChanges, taskevent.FieldCreatedAt).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	changed := make(map[string]time.Time)
+	for _, entEvent := range entEvents {
+		for _, change := range entEvent.Changes {
+			if entEvent.CreatedAt.After(changed[change.Field]) {
+				changed[change.Field] = entEvent.CreatedAt
+			}
+		}
+	}
+	return changed, nil
+}
+
+func cursorLess(a, b models.SyncCursor) bool {
+	if a.Seq != b.Seq {
+		return a.Seq < b.Seq
+	}
+	return bytes.Compare(a.ID[:], b.ID[:]) < 0
+}
+
+// forUpdate locks the selected rows until the end of the transaction.
+// SQLite has no row locks and serializes writers anyway.
+func forUpdate(s *sql.Selector) {
+	if s.Dialect() != dialect.SQLite {
+		s.ForUpdate()
+	}
+}
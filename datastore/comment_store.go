@@ -0,0 +1,187 @@
+package datastore
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/ent"
+	"github.com/localopsco/go-sample/ent/comment"
+	"github.com/localopsco/go-sample/ent/user"
+	"github.com/localopsco/go-sample/models"
+)
+
+type CommentStore struct {
+	client *ent.Client
+}
+
+func NewCommentStore(client *ent.Client) *CommentStore {
+	return &CommentStore{
+		client,
+	}
+}
+
+func (store *CommentStore) CreateComment(ctx context.Context, c models.Comment, authorID uuid.UUID, mentionIDs []uuid.UUID) (*models.Comment, error) {
+	entComment, err := store.client.Comment.Create().
+		SetTaskID(c.TaskID).
+		SetAuthorID(authorID).
+		SetNillableParentID(c.ParentID).
+		SetBody(c.Body).
+		SetBodyHTML(c.BodyHTML).
+		AddMentionIDs(mentionIDs...).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return store.GetComment(ctx, entComment.ID)
+}
+
+func (store *CommentStore) GetComment(ctx context.Context, commentID uuid.UUID) (*models.Comment, error) {
+	entComment, err := store.query().
+		Where(comment.ID(commentID)).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	comments := []*models.Comment{convertEntComment(entComment)}
+	if err := store.withReplyCounts(ctx, comments); err != nil {
+		return nil, err
+	}
+	return comments[0], nil
+}
+
+// UpdateComment replaces the body of a comment and its mentions.
+func (store *CommentStore) UpdateComment(ctx context.Context, c models.Comment, mentionIDs []uuid.UUID) (*models.Comment, error) {
+	err := store.client.Comment.UpdateOneID(c.ID).
+		SetBody(c.Body).
+		SetBodyHTML(c.BodyHTML).
+		SetEditedAt(time.Now()).
+		ClearMentions().
+		AddMentionIDs(mentionIDs...).
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return store.GetComment(ctx, c.ID)
+}
+
+// DeleteComment deletes a comment along with its replies.
+func (store *CommentStore) DeleteComment(ctx context.Context, commentID uuid.UUID) error {
+	return store.client.Comment.DeleteOneID(commentID).Exec(ctx)
+}
+
+// ListComments returns a page of the comments on a task that reply to
+// parentID, or of its top-level comments if parentID is nil, oldest first.
+func (store *CommentStore) ListComments(ctx context.Context, taskID uuid.UUID, parentID *uuid.UUID, limit, offset int) (*models.Page[*models.Comment], error) {
+	query := store.query().Where(comment.TaskID(taskID))
+	if parentID != nil {
+		query = query.Where(comment.ParentID(*parentID))
+	} else {
+		query = query.Where(comment.ParentIDIsNil())
+	}
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+	entComments, err := query.
+		Order(ent.Asc(comment.FieldCreatedAt), ent.Asc(comment.FieldID)).
+		Limit(limit).
+		Offset(offset).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	comments := make([]*models.Comment, 0, len(entComments))
+	for _, entComment := range entComments {
+		comments = append(comments, convertEntComment(entComment))
+	}
+	if err := store.withReplyCounts(ctx, comments); err != nil {
+		return nil, err
+	}
+	return &models.Page[*models.Comment]{
+		Items:  comments,
+		Total:  total,
+		Limit:  limit,
+		Offset: offset,
+	}, nil
+}
+
+// MentionedTaskIDs returns the tasks with comments mentioning the user, most
+// recently mentioned first.
+func (store *CommentStore) MentionedTaskIDs(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error) {
+	entComments, err := store.client.Comment.Query().
+		Where(comment.HasMentionsWith(user.ID(userID))).
+		Order(ent.Desc(comment.FieldCreatedAt)).
+		Select(comment.FieldTaskID).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	seen := make(map[uuid.UUID]bool, len(entComments))
+	taskIDs := make([]uuid.UUID, 0, len(entComments))
+	for _, entComment := range entComments {
+		if !seen[entComment.TaskID] {
+			seen[entComment.TaskID] = true
+			taskIDs = append(taskIDs, entComment.TaskID)
+		}
+	}
+	return taskIDs, nil
+}
+
+func (store *CommentStore) withReplyCounts(ctx context.Context, comments []*models.Comment) error {
+	if len(comments) == 0 {
+		return nil
+	}
+	ids := make([]uuid.UUID, 0, len(comments))
+	for _, c := range comments {
+		ids = append(ids, c.ID)
+	}
+	var rows []struct {
+		ParentID uuid.UUID `json:"parent_id"`
+		Count    int       `json:"count"`
+	}
+	err := store.client.Comment.Query().
+		Where(comment.ParentIDIn(ids...)).
+		GroupBy(comment.FieldParentID).
+		Aggregate(ent.Count()).
+		Scan(ctx, &rows)
+	if err != nil {
+		return err
+	}
+	counts := make(map[uuid.UUID]int, len(rows))
+	for _, row := range rows {
+		counts[row.ParentID] = row.Count
+	}
+	for _, c := range comments {
+		c.ReplyCount = counts[c.ID]
+	}
+	return nil
+}
+
+func (store *CommentStore) query() *ent.CommentQuery {
+	return store.client.Comment.Query().
+		WithAuthor().
+		WithMentions(func(q *ent.UserQuery) {
+			q.Select(user.FieldUsername)
+		})
+}
+
+func convertEntComment(entComment *ent.Comment) *models.Comment {
+	c := &models.Comment{
+		ID:        entComment.ID,
+		TaskID:    entComment.TaskID,
+		ParentID:  entComment.ParentID,
+		Body:      entComment.Body,
+		BodyHTML:  entComment.BodyHTML,
+		Mentions:  make([]string, 0, len(entComment.Edges.Mentions)),
+		CreatedAt: entComment.CreatedAt,
+		EditedAt:  entComment.EditedAt,
+	}
+	if entComment.Edges.Author != nil {
+		c.Author = convertEntUser(entComment.Edges.Author)
+	}
+	for _, mentioned := range entComment.Edges.Mentions {
+		c.Mentions = append(c.Mentions, mentioned.Username)
+	}
+	return c
+}
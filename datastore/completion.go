@@ -0,0 +1,40 @@
+package datastore
+
+import (
+	"context"
+	"time"
+
+	"github.com/localopsco/go-sample/ent"
+	"github.com/localopsco/go-sample/ent/hook"
+	"github.com/localopsco/go-sample/models"
+)
+
+// trackCompletion sets completed_at when a mutation moves tasks into a closed
+// status category and clears it when they are reopened. Moving a single
+// task between closed categories, such as from done to cancelled, keeps its
+// completion time.
+func trackCompletion(next ent.Mutator) ent.Mutator {
+	return hook.TaskFunc(func(ctx context.Context, m *ent.TaskMutation) (ent.Value, error) {
+		category, ok := m.StatusCategory()
+		if !ok {
+			return next.Mutate(ctx, m)
+		}
+		if !models.IsClosedCategory(category.String()) {
+			if !m.Op().Is(ent.OpCreate) {
+				m.ClearCompletedAt()
+			}
+			return next.Mutate(ctx, m)
+		}
+		if m.Op().Is(ent.OpUpdateOne) {
+			old, err := m.OldStatusCategory(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if models.IsClosedCategory(old.String()) {
+				return next.Mutate(ctx, m)
+			}
+		}
+		m.SetCompletedAt(time.Now())
+		return next.Mutate(ctx, m)
+	})
+}
@@ -0,0 +1,35 @@
+// Package datastoretest provides databases for the tests of the datastore
+// and the packages built on it.
+package datastoretest
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/datastore"
+	"github.com/localopsco/go-sample/ent"
+	_ "modernc.org/sqlite"
+)
+
+// NewClient returns a client of a new in-memory SQLite database, migrated
+// and with the hooks of the datastore registered. The database is closed
+// when the test ends.
+func NewClient(t testing.TB) *ent.Client {
+	t.Helper()
+	dsn := "file:" + uuid.NewString() + "?mode=memory&cache=shared&_pragma=foreign_keys(1)&_time_format=sqlite"
+	db, err := sql.Open("sqlite", dsn)
+	if err != nil {
+		t.Fatalf("opening database: %v", err)
+	}
+	client := ent.NewClient(ent.Driver(entsql.OpenDB(dialect.SQLite, db)))
+	t.Cleanup(func() { client.Close() })
+	datastore.RegisterHooks(client)
+	if err := datastore.Migrate(context.Background(), client, dialect.SQLite); err != nil {
+		t.Fatalf("migrating database: %v", err)
+	}
+	return client
+}
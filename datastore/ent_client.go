@@ -4,28 +4,73 @@ import (
 	"context"
 	"fmt"
 
+	"entgo.io/ent/dialect"
 	_ "github.com/lib/pq"
 	"github.com/localopsco/go-sample/ent"
 )
 
+// PostgresDSN returns the connection string of a Postgres database.
+func PostgresDSN(host, port, userName, password, dbName string) string {
+	return fmt.Sprintf(
+		"host=%s port=%s user=%s dbname=%s password=%s sslmode=disable",
+		host,
+		port,
+		userName,
+		dbName,
+		password,
+	)
+}
+
 func NewEntClient(host, port, userName, password, dbName string) (*ent.Client, error) {
 	client, err := ent.Open(
 		"postgres",
-		fmt.Sprintf(
-			"host=%s port=%s user=%s dbname=%s password=%s sslmode=disable",
-			host,
-			port,
-			userName,
-			dbName,
-			password,
-		),
+		PostgresDSN(host, port, userName, password, dbName),
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed opening connection to postgres: %w", err)
 	}
-	// Run the auto migration tool.
-	if err := client.Schema.Create(context.Background()); err != nil {
-		return nil, fmt.Errorf("failed creating schema resources: %w", err)
+	RegisterHooks(client)
+	if err := Migrate(context.Background(), client, dialect.Postgres); err != nil {
+		return nil, err
 	}
 	return client, nil
 }
+
+// Migrate creates or updates the schema of a database and brings existing
+// rows up to date. Steps that only apply to Postgres, such as the full-text
+// search columns, are skipped for other drivers.
+func Migrate(ctx context.Context, client *ent.Client, driverName string) error {
+	if driverName == dialect.Postgres {
+		if err := migrateDuplicateDeliveries(ctx, client); err != nil {
+			return fmt.Errorf("failed removing duplicate webhook deliveries: %w", err)
+		}
+	}
+	// Run the auto migration tool.
+	if err := client.Schema.Create(ctx); err != nil {
+		return fmt.Errorf("failed creating schema resources: %w", err)
+	}
+	if err := migrateTaskStatuses(ctx, client, driverName); err != nil {
+		return fmt.Errorf("failed migrating task statuses: %w", err)
+	}
+	if err := migrateRanks(ctx, client); err != nil {
+		return fmt.Errorf("failed ranking tasks: %w", err)
+	}
+	if err := migrateChangeSequence(ctx, client); err != nil {
+		return fmt.Errorf("failed creating change sequence: %w", err)
+	}
+	if err := migrateRelayedSeq(ctx, client); err != nil {
+		return fmt.Errorf("failed numbering relayed events: %w", err)
+	}
+	if driverName == dialect.Postgres {
+		if err := migrateSavedFilters(ctx, client); err != nil {
+			return fmt.Errorf("failed moving saved filters to saved views: %w", err)
+		}
+		if err := migrateImportKeys(ctx, client); err != nil {
+			return fmt.Errorf("failed dropping the unique index of external IDs: %w", err)
+		}
+		if err := migrateSearch(ctx, client); err != nil {
+			return fmt.Errorf("failed creating search indexes: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+package datastore
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/localopsco/go-sample/ent"
+	"github.com/localopsco/go-sample/models"
+)
+
+// EventChannel is the Postgres notification channel on which relayed
+// events are announced to every replica, by their relayed position.
+const EventChannel = "task_events"
+
+const (
+	listenerMinReconnect = time.Second
+	listenerMaxReconnect = time.Minute
+	listenerPingInterval = 90 * time.Second
+)
+
+// EventNotifier announces events with NOTIFY. Notifications only carry the
+// position of the event, since payloads are limited to 8000 bytes.
+type EventNotifier struct {
+	client *ent.Client
+}
+
+func NewEventNotifier(client *ent.Client) *EventNotifier {
+	return &EventNotifier{
+		client,
+	}
+}
+
+// Publish announces an event. Within the transaction of the relay,
+// Postgres holds the notification back until the event is committed and
+// can be loaded by its position.
+func (notifier *EventNotifier) Publish(ctx context.Context, event models.Event) error {
+	client := notifier.client
+	if tx := ent.TxFromContext(ctx); tx != nil {
+		client = tx.Client()
+	}
+	_, err := client.ExecContext(ctx, "SELECT pg_notify($1, $2)", EventChannel, strconv.Itoa(event.Seq))
+	return err
+}
+
+// ListenEvents calls onEvent with the position of every event announced on
+// EventChannel until ctx is cancelled. Notifications sent while the
+// connection was down are lost, so onReconnect is called once it is back.
+func ListenEvents(ctx context.Context, dsn string, onEvent func(seq int), onReconnect func()) error {
+	listener := pq.NewListener(dsn, listenerMinReconnect, listenerMaxReconnect, nil)
+	defer listener.Close()
+	if err := listener.Listen(EventChannel); err != nil {
+		return err
+	}
+	ticker := time.NewTicker(listenerPingInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case n := <-listener.Notify:
+			if n == nil {
+				onReconnect()
+				continue
+			}
+			if seq, err := strconv.Atoi(n.Extra); err == nil {
+				onEvent(seq)
+			}
+		case <-ticker.C:
+			go listener.Ping()
+		}
+	}
+}
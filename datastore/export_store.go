@@ -0,0 +1,131 @@
+package datastore
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/ent"
+	"github.com/localopsco/go-sample/ent/exportjob"
+	"github.com/localopsco/go-sample/models"
+)
+
+type ExportJobStore struct {
+	client *ent.Client
+}
+
+func NewExportJobStore(client *ent.Client) *ExportJobStore {
+	return &ExportJobStore{
+		client,
+	}
+}
+
+// CreateJob queues an export. The filter's location is stored by name in
+// the job's timezone.
+func (store *ExportJobStore) CreateJob(ctx context.Context, job models.ExportJob) (*models.ExportJob, error) {
+	filter := job.Filter
+	filter.Location = nil
+	entJob, err := store.client.ExportJob.Create().
+		SetNillableUserID(job.UserID).
+		SetFormat(exportjob.Format(job.Format)).
+		SetColumns(job.Columns).
+		SetFilter(filter).
+		SetTimezone(job.Timezone).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return convertEntExportJob(entJob), nil
+}
+
+func (store *ExportJobStore) GetJob(ctx context.Context, jobID uuid.UUID) (*models.ExportJob, error) {
+	entJob, err := store.client.ExportJob.Get(ctx, jobID)
+	if err != nil {
+		return nil, err
+	}
+	return convertEntExportJob(entJob), nil
+}
+
+// ClaimJob marks the oldest pending export as running and returns it, or
+// returns nil if there is none. Jobs left running since before staleBefore
+// are taken to belong to a worker that died and are claimed again.
+func (store *ExportJobStore) ClaimJob(ctx context.Context, now, staleBefore time.Time) (*models.ExportJob, error) {
+	for {
+		entJob, err := store.client.ExportJob.Query().
+			Where(exportjob.Or(
+				exportjob.StatusEQ(exportjob.StatusPending),
+				exportjob.And(exportjob.StatusEQ(exportjob.StatusRunning), exportjob.StartedAtLT(staleBefore)),
+			)).
+			Order(exportjob.ByCreatedAt()).
+			First(ctx)
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		update := store.client.ExportJob.Update().
+			Where(exportjob.ID(entJob.ID), exportjob.StatusEQ(entJob.Status)).
+			SetStatus(exportjob.StatusRunning).
+			SetStartedAt(now)
+		if entJob.StartedAt != nil {
+			update.Where(exportjob.StartedAt(*entJob.StartedAt))
+		}
+		n, err := update.Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if n == 1 {
+			entJob.Status, entJob.StartedAt = exportjob.StatusRunning, &now
+			return convertEntExportJob(entJob), nil
+		}
+	}
+}
+
+// FinishJob records the outcome of a running export.
+func (store *ExportJobStore) FinishJob(ctx context.Context, job models.ExportJob) error {
+	return store.client.ExportJob.UpdateOneID(job.ID).
+		SetStatus(exportjob.Status(job.Status)).
+		SetError(job.Error).
+		SetRows(job.Rows).
+		SetResultKey(job.ResultKey).
+		SetFinishedAt(time.Now()).
+		Exec(ctx)
+}
+
+// ListFinishedBefore returns the exports that finished before cutoff.
+func (store *ExportJobStore) ListFinishedBefore(ctx context.Context, cutoff time.Time) ([]*models.ExportJob, error) {
+	entJobs, err := store.client.ExportJob.Query().
+		Where(exportjob.FinishedAtLT(cutoff)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	jobs := make([]*models.ExportJob, 0, len(entJobs))
+	for _, entJob := range entJobs {
+		jobs = append(jobs, convertEntExportJob(entJob))
+	}
+	return jobs, nil
+}
+
+func (store *ExportJobStore) DeleteJob(ctx context.Context, jobID uuid.UUID) error {
+	return store.client.ExportJob.DeleteOneID(jobID).Exec(ctx)
+}
+
+func convertEntExportJob(entJob *ent.ExportJob) *models.ExportJob {
+	return &models.ExportJob{
+		ID:         entJob.ID,
+		UserID:     entJob.UserID,
+		Format:     entJob.Format.String(),
+		Columns:    entJob.Columns,
+		Timezone:   entJob.Timezone,
+		Status:     entJob.Status.String(),
+		Error:      entJob.Error,
+		Rows:       entJob.Rows,
+		CreatedAt:  entJob.CreatedAt,
+		StartedAt:  entJob.StartedAt,
+		FinishedAt: entJob.FinishedAt,
+		Filter:     entJob.Filter,
+		ResultKey:  entJob.ResultKey,
+	}
+}
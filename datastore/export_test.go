@@ -0,0 +1,3 @@
+package datastore
+
+var MarkMatches = markMatches
@@ -0,0 +1,101 @@
+package datastore
+
+import (
+	"context"
+
+	"entgo.io/contrib/entgql"
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/ent"
+	"github.com/localopsco/go-sample/ent/project"
+	"github.com/localopsco/go-sample/ent/task"
+	"github.com/localopsco/go-sample/ent/user"
+	"github.com/localopsco/go-sample/ent/workflowstatus"
+)
+
+// GraphStore reads the entities of the GraphQL API, which is generated from
+// the ent schema and resolves to ent entities rather than models. Queries
+// load the edges that the fields of the GraphQL operation in ctx select.
+type GraphStore struct {
+	client *ent.Client
+}
+
+func NewGraphStore(client *ent.Client) *GraphStore {
+	return &GraphStore{
+		client,
+	}
+}
+
+func (store *GraphStore) PaginateTasks(ctx context.Context, after *ent.Cursor, first *int, before *ent.Cursor, last *int, orderBy *ent.TaskOrder, where *ent.TaskWhereInput) (*ent.TaskConnection, error) {
+	return store.client.Task.Query().
+		Paginate(ctx, after, first, before, last,
+			ent.WithTaskOrder(orderBy),
+			ent.WithTaskFilter(where.Filter),
+		)
+}
+
+func (store *GraphStore) PaginateProjects(ctx context.Context, after *ent.Cursor, first *int, before *ent.Cursor, last *int, orderBy *ent.ProjectOrder, where *ent.ProjectWhereInput) (*ent.ProjectConnection, error) {
+	return store.client.Project.Query().
+		Paginate(ctx, after, first, before, last,
+			ent.WithProjectOrder(orderBy),
+			ent.WithProjectFilter(where.Filter),
+		)
+}
+
+func (store *GraphStore) GetTask(ctx context.Context, taskID uuid.UUID) (*ent.Task, error) {
+	query, err := store.client.Task.Query().Where(task.ID(taskID)).CollectFields(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return query.Only(ctx)
+}
+
+func (store *GraphStore) GetProject(ctx context.Context, projectID uuid.UUID) (*ent.Project, error) {
+	query, err := store.client.Project.Query().Where(project.ID(projectID)).CollectFields(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return query.Only(ctx)
+}
+
+func (store *GraphStore) GetUser(ctx context.Context, userID uuid.UUID) (*ent.User, error) {
+	query, err := store.client.User.Query().Where(user.ID(userID)).CollectFields(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return query.Only(ctx)
+}
+
+func (store *GraphStore) GetNode(ctx context.Context, id uuid.UUID) (ent.Noder, error) {
+	return store.client.Noder(ctx, id, ent.WithNodeType(store.nodeType))
+}
+
+// GetNodes returns the nodes with the given IDs, in their order. IDs that
+// no node has are reported as errors of the GraphQL operation in ctx and
+// left nil.
+func (store *GraphStore) GetNodes(ctx context.Context, ids []uuid.UUID) ([]ent.Noder, error) {
+	return store.client.Noders(ctx, ids, ent.WithNodeType(store.nodeType))
+}
+
+// nodeType returns the table of the node with an ID. UUIDs are unique
+// across tables, so the ID is looked up in each table that holds nodes.
+func (store *GraphStore) nodeType(ctx context.Context, id uuid.UUID) (string, error) {
+	tables := []struct {
+		name  string
+		exist func(context.Context) (bool, error)
+	}{
+		{task.Table, store.client.Task.Query().Where(task.ID(id)).Exist},
+		{project.Table, store.client.Project.Query().Where(project.ID(id)).Exist},
+		{user.Table, store.client.User.Query().Where(user.ID(id)).Exist},
+		{workflowstatus.Table, store.client.WorkflowStatus.Query().Where(workflowstatus.ID(id)).Exist},
+	}
+	for _, table := range tables {
+		exists, err := table.exist(ctx)
+		if err != nil {
+			return "", err
+		}
+		if exists {
+			return table.name, nil
+		}
+	}
+	return "", entgql.ErrNodeNotFound(id)
+}
@@ -0,0 +1,89 @@
+package datastore
+
+import (
+	"context"
+	"time"
+
+	"github.com/localopsco/go-sample/ent"
+	"github.com/localopsco/go-sample/ent/idempotencykey"
+	"github.com/localopsco/go-sample/models"
+)
+
+type IdempotencyStore struct {
+	client *ent.Client
+}
+
+func NewIdempotencyStore(client *ent.Client) *IdempotencyStore {
+	return &IdempotencyStore{
+		client,
+	}
+}
+
+// ReserveKey records that the request of key is being processed. It fails
+// with a constraint error if the key is already recorded for the caller.
+func (store *IdempotencyStore) ReserveKey(ctx context.Context, key models.IdempotencyKey, expiresAt time.Time) error {
+	return store.client.IdempotencyKey.Create().
+		SetKey(key.Key).
+		SetCaller(key.Caller).
+		SetFingerprint(key.Fingerprint).
+		SetExpiresAt(expiresAt).
+		Exec(ctx)
+}
+
+// GetKey returns what is recorded for the key of a caller.
+func (store *IdempotencyStore) GetKey(ctx context.Context, key models.IdempotencyKey) (*models.StoredResponse, error) {
+	entKey, err := store.client.IdempotencyKey.Query().
+		Where(idempotencykey.Key(key.Key), idempotencykey.Caller(key.Caller)).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	stored := &models.StoredResponse{
+		Fingerprint: entKey.Fingerprint,
+		CreatedAt:   entKey.CreatedAt,
+		ExpiresAt:   entKey.ExpiresAt,
+	}
+	if entKey.ResponseStatus != nil {
+		stored.Response = &models.RecordedResponse{
+			Status: *entKey.ResponseStatus,
+			Header: entKey.ResponseHeader,
+			Body:   entKey.ResponseBody,
+		}
+	}
+	return stored, nil
+}
+
+// SaveResponse records the response to the request of key.
+func (store *IdempotencyStore) SaveResponse(ctx context.Context, key models.IdempotencyKey, response models.RecordedResponse) error {
+	return store.client.IdempotencyKey.Update().
+		Where(
+			idempotencykey.Key(key.Key),
+			idempotencykey.Caller(key.Caller),
+			idempotencykey.Fingerprint(key.Fingerprint),
+		).
+		SetResponseStatus(response.Status).
+		SetResponseHeader(response.Header).
+		SetResponseBody(response.Body).
+		Exec(ctx)
+}
+
+// DeleteKey forgets the key of a caller if it was recorded before
+// createdBefore, so that a newer reservation is left alone.
+func (store *IdempotencyStore) DeleteKey(ctx context.Context, key models.IdempotencyKey, createdBefore time.Time) error {
+	_, err := store.client.IdempotencyKey.Delete().
+		Where(
+			idempotencykey.Key(key.Key),
+			idempotencykey.Caller(key.Caller),
+			idempotencykey.CreatedAtLTE(createdBefore),
+		).
+		Exec(ctx)
+	return err
+}
+
+// DeleteExpiredKeys removes the keys that expired before now and returns
+// how many there were.
+func (store *IdempotencyStore) DeleteExpiredKeys(ctx context.Context, now time.Time) (int, error) {
+	return store.client.IdempotencyKey.Delete().
+		Where(idempotencykey.ExpiresAtLT(now)).
+		Exec(ctx)
+}
@@ -0,0 +1,163 @@
+package datastore
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect"
+	"github.com/localopsco/go-sample/ent"
+	"github.com/localopsco/go-sample/ent/task"
+	"github.com/localopsco/go-sample/models"
+)
+
+// DefaultWorkflow is created on startup for tasks that do not belong to a
+// project with a workflow of its own.
+var DefaultWorkflow = models.Workflow{
+	Name: "Default",
+	Statuses: []*models.WorkflowStatus{
+		{Key: "todo", Name: "To do", Category: models.StatusCategoryTodo, Transitions: []string{"in_progress", "done", "wont_do"}},
+		{Key: "in_progress", Name: "In progress", Category: models.StatusCategoryInProgress, Transitions: []string{"todo", "in_review", "done", "wont_do"}},
+		{Key: "in_review", Name: "In review", Category: models.StatusCategoryInProgress, Transitions: []string{"in_progress", "done", "wont_do"}},
+		{Key: "done", Name: "Done", Category: models.StatusCategoryDone, Transitions: []string{"todo", "in_progress"}},
+		{Key: "wont_do", Name: "Won't do", Category: models.StatusCategoryCancelled, Transitions: []string{"todo"}},
+	},
+}
+
+// migrateTaskStatuses seeds the default workflow and moves tasks created
+// before workflows existed onto it, using the legacy is_completed column to
+// pick their status.
+//
+// The legacy column is left in place, since pods of the previous release
+// still read and write it while a rolling deploy replaces them. A later
+// release drops it with ALTER TABLE tasks DROP COLUMN IF EXISTS is_completed.
+func migrateTaskStatuses(ctx context.Context, client *ent.Client, driverName string) error {
+	defaultWorkflow, err := NewWorkflowStore(client).EnsureDefaultWorkflow(ctx, DefaultWorkflow)
+	if err != nil {
+		return fmt.Errorf("creating default workflow: %w", err)
+	}
+	todo, done := defaultWorkflow.Status("todo"), defaultWorkflow.Status("done")
+
+	// Only databases created before workflows existed, which were all
+	// Postgres, have the legacy column.
+	hasLegacyColumn := false
+	if driverName == dialect.Postgres {
+		if hasLegacyColumn, err = columnExists(ctx, client, task.Table, "is_completed"); err != nil {
+			return err
+		}
+	}
+	if hasLegacyColumn {
+		_, err := client.ExecContext(ctx,
+			"UPDATE tasks SET status_id = $1, status_category = $2 WHERE status_id IS NULL AND is_completed",
+			done.ID, done.Category,
+		)
+		if err != nil {
+			return fmt.Errorf("migrating completed tasks: %w", err)
+		}
+	}
+	err = client.Task.Update().
+		Where(task.StatusIDIsNil()).
+		SetStatusID(todo.ID).
+		SetStatusCategory(task.StatusCategory(todo.Category)).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("migrating open tasks: %w", err)
+	}
+	return nil
+}
+
+// migrateSavedFilters copies the saved filters of the release before saved
+// views into saved views of the same owner and name, which keep their ID.
+// Filters whose owner already has a view of that name are left out. The
+// saved_filters table is left in place, to be dropped by a later release.
+func migrateSavedFilters(ctx context.Context, client *ent.Client) error {
+	hasSavedFilters, err := tableExists(ctx, client, "saved_filters")
+	if err != nil || !hasSavedFilters {
+		return err
+	}
+	_, err = client.ExecContext(ctx, `INSERT INTO saved_views (id, name, query, visibility, owner_id, created_at, updated_at)
+		SELECT id, name, query, 'private', owner_id, created_at, created_at FROM saved_filters
+		ON CONFLICT DO NOTHING`)
+	return err
+}
+
+// migrateImportKeys drops the unique index that external IDs had before
+// they were scoped by import_key. Auto migration leaves indexes that left
+// the schema in place.
+func migrateImportKeys(ctx context.Context, client *ent.Client) error {
+	_, err := client.ExecContext(ctx, "DROP INDEX IF EXISTS tasks_external_id_key")
+	return err
+}
+
+// migrateDuplicateDeliveries deletes all but the first delivery of an
+// event to a webhook, which events relayed again used to queue, so that
+// auto migration can add the unique index of webhook_id and event_id.
+func migrateDuplicateDeliveries(ctx context.Context, client *ent.Client) error {
+	hasDeliveries, err := tableExists(ctx, client, "webhook_deliveries")
+	if err != nil || !hasDeliveries {
+		return err
+	}
+	_, err = client.ExecContext(ctx, `DELETE FROM webhook_deliveries d USING webhook_deliveries kept
+		WHERE d.webhook_id = kept.webhook_id AND d.event_id = kept.event_id
+		AND (d.created_at, d.id) > (kept.created_at, kept.id)`)
+	return err
+}
+
+// searchMigrations add weighted tsvector columns and GIN indexes for full-text
+// search. Title words rank above description words, which rank above words
+// in comments. The columns are generated, so Postgres keeps them current.
+var searchMigrations = []string{
+	`ALTER TABLE tasks ADD COLUMN IF NOT EXISTS search_vector tsvector GENERATED ALWAYS AS (
+		setweight(to_tsvector('english', COALESCE(title, '')), 'A') ||
+		setweight(to_tsvector('english', COALESCE(description, '')), 'B')
+	) STORED`,
+	`CREATE INDEX IF NOT EXISTS tasks_search_vector_idx ON tasks USING GIN (search_vector)`,
+	`ALTER TABLE comments ADD COLUMN IF NOT EXISTS search_vector tsvector GENERATED ALWAYS AS (
+		setweight(to_tsvector('english', COALESCE(body, '')), 'C')
+	) STORED`,
+	`CREATE INDEX IF NOT EXISTS comments_search_vector_idx ON comments USING GIN (search_vector)`,
+}
+
+func migrateSearch(ctx context.Context, client *ent.Client) error {
+	for _, statement := range searchMigrations {
+		if _, err := client.ExecContext(ctx, statement); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func columnExists(ctx context.Context, client *ent.Client, table, column string) (bool, error) {
+	rows, err := client.QueryContext(ctx,
+		"SELECT EXISTS (SELECT 1 FROM information_schema.columns WHERE table_name = $1 AND column_name = $2)",
+		table, column,
+	)
+	if err != nil {
+		return false, err
+	}
+	defer rows.Close()
+	var exists bool
+	if rows.Next() {
+		if err := rows.Scan(&exists); err != nil {
+			return false, err
+		}
+	}
+	return exists, rows.Err()
+}
+
+func tableExists(ctx context.Context, client *ent.Client, table string) (bool, error) {
+	rows, err := client.QueryContext(ctx,
+		"SELECT EXISTS (SELECT 1 FROM information_schema.tables WHERE table_name = $1)",
+		table,
+	)
+	if err != nil {
+		return false, err
+	}
+	defer rows.Close()
+	var exists bool
+	if rows.Next() {
+		if err := rows.Scan(&exists); err != nil {
+			return false, err
+		}
+	}
+	return exists, rows.Err()
+}
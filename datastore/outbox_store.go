@@ -0,0 +1,293 @@
+package datastore
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"github.com/localopsco/go-sample/ent"
+	"github.com/localopsco/go-sample/ent/changesequence"
+	"github.com/localopsco/go-sample/ent/outboxevent"
+	"github.com/localopsco/go-sample/ent/predicate"
+	"github.com/localopsco/go-sample/models"
+)
+
+// OutboxStore keeps task events until they are published. Events are
+// appended with the client of the store, so a store created from a
+// transaction's client commits them together with the change they describe.
+type OutboxStore struct {
+	client *ent.Client
+}
+
+func NewOutboxStore(client *ent.Client) *OutboxStore {
+	return &OutboxStore{
+		client,
+	}
+}
+
+// Publish appends an event to the outbox.
+func (store *OutboxStore) Publish(ctx context.Context, event models.Event) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	return store.client.OutboxEvent.Create().
+		SetEventID(event.ID).
+		SetEventType(event.Type).
+		SetTaskID(event.TaskID).
+		SetPayload(payload).
+		Exec(ctx)
+}
+
+// Events that fail to publish are retried after outboxRetryBase, doubling
+// up to outboxRetryMax.
+const (
+	outboxRetryBase = time.Second
+	outboxRetryMax  = 5 * time.Minute
+)
+
+// relayedEvents names the change sequence that numbers relayed events.
+const relayedEvents = "relayed_events"
+
+// RelayBatch hands up to limit unprocessed events to publish, oldest first,
+// and marks those it accepts as processed. Events it rejects are kept, with
+// the error, to be tried again after a delay. The transaction of the batch
+// is in the context passed to publish and announce.
+//
+// The events are locked with FOR UPDATE SKIP LOCKED, so relays running
+// side by side share the work instead of waiting for each other. Only the
+// oldest unprocessed event of each task is eligible, which keeps the events
+// of a task in order even across relays.
+//
+// Once the batch is published, its accepted events are numbered with the
+// next relayed positions and handed to announce, which sees the position as
+// their Seq; publish sees a zero Seq. See numberRelayed.
+func (store *OutboxStore) RelayBatch(ctx context.Context, limit int, publish, announce func(ctx context.Context, event models.Event) error) (int, error) {
+	tx, err := store.client.Tx(ctx)
+	if err != nil {
+		return 0, err
+	}
+	processed, err := relayBatch(ent.NewTxContext(ctx, tx), tx.Client(), limit, publish, announce)
+	if err != nil {
+		return 0, rollback(tx, err)
+	}
+	return processed, tx.Commit()
+}
+
+func relayBatch(ctx context.Context, client *ent.Client, limit int, publish, announce func(ctx context.Context, event models.Event) error) (int, error) {
+	rows, err := client.OutboxEvent.Query().
+		Where(
+			outboxevent.ProcessedAtIsNil(),
+			outboxevent.AvailableAtLTE(time.Now()),
+			oldestOfTask(),
+		).
+		Order(outboxevent.ByID()).
+		Limit(limit).
+		Modify(skipLocked).
+		All(ctx)
+	if err != nil {
+		return 0, err
+	}
+	var ids []int
+	var events []*models.Event
+	for _, row := range rows {
+		event, err := convertEntOutboxEvent(row)
+		if err == nil {
+			err = publish(ctx, *event)
+		}
+		if err != nil {
+			err = client.OutboxEvent.UpdateOneID(row.ID).
+				AddAttempts(1).
+				SetLastError(err.Error()).
+				SetAvailableAt(time.Now().Add(outboxRetryDelay(row.Attempts + 1))).
+				Exec(ctx)
+			if err != nil {
+				return 0, err
+			}
+			continue
+		}
+		err = client.OutboxEvent.UpdateOneID(row.ID).
+			AddAttempts(1).
+			SetProcessedAt(time.Now()).
+			ClearLastError().
+			Exec(ctx)
+		if err != nil {
+			return 0, err
+		}
+		ids = append(ids, row.ID)
+		events = append(events, event)
+	}
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	return len(ids), numberRelayed(ctx, client, ids, events, announce)
+}
+
+// numberRelayed gives the events published by a batch, with the outbox
+// IDs ids, the next relayed positions. It locks the counter of positions
+// until the batch commits, so batches that finish side by side number
+// their events one after the other and positions become visible in order:
+// a stream that has seen position n will never later find an event at n or
+// below that it missed. Publishing happens before, outside the lock, and
+// only the numbering and announce wait for other relays.
+func numberRelayed(ctx context.Context, client *ent.Client, ids []int, events []*models.Event, announce func(ctx context.Context, event models.Event) error) error {
+	counter, err := client.ChangeSequence.Query().
+		Where(changesequence.Name(relayedEvents)).
+		Modify(forUpdate).
+		Only(ctx)
+	if err != nil {
+		return err
+	}
+	seq := int(counter.Value)
+	for i, id := range ids {
+		seq++
+		if err := client.OutboxEvent.UpdateOneID(id).SetRelayedSeq(seq).Exec(ctx); err != nil {
+			return err
+		}
+		events[i].Seq = seq
+	}
+	err = client.ChangeSequence.UpdateOne(counter).
+		SetValue(int64(seq)).
+		Exec(ctx)
+	if err != nil || announce == nil {
+		return err
+	}
+	for _, event := range events {
+		if err := announce(ctx, *event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetEvent returns the event relayed at position seq.
+func (store *OutboxStore) GetEvent(ctx context.Context, seq int) (*models.Event, error) {
+	row, err := store.client.OutboxEvent.Query().
+		Where(outboxevent.RelayedSeq(seq)).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return convertEntOutboxEvent(row)
+}
+
+// ListRelayedAfter returns up to limit events relayed after position seq,
+// in the order they were relayed.
+func (store *OutboxStore) ListRelayedAfter(ctx context.Context, seq, limit int) ([]*models.Event, error) {
+	rows, err := store.client.OutboxEvent.Query().
+		Where(outboxevent.RelayedSeqGT(seq)).
+		Order(outboxevent.ByRelayedSeq()).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	events := make([]*models.Event, 0, len(rows))
+	for _, row := range rows {
+		event, err := convertEntOutboxEvent(row)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+// LastRelayedSeq returns the position of the last event relayed, or zero if
+// none was.
+func (store *OutboxStore) LastRelayedSeq(ctx context.Context) (int, error) {
+	counter, err := store.client.ChangeSequence.Query().
+		Where(changesequence.Name(relayedEvents)).
+		Only(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return int(counter.Value), nil
+}
+
+// migrateRelayedSeq numbers the events relayed before relayed positions
+// existed by their place in the outbox, which is what streams resumed from
+// until then, and starts the counter after them.
+func migrateRelayedSeq(ctx context.Context, client *ent.Client) error {
+	exists, err := client.ChangeSequence.Query().
+		Where(changesequence.Name(relayedEvents)).
+		Exist(ctx)
+	if err != nil || exists {
+		return err
+	}
+	_, err = client.ExecContext(ctx, "UPDATE outbox SET relayed_seq = id WHERE processed_at IS NOT NULL AND relayed_seq IS NULL")
+	if err != nil {
+		return err
+	}
+	var last int64
+	row, err := client.OutboxEvent.Query().
+		Where(outboxevent.RelayedSeqNotNil()).
+		Order(outboxevent.ByRelayedSeq(sql.OrderDesc())).
+		First(ctx)
+	switch {
+	case err == nil:
+		last = int64(*row.RelayedSeq)
+	case !ent.IsNotFound(err):
+		return err
+	}
+	return client.ChangeSequence.Create().
+		SetName(relayedEvents).
+		SetValue(last).
+		Exec(ctx)
+}
+
+// DeleteProcessed deletes the events processed before a given time and
+// returns how many were deleted.
+func (store *OutboxStore) DeleteProcessed(ctx context.Context, before time.Time) (int, error) {
+	return store.client.OutboxEvent.Delete().
+		Where(outboxevent.ProcessedAtLT(before)).
+		Exec(ctx)
+}
+
+func outboxRetryDelay(attempts int) time.Duration {
+	if shift := attempts - 1; shift < 16 {
+		if delay := outboxRetryBase << shift; delay < outboxRetryMax {
+			return delay
+		}
+	}
+	return outboxRetryMax
+}
+
+// oldestOfTask matches the events that no earlier unprocessed event of the
+// same task precedes.
+func oldestOfTask() predicate.OutboxEvent {
+	return func(s *sql.Selector) {
+		earlier := sql.Table(outboxevent.Table).As("earlier")
+		s.Where(sql.NotExists(
+			sql.Select(earlier.C(outboxevent.FieldID)).
+				From(earlier).
+				Where(sql.And(
+					sql.ColumnsEQ(earlier.C(outboxevent.FieldTaskID), s.C(outboxevent.FieldTaskID)),
+					sql.IsNull(earlier.C(outboxevent.FieldProcessedAt)),
+					sql.ColumnsLT(earlier.C(outboxevent.FieldID), s.C(outboxevent.FieldID)),
+				)),
+		))
+	}
+}
+
+// skipLocked locks the selected rows, skipping those locked by another
+// transaction. SQLite has no row locks and serializes writers anyway.
+func skipLocked(s *sql.Selector) {
+	if s.Dialect() != dialect.SQLite {
+		s.ForUpdate(sql.WithLockAction(sql.SkipLocked))
+	}
+}
+
+func convertEntOutboxEvent(row *ent.OutboxEvent) (*models.Event, error) {
+	var event models.Event
+	if err := json.Unmarshal(row.Payload, &event); err != nil {
+		return nil, fmt.Errorf("decoding outbox event %d: %w", row.ID, err)
+	}
+	if row.RelayedSeq != nil {
+		event.Seq = *row.RelayedSeq
+	}
+	return &event, nil
+}
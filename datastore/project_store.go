@@ -0,0 +1,110 @@
+package datastore
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/ent"
+	"github.com/localopsco/go-sample/ent/project"
+	"github.com/localopsco/go-sample/models"
+)
+
+type ProjectStore struct {
+	client *ent.Client
+}
+
+func NewProjectStore(client *ent.Client) *ProjectStore {
+	return &ProjectStore{
+		client,
+	}
+}
+
+func (store *ProjectStore) CreateProject(ctx context.Context, p models.Project) (*models.Project, error) {
+	create := store.client.Project.Create().
+		SetName(p.Name).
+		SetDescription(p.Description).
+		SetWorkflowID(p.WorkflowID)
+	if len(p.WIPLimits) > 0 {
+		create.SetWipLimits(p.WIPLimits)
+	}
+	if p.WIPPolicy != "" {
+		create.SetWipPolicy(project.WipPolicy(p.WIPPolicy))
+	}
+	entProject, err := create.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return convertEntProject(entProject), nil
+}
+
+func (store *ProjectStore) GetProject(ctx context.Context, projectID uuid.UUID) (*models.Project, error) {
+	entProject, err := store.client.Project.Get(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	return convertEntProject(entProject), nil
+}
+
+func (store *ProjectStore) ListProjects(ctx context.Context) ([]*models.Project, error) {
+	entProjects, err := store.client.Project.Query().
+		Order(ent.Asc(project.FieldName)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	projects := make([]*models.Project, 0, len(entProjects))
+	for _, entProject := range entProjects {
+		projects = append(projects, convertEntProject(entProject))
+	}
+	return projects, nil
+}
+
+// ListProjectsByIDs returns the given projects, skipping IDs that do not
+// exist.
+func (store *ProjectStore) ListProjectsByIDs(ctx context.Context, projectIDs []uuid.UUID) ([]*models.Project, error) {
+	entProjects, err := store.client.Project.Query().
+		Where(project.IDIn(projectIDs...)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	projects := make([]*models.Project, 0, len(entProjects))
+	for _, entProject := range entProjects {
+		projects = append(projects, convertEntProject(entProject))
+	}
+	return projects, nil
+}
+
+// UpdateProject applies the non-nil fields of update to a project.
+func (store *ProjectStore) UpdateProject(ctx context.Context, update models.ProjectUpdate) (*models.Project, error) {
+	updateOne := store.client.Project.UpdateOneID(update.ID).
+		SetNillableName(update.Name).
+		SetNillableDescription(update.Description)
+	if update.WIPLimits != nil {
+		if len(*update.WIPLimits) == 0 {
+			updateOne.ClearWipLimits()
+		} else {
+			updateOne.SetWipLimits(*update.WIPLimits)
+		}
+	}
+	if update.WIPPolicy != nil {
+		updateOne.SetWipPolicy(project.WipPolicy(*update.WIPPolicy))
+	}
+	entProject, err := updateOne.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return convertEntProject(entProject), nil
+}
+
+func convertEntProject(entProject *ent.Project) *models.Project {
+	return &models.Project{
+		ID:          entProject.ID,
+		Name:        entProject.Name,
+		Description: entProject.Description,
+		WorkflowID:  entProject.WorkflowID,
+		WIPLimits:   entProject.WipLimits,
+		WIPPolicy:   string(entProject.WipPolicy),
+		CreatedAt:   entProject.CreatedAt,
+	}
+}
@@ -0,0 +1,435 @@
+package datastore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/ent"
+	"github.com/localopsco/go-sample/ent/hook"
+	"github.com/localopsco/go-sample/ent/predicate"
+	"github.com/localopsco/go-sample/ent/task"
+	"github.com/localopsco/go-sample/models"
+)
+
+// Ranks order tasks within a board column, which is the set of tasks sharing
+// a project and a status. A rank is a fraction in base 36 written without
+// the leading "0.", so that comparing ranks as strings compares the
+// fractions, and there is always room for a new rank between two others.
+// Moving a task therefore only rewrites that task's rank. Ranks use only
+// digits and lower-case letters, which sort the same under every collation.
+const rankDigits = "0123456789abcdefghijklmnopqrstuvwxyz"
+
+// maxRankLength is the longest rank a move may produce. Repeatedly moving
+// tasks into the same gap, or appending to a long column, makes ranks
+// longer; once one would exceed this, the column is rebalanced to evenly
+// spaced short ranks.
+const maxRankLength = 24
+
+// rankBetween returns a rank sorting after lower and before upper. An empty
+// lower or upper leaves that side unbounded. It returns false if there is
+// no room, because lower does not sort before upper.
+func rankBetween(lower, upper string) (string, bool) {
+	if upper != "" && lower >= upper {
+		return "", false
+	}
+	return rankMidpoint(lower, upper), true
+}
+
+// rankMidpoint implements rankBetween for valid ranks, which never end in
+// the zero digit.
+func rankMidpoint(lower, upper string) string {
+	if upper != "" {
+		// Keep the prefix the bounds share, treating lower as padded with
+		// zeros.
+		n := 0
+		for n < len(upper) && rankDigitAt(lower, n) == upper[n] {
+			n++
+		}
+		if n > 0 {
+			return upper[:n] + rankMidpoint(lowerSuffix(lower, n), upper[n:])
+		}
+	}
+	digitLower := 0
+	if lower != "" {
+		digitLower = strings.IndexByte(rankDigits, lower[0])
+	}
+	digitUpper := len(rankDigits)
+	if upper != "" {
+		digitUpper = strings.IndexByte(rankDigits, upper[0])
+	}
+	if digitUpper-digitLower > 1 {
+		return string(rankDigits[(digitLower+digitUpper+1)/2])
+	}
+	if len(upper) > 1 {
+		return upper[:1]
+	}
+	return string(rankDigits[digitLower]) + rankMidpoint(lowerSuffix(lower, 1), "")
+}
+
+func rankDigitAt(rank string, i int) byte {
+	if i < len(rank) {
+		return rank[i]
+	}
+	return rankDigits[0]
+}
+
+func lowerSuffix(rank string, n int) string {
+	if n >= len(rank) {
+		return ""
+	}
+	return rank[n:]
+}
+
+// rankAfter returns a rank sorting after rank, or the first rank of a column
+// if rank is empty. It grows more slowly than rankBetween(rank, ""), since
+// appending is the most common way to rank a task.
+func rankAfter(rank string) string {
+	for i := len(rank) - 1; i >= 0; i-- {
+		if d := strings.IndexByte(rankDigits, rank[i]); d < len(rankDigits)-1 {
+			return rank[:i] + string(rankDigits[d+1])
+		}
+	}
+	return rank + string(rankDigits[len(rankDigits)/2])
+}
+
+// spacedRanks returns n ranks in ascending order, spread evenly so that
+// later moves have room on either side of each.
+func spacedRanks(n int) []string {
+	width, capacity := 1, len(rankDigits)
+	for capacity <= n*2 {
+		width++
+		capacity *= len(rankDigits)
+	}
+	step := capacity / (n + 1)
+	ranks := make([]string, n)
+	for i := range ranks {
+		value := (i + 1) * step
+		digits := make([]byte, width)
+		for j := width - 1; j >= 0; j-- {
+			digits[j] = rankDigits[value%len(rankDigits)]
+			value /= len(rankDigits)
+		}
+		ranks[i] = strings.TrimRight(string(digits), rankDigits[:1])
+	}
+	return ranks
+}
+
+// rankScope matches the tasks in the board column of a project and status.
+func rankScope(projectID *uuid.UUID, statusID uuid.UUID) predicate.Task {
+	if projectID == nil {
+		return task.And(task.ProjectIDIsNil(), task.StatusID(statusID))
+	}
+	return task.And(task.ProjectID(*projectID), task.StatusID(statusID))
+}
+
+// lastRank returns the highest rank in a column, or "" if it is empty.
+func lastRank(ctx context.Context, client *ent.Client, scope predicate.Task) (string, error) {
+	last, err := client.Task.Query().
+		Where(scope, task.RankNEQ("")).
+		Order(task.ByRank(sql.OrderDesc())).
+		Select(task.FieldRank).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return last.Rank, nil
+}
+
+// rankAtEnd returns a rank placing a task last in a column, rebalancing the
+// column first if that rank would be too long.
+func rankAtEnd(ctx context.Context, client *ent.Client, scope predicate.Task) (string, error) {
+	last, err := lastRank(ctx, client, scope)
+	if err != nil {
+		return "", err
+	}
+	if rank := rankAfter(last); len(rank) <= maxRankLength {
+		return rank, nil
+	}
+	if err := rebalanceRanks(ctx, client, scope); err != nil {
+		return "", err
+	}
+	last, err = lastRank(ctx, client, scope)
+	if err != nil {
+		return "", err
+	}
+	return rankAfter(last), nil
+}
+
+// assignRanks places new tasks, and tasks moved to another status, last in
+// their column unless the mutation sets a rank itself.
+func assignRanks(next ent.Mutator) ent.Mutator {
+	return hook.TaskFunc(func(ctx context.Context, m *ent.TaskMutation) (ent.Value, error) {
+		// Creates see the default empty rank as set.
+		if rank, ok := m.Rank(); ok && rank != "" {
+			return next.Mutate(ctx, m)
+		}
+		statusID, ok := m.StatusID()
+		if !ok {
+			return next.Mutate(ctx, m)
+		}
+		var projectID *uuid.UUID
+		switch {
+		case m.Op().Is(ent.OpCreate):
+			if id, ok := m.ProjectID(); ok {
+				projectID = &id
+			}
+		case m.Op().Is(ent.OpUpdateOne):
+			oldStatusID, err := m.OldStatusID(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if oldStatusID == statusID {
+				return next.Mutate(ctx, m)
+			}
+			if projectID, err = m.OldProjectID(ctx); err != nil {
+				return nil, err
+			}
+		default:
+			return next.Mutate(ctx, m)
+		}
+		rank, err := rankAtEnd(ctx, m.Client(), rankScope(projectID, statusID))
+		if err != nil {
+			return nil, err
+		}
+		m.SetRank(rank)
+		return next.Mutate(ctx, m)
+	})
+}
+
+// rebalanceRanks gives the tasks of a column evenly spaced ranks, keeping
+// their order.
+func rebalanceRanks(ctx context.Context, client *ent.Client, scope predicate.Task) error {
+	entTasks, err := client.Task.Query().
+		Where(scope).
+		Order(task.ByRank(), task.ByCreatedAt(), task.ByID()).
+		Select(task.FieldID).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	for i, rank := range spacedRanks(len(entTasks)) {
+		if err := client.Task.UpdateOneID(entTasks[i].ID).SetRank(rank).Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// migrateRanks ranks the tasks created before ranks existed. They are
+// placed after any ranked tasks of their column, in order of creation, and
+// the column is given evenly spaced ranks.
+func migrateRanks(ctx context.Context, client *ent.Client) error {
+	ctx = withDeleted(ctx)
+	unranked, err := client.Task.Query().
+		Where(task.Rank("")).
+		Order(task.ByCreatedAt(), task.ByID()).
+		Select(task.FieldID, task.FieldProjectID, task.FieldStatusID).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("loading unranked tasks: %w", err)
+	}
+	type column struct {
+		projectID *uuid.UUID
+		statusID  uuid.UUID
+		ids       []uuid.UUID
+	}
+	var columns []*column
+	byKey := make(map[[2]uuid.UUID]*column)
+	for _, entTask := range unranked {
+		key := [2]uuid.UUID{{}, entTask.StatusID}
+		if entTask.ProjectID != nil {
+			key[0] = *entTask.ProjectID
+		}
+		col, ok := byKey[key]
+		if !ok {
+			col = &column{projectID: entTask.ProjectID, statusID: entTask.StatusID}
+			byKey[key] = col
+			columns = append(columns, col)
+		}
+		col.ids = append(col.ids, entTask.ID)
+	}
+	for _, col := range columns {
+		ranked, err := client.Task.Query().
+			Where(rankScope(col.projectID, col.statusID), task.RankNEQ("")).
+			Order(task.ByRank(), task.ByID()).
+			IDs(ctx)
+		if err != nil {
+			return fmt.Errorf("ranking tasks: %w", err)
+		}
+		ids := append(ranked, col.ids...)
+		for i, rank := range spacedRanks(len(ids)) {
+			if err := client.Task.UpdateOneID(ids[i]).SetRank(rank).Exec(ctx); err != nil {
+				return fmt.Errorf("ranking tasks: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+// ErrAnchorColumn is returned when a task is moved next to a task in
+// another column.
+var ErrAnchorColumn = errors.New("anchor task is in another column")
+
+// ErrAnchorOrder is returned when a task is moved between two tasks that are
+// not in ascending order.
+var ErrAnchorOrder = errors.New("after anchor does not come before the before anchor")
+
+// ErrAnchorNotFound is returned when an anchor of a move does not exist.
+var ErrAnchorNotFound = errors.New("anchor task not found")
+
+// errNoRankRoom means a move needs the column to be rebalanced first.
+var errNoRankRoom = errors.New("no room between ranks")
+
+// MoveTask ranks a task right after move.After and before move.Before
+// within its column. With one anchor, the task is placed next to it. The
+// task first gets the status or labels of target, if any, and is placed
+// last in its new column when there is no anchor. Everything happens in one
+// transaction, which fails with ErrWIPLimitExceeded if target enforces a
+// WIP limit that the move exceeds.
+func (store *TaskStore) MoveTask(ctx context.Context, move models.TaskMove, target models.ColumnTarget) (*models.Task, error) {
+	err := withTx(ctx, store.client, func(client *ent.Client) error {
+		return moveTask(ctx, client, move, target)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return store.GetTask(ctx, move.ID)
+}
+
+func moveTask(ctx context.Context, client *ent.Client, move models.TaskMove, target models.ColumnTarget) error {
+	moved, err := client.Task.Get(ctx, move.ID)
+	if err != nil {
+		return err
+	}
+	statusID := moved.StatusID
+	if target.Status != nil {
+		statusID = target.Status.ID
+	}
+	scope := rankScope(moved.ProjectID, statusID)
+	rank := moved.Rank
+	switch {
+	case move.After != nil || move.Before != nil:
+		rank, err = rankForMove(ctx, client, scope, move)
+		if errors.Is(err, errNoRankRoom) {
+			if err := rebalanceRanks(ctx, client, scope); err != nil {
+				return err
+			}
+			rank, err = rankForMove(ctx, client, scope, move)
+		}
+	case statusID != moved.StatusID:
+		rank, err = rankAtEnd(ctx, client, scope)
+	}
+	if err != nil {
+		return err
+	}
+	update := client.Task.UpdateOneID(move.ID).SetRank(rank)
+	if statusID != moved.StatusID {
+		update.
+			SetStatusID(statusID).
+			SetStatusCategory(task.StatusCategory(target.Status.Category))
+	}
+	if target.Labels != nil {
+		if len(*target.Labels) == 0 {
+			update.ClearLabels()
+		} else {
+			update.SetLabels(*target.Labels)
+		}
+	}
+	if err := update.Exec(ctx); err != nil {
+		return err
+	}
+	if target.WIPLimit == 0 || !target.EnforceWIP || moved.ProjectID == nil {
+		return nil
+	}
+	count, err := countColumnWIP(ctx, client, *moved.ProjectID, target)
+	if err != nil {
+		return err
+	}
+	if count > target.WIPLimit {
+		return ErrWIPLimitExceeded
+	}
+	return nil
+}
+
+// rankForMove finds the rank between the anchors of a move, or next to its
+// only anchor.
+func rankForMove(ctx context.Context, client *ent.Client, scope predicate.Task, move models.TaskMove) (string, error) {
+	var lower, upper string
+	if move.After != nil {
+		anchor, err := moveAnchor(ctx, client, scope, *move.After)
+		if err != nil {
+			return "", err
+		}
+		lower = anchor.Rank
+		if move.Before == nil {
+			next, err := client.Task.Query().
+				Where(scope, task.RankGT(lower), task.IDNEQ(move.ID)).
+				Order(task.ByRank()).
+				Select(task.FieldRank).
+				First(ctx)
+			if err != nil && !ent.IsNotFound(err) {
+				return "", err
+			}
+			if next != nil {
+				upper = next.Rank
+			}
+		}
+	}
+	if move.Before != nil {
+		anchor, err := moveAnchor(ctx, client, scope, *move.Before)
+		if err != nil {
+			return "", err
+		}
+		upper = anchor.Rank
+		if move.After == nil {
+			prev, err := client.Task.Query().
+				Where(scope, task.RankLT(upper), task.IDNEQ(move.ID)).
+				Order(task.ByRank(sql.OrderDesc())).
+				Select(task.FieldRank).
+				First(ctx)
+			if err != nil && !ent.IsNotFound(err) {
+				return "", err
+			}
+			if prev != nil {
+				lower = prev.Rank
+			}
+		}
+	}
+	if move.After != nil && move.Before != nil && lower > upper {
+		return "", ErrAnchorOrder
+	}
+	return rankInGap(lower, upper)
+}
+
+// rankInGap returns rankBetween(lower, upper), or errNoRankRoom if there is
+// no room or the rank would be longer than maxRankLength.
+func rankInGap(lower, upper string) (string, error) {
+	rank, ok := rankBetween(lower, upper)
+	if !ok || len(rank) > maxRankLength {
+		return "", errNoRankRoom
+	}
+	return rank, nil
+}
+
+func moveAnchor(ctx context.Context, client *ent.Client, scope predicate.Task, anchorID uuid.UUID) (*ent.Task, error) {
+	if _, err := client.Task.Get(ctx, anchorID); err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrAnchorNotFound
+		}
+		return nil, err
+	}
+	anchor, err := client.Task.Query().
+		Where(task.ID(anchorID), scope).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrAnchorColumn
+	}
+	return anchor, err
+}
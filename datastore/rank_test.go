@@ -0,0 +1,194 @@
+package datastore
+
+import (
+	"errors"
+	"math/rand"
+	"sort"
+	"strings"
+	"testing"
+)
+
+// randomRank returns a valid rank of up to maxLength digits, which is never
+// empty and never ends in the zero digit.
+func randomRank(r *rand.Rand, maxLength int) string {
+	var b strings.Builder
+	for i, n := 0, 1+r.Intn(maxLength); i < n; i++ {
+		b.WriteByte(rankDigits[r.Intn(len(rankDigits))])
+	}
+	rank := strings.TrimRight(b.String(), rankDigits[:1])
+	if rank == "" {
+		return rankDigits[1:2]
+	}
+	return rank
+}
+
+// checkRank fails unless rank is a valid rank strictly between lower and
+// upper, where an empty bound is unbounded.
+func checkRank(t *testing.T, lower, upper, rank string) {
+	t.Helper()
+	if rank == "" || strings.HasSuffix(rank, rankDigits[:1]) {
+		t.Fatalf("rankBetween(%q, %q) = %q, want a rank not ending in %q", lower, upper, rank, rankDigits[:1])
+	}
+	if strings.Trim(rank, rankDigits) != "" {
+		t.Fatalf("rankBetween(%q, %q) = %q, which has digits outside %q", lower, upper, rank, rankDigits)
+	}
+	if rank <= lower || (upper != "" && rank >= upper) {
+		t.Fatalf("rankBetween(%q, %q) = %q, which is not between them", lower, upper, rank)
+	}
+}
+
+func TestRankBetween(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 10000; i++ {
+		lower, upper := randomRank(r, 6), randomRank(r, 6)
+		switch {
+		case lower == upper:
+			continue
+		case lower > upper:
+			lower, upper = upper, lower
+		}
+		switch i % 4 {
+		case 1:
+			lower = ""
+		case 2:
+			upper = ""
+		}
+		rank, ok := rankBetween(lower, upper)
+		if !ok {
+			t.Fatalf("rankBetween(%q, %q) found no room", lower, upper)
+		}
+		checkRank(t, lower, upper, rank)
+	}
+}
+
+func TestRankBetweenEdges(t *testing.T) {
+	tests := []struct {
+		lower, upper string
+	}{
+		{"", ""},
+		{"", "1"},
+		{"", "01"},
+		{"", "001"},
+		{"z", ""},
+		{"zzz", ""},
+		{"1", "2"},
+		{"1", "11"},
+		{"1", "101"},
+		{"y", "z"},
+		{"yz", "z"},
+		{"yzzz", "z"},
+		{"a", "a1"},
+		{"a", "a01"},
+		{"a0001", "a001"},
+	}
+	for _, tt := range tests {
+		rank, ok := rankBetween(tt.lower, tt.upper)
+		if !ok {
+			t.Fatalf("rankBetween(%q, %q) found no room", tt.lower, tt.upper)
+		}
+		checkRank(t, tt.lower, tt.upper, rank)
+	}
+	for _, bounds := range [][2]string{{"b", "a"}, {"a", "a"}} {
+		if rank, ok := rankBetween(bounds[0], bounds[1]); ok {
+			t.Errorf("rankBetween(%q, %q) = %q, want no room", bounds[0], bounds[1], rank)
+		}
+	}
+}
+
+func TestRankInGapRepeatedInserts(t *testing.T) {
+	tests := []struct {
+		name string
+		// after reports whether the next rank goes right after the rank
+		// just inserted rather than right before it.
+		after func(inserts int) bool
+	}{
+		{"always first in the gap", func(int) bool { return false }},
+		{"always last in the gap", func(int) bool { return true }},
+		{"alternating", func(inserts int) bool { return inserts%2 == 0 }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// The gap is between column[at-1] and column[at].
+			column, at := []string{"a", "b"}, 1
+			for inserts := 0; ; inserts++ {
+				lower, upper := column[at-1], column[at]
+				rank, err := rankInGap(lower, upper)
+				if errors.Is(err, errNoRankRoom) {
+					// There is room for a rank, but it is too long.
+					if rank, ok := rankBetween(lower, upper); !ok || len(rank) <= maxRankLength {
+						t.Fatalf("rankInGap(%q, %q) found no room for %q", lower, upper, rank)
+					}
+					if inserts < maxRankLength {
+						t.Fatalf("ran out of room after %d inserts", inserts)
+					}
+					return
+				}
+				if err != nil {
+					t.Fatal(err)
+				}
+				checkRank(t, lower, upper, rank)
+				if len(rank) > maxRankLength {
+					t.Fatalf("rankInGap(%q, %q) = %q, longer than %d", lower, upper, rank, maxRankLength)
+				}
+				column = append(column[:at], append([]string{rank}, column[at:]...)...)
+				if !sort.StringsAreSorted(column) {
+					t.Fatalf("column is out of order after inserting %q: %q", rank, column)
+				}
+				if tt.after(inserts) {
+					at++
+				}
+				if inserts > len(rankDigits)*maxRankLength {
+					t.Fatalf("%d inserts into one gap never ran out of room", inserts)
+				}
+			}
+		})
+	}
+}
+
+func TestRankInGapInsertOrder(t *testing.T) {
+	// Inserting at random places keeps a column sorted, rebalancing it
+	// when a gap runs out of room as MoveTask does.
+	r := rand.New(rand.NewSource(2))
+	column := []string{"a", "b"}
+	for i := 0; i < 200; i++ {
+		at := 1 + r.Intn(len(column)-1)
+		rank, err := rankInGap(column[at-1], column[at])
+		if errors.Is(err, errNoRankRoom) {
+			column = spacedRanks(len(column))
+			continue
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		checkRank(t, column[at-1], column[at], rank)
+		column = append(column[:at], append([]string{rank}, column[at:]...)...)
+		if !sort.StringsAreSorted(column) {
+			t.Fatalf("column is out of order after inserting %q: %q", rank, column)
+		}
+	}
+}
+
+func TestRankAfter(t *testing.T) {
+	rank := ""
+	for i := 0; i < 1000; i++ {
+		next := rankAfter(rank)
+		checkRank(t, rank, "", next)
+		rank = next
+	}
+}
+
+func TestSpacedRanks(t *testing.T) {
+	for _, n := range []int{0, 1, 2, 17, 18, 35, 36, 648, 649, 5000} {
+		ranks := spacedRanks(n)
+		if len(ranks) != n {
+			t.Fatalf("spacedRanks(%d) returned %d ranks", n, len(ranks))
+		}
+		for i, rank := range ranks {
+			lower := ""
+			if i > 0 {
+				lower = ranks[i-1]
+			}
+			checkRank(t, lower, "", rank)
+		}
+	}
+}
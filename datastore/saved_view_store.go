@@ -0,0 +1,105 @@
+package datastore
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/ent"
+	"github.com/localopsco/go-sample/ent/savedview"
+	"github.com/localopsco/go-sample/models"
+)
+
+type SavedViewStore struct {
+	client *ent.Client
+}
+
+func NewSavedViewStore(client *ent.Client) *SavedViewStore {
+	return &SavedViewStore{
+		client,
+	}
+}
+
+func (store *SavedViewStore) CreateSavedView(ctx context.Context, v models.SavedView) (*models.SavedView, error) {
+	entView, err := store.client.SavedView.Create().
+		SetName(v.Name).
+		SetQuery(v.Query).
+		SetFilters(v.Filters).
+		SetSort(v.Sort).
+		SetVisibility(savedview.Visibility(v.Visibility)).
+		SetOwnerID(v.OwnerID).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return convertEntSavedView(entView), nil
+}
+
+func (store *SavedViewStore) GetSavedView(ctx context.Context, viewID uuid.UUID) (*models.SavedView, error) {
+	entView, err := store.client.SavedView.Get(ctx, viewID)
+	if err != nil {
+		return nil, err
+	}
+	return convertEntSavedView(entView), nil
+}
+
+// ListSavedViews returns the views of a user along with the views others
+// have shared, by name.
+func (store *SavedViewStore) ListSavedViews(ctx context.Context, userID uuid.UUID) ([]*models.SavedView, error) {
+	entViews, err := store.client.SavedView.Query().
+		Where(savedview.Or(
+			savedview.OwnerID(userID),
+			savedview.VisibilityEQ(savedview.VisibilityShared),
+		)).
+		Order(ent.Asc(savedview.FieldName), ent.Asc(savedview.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	views := make([]*models.SavedView, 0, len(entViews))
+	for _, entView := range entViews {
+		views = append(views, convertEntSavedView(entView))
+	}
+	return views, nil
+}
+
+func (store *SavedViewStore) UpdateSavedView(ctx context.Context, v models.SavedViewUpdate) (*models.SavedView, error) {
+	update := store.client.SavedView.UpdateOneID(v.ID)
+	if v.Name != nil {
+		update.SetName(*v.Name)
+	}
+	if v.Query != nil {
+		update.SetQuery(*v.Query)
+	}
+	if v.Filters != nil {
+		update.SetFilters(*v.Filters)
+	}
+	if v.Sort != nil {
+		update.SetSort(*v.Sort)
+	}
+	if v.Visibility != nil {
+		update.SetVisibility(savedview.Visibility(*v.Visibility))
+	}
+	entView, err := update.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return convertEntSavedView(entView), nil
+}
+
+func (store *SavedViewStore) DeleteSavedView(ctx context.Context, viewID uuid.UUID) error {
+	return store.client.SavedView.DeleteOneID(viewID).Exec(ctx)
+}
+
+func convertEntSavedView(entView *ent.SavedView) *models.SavedView {
+	return &models.SavedView{
+		ID:         entView.ID,
+		Name:       entView.Name,
+		Query:      entView.Query,
+		Filters:    entView.Filters,
+		Sort:       entView.Sort,
+		Visibility: entView.Visibility.String(),
+		OwnerID:    entView.OwnerID,
+		CreatedAt:  entView.CreatedAt,
+		UpdatedAt:  entView.UpdatedAt,
+	}
+}
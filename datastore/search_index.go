@@ -0,0 +1,185 @@
+package datastore
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/ent"
+	"github.com/localopsco/go-sample/ent/comment"
+	"github.com/localopsco/go-sample/ent/hook"
+	"github.com/localopsco/go-sample/ent/task"
+)
+
+// searchIndex is an in-memory inverted index of task titles, descriptions
+// and comments, used for full-text search on databases without Postgres'
+// text search. Hooks reindex a task whenever it or one of its comments
+// changes.
+type searchIndex struct {
+	mu sync.RWMutex
+	// postings maps each word to the weighted number of times it occurs
+	// in each task.
+	postings map[string]map[uuid.UUID]float64
+	// words lists the words indexed for each task, for removal.
+	words map[uuid.UUID][]string
+}
+
+func newSearchIndex(ctx context.Context, client *ent.Client) (*searchIndex, error) {
+	index := &searchIndex{
+		postings: make(map[string]map[uuid.UUID]float64),
+		words:    make(map[uuid.UUID][]string),
+	}
+	ids, err := client.Task.Query().IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := index.reindex(ctx, client, ids...); err != nil {
+		return nil, err
+	}
+	client.Task.Use(index.reindexTasks)
+	client.Comment.Use(index.reindexCommentTasks)
+	return index, nil
+}
+
+// search returns the tasks containing a word starting with every term,
+// scored by the weighted number of matching words.
+func (index *searchIndex) search(terms []string) map[uuid.UUID]float64 {
+	index.mu.RLock()
+	defer index.mu.RUnlock()
+	var scores map[uuid.UUID]float64
+	for _, term := range terms {
+		termScores := make(map[uuid.UUID]float64)
+		for word, postings := range index.postings {
+			if !strings.HasPrefix(word, term) {
+				continue
+			}
+			for id, weight := range postings {
+				termScores[id] += weight
+			}
+		}
+		if scores == nil {
+			scores = termScores
+			continue
+		}
+		for id, score := range scores {
+			if termScore, ok := termScores[id]; ok {
+				scores[id] = score + termScore
+			} else {
+				delete(scores, id)
+			}
+		}
+	}
+	return scores
+}
+
+// reindex replaces the index entries of the given tasks with their current
+// content. Tasks that no longer exist or are in the trash are dropped.
+func (index *searchIndex) reindex(ctx context.Context, client *ent.Client, taskIDs ...uuid.UUID) error {
+	if len(taskIDs) == 0 {
+		return nil
+	}
+	entTasks, err := client.Task.Query().
+		Where(task.IDIn(taskIDs...)).
+		WithComments(func(q *ent.CommentQuery) {
+			q.Select(comment.FieldTaskID, comment.FieldBody)
+		}).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	counts := make(map[uuid.UUID]map[string]float64, len(entTasks))
+	for _, entTask := range entTasks {
+		taskCounts := make(map[string]float64)
+		countWords(taskCounts, entTask.Title, titleWeight)
+		countWords(taskCounts, entTask.Description, descriptionWeight)
+		for _, entComment := range entTask.Edges.Comments {
+			countWords(taskCounts, entComment.Body, commentWeight)
+		}
+		counts[entTask.ID] = taskCounts
+	}
+
+	index.mu.Lock()
+	defer index.mu.Unlock()
+	for _, id := range taskIDs {
+		for _, word := range index.words[id] {
+			delete(index.postings[word], id)
+			if len(index.postings[word]) == 0 {
+				delete(index.postings, word)
+			}
+		}
+		delete(index.words, id)
+		words := make([]string, 0, len(counts[id]))
+		for word, weight := range counts[id] {
+			if index.postings[word] == nil {
+				index.postings[word] = make(map[uuid.UUID]float64)
+			}
+			index.postings[word][id] = weight
+			words = append(words, word)
+		}
+		if len(words) > 0 {
+			sort.Strings(words)
+			index.words[id] = words
+		}
+	}
+	return nil
+}
+
+func countWords(counts map[string]float64, text string, weight float64) {
+	for _, word := range tokenize(text) {
+		counts[word] += weight
+	}
+}
+
+// reindexTasks keeps the index in sync with task mutations.
+func (index *searchIndex) reindexTasks(next ent.Mutator) ent.Mutator {
+	return hook.TaskFunc(func(ctx context.Context, m *ent.TaskMutation) (ent.Value, error) {
+		var ids []uuid.UUID
+		if !m.Op().Is(ent.OpCreate) {
+			var err error
+			if ids, err = m.IDs(withDeleted(ctx)); err != nil {
+				return nil, err
+			}
+		}
+		v, err := next.Mutate(ctx, m)
+		if err != nil {
+			return nil, err
+		}
+		if created, ok := v.(*ent.Task); ok && m.Op().Is(ent.OpCreate) {
+			ids = append(ids, created.ID)
+		}
+		return v, index.reindex(ctx, m.Client(), ids...)
+	})
+}
+
+// reindexCommentTasks reindexes the tasks whose comments a mutation changes.
+func (index *searchIndex) reindexCommentTasks(next ent.Mutator) ent.Mutator {
+	return hook.CommentFunc(func(ctx context.Context, m *ent.CommentMutation) (ent.Value, error) {
+		var taskIDs []uuid.UUID
+		if !m.Op().Is(ent.OpCreate) {
+			ids, err := m.IDs(ctx)
+			if err != nil {
+				return nil, err
+			}
+			entComments, err := m.Client().Comment.Query().
+				Where(comment.IDIn(ids...)).
+				Select(comment.FieldTaskID).
+				All(ctx)
+			if err != nil {
+				return nil, err
+			}
+			for _, entComment := range entComments {
+				taskIDs = append(taskIDs, entComment.TaskID)
+			}
+		}
+		v, err := next.Mutate(ctx, m)
+		if err != nil {
+			return nil, err
+		}
+		if taskID, ok := m.TaskID(); ok {
+			taskIDs = append(taskIDs, taskID)
+		}
+		return v, index.reindex(ctx, m.Client(), taskIDs...)
+	})
+}
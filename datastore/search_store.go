@@ -0,0 +1,256 @@
+package datastore
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/localopsco/go-sample/ent"
+	"github.com/localopsco/go-sample/ent/comment"
+	"github.com/localopsco/go-sample/ent/task"
+	"github.com/localopsco/go-sample/models"
+)
+
+// SearchStore runs full-text searches over tasks and their comments. On
+// Postgres it uses the search_vector columns created by migrateSearch;
+// other databases fall back to an in-memory inverted index.
+type SearchStore struct {
+	client *ent.Client
+	tasks  *TaskStore
+	index  *searchIndex
+}
+
+func NewSearchStore(ctx context.Context, client *ent.Client, taskStore *TaskStore, driverName string) (*SearchStore, error) {
+	store := &SearchStore{
+		client: client,
+		tasks:  taskStore,
+	}
+	if driverName != dialect.Postgres {
+		index, err := newSearchIndex(ctx, client)
+		if err != nil {
+			return nil, fmt.Errorf("building search index: %w", err)
+		}
+		store.index = index
+	}
+	return store, nil
+}
+
+// Search returns a page of the tasks matching every word of query as a
+// prefix and the list filter, best matches first.
+func (store *SearchStore) Search(ctx context.Context, query string, filter models.TaskFilter, limit, offset int) (*models.Page[*models.SearchResult], error) {
+	terms := searchTerms(query)
+	if len(terms) == 0 {
+		return &models.Page[*models.SearchResult]{Items: []*models.SearchResult{}, Limit: limit, Offset: offset}, nil
+	}
+	if store.index != nil {
+		return store.searchIndex(ctx, terms, filter, limit, offset)
+	}
+	return store.searchPostgres(ctx, terms, filter, limit, offset)
+}
+
+func (store *SearchStore) searchPostgres(ctx context.Context, terms []string, filter models.TaskFilter, limit, offset int) (*models.Page[*models.SearchResult], error) {
+	// Terms only contain letters and digits, so the tsquery can be
+	// embedded as a literal.
+	tsquery := fmt.Sprintf("to_tsquery('english', '%s')", prefixTSQuery(terms))
+	rank := func(s *sql.Selector) string {
+		return fmt.Sprintf(
+			"ts_rank(%s, %s) + COALESCE((SELECT MAX(ts_rank(c.search_vector, %s)) FROM %s c WHERE c.%s = %s AND c.search_vector @@ %s), 0)",
+			s.C("search_vector"), tsquery, tsquery, comment.Table, comment.FieldTaskID, s.C(task.FieldID), tsquery,
+		)
+	}
+	predicates, err := filterPredicates(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	query := store.client.Task.Query().
+		Where(predicates...).
+		Where(func(s *sql.Selector) {
+			s.Where(sql.ExprP(fmt.Sprintf(
+				"(%s @@ %s OR EXISTS (SELECT 1 FROM %s c WHERE c.%s = %s AND c.search_vector @@ %s))",
+				s.C("search_vector"), tsquery, comment.Table, comment.FieldTaskID, s.C(task.FieldID), tsquery,
+			)))
+		})
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var ranked []struct {
+		ID   uuid.UUID `json:"id"`
+		Rank float64   `json:"rank"`
+	}
+	err = query.
+		Limit(limit).
+		Offset(offset).
+		Modify(func(s *sql.Selector) {
+			s.Select(s.C(task.FieldID)).
+				AppendSelectExprAs(sql.Expr(rank(s)), "rank").
+				OrderExpr(sql.Expr("rank DESC"), sql.Expr(s.C(task.FieldCreatedAt)+" DESC"))
+		}).
+		Scan(ctx, &ranked)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]uuid.UUID, 0, len(ranked))
+	ranks := make(map[uuid.UUID]float64, len(ranked))
+	for _, r := range ranked {
+		ids = append(ids, r.ID)
+		ranks[r.ID] = r.Rank
+	}
+	snippets, err := store.headlines(ctx, tsquery, ids)
+	if err != nil {
+		return nil, err
+	}
+	return store.results(ctx, ids, ranks, snippets, total, limit, offset)
+}
+
+// headlines asks Postgres for the marked-up excerpts of the matching tasks
+// and of their best matching comment.
+func (store *SearchStore) headlines(ctx context.Context, tsquery string, ids []uuid.UUID) (map[uuid.UUID]*models.SearchSnippets, error) {
+	snippets := make(map[uuid.UUID]*models.SearchSnippets, len(ids))
+	if len(ids) == 0 {
+		return snippets, nil
+	}
+	idStrings := make([]string, 0, len(ids))
+	for _, id := range ids {
+		idStrings = append(idStrings, id.String())
+		snippets[id] = &models.SearchSnippets{}
+	}
+	options := fmt.Sprintf("StartSel=%s, StopSel=%s, MaxWords=30, MinWords=10", matchStart, matchStop)
+
+	rows, err := store.client.QueryContext(ctx, fmt.Sprintf(
+		"SELECT id, ts_headline('english', title, %[1]s, $1), ts_headline('english', description, %[1]s, $1) FROM %[2]s WHERE id = ANY($2::uuid[])",
+		tsquery, task.Table,
+	), options, pq.Array(idStrings))
+	if err != nil {
+		return nil, err
+	}
+	for rows.Next() {
+		var id uuid.UUID
+		var title, description string
+		if err := rows.Scan(&id, &title, &description); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		snippets[id].Title = snippetHTML(title)
+		snippets[id].Description = snippetHTML(description)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	rows, err = store.client.QueryContext(ctx, fmt.Sprintf(
+		"SELECT DISTINCT ON (%[3]s) %[3]s, ts_headline('english', body, %[1]s, $1) FROM %[2]s WHERE %[3]s = ANY($2::uuid[]) AND search_vector @@ %[1]s ORDER BY %[3]s, ts_rank(search_vector, %[1]s) DESC",
+		tsquery, comment.Table, comment.FieldTaskID,
+	), options, pq.Array(idStrings))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var id uuid.UUID
+		var body string
+		if err := rows.Scan(&id, &body); err != nil {
+			return nil, err
+		}
+		snippets[id].Comment = snippetHTML(body)
+	}
+	return snippets, rows.Err()
+}
+
+func (store *SearchStore) searchIndex(ctx context.Context, terms []string, filter models.TaskFilter, limit, offset int) (*models.Page[*models.SearchResult], error) {
+	scores := store.index.search(terms)
+	candidates := make([]uuid.UUID, 0, len(scores))
+	for id := range scores {
+		candidates = append(candidates, id)
+	}
+	predicates, err := filterPredicates(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	entTasks, err := store.client.Task.Query().
+		Where(predicates...).
+		Where(task.IDIn(candidates...)).
+		Select(task.FieldID, task.FieldCreatedAt).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(entTasks, func(i, j int) bool {
+		a, b := entTasks[i], entTasks[j]
+		if scores[a.ID] != scores[b.ID] {
+			return scores[a.ID] > scores[b.ID]
+		}
+		return a.CreatedAt.After(b.CreatedAt)
+	})
+	total := len(entTasks)
+	if offset > total {
+		offset = total
+	}
+	entTasks = entTasks[offset:min(offset+limit, total)]
+
+	ids := make([]uuid.UUID, 0, len(entTasks))
+	for _, entTask := range entTasks {
+		ids = append(ids, entTask.ID)
+	}
+	snippets, err := store.markedSnippets(ctx, terms, ids)
+	if err != nil {
+		return nil, err
+	}
+	return store.results(ctx, ids, scores, snippets, total, limit, offset)
+}
+
+// markedSnippets builds excerpts of the matching tasks and of their first
+// matching comment.
+func (store *SearchStore) markedSnippets(ctx context.Context, terms []string, ids []uuid.UUID) (map[uuid.UUID]*models.SearchSnippets, error) {
+	entTasks, err := store.client.Task.Query().
+		Where(task.IDIn(ids...)).
+		WithComments(func(q *ent.CommentQuery) {
+			q.Order(ent.Asc(comment.FieldCreatedAt))
+		}).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	snippets := make(map[uuid.UUID]*models.SearchSnippets, len(entTasks))
+	for _, entTask := range entTasks {
+		s := &models.SearchSnippets{
+			Title:       snippetHTML(markMatches(entTask.Title, terms)),
+			Description: snippetHTML(markMatches(entTask.Description, terms)),
+		}
+		for _, entComment := range entTask.Edges.Comments {
+			if s.Comment = snippetHTML(markMatches(entComment.Body, terms)); s.Comment != "" {
+				break
+			}
+		}
+		snippets[entTask.ID] = s
+	}
+	return snippets, nil
+}
+
+// results loads the tasks of a page of search hits in rank order.
+func (store *SearchStore) results(ctx context.Context, ids []uuid.UUID, ranks map[uuid.UUID]float64, snippets map[uuid.UUID]*models.SearchSnippets, total, limit, offset int) (*models.Page[*models.SearchResult], error) {
+	tasks, err := store.tasks.ListTasksByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	results := make([]*models.SearchResult, 0, len(tasks))
+	for _, t := range tasks {
+		result := &models.SearchResult{Task: t, Rank: ranks[t.ID]}
+		if s := snippets[t.ID]; s != nil {
+			result.Snippets = *s
+		}
+		results = append(results, result)
+	}
+	return &models.Page[*models.SearchResult]{
+		Items:  results,
+		Total:  total,
+		Limit:  limit,
+		Offset: offset,
+	}, nil
+}
@@ -0,0 +1,168 @@
+package datastore_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"github.com/localopsco/go-sample/datastore"
+	"github.com/localopsco/go-sample/datastore/datastoretest"
+	"github.com/localopsco/go-sample/models"
+	"github.com/localopsco/go-sample/service"
+)
+
+// newSearch returns a search store backed by the in-memory index, and a
+// task service writing to the same database.
+func newSearch(t *testing.T) (*datastore.SearchStore, *service.TaskService, *service.CommentService, *service.UserService) {
+	t.Helper()
+	client := datastoretest.NewClient(t)
+	taskStore := datastore.NewTaskStore(client)
+	search, err := datastore.NewSearchStore(context.Background(), client, taskStore, dialect.SQLite)
+	if err != nil {
+		t.Fatal(err)
+	}
+	taskSvc := service.NewTaskService(taskStore, datastore.NewWorkflowStore(client), datastore.NewProjectStore(client), datastore.NewUserStore(client), nil, nil)
+	commentSvc := service.NewCommentService(datastore.NewCommentStore(client), taskStore, datastore.NewUserStore(client))
+	userSvc := service.NewUserService(datastore.NewUserStore(client))
+	return search, taskSvc, commentSvc, userSvc
+}
+
+func resultTitles(page *models.Page[*models.SearchResult]) []string {
+	titles := make([]string, 0, len(page.Items))
+	for _, result := range page.Items {
+		titles = append(titles, result.Task.Title)
+	}
+	return titles
+}
+
+func TestSearchIndex(t *testing.T) {
+	ctx := context.Background()
+	search, taskSvc, commentSvc, userSvc := newSearch(t)
+	author, _, err := userSvc.CreateUser(ctx, "ann", "Ann")
+	if err != nil {
+		t.Fatal(err)
+	}
+	inComment, err := taskSvc.CreateTask(ctx, models.Task{Title: "Plan the week"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := commentSvc.CreateComment(ctx, inComment.ID, author, "Remember the invoices", nil); err != nil {
+		t.Fatal(err)
+	}
+	for _, task := range []models.Task{
+		{Title: "Call the bank", Description: "Ask about the invoice from March"},
+		{Title: "Pay invoice", Description: "Before Friday"},
+		{Title: "Water plants"},
+	} {
+		if _, err := taskSvc.CreateTask(ctx, task); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		name  string
+		query string
+		want  []string
+	}{
+		{name: "title ranks over description and comments", query: "invoice", want: []string{"Pay invoice", "Call the bank", "Plan the week"}},
+		{name: "terms match word prefixes", query: "inv", want: []string{"Pay invoice", "Call the bank", "Plan the week"}},
+		{name: "every term must match", query: "invoice march", want: []string{"Call the bank"}},
+		{name: "case and punctuation are ignored", query: "PAY, inv!", want: []string{"Pay invoice"}},
+		{name: "terms do not match inside words", query: "voice", want: []string{}},
+		{name: "no terms", query: "!!", want: []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			page, err := search.Search(ctx, tt.query, models.TaskFilter{}, 10, 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := resultTitles(page); strings.Join(got, "|") != strings.Join(tt.want, "|") {
+				t.Fatalf("Search(%q) = %q, want %q", tt.query, got, tt.want)
+			}
+		})
+	}
+
+	page, err := search.Search(ctx, "invoice", models.TaskFilter{}, 10, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	snippets := map[string]models.SearchSnippets{}
+	for _, result := range page.Items {
+		snippets[result.Task.Title] = result.Snippets
+	}
+	if got := snippets["Pay invoice"]; got.Title != "Pay <mark>invoice</mark>" || got.Description != "" {
+		t.Errorf("snippets of title match = %+v", got)
+	}
+	if got := snippets["Call the bank"]; got.Title != "" || got.Description != "Ask about the <mark>invoice</mark> from March" {
+		t.Errorf("snippets of description match = %+v", got)
+	}
+	if got := snippets["Plan the week"]; got.Comment != "Remember the <mark>invoices</mark>" {
+		t.Errorf("snippets of comment match = %+v", got)
+	}
+}
+
+func TestSearchIndexFollowsChanges(t *testing.T) {
+	ctx := context.Background()
+	search, taskSvc, _, _ := newSearch(t)
+	task, err := taskSvc.CreateTask(ctx, models.Task{Title: "Renew passport"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := taskSvc.UpdateTask(ctx, models.TaskUpdate{ID: task.ID, Title: "Renew driving licence"}, false); err != nil {
+		t.Fatal(err)
+	}
+	for query, want := range map[string]int{"passport": 0, "licence": 1} {
+		page, err := search.Search(ctx, query, models.TaskFilter{}, 10, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if page.Total != want {
+			t.Errorf("Search(%q) found %d tasks, want %d", query, page.Total, want)
+		}
+	}
+	if err := taskSvc.DeleteTask(ctx, task.ID); err != nil {
+		t.Fatal(err)
+	}
+	page, err := search.Search(ctx, "licence", models.TaskFilter{}, 10, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if page.Total != 0 {
+		t.Errorf("Search found %d deleted tasks, want 0", page.Total)
+	}
+}
+
+func TestMarkMatches(t *testing.T) {
+	long := strings.Repeat("lorem ipsum ", 20)
+	tests := []struct {
+		name  string
+		text  string
+		terms []string
+		want  string
+	}{
+		{name: "no match", text: "Water plants", terms: []string{"invoice"}, want: ""},
+		{name: "whole word", text: "Pay invoice", terms: []string{"invoice"}, want: "Pay [invoice]"},
+		{name: "prefix keeps the whole word", text: "Pay Invoices now", terms: []string{"inv"}, want: "Pay [Invoices] now"},
+		{name: "several terms", text: "pay the invoice", terms: []string{"pay", "inv"}, want: "[pay] the [invoice]"},
+		{name: "not inside words", text: "convoice", terms: []string{"voice"}, want: ""},
+		{name: "punctuation is kept", text: "Invoice: (urgent)!", terms: []string{"urgent"}, want: "Invoice: ([urgent])!"},
+		{name: "multi-byte text", text: "Überweisung für Müller", terms: []string{"mül"}, want: "Überweisung für [Müller]"},
+		{
+			name:  "long text is trimmed around the first match",
+			text:  long + "invoice " + long,
+			terms: []string{"invoice"},
+			want:  "… ipsum " + strings.Repeat("lorem ipsum ", 6) + "[invoice] " + strings.Repeat("lorem ipsum ", 6) + "…",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Show the match markers as brackets.
+			got := strings.NewReplacer("\uE000", "[", "\uE001", "]").Replace(datastore.MarkMatches(tt.text, tt.terms))
+			if got != tt.want {
+				t.Errorf("markMatches(%q, %q) = %q, want %q", tt.text, tt.terms, got, tt.want)
+			}
+		})
+	}
+}
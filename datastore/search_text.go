@@ -0,0 +1,138 @@
+package datastore
+
+import (
+	"html"
+	"strings"
+	"unicode"
+)
+
+// Search snippets are produced with these private-use markers around
+// matches, so that the text can be HTML-escaped before the markers are
+// turned into <mark> tags.
+const (
+	matchStart = "\uE000"
+	matchStop  = "\uE001"
+)
+
+// Relative weights of the fields a task is searched by.
+const (
+	titleWeight       = 1.0
+	descriptionWeight = 0.4
+	commentWeight     = 0.2
+)
+
+// snippetRadius is roughly how many characters of context a snippet keeps
+// around its first match.
+const snippetRadius = 80
+
+// searchTerms splits a search query into lowercase words, dropping
+// punctuation and operators, so every term is safe to embed in a tsquery.
+func searchTerms(query string) []string {
+	var terms []string
+	seen := make(map[string]bool)
+	for _, term := range tokenize(query) {
+		if !seen[term] {
+			seen[term] = true
+			terms = append(terms, term)
+		}
+	}
+	return terms
+}
+
+func tokenize(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// prefixTSQuery builds a tsquery matching documents that contain every
+// term, each as a word prefix.
+func prefixTSQuery(terms []string) string {
+	parts := make([]string, 0, len(terms))
+	for _, term := range terms {
+		parts = append(parts, term+":*")
+	}
+	return strings.Join(parts, " & ")
+}
+
+// markMatches wraps the words of text that start with one of terms in
+// match markers, trimming long text to the words around the first match.
+// It returns "" if nothing in text matches.
+func markMatches(text string, terms []string) string {
+	type span struct {
+		start, end int
+		match      bool
+	}
+	var spans []span
+	first := -1
+	start := -1
+	for i, r := range text + " " {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			if start < 0 {
+				start = i
+			}
+			continue
+		}
+		if start < 0 {
+			continue
+		}
+		w := span{start: start, end: i}
+		word := strings.ToLower(text[start:i])
+		for _, term := range terms {
+			if strings.HasPrefix(word, term) {
+				w.match = true
+				break
+			}
+		}
+		if w.match && first < 0 {
+			first = len(spans)
+		}
+		spans = append(spans, w)
+		start = -1
+	}
+	if first < 0 {
+		return ""
+	}
+
+	from, to := first, first
+	for from > 0 && spans[first].start-spans[from-1].start <= snippetRadius {
+		from--
+	}
+	for to < len(spans)-1 && spans[to+1].end-spans[first].start <= snippetRadius {
+		to++
+	}
+	var b strings.Builder
+	if from > 0 {
+		b.WriteString("… ")
+	}
+	pos := 0
+	if from > 0 {
+		pos = spans[from].start
+	}
+	for _, w := range spans[from : to+1] {
+		b.WriteString(text[pos:w.start])
+		if w.match {
+			b.WriteString(matchStart + text[w.start:w.end] + matchStop)
+		} else {
+			b.WriteString(text[w.start:w.end])
+		}
+		pos = w.end
+	}
+	if to < len(spans)-1 {
+		b.WriteString(" …")
+	} else {
+		b.WriteString(text[pos:])
+	}
+	return b.String()
+}
+
+// snippetHTML escapes a marked snippet and turns its markers into <mark>
+// tags. Snippets without any match are dropped.
+func snippetHTML(marked string) string {
+	if !strings.Contains(marked, matchStart) {
+		return ""
+	}
+	escaped := html.EscapeString(marked)
+	escaped = strings.ReplaceAll(escaped, matchStart, "<mark>")
+	return strings.ReplaceAll(escaped, matchStop, "</mark>")
+}
@@ -0,0 +1,174 @@
+package datastore
+
+import (
+	"context"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/ent"
+	"github.com/localopsco/go-sample/ent/intercept"
+	"github.com/localopsco/go-sample/ent/predicate"
+	"github.com/localopsco/go-sample/ent/task"
+	"github.com/localopsco/go-sample/models"
+)
+
+type includeDeletedKey struct{}
+
+// withDeleted returns a context in which task queries also see soft-deleted
+// tasks.
+func withDeleted(ctx context.Context) context.Context {
+	return context.WithValue(ctx, includeDeletedKey{}, true)
+}
+
+// excludeDeletedTasks hides soft-deleted tasks from every task query,
+// including edge traversals and eager loading, unless the context was
+// prepared with withDeleted.
+var excludeDeletedTasks = intercept.TraverseTask(func(ctx context.Context, q *ent.TaskQuery) error {
+	if include, _ := ctx.Value(includeDeletedKey{}).(bool); include {
+		return nil
+	}
+	q.Where(task.DeletedAtIsNil())
+	return nil
+})
+
+// DeleteTask moves a task and its subtasks to the trash.
+func (store *TaskStore) DeleteTask(ctx context.Context, taskID uuid.UUID) error {
+	return withTx(ctx, store.client, func(client *ent.Client) error {
+		if _, err := client.Task.Query().Where(task.ID(taskID)).OnlyID(ctx); err != nil {
+			return err
+		}
+		ids, err := subtreeIDs(ctx, client, taskID)
+		if err != nil {
+			return err
+		}
+		// Postgres keeps microseconds, so truncate to let RestoreTask and
+		// PurgeTask match the stored value exactly.
+		return client.Task.Update().
+			Where(task.IDIn(ids...)).
+			SetDeletedAt(time.Now().UTC().Truncate(time.Microsecond)).
+			Exec(ctx)
+	})
+}
+
+// RestoreTask takes a task out of the trash together with the subtasks
+// that were deleted along with it.
+func (store *TaskStore) RestoreTask(ctx context.Context, taskID uuid.UUID) error {
+	ctx = withDeleted(ctx)
+	return withTx(ctx, store.client, func(client *ent.Client) error {
+		entTask, err := client.Task.Query().
+			Where(task.ID(taskID), task.DeletedAtNotNil()).
+			Only(ctx)
+		if err != nil {
+			return err
+		}
+		ids, err := subtreeIDs(ctx, client, taskID, task.DeletedAt(*entTask.DeletedAt))
+		if err != nil {
+			return err
+		}
+		return client.Task.Update().
+			Where(task.IDIn(ids...)).
+			ClearDeletedAt().
+			Exec(ctx)
+	})
+}
+
+// GetDeletedTask returns a task from the trash.
+func (store *TaskStore) GetDeletedTask(ctx context.Context, taskID uuid.UUID) (*models.Task, error) {
+	entTask, err := store.query().
+		Where(task.ID(taskID), task.DeletedAtNotNil()).
+		Only(withDeleted(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return convertEntTask(entTask), nil
+}
+
+// ListTrash returns a page of soft-deleted tasks, most recently deleted
+// first.
+func (store *TaskStore) ListTrash(ctx context.Context, limit, offset int) (*models.Page[*models.Task], error) {
+	ctx = withDeleted(ctx)
+	query := store.query().Where(task.DeletedAtNotNil())
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+	entTasks, err := query.
+		Order(task.ByDeletedAt(sql.OrderDesc()), task.ByID()).
+		Limit(limit).
+		Offset(offset).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &models.Page[*models.Task]{
+		Items:  convertEntTasks(entTasks),
+		Total:  total,
+		Limit:  limit,
+		Offset: offset,
+	}, nil
+}
+
+// PurgeTask permanently deletes a task in the trash along with the subtasks
+// deleted with it, and returns the purged tasks.
+func (store *TaskStore) PurgeTask(ctx context.Context, taskID uuid.UUID) ([]*models.Task, error) {
+	ctx = withDeleted(ctx)
+	entTask, err := store.client.Task.Query().
+		Where(task.ID(taskID), task.DeletedAtNotNil()).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ids, err := subtreeIDs(ctx, store.client, taskID, task.DeletedAt(*entTask.DeletedAt))
+	if err != nil {
+		return nil, err
+	}
+	return store.purge(ctx, task.IDIn(ids...))
+}
+
+// PurgeDeletedBefore permanently deletes the tasks that were moved to the
+// trash before cutoff, and returns the purged tasks.
+func (store *TaskStore) PurgeDeletedBefore(ctx context.Context, cutoff time.Time) ([]*models.Task, error) {
+	return store.purge(withDeleted(ctx), task.DeletedAtLT(cutoff))
+}
+
+func (store *TaskStore) purge(ctx context.Context, where ...predicate.Task) ([]*models.Task, error) {
+	var entTasks []*ent.Task
+	err := withTx(ctx, store.client, func(client *ent.Client) error {
+		var err error
+		entTasks, err = client.Task.Query().
+			Where(append(where, task.DeletedAtNotNil())...).
+			All(ctx)
+		if err != nil || len(entTasks) == 0 {
+			return err
+		}
+		ids := make([]uuid.UUID, 0, len(entTasks))
+		for _, entTask := range entTasks {
+			ids = append(ids, entTask.ID)
+		}
+		_, err = client.Task.Delete().Where(task.IDIn(ids...)).Exec(ctx)
+		return err
+	})
+	if err != nil || len(entTasks) == 0 {
+		return nil, err
+	}
+	return convertEntTasks(entTasks), nil
+}
+
+// subtreeIDs returns the ID of a task and of all its descendants matching
+// the predicates, walking the tree one level per query.
+func subtreeIDs(ctx context.Context, client *ent.Client, rootID uuid.UUID, where ...predicate.Task) ([]uuid.UUID, error) {
+	ids := []uuid.UUID{rootID}
+	frontier := ids
+	for len(frontier) > 0 {
+		children, err := client.Task.Query().
+			Where(append(where, task.ParentIDIn(frontier...))...).
+			IDs(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, children...)
+		frontier = children
+	}
+	return ids, nil
+}
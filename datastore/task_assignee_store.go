@@ -0,0 +1,31 @@
+package datastore
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+func (store *TaskStore) AddAssignee(ctx context.Context, taskID, userID uuid.UUID) error {
+	return store.client.Task.UpdateOneID(taskID).
+		AddAssigneeIDs(userID).
+		Exec(ctx)
+}
+
+func (store *TaskStore) RemoveAssignee(ctx context.Context, taskID, userID uuid.UUID) error {
+	return store.client.Task.UpdateOneID(taskID).
+		RemoveAssigneeIDs(userID).
+		Exec(ctx)
+}
+
+func (store *TaskStore) AddWatcher(ctx context.Context, taskID, userID uuid.UUID) error {
+	return store.client.Task.UpdateOneID(taskID).
+		AddWatcherIDs(userID).
+		Exec(ctx)
+}
+
+func (store *TaskStore) RemoveWatcher(ctx context.Context, taskID, userID uuid.UUID) error {
+	return store.client.Task.UpdateOneID(taskID).
+		RemoveWatcherIDs(userID).
+		Exec(ctx)
+}
@@ -0,0 +1,107 @@
+package datastore
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/ent"
+	"github.com/localopsco/go-sample/ent/predicate"
+	"github.com/localopsco/go-sample/ent/task"
+	"github.com/localopsco/go-sample/models"
+)
+
+func (store *TaskStore) AddBlocker(ctx context.Context, taskID, blockerID uuid.UUID) error {
+	return store.client.Task.UpdateOneID(taskID).
+		AddBlockedByIDs(blockerID).
+		Exec(ctx)
+}
+
+func (store *TaskStore) RemoveBlocker(ctx context.Context, taskID, blockerID uuid.UUID) error {
+	return store.client.Task.UpdateOneID(taskID).
+		RemoveBlockedByIDs(blockerID).
+		Exec(ctx)
+}
+
+// BlockedIDs returns the IDs of the tasks directly blocked by any of taskIDs.
+func (store *TaskStore) BlockedIDs(ctx context.Context, taskIDs ...uuid.UUID) ([]uuid.UUID, error) {
+	return store.client.Task.Query().
+		Where(task.HasBlockedByWith(task.IDIn(taskIDs...), task.DeletedAtIsNil())).
+		IDs(ctx)
+}
+
+// ListDependencies returns the given tasks together with every dependency edge
+// touching them.
+func (store *TaskStore) ListDependencies(ctx context.Context, taskIDs []uuid.UUID) ([]*models.Task, []models.Dependency, error) {
+	return store.listDependencies(ctx, task.IDIn(taskIDs...))
+}
+
+// ListAllDependencies returns every task that takes part in a dependency,
+// optionally limited to a project, together with its dependency edges.
+func (store *TaskStore) ListAllDependencies(ctx context.Context, projectID *uuid.UUID) ([]*models.Task, []models.Dependency, error) {
+	predicates := []predicate.Task{task.Or(
+		task.HasBlocksWith(task.DeletedAtIsNil()),
+		task.HasBlockedByWith(task.DeletedAtIsNil()),
+	)}
+	if projectID != nil {
+		predicates = append(predicates, task.ProjectID(*projectID))
+	}
+	return store.listDependencies(ctx, predicates...)
+}
+
+func (store *TaskStore) listDependencies(ctx context.Context, predicates ...predicate.Task) ([]*models.Task, []models.Dependency, error) {
+	entTasks, err := store.query().
+		Where(predicates...).
+		WithBlocks(func(q *ent.TaskQuery) {
+			q.Select(task.FieldID)
+		}).
+		WithBlockedBy(func(q *ent.TaskQuery) {
+			q.Select(task.FieldID)
+		}).
+		Order(ent.Asc(task.FieldCreatedAt), ent.Asc(task.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	var deps []models.Dependency
+	seen := make(map[models.Dependency]bool)
+	addDep := func(dep models.Dependency) {
+		if !seen[dep] {
+			seen[dep] = true
+			deps = append(deps, dep)
+		}
+	}
+	for _, entTask := range entTasks {
+		for _, blocked := range entTask.Edges.Blocks {
+			addDep(models.Dependency{BlockerID: entTask.ID, BlockedID: blocked.ID})
+		}
+		for _, blocker := range entTask.Edges.BlockedBy {
+			addDep(models.Dependency{BlockerID: blocker.ID, BlockedID: entTask.ID})
+		}
+	}
+	tasks := convertEntTasks(entTasks)
+	if err := store.withComputedFields(ctx, tasks...); err != nil {
+		return nil, nil, err
+	}
+	return tasks, deps, nil
+}
+
+// blockedTaskIDs returns which of taskIDs have at least one open blocker.
+func (store *TaskStore) blockedTaskIDs(ctx context.Context, taskIDs ...uuid.UUID) (map[uuid.UUID]bool, error) {
+	blocked := make(map[uuid.UUID]bool, len(taskIDs))
+	if len(taskIDs) == 0 {
+		return blocked, nil
+	}
+	ids, err := store.client.Task.Query().
+		Where(
+			task.IDIn(taskIDs...),
+			task.HasBlockedByWith(task.StatusCategoryIn(openStatusCategories...), task.DeletedAtIsNil()),
+		).
+		IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range ids {
+		blocked[id] = true
+	}
+	return blocked, nil
+}
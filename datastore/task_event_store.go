@@ -0,0 +1,87 @@
+package datastore
+
+import (
+	"context"
+
+	"github.com/localopsco/go-sample/ent"
+	"github.com/localopsco/go-sample/ent/predicate"
+	"github.com/localopsco/go-sample/ent/taskevent"
+	"github.com/localopsco/go-sample/models"
+)
+
+type TaskEventStore struct {
+	client *ent.Client
+}
+
+func NewTaskEventStore(client *ent.Client) *TaskEventStore {
+	return &TaskEventStore{
+		client,
+	}
+}
+
+// ListTaskEvents returns a page of the events matching filter, newest first.
+func (store *TaskEventStore) ListTaskEvents(ctx context.Context, filter models.TaskEventFilter, limit, offset int) (*models.Page[*models.TaskEvent], error) {
+	var predicates []predicate.TaskEvent
+	if filter.TaskID != nil {
+		predicates = append(predicates, taskevent.TaskID(*filter.TaskID))
+	}
+	if filter.ProjectID != nil {
+		predicates = append(predicates, taskevent.ProjectID(*filter.ProjectID))
+	}
+	if filter.ActorID != nil {
+		predicates = append(predicates, taskevent.ActorID(*filter.ActorID))
+	}
+	if filter.Action != "" {
+		predicates = append(predicates, taskevent.ActionEQ(taskevent.Action(filter.Action)))
+	}
+	if filter.Since != nil {
+		predicates = append(predicates, taskevent.CreatedAtGTE(*filter.Since))
+	}
+	if filter.Until != nil {
+		predicates = append(predicates, taskevent.CreatedAtLT(*filter.Until))
+	}
+	query := store.client.TaskEvent.Query().Where(predicates...)
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+	entEvents, err := query.
+		WithActor().
+		Order(ent.Desc(taskevent.FieldCreatedAt), ent.Desc(taskevent.FieldID)).
+		Limit(limit).
+		Offset(offset).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	events := make([]*models.TaskEvent, 0, len(entEvents))
+	for _, entEvent := range entEvents {
+		events = append(events, convertEntTaskEvent(entEvent))
+	}
+	return &models.Page[*models.TaskEvent]{
+		Items:  events,
+		Total:  total,
+		Limit:  limit,
+		Offset: offset,
+	}, nil
+}
+
+func convertEntTaskEvent(entEvent *ent.TaskEvent) *models.TaskEvent {
+	event := &models.TaskEvent{
+		ID:        entEvent.ID,
+		TaskID:    entEvent.TaskID,
+		TaskTitle: entEvent.TaskTitle,
+		ProjectID: entEvent.ProjectID,
+		Action:    entEvent.Action.String(),
+		Changes:   entEvent.Changes,
+		RequestID: entEvent.RequestID,
+		CreatedAt: entEvent.CreatedAt,
+	}
+	if entEvent.Edges.Actor != nil {
+		event.Actor = convertEntUser(entEvent.Edges.Actor)
+	}
+	if event.Changes == nil {
+		event.Changes = []models.FieldChange{}
+	}
+	return event
+}
@@ -0,0 +1,276 @@
+package datastore
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/ent/predicate"
+	"github.com/localopsco/go-sample/ent/task"
+	"github.com/localopsco/go-sample/ent/user"
+	"github.com/localopsco/go-sample/ent/workflowstatus"
+	"github.com/localopsco/go-sample/taskquery"
+)
+
+// expressionCompiler turns a parsed query into task predicates. Dates are
+// evaluated in loc and "me" refers to actor.
+type expressionCompiler struct {
+	query string
+	actor *uuid.UUID
+	loc   *time.Location
+}
+
+// queryPredicate parses and compiles a query of the filter language.
+func queryPredicate(query string, actor *uuid.UUID, loc *time.Location) (predicate.Task, error) {
+	expr, err := taskquery.Parse(query)
+	if err != nil {
+		return nil, err
+	}
+	c := &expressionCompiler{query: query, actor: actor, loc: loc}
+	return c.compile(expr)
+}
+
+func (c *expressionCompiler) compile(expr taskquery.Expr) (predicate.Task, error) {
+	switch e := expr.(type) {
+	case *taskquery.And:
+		left, right, err := c.compilePair(e.Left, e.Right)
+		if err != nil {
+			return nil, err
+		}
+		return task.And(left, right), nil
+	case *taskquery.Or:
+		left, right, err := c.compilePair(e.Left, e.Right)
+		if err != nil {
+			return nil, err
+		}
+		return task.Or(left, right), nil
+	case *taskquery.Not:
+		p, err := c.compile(e.Expr)
+		if err != nil {
+			return nil, err
+		}
+		return task.Not(p), nil
+	case *taskquery.Comparison:
+		return c.comparison(e)
+	}
+	return nil, taskquery.ErrorAt(c.query, expr.Pos(), "unsupported expression")
+}
+
+func (c *expressionCompiler) compilePair(left, right taskquery.Expr) (predicate.Task, predicate.Task, error) {
+	l, err := c.compile(left)
+	if err != nil {
+		return nil, nil, err
+	}
+	r, err := c.compile(right)
+	if err != nil {
+		return nil, nil, err
+	}
+	return l, r, nil
+}
+
+func (c *expressionCompiler) comparison(e *taskquery.Comparison) (predicate.Task, error) {
+	negate := e.Op == taskquery.OpNotEqual
+	switch e.Field {
+	case "status":
+		var p predicate.Task
+		switch strings.ToLower(e.Value) {
+		case "open":
+			p = task.StatusCategoryIn(openStatusCategories...)
+		case "closed":
+			p = task.StatusCategoryIn(task.StatusCategoryDone, task.StatusCategoryCancelled)
+		default:
+			p = task.HasStatusWith(workflowstatus.Key(e.Value))
+		}
+		return not(p, negate), nil
+	case "priority":
+		return priorityPredicate(e.Op, e.Value), nil
+	case "label":
+		contains := hasLabel(strings.ToLower(e.Value))
+		if negate {
+			return task.Or(task.LabelsIsNil(), task.Not(contains)), nil
+		}
+		return contains, nil
+	case "due":
+		return c.timePredicate(e, task.FieldDueAt, task.DueAtIsNil())
+	case "start":
+		return c.timePredicate(e, task.FieldStartAt, task.StartAtIsNil())
+	case "created":
+		return c.timePredicate(e, task.FieldCreatedAt, nil)
+	case "completed":
+		return c.timePredicate(e, task.FieldCompletedAt, task.CompletedAtIsNil())
+	case "title":
+		return textPredicate(e, task.TitleEQ, task.TitleContainsFold), nil
+	case "description":
+		return textPredicate(e, task.DescriptionEQ, task.DescriptionContainsFold), nil
+	case "project":
+		if e.Value == taskquery.None {
+			return not(task.ProjectIDIsNil(), negate), nil
+		}
+		id := uuid.MustParse(e.Value)
+		if negate {
+			return task.Or(task.ProjectIDIsNil(), task.ProjectIDNEQ(id)), nil
+		}
+		return task.ProjectID(id), nil
+	case "parent":
+		if e.Value == taskquery.None {
+			return not(task.ParentIDIsNil(), negate), nil
+		}
+		id := uuid.MustParse(e.Value)
+		if negate {
+			return task.Or(task.ParentIDIsNil(), task.ParentIDNEQ(id)), nil
+		}
+		return task.ParentID(id), nil
+	case "assignee":
+		p, err := c.userPredicate(e, task.HasAssignees, task.HasAssigneesWith)
+		if err != nil {
+			return nil, err
+		}
+		return not(p, negate), nil
+	case "watcher":
+		p, err := c.userPredicate(e, task.HasWatchers, task.HasWatchersWith)
+		if err != nil {
+			return nil, err
+		}
+		return not(p, negate), nil
+	case "blocked":
+		blocked, _ := strconv.ParseBool(e.Value)
+		p := task.HasBlockedByWith(task.StatusCategoryIn(openStatusCategories...), task.DeletedAtIsNil())
+		return not(p, blocked == negate), nil
+	case "estimate":
+		if e.Value == taskquery.None {
+			return not(task.EstimateMinutesIsNil(), negate), nil
+		}
+		n, _ := strconv.Atoi(e.Value)
+		return orderedPredicate(task.FieldEstimateMinutes, e.Op, n), nil
+	}
+	return nil, taskquery.ErrorAt(c.query, e.Offset, "unknown field %q", e.Field)
+}
+
+// timePredicate compares a time field. Calendar dates cover the whole day,
+// so due:2026-11-01 matches any time on that day and due>2026-11-01 starts
+// on the day after.
+func (c *expressionCompiler) timePredicate(e *taskquery.Comparison, field string, isNil predicate.Task) (predicate.Task, error) {
+	if e.Value == taskquery.None {
+		return not(isNil, e.Op == taskquery.OpNotEqual), nil
+	}
+	start, day, err := taskquery.ParseDate(e.Value, c.loc)
+	if err != nil {
+		return nil, taskquery.ErrorAt(c.query, e.ValueOffset, "%s", err)
+	}
+	start = start.UTC()
+	end := start
+	if day {
+		end = start.AddDate(0, 0, 1)
+	}
+	switch e.Op {
+	case taskquery.OpLess:
+		return sql.FieldLT(field, start), nil
+	case taskquery.OpLessEq:
+		if day {
+			return sql.FieldLT(field, end), nil
+		}
+		return sql.FieldLTE(field, start), nil
+	case taskquery.OpGreater:
+		if day {
+			return sql.FieldGTE(field, end), nil
+		}
+		return sql.FieldGT(field, start), nil
+	case taskquery.OpGreatEq:
+		return sql.FieldGTE(field, start), nil
+	}
+	p := predicate.Task(sql.FieldEQ(field, start))
+	if day {
+		p = task.And(sql.FieldGTE(field, start), sql.FieldLT(field, end))
+	}
+	if e.Op == taskquery.OpNotEqual {
+		if isNil != nil {
+			return task.Or(isNil, task.Not(p)), nil
+		}
+		return task.Not(p), nil
+	}
+	return p, nil
+}
+
+func (c *expressionCompiler) userPredicate(e *taskquery.Comparison, has func() predicate.Task, hasWith func(...predicate.User) predicate.Task) (predicate.Task, error) {
+	switch e.Value {
+	case taskquery.None:
+		return task.Not(has()), nil
+	case taskquery.Me:
+		if c.actor == nil {
+			return nil, taskquery.ErrorAt(c.query, e.ValueOffset, "%q requires authentication", taskquery.Me)
+		}
+		return hasWith(user.ID(*c.actor)), nil
+	}
+	if id, err := uuid.Parse(e.Value); err == nil {
+		return hasWith(user.ID(id)), nil
+	}
+	return hasWith(user.UsernameEqualFold(e.Value)), nil
+}
+
+func priorityPredicate(op taskquery.Operator, value string) predicate.Task {
+	rank := taskquery.PriorityRank(value)
+	var matching []task.Priority
+	for r, priority := range priorityRank {
+		var ok bool
+		switch op {
+		case taskquery.OpLess:
+			ok = r < rank
+		case taskquery.OpLessEq:
+			ok = r <= rank
+		case taskquery.OpGreater:
+			ok = r > rank
+		case taskquery.OpGreatEq:
+			ok = r >= rank
+		case taskquery.OpNotEqual:
+			ok = r != rank
+		default:
+			ok = r == rank
+		}
+		if ok {
+			matching = append(matching, priority)
+		}
+	}
+	return task.PriorityIn(matching...)
+}
+
+func textPredicate(e *taskquery.Comparison, eq, containsFold func(string) predicate.Task) predicate.Task {
+	switch e.Op {
+	case taskquery.OpEqual:
+		return eq(e.Value)
+	case taskquery.OpNotEqual:
+		return task.Not(eq(e.Value))
+	}
+	return containsFold(e.Value)
+}
+
+func orderedPredicate(field string, op taskquery.Operator, value any) predicate.Task {
+	switch op {
+	case taskquery.OpLess:
+		return sql.FieldLT(field, value)
+	case taskquery.OpLessEq:
+		return sql.FieldLTE(field, value)
+	case taskquery.OpGreater:
+		return sql.FieldGT(field, value)
+	case taskquery.OpGreatEq:
+		return sql.FieldGTE(field, value)
+	case taskquery.OpNotEqual:
+		return sql.FieldNEQ(field, value)
+	}
+	return sql.FieldEQ(field, value)
+}
+
+func hasLabel(label string) predicate.Task {
+	return func(s *sql.Selector) {
+		s.Where(sqljson.ValueContains(s.C(task.FieldLabels), label))
+	}
+}
+
+func not(p predicate.Task, negate bool) predicate.Task {
+	if negate {
+		return task.Not(p)
+	}
+	return p
+}
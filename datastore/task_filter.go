@@ -0,0 +1,128 @@
+package datastore
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/localopsco/go-sample/ent/predicate"
+	"github.com/localopsco/go-sample/ent/task"
+	"github.com/localopsco/go-sample/ent/user"
+	"github.com/localopsco/go-sample/ent/workflowstatus"
+	"github.com/localopsco/go-sample/models"
+)
+
+// priorityRank orders priorities from least to most urgent, since the enum
+// values themselves do not sort meaningfully.
+var priorityRank = []task.Priority{
+	task.PriorityNone,
+	task.PriorityLow,
+	task.PriorityMedium,
+	task.PriorityHigh,
+	task.PriorityUrgent,
+}
+
+// filterPredicates returns the predicates for filter. The query expression
+// is evaluated on behalf of the actor in ctx.
+func filterPredicates(ctx context.Context, filter models.TaskFilter) ([]predicate.Task, error) {
+	var predicates []predicate.Task
+	loc := filter.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+	now := time.Now().In(loc)
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
+	switch filter.Due {
+	case "overdue":
+		predicates = append(predicates, task.DueAtLT(now), task.StatusCategoryIn(openStatusCategories...))
+	case "today":
+		predicates = append(predicates, task.DueAtGTE(today), task.DueAtLT(today.AddDate(0, 0, 1)))
+	case "week":
+		// Weeks start on Monday.
+		weekStart := today.AddDate(0, 0, -(int(today.Weekday())+6)%7)
+		predicates = append(predicates, task.DueAtGTE(weekStart), task.DueAtLT(weekStart.AddDate(0, 0, 7)))
+	}
+	if filter.Priority != "" {
+		predicates = append(predicates, task.PriorityEQ(task.Priority(filter.Priority)))
+	}
+	if filter.Status != "" {
+		predicates = append(predicates, task.HasStatusWith(workflowstatus.Key(filter.Status)))
+	}
+	if filter.ProjectID != nil {
+		predicates = append(predicates, task.ProjectID(*filter.ProjectID))
+	}
+	if filter.NoProject {
+		predicates = append(predicates, task.ProjectIDIsNil())
+	}
+	if filter.AssigneeID != nil {
+		predicates = append(predicates, task.HasAssigneesWith(user.ID(*filter.AssigneeID)))
+	}
+	if filter.WatcherID != nil {
+		predicates = append(predicates, task.HasWatchersWith(user.ID(*filter.WatcherID)))
+	}
+	if filter.Unassigned {
+		predicates = append(predicates, task.Not(task.HasAssignees()))
+	}
+	if filter.Open {
+		predicates = append(predicates, task.StatusCategoryIn(openStatusCategories...))
+	}
+	if filter.Label != "" {
+		predicates = append(predicates, hasLabel(strings.ToLower(filter.Label)))
+	}
+	if filter.HasDue {
+		predicates = append(predicates, task.DueAtNotNil())
+	}
+	if filter.Query != "" {
+		p, err := queryPredicate(filter.Query, models.ActorFrom(ctx), loc)
+		if err != nil {
+			return nil, err
+		}
+		predicates = append(predicates, p)
+	}
+	return predicates, nil
+}
+
+func filterOrder(filter models.TaskFilter) []task.OrderOption {
+	field, desc := strings.TrimPrefix(filter.Sort, "-"), strings.HasPrefix(filter.Sort, "-")
+	opts := []sql.OrderTermOption{sql.OrderNullsLast()}
+	if desc {
+		opts = append(opts, sql.OrderDesc())
+	}
+	var order task.OrderOption
+	switch field {
+	case task.FieldDueAt:
+		order = task.ByDueAt(opts...)
+	case task.FieldStartAt:
+		order = task.ByStartAt(opts...)
+	case task.FieldTitle:
+		order = task.ByTitle(opts...)
+	case task.FieldPriority:
+		order = byPriority(desc)
+	case task.FieldCreatedAt:
+		order = task.ByCreatedAt(opts...)
+	case task.FieldCompletedAt:
+		order = task.ByCompletedAt(opts...)
+	case task.FieldRank:
+		order = task.ByRank(opts...)
+	default:
+		return []task.OrderOption{task.ByCreatedAt(sql.OrderDesc())}
+	}
+	return []task.OrderOption{order, task.ByCreatedAt(sql.OrderDesc())}
+}
+
+func byPriority(desc bool) task.OrderOption {
+	return func(s *sql.Selector) {
+		var b strings.Builder
+		fmt.Fprintf(&b, "CASE %s", s.C(task.FieldPriority))
+		for rank, priority := range priorityRank {
+			fmt.Fprintf(&b, " WHEN '%s' THEN %d", priority, rank)
+		}
+		b.WriteString(" END")
+		if desc {
+			b.WriteString(" DESC")
+		}
+		s.OrderExpr(sql.Expr(b.String()))
+	}
+}
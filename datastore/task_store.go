@@ -2,13 +2,23 @@ package datastore
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"time"
 
+	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
 	"github.com/localopsco/go-sample/ent"
 	"github.com/localopsco/go-sample/ent/task"
+	"github.com/localopsco/go-sample/ent/user"
+	"github.com/localopsco/go-sample/ent/workflowstatus"
 	"github.com/localopsco/go-sample/models"
 )
 
+// ErrChildrenMismatch is returned when a reorder request does not list exactly
+// the current subtasks of a task.
+var ErrChildrenMismatch = errors.New("subtask list does not match the task's subtasks")
+
 type TaskStore struct {
 	client *ent.Client
 }
@@ -19,75 +29,655 @@ func NewTaskStore(client *ent.Client) *TaskStore {
 	}
 }
 
-func (store *TaskStore) CreateTask(task models.Task) (*models.Task, error) {
-	entTask, err := store.client.Task.Create().
-		SetTitle(task.Title).
-		SetDescription(task.Description).
-		SetIsCompleted(task.IsCompleted).
-		Save(context.Background())
+func (store *TaskStore) CreateTask(ctx context.Context, t models.Task) (*models.Task, error) {
+	entTask, err := taskCreate(store.client, t).Save(ctx)
 	if err != nil {
 		return nil, err
 	}
-	return convertEntTask(entTask), nil
+	return store.GetTask(ctx, entTask.ID)
 }
 
-func (store *TaskStore) GetTask(taskID uuid.UUID) (*models.Task, error) {
-	entTask, err := store.client.Task.Get(context.Background(), taskID)
+// CreateTasks inserts tasks with a single statement and returns them in the
+// same order. The tasks are ranked here, last in their columns in the order
+// given, because the rank hook would give every task of one statement the
+// same rank.
+func (store *TaskStore) CreateTasks(ctx context.Context, tasks []models.Task) ([]*models.Task, error) {
+	var ids []uuid.UUID
+	err := withTx(ctx, store.client, func(client *ent.Client) error {
+		lastRanks := make(map[[2]uuid.UUID]string)
+		builders := make([]*ent.TaskCreate, 0, len(tasks))
+		for _, t := range tasks {
+			column := [2]uuid.UUID{{}, t.StatusID}
+			if t.ProjectID != nil {
+				column[0] = *t.ProjectID
+			}
+			last, ok := lastRanks[column]
+			if !ok {
+				var err error
+				if last, err = lastRank(ctx, client, rankScope(t.ProjectID, t.StatusID)); err != nil {
+					return err
+				}
+			}
+			lastRanks[column] = rankAfter(last)
+			builders = append(builders, taskCreate(client, t).SetRank(lastRanks[column]))
+		}
+		entTasks, err := client.Task.CreateBulk(builders...).Save(ctx)
+		if err != nil {
+			return err
+		}
+		for _, entTask := range entTasks {
+			ids = append(ids, entTask.ID)
+		}
+		return nil
+	})
 	if err != nil {
 		return nil, err
 	}
-	return convertEntTask(entTask), nil
+	return store.ListTasksByIDs(ctx, ids)
 }
 
-func (store *TaskStore) DeleteTask(taskID uuid.UUID) error {
-	return store.client.Task.DeleteOneID(taskID).Exec(context.Background())
+// ExistingImports returns which of the given import keys belong to a task,
+// including tasks in the trash. externalIDs maps each key to its external
+// ID, which is matched against the tasks imported before keys were scoped.
+func (store *TaskStore) ExistingImports(ctx context.Context, externalIDs map[string]string) (map[string]bool, error) {
+	existing := make(map[string]bool)
+	if len(externalIDs) == 0 {
+		return existing, nil
+	}
+	keys := make([]string, 0, len(externalIDs))
+	ids := make([]string, 0, len(externalIDs))
+	for key, id := range externalIDs {
+		keys = append(keys, key)
+		ids = append(ids, id)
+	}
+	found, err := store.client.Task.Query().
+		Where(task.Or(
+			task.ImportKeyIn(keys...),
+			task.And(task.ImportKeyIsNil(), task.ExternalIDIn(ids...)),
+		)).
+		Select(task.FieldImportKey, task.FieldExternalID).
+		All(withDeleted(ctx))
+	if err != nil {
+		return nil, err
+	}
+	legacy := make(map[string]bool)
+	for _, entTask := range found {
+		if entTask.ImportKey != nil {
+			existing[*entTask.ImportKey] = true
+		} else if entTask.ExternalID != nil {
+			legacy[*entTask.ExternalID] = true
+		}
+	}
+	for key, id := range externalIDs {
+		if legacy[id] {
+			existing[key] = true
+		}
+	}
+	return existing, nil
 }
 
-func (store *TaskStore) ListTasks() ([]*models.Task, error) {
+func (store *TaskStore) GetTaskByICalUID(ctx context.Context, uid string) (*models.Task, error) {
+	entTask, err := store.query().
+		Where(task.IcalUID(uid)).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	foundTask := convertEntTask(entTask)
+	if err := store.withComputedFields(ctx, foundTask); err != nil {
+		return nil, err
+	}
+	return foundTask, nil
+}
+
+// ICalUIDs returns the iCalendar UIDs of those of the given tasks that were
+// created with one, including tasks in the trash.
+func (store *TaskStore) ICalUIDs(ctx context.Context, taskIDs []uuid.UUID) (map[uuid.UUID]string, error) {
+	uids := make(map[uuid.UUID]string)
+	if len(taskIDs) == 0 {
+		return uids, nil
+	}
 	entTasks, err := store.client.Task.Query().
-		Order(ent.Desc(task.FieldCreatedAt)).
-		All(context.Background())
+		Where(task.IDIn(taskIDs...), task.IcalUIDNotNil()).
+		Select(task.FieldID, task.FieldIcalUID).
+		All(withDeleted(ctx))
 	if err != nil {
 		return nil, err
 	}
-	tasks := make([]*models.Task, 0, len(entTasks))
 	for _, entTask := range entTasks {
-		tasks = append(tasks, convertEntTask(entTask))
+		uids[entTask.ID] = *entTask.IcalUID
+	}
+	return uids, nil
+}
+
+func taskCreate(client *ent.Client, t models.Task) *ent.TaskCreate {
+	create := client.Task.Create().
+		SetTitle(t.Title).
+		SetDescription(t.Description).
+		SetStatusID(t.StatusID).
+		SetStatusCategory(task.StatusCategory(t.StatusCategory)).
+		SetNillableProjectID(t.ProjectID).
+		SetNillableParentID(t.ParentID).
+		SetPosition(t.Position).
+		SetNillableStartAt(inUTC(t.StartAt)).
+		SetNillableDueAt(inUTC(t.DueAt)).
+		SetNillableEstimateMinutes(t.EstimateMinutes).
+		SetNillableSeriesID(t.SeriesID).
+		SetNillableExternalID(t.ExternalID).
+		SetNillableImportKey(t.ImportKey).
+		SetNillableIcalUID(t.ICalUID)
+	if t.ID != uuid.Nil {
+		create.SetID(t.ID)
+	}
+	if len(t.Labels) > 0 {
+		create.SetLabels(t.Labels)
+	}
+	if t.Priority != "" {
+		create.SetPriority(task.Priority(t.Priority))
+	}
+	if r := t.Recurrence; r != nil {
+		create.
+			SetRecurrenceRule(r.Rule).
+			SetRecurrenceTimezone(r.Timezone).
+			SetNillableRecurrenceStart(inUTC(r.StartsAt)).
+			SetRecurrencePaused(r.Paused)
+	}
+	return create
+}
+
+func (store *TaskStore) GetTask(ctx context.Context, taskID uuid.UUID) (*models.Task, error) {
+	entTask, err := store.query().
+		Where(task.ID(taskID)).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	foundTask := convertEntTask(entTask)
+	if err := store.withComputedFields(ctx, foundTask); err != nil {
+		return nil, err
+	}
+	return foundTask, nil
+}
+
+func (store *TaskStore) ListTasks(ctx context.Context, filter models.TaskFilter) ([]*models.Task, error) {
+	predicates, err := filterPredicates(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	entTasks, err := store.query().
+		Where(predicates...).
+		Order(filterOrder(filter)...).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tasks := convertEntTasks(entTasks)
+	if err := store.withComputedFields(ctx, tasks...); err != nil {
+		return nil, err
 	}
 	return tasks, nil
 }
 
-func (store *TaskStore) UpdateTask(task models.Task) (*models.Task, error) {
-	entTask, err := store.client.Task.UpdateOneID(task.ID).
-		SetTitle(task.Title).
-		SetDescription(task.Description).
-		SetIsCompleted(task.IsCompleted).
-		Save(context.Background())
+// ListTasksPage returns a page of the tasks matching filter.
+func (store *TaskStore) ListTasksPage(ctx context.Context, filter models.TaskFilter, limit, offset int) (*models.Page[*models.Task], error) {
+	predicates, err := filterPredicates(ctx, filter)
 	if err != nil {
 		return nil, err
 	}
-	return convertEntTask(entTask), nil
+	query := store.query().Where(predicates...)
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+	entTasks, err := query.
+		Order(filterOrder(filter)...).
+		Order(task.ByID()).
+		Limit(limit).
+		Offset(offset).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tasks := convertEntTasks(entTasks)
+	if err := store.withComputedFields(ctx, tasks...); err != nil {
+		return nil, err
+	}
+	return &models.Page[*models.Task]{
+		Items:  tasks,
+		Total:  total,
+		Limit:  limit,
+		Offset: offset,
+	}, nil
+}
+
+// CountTasks returns the number of tasks matching filter.
+func (store *TaskStore) CountTasks(ctx context.Context, filter models.TaskFilter) (int, error) {
+	predicates, err := filterPredicates(ctx, filter)
+	if err != nil {
+		return 0, err
+	}
+	return store.client.Task.Query().
+		Where(predicates...).
+		Count(ctx)
+}
+
+// EachTaskPage calls fn with the tasks matching filter, pageSize at a time
+// and in order of creation, so that all of them can be processed without
+// holding them in memory. Pages are read with a keyset rather than an
+// offset, so tasks changed in the meantime are neither skipped nor repeated.
+func (store *TaskStore) EachTaskPage(ctx context.Context, filter models.TaskFilter, pageSize int, fn func(tasks []*models.Task) error) error {
+	predicates, err := filterPredicates(ctx, filter)
+	if err != nil {
+		return err
+	}
+	var last *ent.Task
+	for {
+		query := store.query().Where(predicates...)
+		if last != nil {
+			query.Where(task.Or(
+				task.CreatedAtGT(last.CreatedAt),
+				task.And(task.CreatedAt(last.CreatedAt), task.IDGT(last.ID)),
+			))
+		}
+		entTasks, err := query.
+			Order(task.ByCreatedAt(), task.ByID()).
+			Limit(pageSize).
+			All(ctx)
+		if err != nil {
+			return err
+		}
+		if len(entTasks) == 0 {
+			return nil
+		}
+		tasks := convertEntTasks(entTasks)
+		if err := store.withComputedFields(ctx, tasks...); err != nil {
+			return err
+		}
+		if err := fn(tasks); err != nil {
+			return err
+		}
+		if len(entTasks) < pageSize {
+			return nil
+		}
+		last = entTasks[len(entTasks)-1]
+	}
+}
+
+// ListTaskIDs returns the IDs of at most limit tasks matching filter.
+func (store *TaskStore) ListTaskIDs(ctx context.Context, filter models.TaskFilter, limit int) ([]uuid.UUID, error) {
+	predicates, err := filterPredicates(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	return store.client.Task.Query().
+		Where(predicates...).
+		Order(filterOrder(filter)...).
+		Order(task.ByID()).
+		Limit(limit).
+		IDs(ctx)
+}
+
+// ListTasksByIDs returns the given tasks in the order of taskIDs, skipping
+// IDs that do not exist.
+func (store *TaskStore) ListTasksByIDs(ctx context.Context, taskIDs []uuid.UUID) ([]*models.Task, error) {
+	entTasks, err := store.query().
+		Where(task.IDIn(taskIDs...)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[uuid.UUID]*ent.Task, len(entTasks))
+	for _, entTask := range entTasks {
+		byID[entTask.ID] = entTask
+	}
+	ordered := make([]*ent.Task, 0, len(entTasks))
+	for _, id := range taskIDs {
+		if entTask, ok := byID[id]; ok {
+			ordered = append(ordered, entTask)
+		}
+	}
+	tasks := convertEntTasks(ordered)
+	if err := store.withComputedFields(ctx, tasks...); err != nil {
+		return nil, err
+	}
+	return tasks, nil
 }
-func (store *TaskStore) UpdateAttachmentURL(taskID uuid.UUID, url string) (*models.Task, error) {
-	entTask, err := store.client.Task.UpdateOneID(taskID).
+
+// ListChildren returns the direct subtasks of a task in their manual order.
+func (store *TaskStore) ListChildren(ctx context.Context, parentID uuid.UUID) ([]*models.Task, error) {
+	entTasks, err := store.query().
+		Where(task.ParentID(parentID)).
+		Order(ent.Asc(task.FieldPosition), ent.Asc(task.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tasks := convertEntTasks(entTasks)
+	if err := store.withComputedFields(ctx, tasks...); err != nil {
+		return nil, err
+	}
+	return tasks, nil
+}
+
+// ListChildrenOf returns the direct subtasks of several tasks, in their
+// manual order under each parent.
+func (store *TaskStore) ListChildrenOf(ctx context.Context, parentIDs []uuid.UUID) ([]*models.Task, error) {
+	entTasks, err := store.query().
+		Where(task.ParentIDIn(parentIDs...)).
+		Order(ent.Asc(task.FieldPosition), ent.Asc(task.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tasks := convertEntTasks(entTasks)
+	if err := store.withComputedFields(ctx, tasks...); err != nil {
+		return nil, err
+	}
+	return tasks, nil
+}
+
+// Depth returns the nesting level of a task, where top-level tasks are at
+// depth 1. Walking stops once maxDepth is exceeded.
+func (store *TaskStore) Depth(ctx context.Context, taskID uuid.UUID, maxDepth int) (int, error) {
+	depth := 0
+	for id := &taskID; id != nil && depth <= maxDepth; depth++ {
+		entTask, err := store.client.Task.Query().
+			Where(task.ID(*id)).
+			Select(task.FieldParentID).
+			Only(ctx)
+		if err != nil {
+			return 0, err
+		}
+		id = entTask.ParentID
+	}
+	return depth, nil
+}
+
+// CountChildren returns the number of direct subtasks of a task and how many
+// of them are still open.
+func (store *TaskStore) CountChildren(ctx context.Context, parentID uuid.UUID) (total int, open int, err error) {
+	progress, err := store.progress(ctx, parentID)
+	if err != nil {
+		return 0, 0, err
+	}
+	p := progress[parentID]
+	return p.Total, p.Total - p.Done, nil
+}
+
+// ReorderChildren assigns positions to the subtasks of a task following the
+// order of childIDs. childIDs must list every direct subtask exactly once.
+func (store *TaskStore) ReorderChildren(ctx context.Context, parentID uuid.UUID, childIDs []uuid.UUID) error {
+	return withTx(ctx, store.client, func(client *ent.Client) error {
+		currentIDs, err := client.Task.Query().Where(task.ParentID(parentID)).IDs(ctx)
+		if err != nil {
+			return err
+		}
+		if !sameIDs(currentIDs, childIDs) {
+			return ErrChildrenMismatch
+		}
+		for i, childID := range childIDs {
+			err := client.Task.UpdateOneID(childID).SetPosition(i).Exec(ctx)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// UpdateTask applies t to a task, moving it to status unless status is nil.
+func (store *TaskStore) UpdateTask(ctx context.Context, t models.TaskUpdate, status *models.WorkflowStatus) (*models.Task, error) {
+	update := store.client.Task.UpdateOneID(t.ID).
+		SetTitle(t.Title).
+		SetDescription(t.Description)
+	if status != nil {
+		update.
+			SetStatusID(status.ID).
+			SetStatusCategory(task.StatusCategory(status.Category))
+	}
+	if t.StartAt.Set {
+		if t.StartAt.Value != nil {
+			update.SetStartAt(t.StartAt.Value.UTC())
+		} else {
+			update.ClearStartAt()
+		}
+	}
+	if t.DueAt.Set {
+		if t.DueAt.Value != nil {
+			update.SetDueAt(t.DueAt.Value.UTC())
+		} else {
+			update.ClearDueAt()
+		}
+	}
+	if t.Priority != nil {
+		update.SetPriority(task.Priority(*t.Priority))
+	}
+	if t.EstimateMinutes.Set {
+		if t.EstimateMinutes.Value != nil {
+			update.SetEstimateMinutes(*t.EstimateMinutes.Value)
+		} else {
+			update.ClearEstimateMinutes()
+		}
+	}
+	if t.Labels != nil {
+		if len(*t.Labels) > 0 {
+			update.SetLabels(*t.Labels)
+		} else {
+			update.ClearLabels()
+		}
+	}
+	if t.Recurrence.Set {
+		if r := t.Recurrence.Value; r != nil {
+			update.
+				SetRecurrenceRule(r.Rule).
+				SetRecurrenceTimezone(r.Timezone).
+				SetNillableRecurrenceStart(inUTC(r.StartsAt)).
+				SetRecurrencePaused(r.Paused)
+		} else {
+			clearRecurrence(update)
+		}
+	}
+	if err := update.Exec(ctx); err != nil {
+		return nil, err
+	}
+	return store.GetTask(ctx, t.ID)
+}
+
+// ClearRecurrence stops a task from repeating, once its next occurrence has
+// been created or its series has ended.
+func (store *TaskStore) ClearRecurrence(ctx context.Context, taskID uuid.UUID) error {
+	return clearRecurrence(store.client.Task.UpdateOneID(taskID)).
+		Exec(ctx)
+}
+
+func clearRecurrence(update *ent.TaskUpdateOne) *ent.TaskUpdateOne {
+	return update.
+		ClearRecurrenceRule().
+		ClearRecurrenceTimezone().
+		ClearRecurrenceStart().
+		SetRecurrencePaused(false)
+}
+
+func (store *TaskStore) SetStatus(ctx context.Context, taskID uuid.UUID, status models.WorkflowStatus) error {
+	return store.client.Task.UpdateOneID(taskID).
+		SetStatusID(status.ID).
+		SetStatusCategory(task.StatusCategory(status.Category)).
+		Exec(ctx)
+}
+
+func (store *TaskStore) UpdateAttachmentURL(ctx context.Context, taskID uuid.UUID, url string) (*models.Task, error) {
+	err := store.client.Task.UpdateOneID(taskID).
 		SetAttachmentURL(url).
-		Save(context.Background())
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return store.GetTask(ctx, taskID)
+}
+
+// withComputedFields fills in the subtask progress and blocked state of the
+// given tasks using one query per field, rather than one query per task.
+func (store *TaskStore) withComputedFields(ctx context.Context, tasks ...*models.Task) error {
+	ids := make([]uuid.UUID, 0, len(tasks))
+	for _, t := range tasks {
+		ids = append(ids, t.ID)
+	}
+	progress, err := store.progress(ctx, ids...)
+	if err != nil {
+		return err
+	}
+	blocked, err := store.blockedTaskIDs(ctx, ids...)
+	if err != nil {
+		return err
+	}
+	for _, t := range tasks {
+		t.Progress = progress[t.ID]
+		t.IsBlocked = blocked[t.ID]
+	}
+	return nil
+}
+
+func (store *TaskStore) progress(ctx context.Context, parentIDs ...uuid.UUID) (map[uuid.UUID]models.TaskProgress, error) {
+	progress := make(map[uuid.UUID]models.TaskProgress, len(parentIDs))
+	if len(parentIDs) == 0 {
+		return progress, nil
+	}
+	var rows []struct {
+		ParentID uuid.UUID `json:"parent_id"`
+		Total    int       `json:"total"`
+		Done     int       `json:"done"`
+	}
+	err := store.client.Task.Query().
+		Where(task.ParentIDIn(parentIDs...)).
+		GroupBy(task.FieldParentID).
+		Aggregate(ent.As(ent.Count(), "total"), countCompleted("done")).
+		Scan(ctx, &rows)
 	if err != nil {
 		return nil, err
 	}
-	return convertEntTask(entTask), nil
+	for _, row := range rows {
+		progress[row.ParentID] = models.TaskProgress{Done: row.Done, Total: row.Total}
+	}
+	return progress, nil
+}
+
+// countCompleted aggregates the number of tasks in a closed status category
+// in a group.
+func countCompleted(as string) ent.AggregateFunc {
+	return func(s *sql.Selector) string {
+		return sql.As(fmt.Sprintf(
+			"SUM(CASE WHEN %s IN ('%s', '%s') THEN 1 ELSE 0 END)",
+			s.C(task.FieldStatusCategory), task.StatusCategoryDone, task.StatusCategoryCancelled,
+		), as)
+	}
+}
+
+// openStatusCategories are the status categories of unfinished tasks.
+var openStatusCategories = []task.StatusCategory{
+	task.StatusCategoryTodo,
+	task.StatusCategoryInProgress,
+}
+
+// query starts a task query that loads everything convertEntTask needs.
+func (store *TaskStore) query() *ent.TaskQuery {
+	return store.client.Task.Query().
+		WithStatus(func(q *ent.WorkflowStatusQuery) {
+			q.Select(workflowstatus.FieldKey)
+		}).
+		WithAssignees(func(q *ent.UserQuery) {
+			q.Order(ent.Asc(user.FieldUsername))
+		}).
+		WithWatchers(func(q *ent.UserQuery) {
+			q.Order(ent.Asc(user.FieldUsername))
+		})
+}
+
+func inUTC(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	utc := t.UTC()
+	return &utc
+}
+
+func sameIDs(a, b []uuid.UUID) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	seen := make(map[uuid.UUID]bool, len(a))
+	for _, id := range a {
+		seen[id] = true
+	}
+	for _, id := range b {
+		if !seen[id] {
+			return false
+		}
+		delete(seen, id)
+	}
+	return true
+}
+
+func rollback(tx *ent.Tx, err error) error {
+	if rerr := tx.Rollback(); rerr != nil {
+		return fmt.Errorf("%w: rolling back transaction: %v", err, rerr)
+	}
+	return err
 }
 
 func convertEntTask(entTask *ent.Task) *models.Task {
 	task := &models.Task{
-		ID:          entTask.ID,
-		Title:       entTask.Title,
-		Description: entTask.Description,
-		IsCompleted: entTask.IsCompleted,
-		CreatedAt:   entTask.CreatedAt,
+		ID:              entTask.ID,
+		Title:           entTask.Title,
+		Description:     entTask.Description,
+		StatusID:        entTask.StatusID,
+		StatusCategory:  entTask.StatusCategory.String(),
+		IsCompleted:     models.IsClosedCategory(entTask.StatusCategory.String()),
+		ProjectID:       entTask.ProjectID,
+		ParentID:        entTask.ParentID,
+		Position:        entTask.Position,
+		StartAt:         entTask.StartAt,
+		DueAt:           entTask.DueAt,
+		Priority:        entTask.Priority.String(),
+		EstimateMinutes: entTask.EstimateMinutes,
+		SeriesID:        entTask.SeriesID,
+		Labels:          append([]string{}, entTask.Labels...),
+		Rank:            entTask.Rank,
+		Assignees:       make([]*models.User, 0, len(entTask.Edges.Assignees)),
+		Watchers:        make([]*models.User, 0, len(entTask.Edges.Watchers)),
+		CreatedAt:       entTask.CreatedAt,
+		CompletedAt:     entTask.CompletedAt,
+		DeletedAt:       entTask.DeletedAt,
+		Version:         entTask.Version,
+		ExternalID:      entTask.ExternalID,
+		ICalUID:         entTask.IcalUID,
 	}
 	if entTask.AttachmentURL != "" {
 		task.AttachmentURL = &entTask.AttachmentURL
 	}
+	if entTask.Edges.Status != nil {
+		task.Status = entTask.Edges.Status.Key
+	}
+	for _, assignee := range entTask.Edges.Assignees {
+		task.Assignees = append(task.Assignees, convertEntUser(assignee))
+	}
+	for _, watcher := range entTask.Edges.Watchers {
+		task.Watchers = append(task.Watchers, convertEntUser(watcher))
+	}
+	if entTask.RecurrenceRule != nil {
+		task.Recurrence = &models.Recurrence{
+			Rule:     *entTask.RecurrenceRule,
+			Timezone: entTask.RecurrenceTimezone,
+			Paused:   entTask.RecurrencePaused,
+			StartsAt: entTask.RecurrenceStart,
+		}
+	}
 	return task
 }
+
+func convertEntTasks(entTasks []*ent.Task) []*models.Task {
+	tasks := make([]*models.Task, 0, len(entTasks))
+	for _, entTask := range entTasks {
+		tasks = append(tasks, convertEntTask(entTask))
+	}
+	return tasks
+}
@@ -0,0 +1,51 @@
+package datastore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/localopsco/go-sample/ent"
+)
+
+// withTx runs fn in a new transaction, committing it if fn succeeds. If
+// client already belongs to a transaction, fn runs in that one instead, so
+// that store methods can take part in a larger transaction such as a bulk
+// operation.
+func withTx(ctx context.Context, client *ent.Client, fn func(client *ent.Client) error) error {
+	tx, err := client.Tx(ctx)
+	if errors.Is(err, ent.ErrTxStarted) {
+		return fn(client)
+	}
+	if err != nil {
+		return err
+	}
+	if err := fn(tx.Client()); err != nil {
+		return rollback(tx, err)
+	}
+	return tx.Commit()
+}
+
+// InTx runs fn with a client bound to one transaction, which is committed
+// if fn succeeds and rolled back otherwise. Stores created from the client
+// read and write through the transaction.
+func (store *TaskStore) InTx(ctx context.Context, fn func(client *ent.Client) error) error {
+	return withTx(ctx, store.client, fn)
+}
+
+// Savepoint runs fn inside the transaction of a store created by InTx, so
+// that if fn fails only its own changes are rolled back and the transaction
+// can carry on.
+func (store *TaskStore) Savepoint(ctx context.Context, fn func() error) error {
+	if _, err := store.client.ExecContext(ctx, "SAVEPOINT task_savepoint"); err != nil {
+		return err
+	}
+	if err := fn(); err != nil {
+		if _, rerr := store.client.ExecContext(ctx, "ROLLBACK TO SAVEPOINT task_savepoint"); rerr != nil {
+			return fmt.Errorf("%w: rolling back to savepoint: %v", err, rerr)
+		}
+		return err
+	}
+	_, err := store.client.ExecContext(ctx, "RELEASE SAVEPOINT task_savepoint")
+	return err
+}
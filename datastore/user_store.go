@@ -0,0 +1,102 @@
+package datastore
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/ent"
+	"github.com/localopsco/go-sample/ent/user"
+	"github.com/localopsco/go-sample/models"
+)
+
+type UserStore struct {
+	client *ent.Client
+}
+
+func NewUserStore(client *ent.Client) *UserStore {
+	return &UserStore{
+		client,
+	}
+}
+
+func (store *UserStore) CreateUser(ctx context.Context, u models.User, apiTokenHash string) (*models.User, error) {
+	entUser, err := store.client.User.Create().
+		SetUsername(u.Username).
+		SetDisplayName(u.DisplayName).
+		SetAPITokenHash(apiTokenHash).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return convertEntUser(entUser), nil
+}
+
+func (store *UserStore) GetUser(ctx context.Context, userID uuid.UUID) (*models.User, error) {
+	entUser, err := store.client.User.Get(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return convertEntUser(entUser), nil
+}
+
+func (store *UserStore) GetUserByUsername(ctx context.Context, username string) (*models.User, error) {
+	entUser, err := store.client.User.Query().
+		Where(user.Username(username)).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return convertEntUser(entUser), nil
+}
+
+func (store *UserStore) GetUserByTokenHash(ctx context.Context, apiTokenHash string) (*models.User, error) {
+	entUser, err := store.client.User.Query().
+		Where(user.APITokenHash(apiTokenHash)).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return convertEntUser(entUser), nil
+}
+
+func (store *UserStore) GetUserByCalendarTokenHash(ctx context.Context, calendarTokenHash string) (*models.User, error) {
+	entUser, err := store.client.User.Query().
+		Where(user.CalendarTokenHash(calendarTokenHash)).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return convertEntUser(entUser), nil
+}
+
+// SetCalendarTokenHash replaces the calendar feed token of a user, or
+// revokes it when calendarTokenHash is nil.
+func (store *UserStore) SetCalendarTokenHash(ctx context.Context, userID uuid.UUID, calendarTokenHash *string) error {
+	update := store.client.User.UpdateOneID(userID)
+	if calendarTokenHash == nil {
+		update.ClearCalendarTokenHash()
+	} else {
+		update.SetCalendarTokenHash(*calendarTokenHash)
+	}
+	return update.Exec(ctx)
+}
+
+// FindUserIDs returns the IDs of the users with the given usernames, skipping
+// usernames that do not exist.
+func (store *UserStore) FindUserIDs(ctx context.Context, usernames []string) ([]uuid.UUID, error) {
+	if len(usernames) == 0 {
+		return nil, nil
+	}
+	return store.client.User.Query().
+		Where(user.UsernameIn(usernames...)).
+		IDs(ctx)
+}
+
+func convertEntUser(entUser *ent.User) *models.User {
+	return &models.User{
+		ID:          entUser.ID,
+		Username:    entUser.Username,
+		DisplayName: entUser.DisplayName,
+		CreatedAt:   entUser.CreatedAt,
+	}
+}
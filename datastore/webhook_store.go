@@ -0,0 +1,323 @@
+package datastore
+
+import (
+	"context"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/ent"
+	"github.com/localopsco/go-sample/ent/predicate"
+	"github.com/localopsco/go-sample/ent/webhook"
+	"github.com/localopsco/go-sample/ent/webhookdelivery"
+	"github.com/localopsco/go-sample/models"
+)
+
+type WebhookStore struct {
+	client *ent.Client
+}
+
+func NewWebhookStore(client *ent.Client) *WebhookStore {
+	return &WebhookStore{
+		client,
+	}
+}
+
+func (store *WebhookStore) CreateWebhook(ctx context.Context, w models.Webhook) (*models.Webhook, error) {
+	entWebhook, err := store.client.Webhook.Create().
+		SetURL(w.URL).
+		SetEventTypes(w.EventTypes).
+		SetSecret(w.Secret).
+		SetActive(w.Active).
+		SetNillableOwnerID(w.OwnerID).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return convertEntWebhook(entWebhook), nil
+}
+
+func (store *WebhookStore) GetWebhook(ctx context.Context, webhookID uuid.UUID) (*models.Webhook, error) {
+	entWebhook, err := store.client.Webhook.Get(ctx, webhookID)
+	if err != nil {
+		return nil, err
+	}
+	return convertEntWebhook(entWebhook), nil
+}
+
+// ListWebhooks returns the webhooks of an owner, oldest first.
+func (store *WebhookStore) ListWebhooks(ctx context.Context, ownerID uuid.UUID) ([]*models.Webhook, error) {
+	entWebhooks, err := store.client.Webhook.Query().
+		Where(webhook.OwnerID(ownerID)).
+		Order(ent.Asc(webhook.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return convertEntWebhooks(entWebhooks), nil
+}
+
+// ListSubscribedWebhooks returns the active webhooks subscribed to an event
+// type.
+func (store *WebhookStore) ListSubscribedWebhooks(ctx context.Context, eventType string) ([]*models.Webhook, error) {
+	entWebhooks, err := store.client.Webhook.Query().
+		Where(webhook.Active(true), subscribedTo(eventType)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return convertEntWebhooks(entWebhooks), nil
+}
+
+// UpdateWebhook applies the non-nil fields of update to a webhook.
+func (store *WebhookStore) UpdateWebhook(ctx context.Context, update models.WebhookUpdate) (*models.Webhook, error) {
+	updateOne := store.client.Webhook.UpdateOneID(update.ID).
+		SetNillableURL(update.URL).
+		SetNillableSecret(update.Secret).
+		SetNillableActive(update.Active)
+	if update.EventTypes != nil {
+		updateOne.SetEventTypes(*update.EventTypes)
+	}
+	entWebhook, err := updateOne.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return convertEntWebhook(entWebhook), nil
+}
+
+// DeleteWebhook deletes a webhook along with its deliveries.
+func (store *WebhookStore) DeleteWebhook(ctx context.Context, webhookID uuid.UUID) error {
+	return withTx(ctx, store.client, func(client *ent.Client) error {
+		_, err := client.WebhookDelivery.Delete().
+			Where(webhookdelivery.WebhookID(webhookID)).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+		return client.Webhook.DeleteOneID(webhookID).Exec(ctx)
+	})
+}
+
+// CreateDeliveries queues deliveries to be attempted right away. Within a
+// transaction in ctx, such as that of the outbox relay, they are written
+// in it. Deliveries of an event to a webhook that already has one are left
+// out, so that an event relayed again is not delivered twice.
+func (store *WebhookStore) CreateDeliveries(ctx context.Context, deliveries []models.WebhookDelivery) error {
+	client := store.client
+	if tx := ent.TxFromContext(ctx); tx != nil {
+		client = tx.Client()
+	}
+	eventIDs := make([]uuid.UUID, 0, len(deliveries))
+	for _, d := range deliveries {
+		eventIDs = append(eventIDs, d.EventID)
+	}
+	existing, err := client.WebhookDelivery.Query().
+		Where(webhookdelivery.EventIDIn(eventIDs...)).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	queued := make(map[[2]uuid.UUID]bool, len(existing))
+	for _, d := range existing {
+		queued[[2]uuid.UUID{d.WebhookID, d.EventID}] = true
+	}
+	now := time.Now()
+	builders := make([]*ent.WebhookDeliveryCreate, 0, len(deliveries))
+	for _, d := range deliveries {
+		key := [2]uuid.UUID{d.WebhookID, d.EventID}
+		if queued[key] {
+			continue
+		}
+		queued[key] = true
+		builders = append(builders, client.WebhookDelivery.Create().
+			SetWebhookID(d.WebhookID).
+			SetEventID(d.EventID).
+			SetEventType(d.EventType).
+			SetPayload(d.Payload).
+			SetNextAttemptAt(now))
+	}
+	if len(builders) == 0 {
+		return nil
+	}
+	return client.WebhookDelivery.CreateBulk(builders...).Exec(ctx)
+}
+
+// ClaimDueDeliveries returns up to limit deliveries whose next attempt is
+// due, pushing their next attempt back by lease so that other workers leave
+// them alone while they are attempted. A worker that dies mid-attempt thus
+// has its deliveries picked up again once the lease runs out. The returned
+// deliveries have their next attempt set to the end of the lease, which
+// RenewLease checks to tell whether the claim still holds.
+func (store *WebhookStore) ClaimDueDeliveries(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*models.WebhookDelivery, error) {
+	// The database may store times less precisely, which would keep
+	// RenewLease from matching the lease it set.
+	until := now.Add(lease).Truncate(time.Millisecond)
+	due, err := store.client.WebhookDelivery.Query().
+		Where(
+			webhookdelivery.StatusIn(webhookdelivery.StatusPending, webhookdelivery.StatusRetrying),
+			webhookdelivery.NextAttemptAtLTE(now),
+		).
+		Order(webhookdelivery.ByNextAttemptAt(), webhookdelivery.ByCreatedAt()).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	claimed := make([]*models.WebhookDelivery, 0, len(due))
+	for _, entDelivery := range due {
+		n, err := store.client.WebhookDelivery.Update().
+			Where(
+				webhookdelivery.ID(entDelivery.ID),
+				webhookdelivery.NextAttemptAt(*entDelivery.NextAttemptAt),
+			).
+			SetNextAttemptAt(until).
+			Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if n == 1 {
+			delivery := convertEntDelivery(entDelivery)
+			delivery.NextAttemptAt = &until
+			claimed = append(claimed, delivery)
+		}
+	}
+	return claimed, nil
+}
+
+// RenewLease extends the lease on a claimed delivery to lease from now, and
+// moves delivery.NextAttemptAt to its new end. It returns false if the claim
+// no longer holds, because the lease ran out and another worker claimed the
+// delivery, or because it was redelivered.
+func (store *WebhookStore) RenewLease(ctx context.Context, delivery *models.WebhookDelivery, now time.Time, lease time.Duration) (bool, error) {
+	until := now.Add(lease).Truncate(time.Millisecond)
+	n, err := store.client.WebhookDelivery.Update().
+		Where(
+			webhookdelivery.ID(delivery.ID),
+			webhookdelivery.StatusIn(webhookdelivery.StatusPending, webhookdelivery.StatusRetrying),
+			webhookdelivery.NextAttemptAt(*delivery.NextAttemptAt),
+		).
+		SetNextAttemptAt(until).
+		Save(ctx)
+	if err != nil || n == 0 {
+		return false, err
+	}
+	delivery.NextAttemptAt = &until
+	return true, nil
+}
+
+// RecordAttempt logs an attempt to deliver and moves the delivery to
+// status, to be attempted again at nextAttemptAt unless that is nil.
+func (store *WebhookStore) RecordAttempt(ctx context.Context, deliveryID uuid.UUID, attempt models.DeliveryAttempt, status string, nextAttemptAt *time.Time) error {
+	update := store.client.WebhookDelivery.UpdateOneID(deliveryID).
+		SetStatus(webhookdelivery.Status(status)).
+		AddAttemptCount(1).
+		AppendAttempts([]models.DeliveryAttempt{attempt})
+	if attempt.StatusCode != 0 {
+		update.SetLastStatusCode(attempt.StatusCode)
+	}
+	if nextAttemptAt != nil {
+		update.SetNextAttemptAt(*nextAttemptAt)
+	} else {
+		update.ClearNextAttemptAt()
+	}
+	return update.Exec(ctx)
+}
+
+// Redeliver queues a delivery to be attempted again right away, with a
+// fresh set of attempts. Its attempt log is kept.
+func (store *WebhookStore) Redeliver(ctx context.Context, deliveryID uuid.UUID) error {
+	return store.client.WebhookDelivery.UpdateOneID(deliveryID).
+		SetStatus(webhookdelivery.StatusPending).
+		SetAttemptCount(0).
+		SetNextAttemptAt(time.Now()).
+		Exec(ctx)
+}
+
+func (store *WebhookStore) GetDelivery(ctx context.Context, deliveryID uuid.UUID) (*models.WebhookDelivery, error) {
+	entDelivery, err := store.client.WebhookDelivery.Get(ctx, deliveryID)
+	if err != nil {
+		return nil, err
+	}
+	return convertEntDelivery(entDelivery), nil
+}
+
+// ListDeliveries returns a page of the deliveries of a webhook, newest
+// first.
+func (store *WebhookStore) ListDeliveries(ctx context.Context, filter models.WebhookDeliveryFilter, limit, offset int) (*models.Page[*models.WebhookDelivery], error) {
+	predicates := []predicate.WebhookDelivery{webhookdelivery.WebhookID(filter.WebhookID)}
+	if filter.Status != "" {
+		predicates = append(predicates, webhookdelivery.StatusEQ(webhookdelivery.Status(filter.Status)))
+	}
+	query := store.client.WebhookDelivery.Query().Where(predicates...)
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+	entDeliveries, err := query.
+		Order(webhookdelivery.ByCreatedAt(sql.OrderDesc()), webhookdelivery.ByID()).
+		Limit(limit).
+		Offset(offset).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	deliveries := make([]*models.WebhookDelivery, 0, len(entDeliveries))
+	for _, entDelivery := range entDeliveries {
+		deliveries = append(deliveries, convertEntDelivery(entDelivery))
+	}
+	return &models.Page[*models.WebhookDelivery]{
+		Items:  deliveries,
+		Total:  total,
+		Limit:  limit,
+		Offset: offset,
+	}, nil
+}
+
+func subscribedTo(eventType string) predicate.Webhook {
+	return func(s *sql.Selector) {
+		s.Where(sqljson.ValueContains(s.C(webhook.FieldEventTypes), eventType))
+	}
+}
+
+func convertEntWebhook(entWebhook *ent.Webhook) *models.Webhook {
+	return &models.Webhook{
+		ID:         entWebhook.ID,
+		URL:        entWebhook.URL,
+		EventTypes: entWebhook.EventTypes,
+		Secret:     entWebhook.Secret,
+		Active:     entWebhook.Active,
+		OwnerID:    entWebhook.OwnerID,
+		CreatedAt:  entWebhook.CreatedAt,
+		UpdatedAt:  entWebhook.UpdatedAt,
+	}
+}
+
+func convertEntWebhooks(entWebhooks []*ent.Webhook) []*models.Webhook {
+	webhooks := make([]*models.Webhook, 0, len(entWebhooks))
+	for _, entWebhook := range entWebhooks {
+		webhooks = append(webhooks, convertEntWebhook(entWebhook))
+	}
+	return webhooks
+}
+
+func convertEntDelivery(entDelivery *ent.WebhookDelivery) *models.WebhookDelivery {
+	attempts := entDelivery.Attempts
+	if attempts == nil {
+		attempts = []models.DeliveryAttempt{}
+	}
+	return &models.WebhookDelivery{
+		ID:             entDelivery.ID,
+		WebhookID:      entDelivery.WebhookID,
+		EventID:        entDelivery.EventID,
+		EventType:      entDelivery.EventType,
+		Payload:        entDelivery.Payload,
+		Status:         string(entDelivery.Status),
+		AttemptCount:   entDelivery.AttemptCount,
+		NextAttemptAt:  entDelivery.NextAttemptAt,
+		LastStatusCode: entDelivery.LastStatusCode,
+		Attempts:       attempts,
+		CreatedAt:      entDelivery.CreatedAt,
+		UpdatedAt:      entDelivery.UpdatedAt,
+	}
+}
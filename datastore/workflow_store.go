@@ -0,0 +1,168 @@
+package datastore
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/ent"
+	"github.com/localopsco/go-sample/ent/workflow"
+	"github.com/localopsco/go-sample/ent/workflowstatus"
+	"github.com/localopsco/go-sample/models"
+)
+
+type WorkflowStore struct {
+	client *ent.Client
+}
+
+func NewWorkflowStore(client *ent.Client) *WorkflowStore {
+	return &WorkflowStore{
+		client,
+	}
+}
+
+// CreateWorkflow stores a workflow along with its statuses and the
+// transitions between them in a single transaction.
+func (store *WorkflowStore) CreateWorkflow(ctx context.Context, w models.Workflow) (*models.Workflow, error) {
+	tx, err := store.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	entWorkflow, err := tx.Workflow.Create().
+		SetName(w.Name).
+		SetIsDefault(w.IsDefault).
+		Save(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	creates := make([]*ent.WorkflowStatusCreate, 0, len(w.Statuses))
+	for i, status := range w.Statuses {
+		creates = append(creates, tx.WorkflowStatus.Create().
+			SetWorkflowID(entWorkflow.ID).
+			SetKey(status.Key).
+			SetName(status.Name).
+			SetCategory(workflowstatus.Category(status.Category)).
+			SetPosition(i))
+	}
+	entStatuses, err := tx.WorkflowStatus.CreateBulk(creates...).Save(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	statusIDs := make(map[string]uuid.UUID, len(entStatuses))
+	for _, entStatus := range entStatuses {
+		statusIDs[entStatus.Key] = entStatus.ID
+	}
+	for _, status := range w.Statuses {
+		if len(status.Transitions) == 0 {
+			continue
+		}
+		targetIDs := make([]uuid.UUID, 0, len(status.Transitions))
+		for _, key := range status.Transitions {
+			targetIDs = append(targetIDs, statusIDs[key])
+		}
+		err := tx.WorkflowStatus.UpdateOneID(statusIDs[status.Key]).
+			AddTransitionIDs(targetIDs...).
+			Exec(ctx)
+		if err != nil {
+			return nil, rollback(tx, err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return store.GetWorkflow(ctx, entWorkflow.ID)
+}
+
+func (store *WorkflowStore) GetWorkflow(ctx context.Context, workflowID uuid.UUID) (*models.Workflow, error) {
+	entWorkflow, err := store.query().
+		Where(workflow.ID(workflowID)).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return convertEntWorkflow(entWorkflow), nil
+}
+
+// GetDefaultWorkflow returns the workflow used by tasks outside of a project.
+func (store *WorkflowStore) GetDefaultWorkflow(ctx context.Context) (*models.Workflow, error) {
+	entWorkflow, err := store.query().
+		Where(workflow.IsDefault(true)).
+		First(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return convertEntWorkflow(entWorkflow), nil
+}
+
+// GetWorkflowForStatus returns the workflow the given status belongs to.
+func (store *WorkflowStore) GetWorkflowForStatus(ctx context.Context, statusID uuid.UUID) (*models.Workflow, error) {
+	entWorkflow, err := store.query().
+		Where(workflow.HasStatusesWith(workflowstatus.ID(statusID))).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return convertEntWorkflow(entWorkflow), nil
+}
+
+func (store *WorkflowStore) ListWorkflows(ctx context.Context) ([]*models.Workflow, error) {
+	entWorkflows, err := store.query().
+		Order(ent.Asc(workflow.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	workflows := make([]*models.Workflow, 0, len(entWorkflows))
+	for _, entWorkflow := range entWorkflows {
+		workflows = append(workflows, convertEntWorkflow(entWorkflow))
+	}
+	return workflows, nil
+}
+
+// EnsureDefaultWorkflow creates w as the default workflow unless one already
+// exists, and returns the default workflow.
+func (store *WorkflowStore) EnsureDefaultWorkflow(ctx context.Context, w models.Workflow) (*models.Workflow, error) {
+	existing, err := store.GetDefaultWorkflow(ctx)
+	if err == nil {
+		return existing, nil
+	}
+	if !ent.IsNotFound(err) {
+		return nil, err
+	}
+	w.IsDefault = true
+	return store.CreateWorkflow(ctx, w)
+}
+
+func (store *WorkflowStore) query() *ent.WorkflowQuery {
+	return store.client.Workflow.Query().
+		WithStatuses(func(q *ent.WorkflowStatusQuery) {
+			q.Order(ent.Asc(workflowstatus.FieldPosition)).
+				WithTransitions(func(q *ent.WorkflowStatusQuery) {
+					q.Select(workflowstatus.FieldKey)
+				})
+		})
+}
+
+func convertEntWorkflow(entWorkflow *ent.Workflow) *models.Workflow {
+	w := &models.Workflow{
+		ID:        entWorkflow.ID,
+		Name:      entWorkflow.Name,
+		IsDefault: entWorkflow.IsDefault,
+		Statuses:  make([]*models.WorkflowStatus, 0, len(entWorkflow.Edges.Statuses)),
+		CreatedAt: entWorkflow.CreatedAt,
+	}
+	for _, entStatus := range entWorkflow.Edges.Statuses {
+		transitions := make([]string, 0, len(entStatus.Edges.Transitions))
+		for _, target := range entStatus.Edges.Transitions {
+			transitions = append(transitions, target.Key)
+		}
+		w.Statuses = append(w.Statuses, &models.WorkflowStatus{
+			ID:          entStatus.ID,
+			Key:         entStatus.Key,
+			Name:        entStatus.Name,
+			Category:    entStatus.Category.String(),
+			Position:    entStatus.Position,
+			Transitions: transitions,
+		})
+	}
+	return w
+}
@@ -0,0 +1,114 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/localopsco/go-sample/ent/changesequence"
+)
+
+// ChangeSequence is the model entity for the ChangeSequence schema.
+type ChangeSequence struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Value holds the value of the "value" field.
+	Value        int64 `json:"value,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ChangeSequence) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case changesequence.FieldID, changesequence.FieldValue:
+			values[i] = new(sql.NullInt64)
+		case changesequence.FieldName:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ChangeSequence fields.
+func (cs *ChangeSequence) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case changesequence.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			cs.ID = int(value.Int64)
+		case changesequence.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				cs.Name = value.String
+			}
+		case changesequence.FieldValue:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field value", values[i])
+			} else if value.Valid {
+				cs.Value = value.Int64
+			}
+		default:
+			cs.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// GetValue returns the ent.Value that was dynamically selected and assigned to the ChangeSequence.
+// This includes values selected through modifiers, order, etc.
+func (cs *ChangeSequence) GetValue(name string) (ent.Value, error) {
+	return cs.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ChangeSequence.
+// Note that you need to call ChangeSequence.Unwrap() before calling this method if this ChangeSequence
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (cs *ChangeSequence) Update() *ChangeSequenceUpdateOne {
+	return NewChangeSequenceClient(cs.config).UpdateOne(cs)
+}
+
+// Unwrap unwraps the ChangeSequence entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (cs *ChangeSequence) Unwrap() *ChangeSequence {
+	_tx, ok := cs.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ChangeSequence is not a transactional entity")
+	}
+	cs.config.driver = _tx.drv
+	return cs
+}
+
+// String implements the fmt.Stringer.
+func (cs *ChangeSequence) String() string {
+	var builder strings.Builder
+	builder.WriteString("ChangeSequence(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", cs.ID))
+	builder.WriteString("name=")
+	builder.WriteString(cs.Name)
+	builder.WriteString(", ")
+	builder.WriteString("value=")
+	builder.WriteString(fmt.Sprintf("%v", cs.Value))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ChangeSequences is a parsable slice of ChangeSequence.
+type ChangeSequences []*ChangeSequence
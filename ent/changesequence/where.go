@@ -0,0 +1,183 @@
+// Code generated by ent, DO NOT EDIT.
+
+package changesequence
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"github.com/localopsco/go-sample/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ChangeSequence {
+	return predicate.ChangeSequence(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ChangeSequence {
+	return predicate.ChangeSequence(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ChangeSequence {
+	return predicate.ChangeSequence(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ChangeSequence {
+	return predicate.ChangeSequence(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ChangeSequence {
+	return predicate.ChangeSequence(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ChangeSequence {
+	return predicate.ChangeSequence(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ChangeSequence {
+	return predicate.ChangeSequence(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ChangeSequence {
+	return predicate.ChangeSequence(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ChangeSequence {
+	return predicate.ChangeSequence(sql.FieldLTE(FieldID, id))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.ChangeSequence {
+	return predicate.ChangeSequence(sql.FieldEQ(FieldName, v))
+}
+
+// Value applies equality check predicate on the "value" field. It's identical to ValueEQ.
+func Value(v int64) predicate.ChangeSequence {
+	return predicate.ChangeSequence(sql.FieldEQ(FieldValue, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.ChangeSequence {
+	return predicate.ChangeSequence(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.ChangeSequence {
+	return predicate.ChangeSequence(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.ChangeSequence {
+	return predicate.ChangeSequence(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.ChangeSequence {
+	return predicate.ChangeSequence(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.ChangeSequence {
+	return predicate.ChangeSequence(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.ChangeSequence {
+	return predicate.ChangeSequence(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.ChangeSequence {
+	return predicate.ChangeSequence(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.ChangeSequence {
+	return predicate.ChangeSequence(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.ChangeSequence {
+	return predicate.ChangeSequence(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.ChangeSequence {
+	return predicate.ChangeSequence(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.ChangeSequence {
+	return predicate.ChangeSequence(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.ChangeSequence {
+	return predicate.ChangeSequence(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.ChangeSequence {
+	return predicate.ChangeSequence(sql.FieldContainsFold(FieldName, v))
+}
+
+// ValueEQ applies the EQ predicate on the "value" field.
+func ValueEQ(v int64) predicate.ChangeSequence {
+	return predicate.ChangeSequence(sql.FieldEQ(FieldValue, v))
+}
+
+// ValueNEQ applies the NEQ predicate on the "value" field.
+func ValueNEQ(v int64) predicate.ChangeSequence {
+	return predicate.ChangeSequence(sql.FieldNEQ(FieldValue, v))
+}
+
+// ValueIn applies the In predicate on the "value" field.
+func ValueIn(vs ...int64) predicate.ChangeSequence {
+	return predicate.ChangeSequence(sql.FieldIn(FieldValue, vs...))
+}
+
+// ValueNotIn applies the NotIn predicate on the "value" field.
+func ValueNotIn(vs ...int64) predicate.ChangeSequence {
+	return predicate.ChangeSequence(sql.FieldNotIn(FieldValue, vs...))
+}
+
+// ValueGT applies the GT predicate on the "value" field.
+func ValueGT(v int64) predicate.ChangeSequence {
+	return predicate.ChangeSequence(sql.FieldGT(FieldValue, v))
+}
+
+// ValueGTE applies the GTE predicate on the "value" field.
+func ValueGTE(v int64) predicate.ChangeSequence {
+	return predicate.ChangeSequence(sql.FieldGTE(FieldValue, v))
+}
+
+// ValueLT applies the LT predicate on the "value" field.
+func ValueLT(v int64) predicate.ChangeSequence {
+	return predicate.ChangeSequence(sql.FieldLT(FieldValue, v))
+}
+
+// ValueLTE applies the LTE predicate on the "value" field.
+func ValueLTE(v int64) predicate.ChangeSequence {
+	return predicate.ChangeSequence(sql.FieldLTE(FieldValue, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ChangeSequence) predicate.ChangeSequence {
+	return predicate.ChangeSequence(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ChangeSequence) predicate.ChangeSequence {
+	return predicate.ChangeSequence(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ChangeSequence) predicate.ChangeSequence {
+	return predicate.ChangeSequence(sql.NotPredicates(p))
+}
@@ -0,0 +1,214 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/localopsco/go-sample/ent/changesequence"
+)
+
+// ChangeSequenceCreate is the builder for creating a ChangeSequence entity.
+type ChangeSequenceCreate struct {
+	config
+	mutation *ChangeSequenceMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (csc *ChangeSequenceCreate) SetName(s string) *ChangeSequenceCreate {
+	csc.mutation.SetName(s)
+	return csc
+}
+
+// SetValue sets the "value" field.
+func (csc *ChangeSequenceCreate) SetValue(i int64) *ChangeSequenceCreate {
+	csc.mutation.SetValue(i)
+	return csc
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (csc *ChangeSequenceCreate) SetNillableValue(i *int64) *ChangeSequenceCreate {
+	if i != nil {
+		csc.SetValue(*i)
+	}
+	return csc
+}
+
+// Mutation returns the ChangeSequenceMutation object of the builder.
+func (csc *ChangeSequenceCreate) Mutation() *ChangeSequenceMutation {
+	return csc.mutation
+}
+
+// Save creates the ChangeSequence in the database.
+func (csc *ChangeSequenceCreate) Save(ctx context.Context) (*ChangeSequence, error) {
+	csc.defaults()
+	return withHooks(ctx, csc.sqlSave, csc.mutation, csc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (csc *ChangeSequenceCreate) SaveX(ctx context.Context) *ChangeSequence {
+	v, err := csc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (csc *ChangeSequenceCreate) Exec(ctx context.Context) error {
+	_, err := csc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (csc *ChangeSequenceCreate) ExecX(ctx context.Context) {
+	if err := csc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (csc *ChangeSequenceCreate) defaults() {
+	if _, ok := csc.mutation.Value(); !ok {
+		v := changesequence.DefaultValue
+		csc.mutation.SetValue(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (csc *ChangeSequenceCreate) check() error {
+	if _, ok := csc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "ChangeSequence.name"`)}
+	}
+	if _, ok := csc.mutation.Value(); !ok {
+		return &ValidationError{Name: "value", err: errors.New(`ent: missing required field "ChangeSequence.value"`)}
+	}
+	return nil
+}
+
+func (csc *ChangeSequenceCreate) sqlSave(ctx context.Context) (*ChangeSequence, error) {
+	if err := csc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := csc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, csc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	csc.mutation.id = &_node.ID
+	csc.mutation.done = true
+	return _node, nil
+}
+
+func (csc *ChangeSequenceCreate) createSpec() (*ChangeSequence, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ChangeSequence{config: csc.config}
+		_spec = sqlgraph.NewCreateSpec(changesequence.Table, sqlgraph.NewFieldSpec(changesequence.FieldID, field.TypeInt))
+	)
+	if value, ok := csc.mutation.Name(); ok {
+		_spec.SetField(changesequence.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := csc.mutation.Value(); ok {
+		_spec.SetField(changesequence.FieldValue, field.TypeInt64, value)
+		_node.Value = value
+	}
+	return _node, _spec
+}
+
+// ChangeSequenceCreateBulk is the builder for creating many ChangeSequence entities in bulk.
+type ChangeSequenceCreateBulk struct {
+	config
+	err      error
+	builders []*ChangeSequenceCreate
+}
+
+// Save creates the ChangeSequence entities in the database.
+func (cscb *ChangeSequenceCreateBulk) Save(ctx context.Context) ([]*ChangeSequence, error) {
+	if cscb.err != nil {
+		return nil, cscb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(cscb.builders))
+	nodes := make([]*ChangeSequence, len(cscb.builders))
+	mutators := make([]Mutator, len(cscb.builders))
+	for i := range cscb.builders {
+		func(i int, root context.Context) {
+			builder := cscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ChangeSequenceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, cscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, cscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, cscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cscb *ChangeSequenceCreateBulk) SaveX(ctx context.Context) []*ChangeSequence {
+	v, err := cscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cscb *ChangeSequenceCreateBulk) Exec(ctx context.Context) error {
+	_, err := cscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cscb *ChangeSequenceCreateBulk) ExecX(ctx context.Context) {
+	if err := cscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/localopsco/go-sample/ent/changesequence"
+	"github.com/localopsco/go-sample/ent/predicate"
+)
+
+// ChangeSequenceDelete is the builder for deleting a ChangeSequence entity.
+type ChangeSequenceDelete struct {
+	config
+	hooks    []Hook
+	mutation *ChangeSequenceMutation
+}
+
+// Where appends a list predicates to the ChangeSequenceDelete builder.
+func (csd *ChangeSequenceDelete) Where(ps ...predicate.ChangeSequence) *ChangeSequenceDelete {
+	csd.mutation.Where(ps...)
+	return csd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (csd *ChangeSequenceDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, csd.sqlExec, csd.mutation, csd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (csd *ChangeSequenceDelete) ExecX(ctx context.Context) int {
+	n, err := csd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (csd *ChangeSequenceDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(changesequence.Table, sqlgraph.NewFieldSpec(changesequence.FieldID, field.TypeInt))
+	if ps := csd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, csd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	csd.mutation.done = true
+	return affected, err
+}
+
+// ChangeSequenceDeleteOne is the builder for deleting a single ChangeSequence entity.
+type ChangeSequenceDeleteOne struct {
+	csd *ChangeSequenceDelete
+}
+
+// Where appends a list predicates to the ChangeSequenceDelete builder.
+func (csdo *ChangeSequenceDeleteOne) Where(ps ...predicate.ChangeSequence) *ChangeSequenceDeleteOne {
+	csdo.csd.mutation.Where(ps...)
+	return csdo
+}
+
+// Exec executes the deletion query.
+func (csdo *ChangeSequenceDeleteOne) Exec(ctx context.Context) error {
+	n, err := csdo.csd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{changesequence.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (csdo *ChangeSequenceDeleteOne) ExecX(ctx context.Context) {
+	if err := csdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,555 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/localopsco/go-sample/ent/changesequence"
+	"github.com/localopsco/go-sample/ent/predicate"
+)
+
+// ChangeSequenceQuery is the builder for querying ChangeSequence entities.
+type ChangeSequenceQuery struct {
+	config
+	ctx        *QueryContext
+	order      []changesequence.OrderOption
+	inters     []Interceptor
+	predicates []predicate.ChangeSequence
+	loadTotal  []func(context.Context, []*ChangeSequence) error
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ChangeSequenceQuery builder.
+func (csq *ChangeSequenceQuery) Where(ps ...predicate.ChangeSequence) *ChangeSequenceQuery {
+	csq.predicates = append(csq.predicates, ps...)
+	return csq
+}
+
+// Limit the number of records to be returned by this query.
+func (csq *ChangeSequenceQuery) Limit(limit int) *ChangeSequenceQuery {
+	csq.ctx.Limit = &limit
+	return csq
+}
+
+// Offset to start from.
+func (csq *ChangeSequenceQuery) Offset(offset int) *ChangeSequenceQuery {
+	csq.ctx.Offset = &offset
+	return csq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (csq *ChangeSequenceQuery) Unique(unique bool) *ChangeSequenceQuery {
+	csq.ctx.Unique = &unique
+	return csq
+}
+
+// Order specifies how the records should be ordered.
+func (csq *ChangeSequenceQuery) Order(o ...changesequence.OrderOption) *ChangeSequenceQuery {
+	csq.order = append(csq.order, o...)
+	return csq
+}
+
+// First returns the first ChangeSequence entity from the query.
+// Returns a *NotFoundError when no ChangeSequence was found.
+func (csq *ChangeSequenceQuery) First(ctx context.Context) (*ChangeSequence, error) {
+	nodes, err := csq.Limit(1).All(setContextOp(ctx, csq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{changesequence.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (csq *ChangeSequenceQuery) FirstX(ctx context.Context) *ChangeSequence {
+	node, err := csq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ChangeSequence ID from the query.
+// Returns a *NotFoundError when no ChangeSequence ID was found.
+func (csq *ChangeSequenceQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = csq.Limit(1).IDs(setContextOp(ctx, csq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{changesequence.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (csq *ChangeSequenceQuery) FirstIDX(ctx context.Context) int {
+	id, err := csq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ChangeSequence entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ChangeSequence entity is found.
+// Returns a *NotFoundError when no ChangeSequence entities are found.
+func (csq *ChangeSequenceQuery) Only(ctx context.Context) (*ChangeSequence, error) {
+	nodes, err := csq.Limit(2).All(setContextOp(ctx, csq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{changesequence.Label}
+	default:
+		return nil, &NotSingularError{changesequence.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (csq *ChangeSequenceQuery) OnlyX(ctx context.Context) *ChangeSequence {
+	node, err := csq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ChangeSequence ID in the query.
+// Returns a *NotSingularError when more than one ChangeSequence ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (csq *ChangeSequenceQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = csq.Limit(2).IDs(setContextOp(ctx, csq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{changesequence.Label}
+	default:
+		err = &NotSingularError{changesequence.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (csq *ChangeSequenceQuery) OnlyIDX(ctx context.Context) int {
+	id, err := csq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ChangeSequences.
+func (csq *ChangeSequenceQuery) All(ctx context.Context) ([]*ChangeSequence, error) {
+	ctx = setContextOp(ctx, csq.ctx, ent.OpQueryAll)
+	if err := csq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ChangeSequence, *ChangeSequenceQuery]()
+	return withInterceptors[[]*ChangeSequence](ctx, csq, qr, csq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (csq *ChangeSequenceQuery) AllX(ctx context.Context) []*ChangeSequence {
+	nodes, err := csq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ChangeSequence IDs.
+func (csq *ChangeSequenceQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if csq.ctx.Unique == nil && csq.path != nil {
+		csq.Unique(true)
+	}
+	ctx = setContextOp(ctx, csq.ctx, ent.OpQueryIDs)
+	if err = csq.Select(changesequence.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (csq *ChangeSequenceQuery) IDsX(ctx context.Context) []int {
+	ids, err := csq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (csq *ChangeSequenceQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, csq.ctx, ent.OpQueryCount)
+	if err := csq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, csq, querierCount[*ChangeSequenceQuery](), csq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (csq *ChangeSequenceQuery) CountX(ctx context.Context) int {
+	count, err := csq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (csq *ChangeSequenceQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, csq.ctx, ent.OpQueryExist)
+	switch _, err := csq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (csq *ChangeSequenceQuery) ExistX(ctx context.Context) bool {
+	exist, err := csq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ChangeSequenceQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (csq *ChangeSequenceQuery) Clone() *ChangeSequenceQuery {
+	if csq == nil {
+		return nil
+	}
+	return &ChangeSequenceQuery{
+		config:     csq.config,
+		ctx:        csq.ctx.Clone(),
+		order:      append([]changesequence.OrderOption{}, csq.order...),
+		inters:     append([]Interceptor{}, csq.inters...),
+		predicates: append([]predicate.ChangeSequence{}, csq.predicates...),
+		// clone intermediate query.
+		sql:  csq.sql.Clone(),
+		path: csq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ChangeSequence.Query().
+//		GroupBy(changesequence.FieldName).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (csq *ChangeSequenceQuery) GroupBy(field string, fields ...string) *ChangeSequenceGroupBy {
+	csq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ChangeSequenceGroupBy{build: csq}
+	grbuild.flds = &csq.ctx.Fields
+	grbuild.label = changesequence.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//	}
+//
+//	client.ChangeSequence.Query().
+//		Select(changesequence.FieldName).
+//		Scan(ctx, &v)
+func (csq *ChangeSequenceQuery) Select(fields ...string) *ChangeSequenceSelect {
+	csq.ctx.Fields = append(csq.ctx.Fields, fields...)
+	sbuild := &ChangeSequenceSelect{ChangeSequenceQuery: csq}
+	sbuild.label = changesequence.Label
+	sbuild.flds, sbuild.scan = &csq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ChangeSequenceSelect configured with the given aggregations.
+func (csq *ChangeSequenceQuery) Aggregate(fns ...AggregateFunc) *ChangeSequenceSelect {
+	return csq.Select().Aggregate(fns...)
+}
+
+func (csq *ChangeSequenceQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range csq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, csq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range csq.ctx.Fields {
+		if !changesequence.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if csq.path != nil {
+		prev, err := csq.path(ctx)
+		if err != nil {
+			return err
+		}
+		csq.sql = prev
+	}
+	return nil
+}
+
+func (csq *ChangeSequenceQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ChangeSequence, error) {
+	var (
+		nodes = []*ChangeSequence{}
+		_spec = csq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ChangeSequence).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ChangeSequence{config: csq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(csq.modifiers) > 0 {
+		_spec.Modifiers = csq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, csq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	for i := range csq.loadTotal {
+		if err := csq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (csq *ChangeSequenceQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := csq.querySpec()
+	if len(csq.modifiers) > 0 {
+		_spec.Modifiers = csq.modifiers
+	}
+	_spec.Node.Columns = csq.ctx.Fields
+	if len(csq.ctx.Fields) > 0 {
+		_spec.Unique = csq.ctx.Unique != nil && *csq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, csq.driver, _spec)
+}
+
+func (csq *ChangeSequenceQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(changesequence.Table, changesequence.Columns, sqlgraph.NewFieldSpec(changesequence.FieldID, field.TypeInt))
+	_spec.From = csq.sql
+	if unique := csq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if csq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := csq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, changesequence.FieldID)
+		for i := range fields {
+			if fields[i] != changesequence.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := csq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := csq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := csq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := csq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (csq *ChangeSequenceQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(csq.driver.Dialect())
+	t1 := builder.Table(changesequence.Table)
+	columns := csq.ctx.Fields
+	if len(columns) == 0 {
+		columns = changesequence.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if csq.sql != nil {
+		selector = csq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if csq.ctx.Unique != nil && *csq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range csq.modifiers {
+		m(selector)
+	}
+	for _, p := range csq.predicates {
+		p(selector)
+	}
+	for _, p := range csq.order {
+		p(selector)
+	}
+	if offset := csq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := csq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (csq *ChangeSequenceQuery) Modify(modifiers ...func(s *sql.Selector)) *ChangeSequenceSelect {
+	csq.modifiers = append(csq.modifiers, modifiers...)
+	return csq.Select()
+}
+
+// ChangeSequenceGroupBy is the group-by builder for ChangeSequence entities.
+type ChangeSequenceGroupBy struct {
+	selector
+	build *ChangeSequenceQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (csgb *ChangeSequenceGroupBy) Aggregate(fns ...AggregateFunc) *ChangeSequenceGroupBy {
+	csgb.fns = append(csgb.fns, fns...)
+	return csgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (csgb *ChangeSequenceGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, csgb.build.ctx, ent.OpQueryGroupBy)
+	if err := csgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ChangeSequenceQuery, *ChangeSequenceGroupBy](ctx, csgb.build, csgb, csgb.build.inters, v)
+}
+
+func (csgb *ChangeSequenceGroupBy) sqlScan(ctx context.Context, root *ChangeSequenceQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(csgb.fns))
+	for _, fn := range csgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*csgb.flds)+len(csgb.fns))
+		for _, f := range *csgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*csgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := csgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ChangeSequenceSelect is the builder for selecting fields of ChangeSequence entities.
+type ChangeSequenceSelect struct {
+	*ChangeSequenceQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (css *ChangeSequenceSelect) Aggregate(fns ...AggregateFunc) *ChangeSequenceSelect {
+	css.fns = append(css.fns, fns...)
+	return css
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (css *ChangeSequenceSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, css.ctx, ent.OpQuerySelect)
+	if err := css.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ChangeSequenceQuery, *ChangeSequenceSelect](ctx, css.ChangeSequenceQuery, css, css.inters, v)
+}
+
+func (css *ChangeSequenceSelect) sqlScan(ctx context.Context, root *ChangeSequenceQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(css.fns))
+	for _, fn := range css.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*css.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := css.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (css *ChangeSequenceSelect) Modify(modifiers ...func(s *sql.Selector)) *ChangeSequenceSelect {
+	css.modifiers = append(css.modifiers, modifiers...)
+	return css
+}
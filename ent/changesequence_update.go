@@ -0,0 +1,245 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/localopsco/go-sample/ent/changesequence"
+	"github.com/localopsco/go-sample/ent/predicate"
+)
+
+// ChangeSequenceUpdate is the builder for updating ChangeSequence entities.
+type ChangeSequenceUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ChangeSequenceMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ChangeSequenceUpdate builder.
+func (csu *ChangeSequenceUpdate) Where(ps ...predicate.ChangeSequence) *ChangeSequenceUpdate {
+	csu.mutation.Where(ps...)
+	return csu
+}
+
+// SetValue sets the "value" field.
+func (csu *ChangeSequenceUpdate) SetValue(i int64) *ChangeSequenceUpdate {
+	csu.mutation.ResetValue()
+	csu.mutation.SetValue(i)
+	return csu
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (csu *ChangeSequenceUpdate) SetNillableValue(i *int64) *ChangeSequenceUpdate {
+	if i != nil {
+		csu.SetValue(*i)
+	}
+	return csu
+}
+
+// AddValue adds i to the "value" field.
+func (csu *ChangeSequenceUpdate) AddValue(i int64) *ChangeSequenceUpdate {
+	csu.mutation.AddValue(i)
+	return csu
+}
+
+// Mutation returns the ChangeSequenceMutation object of the builder.
+func (csu *ChangeSequenceUpdate) Mutation() *ChangeSequenceMutation {
+	return csu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (csu *ChangeSequenceUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, csu.sqlSave, csu.mutation, csu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (csu *ChangeSequenceUpdate) SaveX(ctx context.Context) int {
+	affected, err := csu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (csu *ChangeSequenceUpdate) Exec(ctx context.Context) error {
+	_, err := csu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (csu *ChangeSequenceUpdate) ExecX(ctx context.Context) {
+	if err := csu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (csu *ChangeSequenceUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ChangeSequenceUpdate {
+	csu.modifiers = append(csu.modifiers, modifiers...)
+	return csu
+}
+
+func (csu *ChangeSequenceUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(changesequence.Table, changesequence.Columns, sqlgraph.NewFieldSpec(changesequence.FieldID, field.TypeInt))
+	if ps := csu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := csu.mutation.Value(); ok {
+		_spec.SetField(changesequence.FieldValue, field.TypeInt64, value)
+	}
+	if value, ok := csu.mutation.AddedValue(); ok {
+		_spec.AddField(changesequence.FieldValue, field.TypeInt64, value)
+	}
+	_spec.AddModifiers(csu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, csu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{changesequence.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	csu.mutation.done = true
+	return n, nil
+}
+
+// ChangeSequenceUpdateOne is the builder for updating a single ChangeSequence entity.
+type ChangeSequenceUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ChangeSequenceMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetValue sets the "value" field.
+func (csuo *ChangeSequenceUpdateOne) SetValue(i int64) *ChangeSequenceUpdateOne {
+	csuo.mutation.ResetValue()
+	csuo.mutation.SetValue(i)
+	return csuo
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (csuo *ChangeSequenceUpdateOne) SetNillableValue(i *int64) *ChangeSequenceUpdateOne {
+	if i != nil {
+		csuo.SetValue(*i)
+	}
+	return csuo
+}
+
+// AddValue adds i to the "value" field.
+func (csuo *ChangeSequenceUpdateOne) AddValue(i int64) *ChangeSequenceUpdateOne {
+	csuo.mutation.AddValue(i)
+	return csuo
+}
+
+// Mutation returns the ChangeSequenceMutation object of the builder.
+func (csuo *ChangeSequenceUpdateOne) Mutation() *ChangeSequenceMutation {
+	return csuo.mutation
+}
+
+// Where appends a list predicates to the ChangeSequenceUpdate builder.
+func (csuo *ChangeSequenceUpdateOne) Where(ps ...predicate.ChangeSequence) *ChangeSequenceUpdateOne {
+	csuo.mutation.Where(ps...)
+	return csuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (csuo *ChangeSequenceUpdateOne) Select(field string, fields ...string) *ChangeSequenceUpdateOne {
+	csuo.fields = append([]string{field}, fields...)
+	return csuo
+}
+
+// Save executes the query and returns the updated ChangeSequence entity.
+func (csuo *ChangeSequenceUpdateOne) Save(ctx context.Context) (*ChangeSequence, error) {
+	return withHooks(ctx, csuo.sqlSave, csuo.mutation, csuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (csuo *ChangeSequenceUpdateOne) SaveX(ctx context.Context) *ChangeSequence {
+	node, err := csuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (csuo *ChangeSequenceUpdateOne) Exec(ctx context.Context) error {
+	_, err := csuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (csuo *ChangeSequenceUpdateOne) ExecX(ctx context.Context) {
+	if err := csuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (csuo *ChangeSequenceUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ChangeSequenceUpdateOne {
+	csuo.modifiers = append(csuo.modifiers, modifiers...)
+	return csuo
+}
+
+func (csuo *ChangeSequenceUpdateOne) sqlSave(ctx context.Context) (_node *ChangeSequence, err error) {
+	_spec := sqlgraph.NewUpdateSpec(changesequence.Table, changesequence.Columns, sqlgraph.NewFieldSpec(changesequence.FieldID, field.TypeInt))
+	id, ok := csuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ChangeSequence.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := csuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, changesequence.FieldID)
+		for _, f := range fields {
+			if !changesequence.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != changesequence.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := csuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := csuo.mutation.Value(); ok {
+		_spec.SetField(changesequence.FieldValue, field.TypeInt64, value)
+	}
+	if value, ok := csuo.mutation.AddedValue(); ok {
+		_spec.AddField(changesequence.FieldValue, field.TypeInt64, value)
+	}
+	_spec.AddModifiers(csuo.modifiers...)
+	_node = &ChangeSequence{config: csuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, csuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{changesequence.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	csuo.mutation.done = true
+	return _node, nil
+}
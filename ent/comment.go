@@ -0,0 +1,332 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/ent/comment"
+	"github.com/localopsco/go-sample/ent/task"
+	"github.com/localopsco/go-sample/ent/user"
+)
+
+// Comment is the model entity for the Comment schema.
+type Comment struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// TaskID holds the value of the "task_id" field.
+	TaskID uuid.UUID `json:"task_id,omitempty"`
+	// AuthorID holds the value of the "author_id" field.
+	AuthorID uuid.UUID `json:"author_id,omitempty"`
+	// ParentID holds the value of the "parent_id" field.
+	ParentID *uuid.UUID `json:"parent_id,omitempty"`
+	// Body holds the value of the "body" field.
+	Body string `json:"body,omitempty"`
+	// BodyHTML holds the value of the "body_html" field.
+	BodyHTML string `json:"body_html,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// EditedAt holds the value of the "edited_at" field.
+	EditedAt *time.Time `json:"edited_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the CommentQuery when eager-loading is set.
+	Edges        CommentEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// CommentEdges holds the relations/edges for other nodes in the graph.
+type CommentEdges struct {
+	// Task holds the value of the task edge.
+	Task *Task `json:"task,omitempty"`
+	// Author holds the value of the author edge.
+	Author *User `json:"author,omitempty"`
+	// Parent holds the value of the parent edge.
+	Parent *Comment `json:"parent,omitempty"`
+	// Replies holds the value of the replies edge.
+	Replies []*Comment `json:"replies,omitempty"`
+	// Mentions holds the value of the mentions edge.
+	Mentions []*User `json:"mentions,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [5]bool
+	// totalCount holds the count of the edges above.
+	totalCount [3]map[string]int
+
+	namedReplies  map[string][]*Comment
+	namedMentions map[string][]*User
+}
+
+// TaskOrErr returns the Task value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CommentEdges) TaskOrErr() (*Task, error) {
+	if e.Task != nil {
+		return e.Task, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: task.Label}
+	}
+	return nil, &NotLoadedError{edge: "task"}
+}
+
+// AuthorOrErr returns the Author value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CommentEdges) AuthorOrErr() (*User, error) {
+	if e.Author != nil {
+		return e.Author, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "author"}
+}
+
+// ParentOrErr returns the Parent value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CommentEdges) ParentOrErr() (*Comment, error) {
+	if e.Parent != nil {
+		return e.Parent, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: comment.Label}
+	}
+	return nil, &NotLoadedError{edge: "parent"}
+}
+
+// RepliesOrErr returns the Replies value or an error if the edge
+// was not loaded in eager-loading.
+func (e CommentEdges) RepliesOrErr() ([]*Comment, error) {
+	if e.loadedTypes[3] {
+		return e.Replies, nil
+	}
+	return nil, &NotLoadedError{edge: "replies"}
+}
+
+// MentionsOrErr returns the Mentions value or an error if the edge
+// was not loaded in eager-loading.
+func (e CommentEdges) MentionsOrErr() ([]*User, error) {
+	if e.loadedTypes[4] {
+		return e.Mentions, nil
+	}
+	return nil, &NotLoadedError{edge: "mentions"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Comment) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case comment.FieldParentID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case comment.FieldBody, comment.FieldBodyHTML:
+			values[i] = new(sql.NullString)
+		case comment.FieldCreatedAt, comment.FieldEditedAt:
+			values[i] = new(sql.NullTime)
+		case comment.FieldID, comment.FieldTaskID, comment.FieldAuthorID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Comment fields.
+func (c *Comment) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case comment.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				c.ID = *value
+			}
+		case comment.FieldTaskID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field task_id", values[i])
+			} else if value != nil {
+				c.TaskID = *value
+			}
+		case comment.FieldAuthorID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field author_id", values[i])
+			} else if value != nil {
+				c.AuthorID = *value
+			}
+		case comment.FieldParentID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field parent_id", values[i])
+			} else if value.Valid {
+				c.ParentID = new(uuid.UUID)
+				*c.ParentID = *value.S.(*uuid.UUID)
+			}
+		case comment.FieldBody:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field body", values[i])
+			} else if value.Valid {
+				c.Body = value.String
+			}
+		case comment.FieldBodyHTML:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field body_html", values[i])
+			} else if value.Valid {
+				c.BodyHTML = value.String
+			}
+		case comment.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				c.CreatedAt = value.Time
+			}
+		case comment.FieldEditedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field edited_at", values[i])
+			} else if value.Valid {
+				c.EditedAt = new(time.Time)
+				*c.EditedAt = value.Time
+			}
+		default:
+			c.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Comment.
+// This includes values selected through modifiers, order, etc.
+func (c *Comment) Value(name string) (ent.Value, error) {
+	return c.selectValues.Get(name)
+}
+
+// QueryTask queries the "task" edge of the Comment entity.
+func (c *Comment) QueryTask() *TaskQuery {
+	return NewCommentClient(c.config).QueryTask(c)
+}
+
+// QueryAuthor queries the "author" edge of the Comment entity.
+func (c *Comment) QueryAuthor() *UserQuery {
+	return NewCommentClient(c.config).QueryAuthor(c)
+}
+
+// QueryParent queries the "parent" edge of the Comment entity.
+func (c *Comment) QueryParent() *CommentQuery {
+	return NewCommentClient(c.config).QueryParent(c)
+}
+
+// QueryReplies queries the "replies" edge of the Comment entity.
+func (c *Comment) QueryReplies() *CommentQuery {
+	return NewCommentClient(c.config).QueryReplies(c)
+}
+
+// QueryMentions queries the "mentions" edge of the Comment entity.
+func (c *Comment) QueryMentions() *UserQuery {
+	return NewCommentClient(c.config).QueryMentions(c)
+}
+
+// Update returns a builder for updating this Comment.
+// Note that you need to call Comment.Unwrap() before calling this method if this Comment
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (c *Comment) Update() *CommentUpdateOne {
+	return NewCommentClient(c.config).UpdateOne(c)
+}
+
+// Unwrap unwraps the Comment entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (c *Comment) Unwrap() *Comment {
+	_tx, ok := c.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Comment is not a transactional entity")
+	}
+	c.config.driver = _tx.drv
+	return c
+}
+
+// String implements the fmt.Stringer.
+func (c *Comment) String() string {
+	var builder strings.Builder
+	builder.WriteString("Comment(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", c.ID))
+	builder.WriteString("task_id=")
+	builder.WriteString(fmt.Sprintf("%v", c.TaskID))
+	builder.WriteString(", ")
+	builder.WriteString("author_id=")
+	builder.WriteString(fmt.Sprintf("%v", c.AuthorID))
+	builder.WriteString(", ")
+	if v := c.ParentID; v != nil {
+		builder.WriteString("parent_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("body=")
+	builder.WriteString(c.Body)
+	builder.WriteString(", ")
+	builder.WriteString("body_html=")
+	builder.WriteString(c.BodyHTML)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(c.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := c.EditedAt; v != nil {
+		builder.WriteString("edited_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// NamedReplies returns the Replies named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (c *Comment) NamedReplies(name string) ([]*Comment, error) {
+	if c.Edges.namedReplies == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := c.Edges.namedReplies[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (c *Comment) appendNamedReplies(name string, edges ...*Comment) {
+	if c.Edges.namedReplies == nil {
+		c.Edges.namedReplies = make(map[string][]*Comment)
+	}
+	if len(edges) == 0 {
+		c.Edges.namedReplies[name] = []*Comment{}
+	} else {
+		c.Edges.namedReplies[name] = append(c.Edges.namedReplies[name], edges...)
+	}
+}
+
+// NamedMentions returns the Mentions named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (c *Comment) NamedMentions(name string) ([]*User, error) {
+	if c.Edges.namedMentions == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := c.Edges.namedMentions[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (c *Comment) appendNamedMentions(name string, edges ...*User) {
+	if c.Edges.namedMentions == nil {
+		c.Edges.namedMentions = make(map[string][]*User)
+	}
+	if len(edges) == 0 {
+		c.Edges.namedMentions[name] = []*User{}
+	} else {
+		c.Edges.namedMentions[name] = append(c.Edges.namedMentions[name], edges...)
+	}
+}
+
+// Comments is a parsable slice of Comment.
+type Comments []*Comment
@@ -0,0 +1,438 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/ent/comment"
+	"github.com/localopsco/go-sample/ent/task"
+	"github.com/localopsco/go-sample/ent/user"
+)
+
+// CommentCreate is the builder for creating a Comment entity.
+type CommentCreate struct {
+	config
+	mutation *CommentMutation
+	hooks    []Hook
+}
+
+// SetTaskID sets the "task_id" field.
+func (cc *CommentCreate) SetTaskID(u uuid.UUID) *CommentCreate {
+	cc.mutation.SetTaskID(u)
+	return cc
+}
+
+// SetAuthorID sets the "author_id" field.
+func (cc *CommentCreate) SetAuthorID(u uuid.UUID) *CommentCreate {
+	cc.mutation.SetAuthorID(u)
+	return cc
+}
+
+// SetParentID sets the "parent_id" field.
+func (cc *CommentCreate) SetParentID(u uuid.UUID) *CommentCreate {
+	cc.mutation.SetParentID(u)
+	return cc
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (cc *CommentCreate) SetNillableParentID(u *uuid.UUID) *CommentCreate {
+	if u != nil {
+		cc.SetParentID(*u)
+	}
+	return cc
+}
+
+// SetBody sets the "body" field.
+func (cc *CommentCreate) SetBody(s string) *CommentCreate {
+	cc.mutation.SetBody(s)
+	return cc
+}
+
+// SetBodyHTML sets the "body_html" field.
+func (cc *CommentCreate) SetBodyHTML(s string) *CommentCreate {
+	cc.mutation.SetBodyHTML(s)
+	return cc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (cc *CommentCreate) SetCreatedAt(t time.Time) *CommentCreate {
+	cc.mutation.SetCreatedAt(t)
+	return cc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (cc *CommentCreate) SetNillableCreatedAt(t *time.Time) *CommentCreate {
+	if t != nil {
+		cc.SetCreatedAt(*t)
+	}
+	return cc
+}
+
+// SetEditedAt sets the "edited_at" field.
+func (cc *CommentCreate) SetEditedAt(t time.Time) *CommentCreate {
+	cc.mutation.SetEditedAt(t)
+	return cc
+}
+
+// SetNillableEditedAt sets the "edited_at" field if the given value is not nil.
+func (cc *CommentCreate) SetNillableEditedAt(t *time.Time) *CommentCreate {
+	if t != nil {
+		cc.SetEditedAt(*t)
+	}
+	return cc
+}
+
+// SetID sets the "id" field.
+func (cc *CommentCreate) SetID(u uuid.UUID) *CommentCreate {
+	cc.mutation.SetID(u)
+	return cc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (cc *CommentCreate) SetNillableID(u *uuid.UUID) *CommentCreate {
+	if u != nil {
+		cc.SetID(*u)
+	}
+	return cc
+}
+
+// SetTask sets the "task" edge to the Task entity.
+func (cc *CommentCreate) SetTask(t *Task) *CommentCreate {
+	return cc.SetTaskID(t.ID)
+}
+
+// SetAuthor sets the "author" edge to the User entity.
+func (cc *CommentCreate) SetAuthor(u *User) *CommentCreate {
+	return cc.SetAuthorID(u.ID)
+}
+
+// SetParent sets the "parent" edge to the Comment entity.
+func (cc *CommentCreate) SetParent(c *Comment) *CommentCreate {
+	return cc.SetParentID(c.ID)
+}
+
+// AddReplyIDs adds the "replies" edge to the Comment entity by IDs.
+func (cc *CommentCreate) AddReplyIDs(ids ...uuid.UUID) *CommentCreate {
+	cc.mutation.AddReplyIDs(ids...)
+	return cc
+}
+
+// AddReplies adds the "replies" edges to the Comment entity.
+func (cc *CommentCreate) AddReplies(c ...*Comment) *CommentCreate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cc.AddReplyIDs(ids...)
+}
+
+// AddMentionIDs adds the "mentions" edge to the User entity by IDs.
+func (cc *CommentCreate) AddMentionIDs(ids ...uuid.UUID) *CommentCreate {
+	cc.mutation.AddMentionIDs(ids...)
+	return cc
+}
+
+// AddMentions adds the "mentions" edges to the User entity.
+func (cc *CommentCreate) AddMentions(u ...*User) *CommentCreate {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return cc.AddMentionIDs(ids...)
+}
+
+// Mutation returns the CommentMutation object of the builder.
+func (cc *CommentCreate) Mutation() *CommentMutation {
+	return cc.mutation
+}
+
+// Save creates the Comment in the database.
+func (cc *CommentCreate) Save(ctx context.Context) (*Comment, error) {
+	cc.defaults()
+	return withHooks(ctx, cc.sqlSave, cc.mutation, cc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (cc *CommentCreate) SaveX(ctx context.Context) *Comment {
+	v, err := cc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cc *CommentCreate) Exec(ctx context.Context) error {
+	_, err := cc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cc *CommentCreate) ExecX(ctx context.Context) {
+	if err := cc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cc *CommentCreate) defaults() {
+	if _, ok := cc.mutation.CreatedAt(); !ok {
+		v := comment.DefaultCreatedAt()
+		cc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := cc.mutation.ID(); !ok {
+		v := comment.DefaultID()
+		cc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cc *CommentCreate) check() error {
+	if _, ok := cc.mutation.TaskID(); !ok {
+		return &ValidationError{Name: "task_id", err: errors.New(`ent: missing required field "Comment.task_id"`)}
+	}
+	if _, ok := cc.mutation.AuthorID(); !ok {
+		return &ValidationError{Name: "author_id", err: errors.New(`ent: missing required field "Comment.author_id"`)}
+	}
+	if _, ok := cc.mutation.Body(); !ok {
+		return &ValidationError{Name: "body", err: errors.New(`ent: missing required field "Comment.body"`)}
+	}
+	if _, ok := cc.mutation.BodyHTML(); !ok {
+		return &ValidationError{Name: "body_html", err: errors.New(`ent: missing required field "Comment.body_html"`)}
+	}
+	if _, ok := cc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Comment.created_at"`)}
+	}
+	if len(cc.mutation.TaskIDs()) == 0 {
+		return &ValidationError{Name: "task", err: errors.New(`ent: missing required edge "Comment.task"`)}
+	}
+	if len(cc.mutation.AuthorIDs()) == 0 {
+		return &ValidationError{Name: "author", err: errors.New(`ent: missing required edge "Comment.author"`)}
+	}
+	return nil
+}
+
+func (cc *CommentCreate) sqlSave(ctx context.Context) (*Comment, error) {
+	if err := cc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := cc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, cc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	cc.mutation.id = &_node.ID
+	cc.mutation.done = true
+	return _node, nil
+}
+
+func (cc *CommentCreate) createSpec() (*Comment, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Comment{config: cc.config}
+		_spec = sqlgraph.NewCreateSpec(comment.Table, sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID))
+	)
+	if id, ok := cc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := cc.mutation.Body(); ok {
+		_spec.SetField(comment.FieldBody, field.TypeString, value)
+		_node.Body = value
+	}
+	if value, ok := cc.mutation.BodyHTML(); ok {
+		_spec.SetField(comment.FieldBodyHTML, field.TypeString, value)
+		_node.BodyHTML = value
+	}
+	if value, ok := cc.mutation.CreatedAt(); ok {
+		_spec.SetField(comment.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := cc.mutation.EditedAt(); ok {
+		_spec.SetField(comment.FieldEditedAt, field.TypeTime, value)
+		_node.EditedAt = &value
+	}
+	if nodes := cc.mutation.TaskIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   comment.TaskTable,
+			Columns: []string{comment.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TaskID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := cc.mutation.AuthorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   comment.AuthorTable,
+			Columns: []string{comment.AuthorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AuthorID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := cc.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   comment.ParentTable,
+			Columns: []string{comment.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ParentID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := cc.mutation.RepliesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   comment.RepliesTable,
+			Columns: []string{comment.RepliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := cc.mutation.MentionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   comment.MentionsTable,
+			Columns: comment.MentionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// CommentCreateBulk is the builder for creating many Comment entities in bulk.
+type CommentCreateBulk struct {
+	config
+	err      error
+	builders []*CommentCreate
+}
+
+// Save creates the Comment entities in the database.
+func (ccb *CommentCreateBulk) Save(ctx context.Context) ([]*Comment, error) {
+	if ccb.err != nil {
+		return nil, ccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ccb.builders))
+	nodes := make([]*Comment, len(ccb.builders))
+	mutators := make([]Mutator, len(ccb.builders))
+	for i := range ccb.builders {
+		func(i int, root context.Context) {
+			builder := ccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CommentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ccb *CommentCreateBulk) SaveX(ctx context.Context) []*Comment {
+	v, err := ccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ccb *CommentCreateBulk) Exec(ctx context.Context) error {
+	_, err := ccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ccb *CommentCreateBulk) ExecX(ctx context.Context) {
+	if err := ccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1037 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/ent/comment"
+	"github.com/localopsco/go-sample/ent/predicate"
+	"github.com/localopsco/go-sample/ent/task"
+	"github.com/localopsco/go-sample/ent/user"
+)
+
+// CommentUpdate is the builder for updating Comment entities.
+type CommentUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *CommentMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the CommentUpdate builder.
+func (cu *CommentUpdate) Where(ps ...predicate.Comment) *CommentUpdate {
+	cu.mutation.Where(ps...)
+	return cu
+}
+
+// SetTaskID sets the "task_id" field.
+func (cu *CommentUpdate) SetTaskID(u uuid.UUID) *CommentUpdate {
+	cu.mutation.SetTaskID(u)
+	return cu
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (cu *CommentUpdate) SetNillableTaskID(u *uuid.UUID) *CommentUpdate {
+	if u != nil {
+		cu.SetTaskID(*u)
+	}
+	return cu
+}
+
+// SetAuthorID sets the "author_id" field.
+func (cu *CommentUpdate) SetAuthorID(u uuid.UUID) *CommentUpdate {
+	cu.mutation.SetAuthorID(u)
+	return cu
+}
+
+// SetNillableAuthorID sets the "author_id" field if the given value is not nil.
+func (cu *CommentUpdate) SetNillableAuthorID(u *uuid.UUID) *CommentUpdate {
+	if u != nil {
+		cu.SetAuthorID(*u)
+	}
+	return cu
+}
+
+// SetParentID sets the "parent_id" field.
+func (cu *CommentUpdate) SetParentID(u uuid.UUID) *CommentUpdate {
+	cu.mutation.SetParentID(u)
+	return cu
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (cu *CommentUpdate) SetNillableParentID(u *uuid.UUID) *CommentUpdate {
+	if u != nil {
+		cu.SetParentID(*u)
+	}
+	return cu
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (cu *CommentUpdate) ClearParentID() *CommentUpdate {
+	cu.mutation.ClearParentID()
+	return cu
+}
+
+// SetBody sets the "body" field.
+func (cu *CommentUpdate) SetBody(s string) *CommentUpdate {
+	cu.mutation.SetBody(s)
+	return cu
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (cu *CommentUpdate) SetNillableBody(s *string) *CommentUpdate {
+	if s != nil {
+		cu.SetBody(*s)
+	}
+	return cu
+}
+
+// SetBodyHTML sets the "body_html" field.
+func (cu *CommentUpdate) SetBodyHTML(s string) *CommentUpdate {
+	cu.mutation.SetBodyHTML(s)
+	return cu
+}
+
+// SetNillableBodyHTML sets the "body_html" field if the given value is not nil.
+func (cu *CommentUpdate) SetNillableBodyHTML(s *string) *CommentUpdate {
+	if s != nil {
+		cu.SetBodyHTML(*s)
+	}
+	return cu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (cu *CommentUpdate) SetCreatedAt(t time.Time) *CommentUpdate {
+	cu.mutation.SetCreatedAt(t)
+	return cu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (cu *CommentUpdate) SetNillableCreatedAt(t *time.Time) *CommentUpdate {
+	if t != nil {
+		cu.SetCreatedAt(*t)
+	}
+	return cu
+}
+
+// SetEditedAt sets the "edited_at" field.
+func (cu *CommentUpdate) SetEditedAt(t time.Time) *CommentUpdate {
+	cu.mutation.SetEditedAt(t)
+	return cu
+}
+
+// SetNillableEditedAt sets the "edited_at" field if the given value is not nil.
+func (cu *CommentUpdate) SetNillableEditedAt(t *time.Time) *CommentUpdate {
+	if t != nil {
+		cu.SetEditedAt(*t)
+	}
+	return cu
+}
+
+// ClearEditedAt clears the value of the "edited_at" field.
+func (cu *CommentUpdate) ClearEditedAt() *CommentUpdate {
+	cu.mutation.ClearEditedAt()
+	return cu
+}
+
+// SetTask sets the "task" edge to the Task entity.
+func (cu *CommentUpdate) SetTask(t *Task) *CommentUpdate {
+	return cu.SetTaskID(t.ID)
+}
+
+// SetAuthor sets the "author" edge to the User entity.
+func (cu *CommentUpdate) SetAuthor(u *User) *CommentUpdate {
+	return cu.SetAuthorID(u.ID)
+}
+
+// SetParent sets the "parent" edge to the Comment entity.
+func (cu *CommentUpdate) SetParent(c *Comment) *CommentUpdate {
+	return cu.SetParentID(c.ID)
+}
+
+// AddReplyIDs adds the "replies" edge to the Comment entity by IDs.
+func (cu *CommentUpdate) AddReplyIDs(ids ...uuid.UUID) *CommentUpdate {
+	cu.mutation.AddReplyIDs(ids...)
+	return cu
+}
+
+// AddReplies adds the "replies" edges to the Comment entity.
+func (cu *CommentUpdate) AddReplies(c ...*Comment) *CommentUpdate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cu.AddReplyIDs(ids...)
+}
+
+// AddMentionIDs adds the "mentions" edge to the User entity by IDs.
+func (cu *CommentUpdate) AddMentionIDs(ids ...uuid.UUID) *CommentUpdate {
+	cu.mutation.AddMentionIDs(ids...)
+	return cu
+}
+
+// AddMentions adds the "mentions" edges to the User entity.
+func (cu *CommentUpdate) AddMentions(u ...*User) *CommentUpdate {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return cu.AddMentionIDs(ids...)
+}
+
+// Mutation returns the CommentMutation object of the builder.
+func (cu *CommentUpdate) Mutation() *CommentMutation {
+	return cu.mutation
+}
+
+// ClearTask clears the "task" edge to the Task entity.
+func (cu *CommentUpdate) ClearTask() *CommentUpdate {
+	cu.mutation.ClearTask()
+	return cu
+}
+
+// ClearAuthor clears the "author" edge to the User entity.
+func (cu *CommentUpdate) ClearAuthor() *CommentUpdate {
+	cu.mutation.ClearAuthor()
+	return cu
+}
+
+// ClearParent clears the "parent" edge to the Comment entity.
+func (cu *CommentUpdate) ClearParent() *CommentUpdate {
+	cu.mutation.ClearParent()
+	return cu
+}
+
+// ClearReplies clears all "replies" edges to the Comment entity.
+func (cu *CommentUpdate) ClearReplies() *CommentUpdate {
+	cu.mutation.ClearReplies()
+	return cu
+}
+
+// RemoveReplyIDs removes the "replies" edge to Comment entities by IDs.
+func (cu *CommentUpdate) RemoveReplyIDs(ids ...uuid.UUID) *CommentUpdate {
+	cu.mutation.RemoveReplyIDs(ids...)
+	return cu
+}
+
+// RemoveReplies removes "replies" edges to Comment entities.
+func (cu *CommentUpdate) RemoveReplies(c ...*Comment) *CommentUpdate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cu.RemoveReplyIDs(ids...)
+}
+
+// ClearMentions clears all "mentions" edges to the User entity.
+func (cu *CommentUpdate) ClearMentions() *CommentUpdate {
+	cu.mutation.ClearMentions()
+	return cu
+}
+
+// RemoveMentionIDs removes the "mentions" edge to User entities by IDs.
+func (cu *CommentUpdate) RemoveMentionIDs(ids ...uuid.UUID) *CommentUpdate {
+	cu.mutation.RemoveMentionIDs(ids...)
+	return cu
+}
+
+// RemoveMentions removes "mentions" edges to User entities.
+func (cu *CommentUpdate) RemoveMentions(u ...*User) *CommentUpdate {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return cu.RemoveMentionIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cu *CommentUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, cu.sqlSave, cu.mutation, cu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cu *CommentUpdate) SaveX(ctx context.Context) int {
+	affected, err := cu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cu *CommentUpdate) Exec(ctx context.Context) error {
+	_, err := cu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cu *CommentUpdate) ExecX(ctx context.Context) {
+	if err := cu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cu *CommentUpdate) check() error {
+	if cu.mutation.TaskCleared() && len(cu.mutation.TaskIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Comment.task"`)
+	}
+	if cu.mutation.AuthorCleared() && len(cu.mutation.AuthorIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Comment.author"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (cu *CommentUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CommentUpdate {
+	cu.modifiers = append(cu.modifiers, modifiers...)
+	return cu
+}
+
+func (cu *CommentUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := cu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(comment.Table, comment.Columns, sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID))
+	if ps := cu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cu.mutation.Body(); ok {
+		_spec.SetField(comment.FieldBody, field.TypeString, value)
+	}
+	if value, ok := cu.mutation.BodyHTML(); ok {
+		_spec.SetField(comment.FieldBodyHTML, field.TypeString, value)
+	}
+	if value, ok := cu.mutation.CreatedAt(); ok {
+		_spec.SetField(comment.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := cu.mutation.EditedAt(); ok {
+		_spec.SetField(comment.FieldEditedAt, field.TypeTime, value)
+	}
+	if cu.mutation.EditedAtCleared() {
+		_spec.ClearField(comment.FieldEditedAt, field.TypeTime)
+	}
+	if cu.mutation.TaskCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   comment.TaskTable,
+			Columns: []string{comment.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.TaskIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   comment.TaskTable,
+			Columns: []string{comment.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cu.mutation.AuthorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   comment.AuthorTable,
+			Columns: []string{comment.AuthorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.AuthorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   comment.AuthorTable,
+			Columns: []string{comment.AuthorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cu.mutation.ParentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   comment.ParentTable,
+			Columns: []string{comment.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   comment.ParentTable,
+			Columns: []string{comment.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cu.mutation.RepliesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   comment.RepliesTable,
+			Columns: []string{comment.RepliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.RemovedRepliesIDs(); len(nodes) > 0 && !cu.mutation.RepliesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   comment.RepliesTable,
+			Columns: []string{comment.RepliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.RepliesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   comment.RepliesTable,
+			Columns: []string{comment.RepliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cu.mutation.MentionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   comment.MentionsTable,
+			Columns: comment.MentionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.RemovedMentionsIDs(); len(nodes) > 0 && !cu.mutation.MentionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   comment.MentionsTable,
+			Columns: comment.MentionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.MentionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   comment.MentionsTable,
+			Columns: comment.MentionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(cu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, cu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{comment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	cu.mutation.done = true
+	return n, nil
+}
+
+// CommentUpdateOne is the builder for updating a single Comment entity.
+type CommentUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *CommentMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetTaskID sets the "task_id" field.
+func (cuo *CommentUpdateOne) SetTaskID(u uuid.UUID) *CommentUpdateOne {
+	cuo.mutation.SetTaskID(u)
+	return cuo
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (cuo *CommentUpdateOne) SetNillableTaskID(u *uuid.UUID) *CommentUpdateOne {
+	if u != nil {
+		cuo.SetTaskID(*u)
+	}
+	return cuo
+}
+
+// SetAuthorID sets the "author_id" field.
+func (cuo *CommentUpdateOne) SetAuthorID(u uuid.UUID) *CommentUpdateOne {
+	cuo.mutation.SetAuthorID(u)
+	return cuo
+}
+
+// SetNillableAuthorID sets the "author_id" field if the given value is not nil.
+func (cuo *CommentUpdateOne) SetNillableAuthorID(u *uuid.UUID) *CommentUpdateOne {
+	if u != nil {
+		cuo.SetAuthorID(*u)
+	}
+	return cuo
+}
+
+// SetParentID sets the "parent_id" field.
+func (cuo *CommentUpdateOne) SetParentID(u uuid.UUID) *CommentUpdateOne {
+	cuo.mutation.SetParentID(u)
+	return cuo
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (cuo *CommentUpdateOne) SetNillableParentID(u *uuid.UUID) *CommentUpdateOne {
+	if u != nil {
+		cuo.SetParentID(*u)
+	}
+	return cuo
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (cuo *CommentUpdateOne) ClearParentID() *CommentUpdateOne {
+	cuo.mutation.ClearParentID()
+	return cuo
+}
+
+// SetBody sets the "body" field.
+func (cuo *CommentUpdateOne) SetBody(s string) *CommentUpdateOne {
+	cuo.mutation.SetBody(s)
+	return cuo
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (cuo *CommentUpdateOne) SetNillableBody(s *string) *CommentUpdateOne {
+	if s != nil {
+		cuo.SetBody(*s)
+	}
+	return cuo
+}
+
+// SetBodyHTML sets the "body_html" field.
+func (cuo *CommentUpdateOne) SetBodyHTML(s string) *CommentUpdateOne {
+	cuo.mutation.SetBodyHTML(s)
+	return cuo
+}
+
+// SetNillableBodyHTML sets the "body_html" field if the given value is not nil.
+func (cuo *CommentUpdateOne) SetNillableBodyHTML(s *string) *CommentUpdateOne {
+	if s != nil {
+		cuo.SetBodyHTML(*s)
+	}
+	return cuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (cuo *CommentUpdateOne) SetCreatedAt(t time.Time) *CommentUpdateOne {
+	cuo.mutation.SetCreatedAt(t)
+	return cuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (cuo *CommentUpdateOne) SetNillableCreatedAt(t *time.Time) *CommentUpdateOne {
+	if t != nil {
+		cuo.SetCreatedAt(*t)
+	}
+	return cuo
+}
+
+// SetEditedAt sets the "edited_at" field.
+func (cuo *CommentUpdateOne) SetEditedAt(t time.Time) *CommentUpdateOne {
+	cuo.mutation.SetEditedAt(t)
+	return cuo
+}
+
+// SetNillableEditedAt sets the "edited_at" field if the given value is not nil.
+func (cuo *CommentUpdateOne) SetNillableEditedAt(t *time.Time) *CommentUpdateOne {
+	if t != nil {
+		cuo.SetEditedAt(*t)
+	}
+	return cuo
+}
+
+// ClearEditedAt clears the value of the "edited_at" field.
+func (cuo *CommentUpdateOne) ClearEditedAt() *CommentUpdateOne {
+	cuo.mutation.ClearEditedAt()
+	return cuo
+}
+
+// SetTask sets the "task" edge to the Task entity.
+func (cuo *CommentUpdateOne) SetTask(t *Task) *CommentUpdateOne {
+	return cuo.SetTaskID(t.ID)
+}
+
+// SetAuthor sets the "author" edge to the User entity.
+func (cuo *CommentUpdateOne) SetAuthor(u *User) *CommentUpdateOne {
+	return cuo.SetAuthorID(u.ID)
+}
+
+// SetParent sets the "parent" edge to the Comment entity.
+func (cuo *CommentUpdateOne) SetParent(c *Comment) *CommentUpdateOne {
+	return cuo.SetParentID(c.ID)
+}
+
+// AddReplyIDs adds the "replies" edge to the Comment entity by IDs.
+func (cuo *CommentUpdateOne) AddReplyIDs(ids ...uuid.UUID) *CommentUpdateOne {
+	cuo.mutation.AddReplyIDs(ids...)
+	return cuo
+}
+
+// AddReplies adds the "replies" edges to the Comment entity.
+func (cuo *CommentUpdateOne) AddReplies(c ...*Comment) *CommentUpdateOne {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cuo.AddReplyIDs(ids...)
+}
+
+// AddMentionIDs adds the "mentions" edge to the User entity by IDs.
+func (cuo *CommentUpdateOne) AddMentionIDs(ids ...uuid.UUID) *CommentUpdateOne {
+	cuo.mutation.AddMentionIDs(ids...)
+	return cuo
+}
+
+// AddMentions adds the "mentions" edges to the User entity.
+func (cuo *CommentUpdateOne) AddMentions(u ...*User) *CommentUpdateOne {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return cuo.AddMentionIDs(ids...)
+}
+
+// Mutation returns the CommentMutation object of the builder.
+func (cuo *CommentUpdateOne) Mutation() *CommentMutation {
+	return cuo.mutation
+}
+
+// ClearTask clears the "task" edge to the Task entity.
+func (cuo *CommentUpdateOne) ClearTask() *CommentUpdateOne {
+	cuo.mutation.ClearTask()
+	return cuo
+}
+
+// ClearAuthor clears the "author" edge to the User entity.
+func (cuo *CommentUpdateOne) ClearAuthor() *CommentUpdateOne {
+	cuo.mutation.ClearAuthor()
+	return cuo
+}
+
+// ClearParent clears the "parent" edge to the Comment entity.
+func (cuo *CommentUpdateOne) ClearParent() *CommentUpdateOne {
+	cuo.mutation.ClearParent()
+	return cuo
+}
+
+// ClearReplies clears all "replies" edges to the Comment entity.
+func (cuo *CommentUpdateOne) ClearReplies() *CommentUpdateOne {
+	cuo.mutation.ClearReplies()
+	return cuo
+}
+
+// RemoveReplyIDs removes the "replies" edge to Comment entities by IDs.
+func (cuo *CommentUpdateOne) RemoveReplyIDs(ids ...uuid.UUID) *CommentUpdateOne {
+	cuo.mutation.RemoveReplyIDs(ids...)
+	return cuo
+}
+
+// RemoveReplies removes "replies" edges to Comment entities.
+func (cuo *CommentUpdateOne) RemoveReplies(c ...*Comment) *CommentUpdateOne {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cuo.RemoveReplyIDs(ids...)
+}
+
+// ClearMentions clears all "mentions" edges to the User entity.
+func (cuo *CommentUpdateOne) ClearMentions() *CommentUpdateOne {
+	cuo.mutation.ClearMentions()
+	return cuo
+}
+
+// RemoveMentionIDs removes the "mentions" edge to User entities by IDs.
+func (cuo *CommentUpdateOne) RemoveMentionIDs(ids ...uuid.UUID) *CommentUpdateOne {
+	cuo.mutation.RemoveMentionIDs(ids...)
+	return cuo
+}
+
+// RemoveMentions removes "mentions" edges to User entities.
+func (cuo *CommentUpdateOne) RemoveMentions(u ...*User) *CommentUpdateOne {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return cuo.RemoveMentionIDs(ids...)
+}
+
+// Where appends a list predicates to the CommentUpdate builder.
+func (cuo *CommentUpdateOne) Where(ps ...predicate.Comment) *CommentUpdateOne {
+	cuo.mutation.Where(ps...)
+	return cuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cuo *CommentUpdateOne) Select(field string, fields ...string) *CommentUpdateOne {
+	cuo.fields = append([]string{field}, fields...)
+	return cuo
+}
+
+// Save executes the query and returns the updated Comment entity.
+func (cuo *CommentUpdateOne) Save(ctx context.Context) (*Comment, error) {
+	return withHooks(ctx, cuo.sqlSave, cuo.mutation, cuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cuo *CommentUpdateOne) SaveX(ctx context.Context) *Comment {
+	node, err := cuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cuo *CommentUpdateOne) Exec(ctx context.Context) error {
+	_, err := cuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cuo *CommentUpdateOne) ExecX(ctx context.Context) {
+	if err := cuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cuo *CommentUpdateOne) check() error {
+	if cuo.mutation.TaskCleared() && len(cuo.mutation.TaskIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Comment.task"`)
+	}
+	if cuo.mutation.AuthorCleared() && len(cuo.mutation.AuthorIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Comment.author"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (cuo *CommentUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CommentUpdateOne {
+	cuo.modifiers = append(cuo.modifiers, modifiers...)
+	return cuo
+}
+
+func (cuo *CommentUpdateOne) sqlSave(ctx context.Context) (_node *Comment, err error) {
+	if err := cuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(comment.Table, comment.Columns, sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID))
+	id, ok := cuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Comment.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, comment.FieldID)
+		for _, f := range fields {
+			if !comment.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != comment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cuo.mutation.Body(); ok {
+		_spec.SetField(comment.FieldBody, field.TypeString, value)
+	}
+	if value, ok := cuo.mutation.BodyHTML(); ok {
+		_spec.SetField(comment.FieldBodyHTML, field.TypeString, value)
+	}
+	if value, ok := cuo.mutation.CreatedAt(); ok {
+		_spec.SetField(comment.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := cuo.mutation.EditedAt(); ok {
+		_spec.SetField(comment.FieldEditedAt, field.TypeTime, value)
+	}
+	if cuo.mutation.EditedAtCleared() {
+		_spec.ClearField(comment.FieldEditedAt, field.TypeTime)
+	}
+	if cuo.mutation.TaskCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   comment.TaskTable,
+			Columns: []string{comment.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.TaskIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   comment.TaskTable,
+			Columns: []string{comment.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cuo.mutation.AuthorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   comment.AuthorTable,
+			Columns: []string{comment.AuthorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.AuthorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   comment.AuthorTable,
+			Columns: []string{comment.AuthorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cuo.mutation.ParentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   comment.ParentTable,
+			Columns: []string{comment.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   comment.ParentTable,
+			Columns: []string{comment.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cuo.mutation.RepliesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   comment.RepliesTable,
+			Columns: []string{comment.RepliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.RemovedRepliesIDs(); len(nodes) > 0 && !cuo.mutation.RepliesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   comment.RepliesTable,
+			Columns: []string{comment.RepliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.RepliesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   comment.RepliesTable,
+			Columns: []string{comment.RepliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cuo.mutation.MentionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   comment.MentionsTable,
+			Columns: comment.MentionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.RemovedMentionsIDs(); len(nodes) > 0 && !cuo.mutation.MentionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   comment.MentionsTable,
+			Columns: comment.MentionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.MentionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   comment.MentionsTable,
+			Columns: comment.MentionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(cuo.modifiers...)
+	_node = &Comment{config: cuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{comment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	cuo.mutation.done = true
+	return _node, nil
+}
@@ -12,7 +12,21 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/localopsco/go-sample/ent/changesequence"
+	"github.com/localopsco/go-sample/ent/comment"
+	"github.com/localopsco/go-sample/ent/exportjob"
+	"github.com/localopsco/go-sample/ent/idempotencykey"
+	"github.com/localopsco/go-sample/ent/outboxevent"
+	"github.com/localopsco/go-sample/ent/project"
+	"github.com/localopsco/go-sample/ent/savedview"
 	"github.com/localopsco/go-sample/ent/task"
+	"github.com/localopsco/go-sample/ent/taskevent"
+	"github.com/localopsco/go-sample/ent/tasktombstone"
+	"github.com/localopsco/go-sample/ent/user"
+	"github.com/localopsco/go-sample/ent/webhook"
+	"github.com/localopsco/go-sample/ent/webhookdelivery"
+	"github.com/localopsco/go-sample/ent/workflow"
+	"github.com/localopsco/go-sample/ent/workflowstatus"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -73,7 +87,21 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			task.Table: task.ValidColumn,
+			changesequence.Table:  changesequence.ValidColumn,
+			comment.Table:         comment.ValidColumn,
+			exportjob.Table:       exportjob.ValidColumn,
+			idempotencykey.Table:  idempotencykey.ValidColumn,
+			outboxevent.Table:     outboxevent.ValidColumn,
+			project.Table:         project.ValidColumn,
+			savedview.Table:       savedview.ValidColumn,
+			task.Table:            task.ValidColumn,
+			taskevent.Table:       taskevent.ValidColumn,
+			tasktombstone.Table:   tasktombstone.ValidColumn,
+			user.Table:            user.ValidColumn,
+			webhook.Table:         webhook.ValidColumn,
+			webhookdelivery.Table: webhookdelivery.ValidColumn,
+			workflow.Table:        workflow.ValidColumn,
+			workflowstatus.Table:  workflowstatus.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
@@ -0,0 +1,249 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/ent/exportjob"
+	"github.com/localopsco/go-sample/models"
+)
+
+// ExportJob is the model entity for the ExportJob schema.
+type ExportJob struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID *uuid.UUID `json:"user_id,omitempty"`
+	// Format holds the value of the "format" field.
+	Format exportjob.Format `json:"format,omitempty"`
+	// Columns holds the value of the "columns" field.
+	Columns []string `json:"columns,omitempty"`
+	// Filter holds the value of the "filter" field.
+	Filter models.TaskFilter `json:"filter,omitempty"`
+	// Timezone holds the value of the "timezone" field.
+	Timezone string `json:"timezone,omitempty"`
+	// Status holds the value of the "status" field.
+	Status exportjob.Status `json:"status,omitempty"`
+	// Error holds the value of the "error" field.
+	Error string `json:"error,omitempty"`
+	// Rows holds the value of the "rows" field.
+	Rows int `json:"rows,omitempty"`
+	// ResultKey holds the value of the "result_key" field.
+	ResultKey string `json:"result_key,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// StartedAt holds the value of the "started_at" field.
+	StartedAt *time.Time `json:"started_at,omitempty"`
+	// FinishedAt holds the value of the "finished_at" field.
+	FinishedAt   *time.Time `json:"finished_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ExportJob) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case exportjob.FieldUserID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case exportjob.FieldColumns, exportjob.FieldFilter:
+			values[i] = new([]byte)
+		case exportjob.FieldRows:
+			values[i] = new(sql.NullInt64)
+		case exportjob.FieldFormat, exportjob.FieldTimezone, exportjob.FieldStatus, exportjob.FieldError, exportjob.FieldResultKey:
+			values[i] = new(sql.NullString)
+		case exportjob.FieldCreatedAt, exportjob.FieldStartedAt, exportjob.FieldFinishedAt:
+			values[i] = new(sql.NullTime)
+		case exportjob.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ExportJob fields.
+func (ej *ExportJob) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case exportjob.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				ej.ID = *value
+			}
+		case exportjob.FieldUserID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				ej.UserID = new(uuid.UUID)
+				*ej.UserID = *value.S.(*uuid.UUID)
+			}
+		case exportjob.FieldFormat:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field format", values[i])
+			} else if value.Valid {
+				ej.Format = exportjob.Format(value.String)
+			}
+		case exportjob.FieldColumns:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field columns", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ej.Columns); err != nil {
+					return fmt.Errorf("unmarshal field columns: %w", err)
+				}
+			}
+		case exportjob.FieldFilter:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field filter", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ej.Filter); err != nil {
+					return fmt.Errorf("unmarshal field filter: %w", err)
+				}
+			}
+		case exportjob.FieldTimezone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field timezone", values[i])
+			} else if value.Valid {
+				ej.Timezone = value.String
+			}
+		case exportjob.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				ej.Status = exportjob.Status(value.String)
+			}
+		case exportjob.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				ej.Error = value.String
+			}
+		case exportjob.FieldRows:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field rows", values[i])
+			} else if value.Valid {
+				ej.Rows = int(value.Int64)
+			}
+		case exportjob.FieldResultKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field result_key", values[i])
+			} else if value.Valid {
+				ej.ResultKey = value.String
+			}
+		case exportjob.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ej.CreatedAt = value.Time
+			}
+		case exportjob.FieldStartedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field started_at", values[i])
+			} else if value.Valid {
+				ej.StartedAt = new(time.Time)
+				*ej.StartedAt = value.Time
+			}
+		case exportjob.FieldFinishedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field finished_at", values[i])
+			} else if value.Valid {
+				ej.FinishedAt = new(time.Time)
+				*ej.FinishedAt = value.Time
+			}
+		default:
+			ej.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ExportJob.
+// This includes values selected through modifiers, order, etc.
+func (ej *ExportJob) Value(name string) (ent.Value, error) {
+	return ej.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ExportJob.
+// Note that you need to call ExportJob.Unwrap() before calling this method if this ExportJob
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ej *ExportJob) Update() *ExportJobUpdateOne {
+	return NewExportJobClient(ej.config).UpdateOne(ej)
+}
+
+// Unwrap unwraps the ExportJob entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ej *ExportJob) Unwrap() *ExportJob {
+	_tx, ok := ej.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ExportJob is not a transactional entity")
+	}
+	ej.config.driver = _tx.drv
+	return ej
+}
+
+// String implements the fmt.Stringer.
+func (ej *ExportJob) String() string {
+	var builder strings.Builder
+	builder.WriteString("ExportJob(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ej.ID))
+	if v := ej.UserID; v != nil {
+		builder.WriteString("user_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("format=")
+	builder.WriteString(fmt.Sprintf("%v", ej.Format))
+	builder.WriteString(", ")
+	builder.WriteString("columns=")
+	builder.WriteString(fmt.Sprintf("%v", ej.Columns))
+	builder.WriteString(", ")
+	builder.WriteString("filter=")
+	builder.WriteString(fmt.Sprintf("%v", ej.Filter))
+	builder.WriteString(", ")
+	builder.WriteString("timezone=")
+	builder.WriteString(ej.Timezone)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", ej.Status))
+	builder.WriteString(", ")
+	builder.WriteString("error=")
+	builder.WriteString(ej.Error)
+	builder.WriteString(", ")
+	builder.WriteString("rows=")
+	builder.WriteString(fmt.Sprintf("%v", ej.Rows))
+	builder.WriteString(", ")
+	builder.WriteString("result_key=")
+	builder.WriteString(ej.ResultKey)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ej.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := ej.StartedAt; v != nil {
+		builder.WriteString("started_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := ej.FinishedAt; v != nil {
+		builder.WriteString("finished_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ExportJobs is a parsable slice of ExportJob.
+type ExportJobs []*ExportJob
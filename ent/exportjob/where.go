@@ -0,0 +1,596 @@
+// Code generated by ent, DO NOT EDIT.
+
+package exportjob
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v uuid.UUID) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldEQ(FieldUserID, v))
+}
+
+// Timezone applies equality check predicate on the "timezone" field. It's identical to TimezoneEQ.
+func Timezone(v string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldEQ(FieldTimezone, v))
+}
+
+// Error applies equality check predicate on the "error" field. It's identical to ErrorEQ.
+func Error(v string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldEQ(FieldError, v))
+}
+
+// Rows applies equality check predicate on the "rows" field. It's identical to RowsEQ.
+func Rows(v int) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldEQ(FieldRows, v))
+}
+
+// ResultKey applies equality check predicate on the "result_key" field. It's identical to ResultKeyEQ.
+func ResultKey(v string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldEQ(FieldResultKey, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// StartedAt applies equality check predicate on the "started_at" field. It's identical to StartedAtEQ.
+func StartedAt(v time.Time) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// FinishedAt applies equality check predicate on the "finished_at" field. It's identical to FinishedAtEQ.
+func FinishedAt(v time.Time) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldEQ(FieldFinishedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v uuid.UUID) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v uuid.UUID) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...uuid.UUID) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...uuid.UUID) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v uuid.UUID) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v uuid.UUID) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v uuid.UUID) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v uuid.UUID) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDIsNil applies the IsNil predicate on the "user_id" field.
+func UserIDIsNil() predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldIsNull(FieldUserID))
+}
+
+// UserIDNotNil applies the NotNil predicate on the "user_id" field.
+func UserIDNotNil() predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldNotNull(FieldUserID))
+}
+
+// FormatEQ applies the EQ predicate on the "format" field.
+func FormatEQ(v Format) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldEQ(FieldFormat, v))
+}
+
+// FormatNEQ applies the NEQ predicate on the "format" field.
+func FormatNEQ(v Format) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldNEQ(FieldFormat, v))
+}
+
+// FormatIn applies the In predicate on the "format" field.
+func FormatIn(vs ...Format) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldIn(FieldFormat, vs...))
+}
+
+// FormatNotIn applies the NotIn predicate on the "format" field.
+func FormatNotIn(vs ...Format) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldNotIn(FieldFormat, vs...))
+}
+
+// TimezoneEQ applies the EQ predicate on the "timezone" field.
+func TimezoneEQ(v string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldEQ(FieldTimezone, v))
+}
+
+// TimezoneNEQ applies the NEQ predicate on the "timezone" field.
+func TimezoneNEQ(v string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldNEQ(FieldTimezone, v))
+}
+
+// TimezoneIn applies the In predicate on the "timezone" field.
+func TimezoneIn(vs ...string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldIn(FieldTimezone, vs...))
+}
+
+// TimezoneNotIn applies the NotIn predicate on the "timezone" field.
+func TimezoneNotIn(vs ...string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldNotIn(FieldTimezone, vs...))
+}
+
+// TimezoneGT applies the GT predicate on the "timezone" field.
+func TimezoneGT(v string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldGT(FieldTimezone, v))
+}
+
+// TimezoneGTE applies the GTE predicate on the "timezone" field.
+func TimezoneGTE(v string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldGTE(FieldTimezone, v))
+}
+
+// TimezoneLT applies the LT predicate on the "timezone" field.
+func TimezoneLT(v string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldLT(FieldTimezone, v))
+}
+
+// TimezoneLTE applies the LTE predicate on the "timezone" field.
+func TimezoneLTE(v string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldLTE(FieldTimezone, v))
+}
+
+// TimezoneContains applies the Contains predicate on the "timezone" field.
+func TimezoneContains(v string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldContains(FieldTimezone, v))
+}
+
+// TimezoneHasPrefix applies the HasPrefix predicate on the "timezone" field.
+func TimezoneHasPrefix(v string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldHasPrefix(FieldTimezone, v))
+}
+
+// TimezoneHasSuffix applies the HasSuffix predicate on the "timezone" field.
+func TimezoneHasSuffix(v string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldHasSuffix(FieldTimezone, v))
+}
+
+// TimezoneEqualFold applies the EqualFold predicate on the "timezone" field.
+func TimezoneEqualFold(v string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldEqualFold(FieldTimezone, v))
+}
+
+// TimezoneContainsFold applies the ContainsFold predicate on the "timezone" field.
+func TimezoneContainsFold(v string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldContainsFold(FieldTimezone, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// ErrorEQ applies the EQ predicate on the "error" field.
+func ErrorEQ(v string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldEQ(FieldError, v))
+}
+
+// ErrorNEQ applies the NEQ predicate on the "error" field.
+func ErrorNEQ(v string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldNEQ(FieldError, v))
+}
+
+// ErrorIn applies the In predicate on the "error" field.
+func ErrorIn(vs ...string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldIn(FieldError, vs...))
+}
+
+// ErrorNotIn applies the NotIn predicate on the "error" field.
+func ErrorNotIn(vs ...string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldNotIn(FieldError, vs...))
+}
+
+// ErrorGT applies the GT predicate on the "error" field.
+func ErrorGT(v string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldGT(FieldError, v))
+}
+
+// ErrorGTE applies the GTE predicate on the "error" field.
+func ErrorGTE(v string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldGTE(FieldError, v))
+}
+
+// ErrorLT applies the LT predicate on the "error" field.
+func ErrorLT(v string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldLT(FieldError, v))
+}
+
+// ErrorLTE applies the LTE predicate on the "error" field.
+func ErrorLTE(v string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldLTE(FieldError, v))
+}
+
+// ErrorContains applies the Contains predicate on the "error" field.
+func ErrorContains(v string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldContains(FieldError, v))
+}
+
+// ErrorHasPrefix applies the HasPrefix predicate on the "error" field.
+func ErrorHasPrefix(v string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldHasPrefix(FieldError, v))
+}
+
+// ErrorHasSuffix applies the HasSuffix predicate on the "error" field.
+func ErrorHasSuffix(v string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldHasSuffix(FieldError, v))
+}
+
+// ErrorIsNil applies the IsNil predicate on the "error" field.
+func ErrorIsNil() predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldIsNull(FieldError))
+}
+
+// ErrorNotNil applies the NotNil predicate on the "error" field.
+func ErrorNotNil() predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldNotNull(FieldError))
+}
+
+// ErrorEqualFold applies the EqualFold predicate on the "error" field.
+func ErrorEqualFold(v string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldEqualFold(FieldError, v))
+}
+
+// ErrorContainsFold applies the ContainsFold predicate on the "error" field.
+func ErrorContainsFold(v string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldContainsFold(FieldError, v))
+}
+
+// RowsEQ applies the EQ predicate on the "rows" field.
+func RowsEQ(v int) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldEQ(FieldRows, v))
+}
+
+// RowsNEQ applies the NEQ predicate on the "rows" field.
+func RowsNEQ(v int) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldNEQ(FieldRows, v))
+}
+
+// RowsIn applies the In predicate on the "rows" field.
+func RowsIn(vs ...int) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldIn(FieldRows, vs...))
+}
+
+// RowsNotIn applies the NotIn predicate on the "rows" field.
+func RowsNotIn(vs ...int) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldNotIn(FieldRows, vs...))
+}
+
+// RowsGT applies the GT predicate on the "rows" field.
+func RowsGT(v int) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldGT(FieldRows, v))
+}
+
+// RowsGTE applies the GTE predicate on the "rows" field.
+func RowsGTE(v int) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldGTE(FieldRows, v))
+}
+
+// RowsLT applies the LT predicate on the "rows" field.
+func RowsLT(v int) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldLT(FieldRows, v))
+}
+
+// RowsLTE applies the LTE predicate on the "rows" field.
+func RowsLTE(v int) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldLTE(FieldRows, v))
+}
+
+// ResultKeyEQ applies the EQ predicate on the "result_key" field.
+func ResultKeyEQ(v string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldEQ(FieldResultKey, v))
+}
+
+// ResultKeyNEQ applies the NEQ predicate on the "result_key" field.
+func ResultKeyNEQ(v string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldNEQ(FieldResultKey, v))
+}
+
+// ResultKeyIn applies the In predicate on the "result_key" field.
+func ResultKeyIn(vs ...string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldIn(FieldResultKey, vs...))
+}
+
+// ResultKeyNotIn applies the NotIn predicate on the "result_key" field.
+func ResultKeyNotIn(vs ...string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldNotIn(FieldResultKey, vs...))
+}
+
+// ResultKeyGT applies the GT predicate on the "result_key" field.
+func ResultKeyGT(v string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldGT(FieldResultKey, v))
+}
+
+// ResultKeyGTE applies the GTE predicate on the "result_key" field.
+func ResultKeyGTE(v string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldGTE(FieldResultKey, v))
+}
+
+// ResultKeyLT applies the LT predicate on the "result_key" field.
+func ResultKeyLT(v string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldLT(FieldResultKey, v))
+}
+
+// ResultKeyLTE applies the LTE predicate on the "result_key" field.
+func ResultKeyLTE(v string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldLTE(FieldResultKey, v))
+}
+
+// ResultKeyContains applies the Contains predicate on the "result_key" field.
+func ResultKeyContains(v string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldContains(FieldResultKey, v))
+}
+
+// ResultKeyHasPrefix applies the HasPrefix predicate on the "result_key" field.
+func ResultKeyHasPrefix(v string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldHasPrefix(FieldResultKey, v))
+}
+
+// ResultKeyHasSuffix applies the HasSuffix predicate on the "result_key" field.
+func ResultKeyHasSuffix(v string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldHasSuffix(FieldResultKey, v))
+}
+
+// ResultKeyIsNil applies the IsNil predicate on the "result_key" field.
+func ResultKeyIsNil() predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldIsNull(FieldResultKey))
+}
+
+// ResultKeyNotNil applies the NotNil predicate on the "result_key" field.
+func ResultKeyNotNil() predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldNotNull(FieldResultKey))
+}
+
+// ResultKeyEqualFold applies the EqualFold predicate on the "result_key" field.
+func ResultKeyEqualFold(v string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldEqualFold(FieldResultKey, v))
+}
+
+// ResultKeyContainsFold applies the ContainsFold predicate on the "result_key" field.
+func ResultKeyContainsFold(v string) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldContainsFold(FieldResultKey, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// StartedAtEQ applies the EQ predicate on the "started_at" field.
+func StartedAtEQ(v time.Time) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// StartedAtNEQ applies the NEQ predicate on the "started_at" field.
+func StartedAtNEQ(v time.Time) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldNEQ(FieldStartedAt, v))
+}
+
+// StartedAtIn applies the In predicate on the "started_at" field.
+func StartedAtIn(vs ...time.Time) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldIn(FieldStartedAt, vs...))
+}
+
+// StartedAtNotIn applies the NotIn predicate on the "started_at" field.
+func StartedAtNotIn(vs ...time.Time) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldNotIn(FieldStartedAt, vs...))
+}
+
+// StartedAtGT applies the GT predicate on the "started_at" field.
+func StartedAtGT(v time.Time) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldGT(FieldStartedAt, v))
+}
+
+// StartedAtGTE applies the GTE predicate on the "started_at" field.
+func StartedAtGTE(v time.Time) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldGTE(FieldStartedAt, v))
+}
+
+// StartedAtLT applies the LT predicate on the "started_at" field.
+func StartedAtLT(v time.Time) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldLT(FieldStartedAt, v))
+}
+
+// StartedAtLTE applies the LTE predicate on the "started_at" field.
+func StartedAtLTE(v time.Time) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldLTE(FieldStartedAt, v))
+}
+
+// StartedAtIsNil applies the IsNil predicate on the "started_at" field.
+func StartedAtIsNil() predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldIsNull(FieldStartedAt))
+}
+
+// StartedAtNotNil applies the NotNil predicate on the "started_at" field.
+func StartedAtNotNil() predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldNotNull(FieldStartedAt))
+}
+
+// FinishedAtEQ applies the EQ predicate on the "finished_at" field.
+func FinishedAtEQ(v time.Time) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldEQ(FieldFinishedAt, v))
+}
+
+// FinishedAtNEQ applies the NEQ predicate on the "finished_at" field.
+func FinishedAtNEQ(v time.Time) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldNEQ(FieldFinishedAt, v))
+}
+
+// FinishedAtIn applies the In predicate on the "finished_at" field.
+func FinishedAtIn(vs ...time.Time) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldIn(FieldFinishedAt, vs...))
+}
+
+// FinishedAtNotIn applies the NotIn predicate on the "finished_at" field.
+func FinishedAtNotIn(vs ...time.Time) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldNotIn(FieldFinishedAt, vs...))
+}
+
+// FinishedAtGT applies the GT predicate on the "finished_at" field.
+func FinishedAtGT(v time.Time) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldGT(FieldFinishedAt, v))
+}
+
+// FinishedAtGTE applies the GTE predicate on the "finished_at" field.
+func FinishedAtGTE(v time.Time) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldGTE(FieldFinishedAt, v))
+}
+
+// FinishedAtLT applies the LT predicate on the "finished_at" field.
+func FinishedAtLT(v time.Time) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldLT(FieldFinishedAt, v))
+}
+
+// FinishedAtLTE applies the LTE predicate on the "finished_at" field.
+func FinishedAtLTE(v time.Time) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldLTE(FieldFinishedAt, v))
+}
+
+// FinishedAtIsNil applies the IsNil predicate on the "finished_at" field.
+func FinishedAtIsNil() predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldIsNull(FieldFinishedAt))
+}
+
+// FinishedAtNotNil applies the NotNil predicate on the "finished_at" field.
+func FinishedAtNotNil() predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldNotNull(FieldFinishedAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ExportJob) predicate.ExportJob {
+	return predicate.ExportJob(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ExportJob) predicate.ExportJob {
+	return predicate.ExportJob(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ExportJob) predicate.ExportJob {
+	return predicate.ExportJob(sql.NotPredicates(p))
+}
@@ -0,0 +1,441 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/ent/exportjob"
+	"github.com/localopsco/go-sample/models"
+)
+
+// ExportJobCreate is the builder for creating a ExportJob entity.
+type ExportJobCreate struct {
+	config
+	mutation *ExportJobMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (ejc *ExportJobCreate) SetUserID(u uuid.UUID) *ExportJobCreate {
+	ejc.mutation.SetUserID(u)
+	return ejc
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (ejc *ExportJobCreate) SetNillableUserID(u *uuid.UUID) *ExportJobCreate {
+	if u != nil {
+		ejc.SetUserID(*u)
+	}
+	return ejc
+}
+
+// SetFormat sets the "format" field.
+func (ejc *ExportJobCreate) SetFormat(e exportjob.Format) *ExportJobCreate {
+	ejc.mutation.SetFormat(e)
+	return ejc
+}
+
+// SetColumns sets the "columns" field.
+func (ejc *ExportJobCreate) SetColumns(s []string) *ExportJobCreate {
+	ejc.mutation.SetColumns(s)
+	return ejc
+}
+
+// SetFilter sets the "filter" field.
+func (ejc *ExportJobCreate) SetFilter(mf models.TaskFilter) *ExportJobCreate {
+	ejc.mutation.SetFilter(mf)
+	return ejc
+}
+
+// SetTimezone sets the "timezone" field.
+func (ejc *ExportJobCreate) SetTimezone(s string) *ExportJobCreate {
+	ejc.mutation.SetTimezone(s)
+	return ejc
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (ejc *ExportJobCreate) SetNillableTimezone(s *string) *ExportJobCreate {
+	if s != nil {
+		ejc.SetTimezone(*s)
+	}
+	return ejc
+}
+
+// SetStatus sets the "status" field.
+func (ejc *ExportJobCreate) SetStatus(e exportjob.Status) *ExportJobCreate {
+	ejc.mutation.SetStatus(e)
+	return ejc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ejc *ExportJobCreate) SetNillableStatus(e *exportjob.Status) *ExportJobCreate {
+	if e != nil {
+		ejc.SetStatus(*e)
+	}
+	return ejc
+}
+
+// SetError sets the "error" field.
+func (ejc *ExportJobCreate) SetError(s string) *ExportJobCreate {
+	ejc.mutation.SetError(s)
+	return ejc
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (ejc *ExportJobCreate) SetNillableError(s *string) *ExportJobCreate {
+	if s != nil {
+		ejc.SetError(*s)
+	}
+	return ejc
+}
+
+// SetRows sets the "rows" field.
+func (ejc *ExportJobCreate) SetRows(i int) *ExportJobCreate {
+	ejc.mutation.SetRows(i)
+	return ejc
+}
+
+// SetNillableRows sets the "rows" field if the given value is not nil.
+func (ejc *ExportJobCreate) SetNillableRows(i *int) *ExportJobCreate {
+	if i != nil {
+		ejc.SetRows(*i)
+	}
+	return ejc
+}
+
+// SetResultKey sets the "result_key" field.
+func (ejc *ExportJobCreate) SetResultKey(s string) *ExportJobCreate {
+	ejc.mutation.SetResultKey(s)
+	return ejc
+}
+
+// SetNillableResultKey sets the "result_key" field if the given value is not nil.
+func (ejc *ExportJobCreate) SetNillableResultKey(s *string) *ExportJobCreate {
+	if s != nil {
+		ejc.SetResultKey(*s)
+	}
+	return ejc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ejc *ExportJobCreate) SetCreatedAt(t time.Time) *ExportJobCreate {
+	ejc.mutation.SetCreatedAt(t)
+	return ejc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ejc *ExportJobCreate) SetNillableCreatedAt(t *time.Time) *ExportJobCreate {
+	if t != nil {
+		ejc.SetCreatedAt(*t)
+	}
+	return ejc
+}
+
+// SetStartedAt sets the "started_at" field.
+func (ejc *ExportJobCreate) SetStartedAt(t time.Time) *ExportJobCreate {
+	ejc.mutation.SetStartedAt(t)
+	return ejc
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (ejc *ExportJobCreate) SetNillableStartedAt(t *time.Time) *ExportJobCreate {
+	if t != nil {
+		ejc.SetStartedAt(*t)
+	}
+	return ejc
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (ejc *ExportJobCreate) SetFinishedAt(t time.Time) *ExportJobCreate {
+	ejc.mutation.SetFinishedAt(t)
+	return ejc
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (ejc *ExportJobCreate) SetNillableFinishedAt(t *time.Time) *ExportJobCreate {
+	if t != nil {
+		ejc.SetFinishedAt(*t)
+	}
+	return ejc
+}
+
+// SetID sets the "id" field.
+func (ejc *ExportJobCreate) SetID(u uuid.UUID) *ExportJobCreate {
+	ejc.mutation.SetID(u)
+	return ejc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (ejc *ExportJobCreate) SetNillableID(u *uuid.UUID) *ExportJobCreate {
+	if u != nil {
+		ejc.SetID(*u)
+	}
+	return ejc
+}
+
+// Mutation returns the ExportJobMutation object of the builder.
+func (ejc *ExportJobCreate) Mutation() *ExportJobMutation {
+	return ejc.mutation
+}
+
+// Save creates the ExportJob in the database.
+func (ejc *ExportJobCreate) Save(ctx context.Context) (*ExportJob, error) {
+	ejc.defaults()
+	return withHooks(ctx, ejc.sqlSave, ejc.mutation, ejc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ejc *ExportJobCreate) SaveX(ctx context.Context) *ExportJob {
+	v, err := ejc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ejc *ExportJobCreate) Exec(ctx context.Context) error {
+	_, err := ejc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ejc *ExportJobCreate) ExecX(ctx context.Context) {
+	if err := ejc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ejc *ExportJobCreate) defaults() {
+	if _, ok := ejc.mutation.Timezone(); !ok {
+		v := exportjob.DefaultTimezone
+		ejc.mutation.SetTimezone(v)
+	}
+	if _, ok := ejc.mutation.Status(); !ok {
+		v := exportjob.DefaultStatus
+		ejc.mutation.SetStatus(v)
+	}
+	if _, ok := ejc.mutation.Rows(); !ok {
+		v := exportjob.DefaultRows
+		ejc.mutation.SetRows(v)
+	}
+	if _, ok := ejc.mutation.CreatedAt(); !ok {
+		v := exportjob.DefaultCreatedAt()
+		ejc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := ejc.mutation.ID(); !ok {
+		v := exportjob.DefaultID()
+		ejc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ejc *ExportJobCreate) check() error {
+	if _, ok := ejc.mutation.Format(); !ok {
+		return &ValidationError{Name: "format", err: errors.New(`ent: missing required field "ExportJob.format"`)}
+	}
+	if v, ok := ejc.mutation.Format(); ok {
+		if err := exportjob.FormatValidator(v); err != nil {
+			return &ValidationError{Name: "format", err: fmt.Errorf(`ent: validator failed for field "ExportJob.format": %w`, err)}
+		}
+	}
+	if _, ok := ejc.mutation.Columns(); !ok {
+		return &ValidationError{Name: "columns", err: errors.New(`ent: missing required field "ExportJob.columns"`)}
+	}
+	if _, ok := ejc.mutation.Filter(); !ok {
+		return &ValidationError{Name: "filter", err: errors.New(`ent: missing required field "ExportJob.filter"`)}
+	}
+	if _, ok := ejc.mutation.Timezone(); !ok {
+		return &ValidationError{Name: "timezone", err: errors.New(`ent: missing required field "ExportJob.timezone"`)}
+	}
+	if _, ok := ejc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "ExportJob.status"`)}
+	}
+	if v, ok := ejc.mutation.Status(); ok {
+		if err := exportjob.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ExportJob.status": %w`, err)}
+		}
+	}
+	if _, ok := ejc.mutation.Rows(); !ok {
+		return &ValidationError{Name: "rows", err: errors.New(`ent: missing required field "ExportJob.rows"`)}
+	}
+	if _, ok := ejc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ExportJob.created_at"`)}
+	}
+	return nil
+}
+
+func (ejc *ExportJobCreate) sqlSave(ctx context.Context) (*ExportJob, error) {
+	if err := ejc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ejc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ejc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	ejc.mutation.id = &_node.ID
+	ejc.mutation.done = true
+	return _node, nil
+}
+
+func (ejc *ExportJobCreate) createSpec() (*ExportJob, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ExportJob{config: ejc.config}
+		_spec = sqlgraph.NewCreateSpec(exportjob.Table, sqlgraph.NewFieldSpec(exportjob.FieldID, field.TypeUUID))
+	)
+	if id, ok := ejc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := ejc.mutation.UserID(); ok {
+		_spec.SetField(exportjob.FieldUserID, field.TypeUUID, value)
+		_node.UserID = &value
+	}
+	if value, ok := ejc.mutation.Format(); ok {
+		_spec.SetField(exportjob.FieldFormat, field.TypeEnum, value)
+		_node.Format = value
+	}
+	if value, ok := ejc.mutation.Columns(); ok {
+		_spec.SetField(exportjob.FieldColumns, field.TypeJSON, value)
+		_node.Columns = value
+	}
+	if value, ok := ejc.mutation.Filter(); ok {
+		_spec.SetField(exportjob.FieldFilter, field.TypeJSON, value)
+		_node.Filter = value
+	}
+	if value, ok := ejc.mutation.Timezone(); ok {
+		_spec.SetField(exportjob.FieldTimezone, field.TypeString, value)
+		_node.Timezone = value
+	}
+	if value, ok := ejc.mutation.Status(); ok {
+		_spec.SetField(exportjob.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := ejc.mutation.Error(); ok {
+		_spec.SetField(exportjob.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	if value, ok := ejc.mutation.Rows(); ok {
+		_spec.SetField(exportjob.FieldRows, field.TypeInt, value)
+		_node.Rows = value
+	}
+	if value, ok := ejc.mutation.ResultKey(); ok {
+		_spec.SetField(exportjob.FieldResultKey, field.TypeString, value)
+		_node.ResultKey = value
+	}
+	if value, ok := ejc.mutation.CreatedAt(); ok {
+		_spec.SetField(exportjob.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := ejc.mutation.StartedAt(); ok {
+		_spec.SetField(exportjob.FieldStartedAt, field.TypeTime, value)
+		_node.StartedAt = &value
+	}
+	if value, ok := ejc.mutation.FinishedAt(); ok {
+		_spec.SetField(exportjob.FieldFinishedAt, field.TypeTime, value)
+		_node.FinishedAt = &value
+	}
+	return _node, _spec
+}
+
+// ExportJobCreateBulk is the builder for creating many ExportJob entities in bulk.
+type ExportJobCreateBulk struct {
+	config
+	err      error
+	builders []*ExportJobCreate
+}
+
+// Save creates the ExportJob entities in the database.
+func (ejcb *ExportJobCreateBulk) Save(ctx context.Context) ([]*ExportJob, error) {
+	if ejcb.err != nil {
+		return nil, ejcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ejcb.builders))
+	nodes := make([]*ExportJob, len(ejcb.builders))
+	mutators := make([]Mutator, len(ejcb.builders))
+	for i := range ejcb.builders {
+		func(i int, root context.Context) {
+			builder := ejcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ExportJobMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ejcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ejcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ejcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ejcb *ExportJobCreateBulk) SaveX(ctx context.Context) []*ExportJob {
+	v, err := ejcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ejcb *ExportJobCreateBulk) Exec(ctx context.Context) error {
+	_, err := ejcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ejcb *ExportJobCreateBulk) ExecX(ctx context.Context) {
+	if err := ejcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,520 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/localopsco/go-sample/ent/exportjob"
+	"github.com/localopsco/go-sample/ent/predicate"
+)
+
+// ExportJobUpdate is the builder for updating ExportJob entities.
+type ExportJobUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ExportJobMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ExportJobUpdate builder.
+func (eju *ExportJobUpdate) Where(ps ...predicate.ExportJob) *ExportJobUpdate {
+	eju.mutation.Where(ps...)
+	return eju
+}
+
+// SetStatus sets the "status" field.
+func (eju *ExportJobUpdate) SetStatus(e exportjob.Status) *ExportJobUpdate {
+	eju.mutation.SetStatus(e)
+	return eju
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (eju *ExportJobUpdate) SetNillableStatus(e *exportjob.Status) *ExportJobUpdate {
+	if e != nil {
+		eju.SetStatus(*e)
+	}
+	return eju
+}
+
+// SetError sets the "error" field.
+func (eju *ExportJobUpdate) SetError(s string) *ExportJobUpdate {
+	eju.mutation.SetError(s)
+	return eju
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (eju *ExportJobUpdate) SetNillableError(s *string) *ExportJobUpdate {
+	if s != nil {
+		eju.SetError(*s)
+	}
+	return eju
+}
+
+// ClearError clears the value of the "error" field.
+func (eju *ExportJobUpdate) ClearError() *ExportJobUpdate {
+	eju.mutation.ClearError()
+	return eju
+}
+
+// SetRows sets the "rows" field.
+func (eju *ExportJobUpdate) SetRows(i int) *ExportJobUpdate {
+	eju.mutation.ResetRows()
+	eju.mutation.SetRows(i)
+	return eju
+}
+
+// SetNillableRows sets the "rows" field if the given value is not nil.
+func (eju *ExportJobUpdate) SetNillableRows(i *int) *ExportJobUpdate {
+	if i != nil {
+		eju.SetRows(*i)
+	}
+	return eju
+}
+
+// AddRows adds i to the "rows" field.
+func (eju *ExportJobUpdate) AddRows(i int) *ExportJobUpdate {
+	eju.mutation.AddRows(i)
+	return eju
+}
+
+// SetResultKey sets the "result_key" field.
+func (eju *ExportJobUpdate) SetResultKey(s string) *ExportJobUpdate {
+	eju.mutation.SetResultKey(s)
+	return eju
+}
+
+// SetNillableResultKey sets the "result_key" field if the given value is not nil.
+func (eju *ExportJobUpdate) SetNillableResultKey(s *string) *ExportJobUpdate {
+	if s != nil {
+		eju.SetResultKey(*s)
+	}
+	return eju
+}
+
+// ClearResultKey clears the value of the "result_key" field.
+func (eju *ExportJobUpdate) ClearResultKey() *ExportJobUpdate {
+	eju.mutation.ClearResultKey()
+	return eju
+}
+
+// SetStartedAt sets the "started_at" field.
+func (eju *ExportJobUpdate) SetStartedAt(t time.Time) *ExportJobUpdate {
+	eju.mutation.SetStartedAt(t)
+	return eju
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (eju *ExportJobUpdate) SetNillableStartedAt(t *time.Time) *ExportJobUpdate {
+	if t != nil {
+		eju.SetStartedAt(*t)
+	}
+	return eju
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (eju *ExportJobUpdate) ClearStartedAt() *ExportJobUpdate {
+	eju.mutation.ClearStartedAt()
+	return eju
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (eju *ExportJobUpdate) SetFinishedAt(t time.Time) *ExportJobUpdate {
+	eju.mutation.SetFinishedAt(t)
+	return eju
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (eju *ExportJobUpdate) SetNillableFinishedAt(t *time.Time) *ExportJobUpdate {
+	if t != nil {
+		eju.SetFinishedAt(*t)
+	}
+	return eju
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (eju *ExportJobUpdate) ClearFinishedAt() *ExportJobUpdate {
+	eju.mutation.ClearFinishedAt()
+	return eju
+}
+
+// Mutation returns the ExportJobMutation object of the builder.
+func (eju *ExportJobUpdate) Mutation() *ExportJobMutation {
+	return eju.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (eju *ExportJobUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, eju.sqlSave, eju.mutation, eju.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (eju *ExportJobUpdate) SaveX(ctx context.Context) int {
+	affected, err := eju.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (eju *ExportJobUpdate) Exec(ctx context.Context) error {
+	_, err := eju.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (eju *ExportJobUpdate) ExecX(ctx context.Context) {
+	if err := eju.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (eju *ExportJobUpdate) check() error {
+	if v, ok := eju.mutation.Status(); ok {
+		if err := exportjob.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ExportJob.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (eju *ExportJobUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ExportJobUpdate {
+	eju.modifiers = append(eju.modifiers, modifiers...)
+	return eju
+}
+
+func (eju *ExportJobUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := eju.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(exportjob.Table, exportjob.Columns, sqlgraph.NewFieldSpec(exportjob.FieldID, field.TypeUUID))
+	if ps := eju.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if eju.mutation.UserIDCleared() {
+		_spec.ClearField(exportjob.FieldUserID, field.TypeUUID)
+	}
+	if value, ok := eju.mutation.Status(); ok {
+		_spec.SetField(exportjob.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := eju.mutation.Error(); ok {
+		_spec.SetField(exportjob.FieldError, field.TypeString, value)
+	}
+	if eju.mutation.ErrorCleared() {
+		_spec.ClearField(exportjob.FieldError, field.TypeString)
+	}
+	if value, ok := eju.mutation.Rows(); ok {
+		_spec.SetField(exportjob.FieldRows, field.TypeInt, value)
+	}
+	if value, ok := eju.mutation.AddedRows(); ok {
+		_spec.AddField(exportjob.FieldRows, field.TypeInt, value)
+	}
+	if value, ok := eju.mutation.ResultKey(); ok {
+		_spec.SetField(exportjob.FieldResultKey, field.TypeString, value)
+	}
+	if eju.mutation.ResultKeyCleared() {
+		_spec.ClearField(exportjob.FieldResultKey, field.TypeString)
+	}
+	if value, ok := eju.mutation.StartedAt(); ok {
+		_spec.SetField(exportjob.FieldStartedAt, field.TypeTime, value)
+	}
+	if eju.mutation.StartedAtCleared() {
+		_spec.ClearField(exportjob.FieldStartedAt, field.TypeTime)
+	}
+	if value, ok := eju.mutation.FinishedAt(); ok {
+		_spec.SetField(exportjob.FieldFinishedAt, field.TypeTime, value)
+	}
+	if eju.mutation.FinishedAtCleared() {
+		_spec.ClearField(exportjob.FieldFinishedAt, field.TypeTime)
+	}
+	_spec.AddModifiers(eju.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, eju.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{exportjob.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	eju.mutation.done = true
+	return n, nil
+}
+
+// ExportJobUpdateOne is the builder for updating a single ExportJob entity.
+type ExportJobUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ExportJobMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetStatus sets the "status" field.
+func (ejuo *ExportJobUpdateOne) SetStatus(e exportjob.Status) *ExportJobUpdateOne {
+	ejuo.mutation.SetStatus(e)
+	return ejuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ejuo *ExportJobUpdateOne) SetNillableStatus(e *exportjob.Status) *ExportJobUpdateOne {
+	if e != nil {
+		ejuo.SetStatus(*e)
+	}
+	return ejuo
+}
+
+// SetError sets the "error" field.
+func (ejuo *ExportJobUpdateOne) SetError(s string) *ExportJobUpdateOne {
+	ejuo.mutation.SetError(s)
+	return ejuo
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (ejuo *ExportJobUpdateOne) SetNillableError(s *string) *ExportJobUpdateOne {
+	if s != nil {
+		ejuo.SetError(*s)
+	}
+	return ejuo
+}
+
+// ClearError clears the value of the "error" field.
+func (ejuo *ExportJobUpdateOne) ClearError() *ExportJobUpdateOne {
+	ejuo.mutation.ClearError()
+	return ejuo
+}
+
+// SetRows sets the "rows" field.
+func (ejuo *ExportJobUpdateOne) SetRows(i int) *ExportJobUpdateOne {
+	ejuo.mutation.ResetRows()
+	ejuo.mutation.SetRows(i)
+	return ejuo
+}
+
+// SetNillableRows sets the "rows" field if the given value is not nil.
+func (ejuo *ExportJobUpdateOne) SetNillableRows(i *int) *ExportJobUpdateOne {
+	if i != nil {
+		ejuo.SetRows(*i)
+	}
+	return ejuo
+}
+
+// AddRows adds i to the "rows" field.
+func (ejuo *ExportJobUpdateOne) AddRows(i int) *ExportJobUpdateOne {
+	ejuo.mutation.AddRows(i)
+	return ejuo
+}
+
+// SetResultKey sets the "result_key" field.
+func (ejuo *ExportJobUpdateOne) SetResultKey(s string) *ExportJobUpdateOne {
+	ejuo.mutation.SetResultKey(s)
+	return ejuo
+}
+
+// SetNillableResultKey sets the "result_key" field if the given value is not nil.
+func (ejuo *ExportJobUpdateOne) SetNillableResultKey(s *string) *ExportJobUpdateOne {
+	if s != nil {
+		ejuo.SetResultKey(*s)
+	}
+	return ejuo
+}
+
+// ClearResultKey clears the value of the "result_key" field.
+func (ejuo *ExportJobUpdateOne) ClearResultKey() *ExportJobUpdateOne {
+	ejuo.mutation.ClearResultKey()
+	return ejuo
+}
+
+// SetStartedAt sets the "started_at" field.
+func (ejuo *ExportJobUpdateOne) SetStartedAt(t time.Time) *ExportJobUpdateOne {
+	ejuo.mutation.SetStartedAt(t)
+	return ejuo
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (ejuo *ExportJobUpdateOne) SetNillableStartedAt(t *time.Time) *ExportJobUpdateOne {
+	if t != nil {
+		ejuo.SetStartedAt(*t)
+	}
+	return ejuo
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (ejuo *ExportJobUpdateOne) ClearStartedAt() *ExportJobUpdateOne {
+	ejuo.mutation.ClearStartedAt()
+	return ejuo
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (ejuo *ExportJobUpdateOne) SetFinishedAt(t time.Time) *ExportJobUpdateOne {
+	ejuo.mutation.SetFinishedAt(t)
+	return ejuo
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (ejuo *ExportJobUpdateOne) SetNillableFinishedAt(t *time.Time) *ExportJobUpdateOne {
+	if t != nil {
+		ejuo.SetFinishedAt(*t)
+	}
+	return ejuo
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (ejuo *ExportJobUpdateOne) ClearFinishedAt() *ExportJobUpdateOne {
+	ejuo.mutation.ClearFinishedAt()
+	return ejuo
+}
+
+// Mutation returns the ExportJobMutation object of the builder.
+func (ejuo *ExportJobUpdateOne) Mutation() *ExportJobMutation {
+	return ejuo.mutation
+}
+
+// Where appends a list predicates to the ExportJobUpdate builder.
+func (ejuo *ExportJobUpdateOne) Where(ps ...predicate.ExportJob) *ExportJobUpdateOne {
+	ejuo.mutation.Where(ps...)
+	return ejuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ejuo *ExportJobUpdateOne) Select(field string, fields ...string) *ExportJobUpdateOne {
+	ejuo.fields = append([]string{field}, fields...)
+	return ejuo
+}
+
+// Save executes the query and returns the updated ExportJob entity.
+func (ejuo *ExportJobUpdateOne) Save(ctx context.Context) (*ExportJob, error) {
+	return withHooks(ctx, ejuo.sqlSave, ejuo.mutation, ejuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ejuo *ExportJobUpdateOne) SaveX(ctx context.Context) *ExportJob {
+	node, err := ejuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ejuo *ExportJobUpdateOne) Exec(ctx context.Context) error {
+	_, err := ejuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ejuo *ExportJobUpdateOne) ExecX(ctx context.Context) {
+	if err := ejuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ejuo *ExportJobUpdateOne) check() error {
+	if v, ok := ejuo.mutation.Status(); ok {
+		if err := exportjob.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ExportJob.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ejuo *ExportJobUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ExportJobUpdateOne {
+	ejuo.modifiers = append(ejuo.modifiers, modifiers...)
+	return ejuo
+}
+
+func (ejuo *ExportJobUpdateOne) sqlSave(ctx context.Context) (_node *ExportJob, err error) {
+	if err := ejuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(exportjob.Table, exportjob.Columns, sqlgraph.NewFieldSpec(exportjob.FieldID, field.TypeUUID))
+	id, ok := ejuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ExportJob.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ejuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, exportjob.FieldID)
+		for _, f := range fields {
+			if !exportjob.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != exportjob.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ejuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if ejuo.mutation.UserIDCleared() {
+		_spec.ClearField(exportjob.FieldUserID, field.TypeUUID)
+	}
+	if value, ok := ejuo.mutation.Status(); ok {
+		_spec.SetField(exportjob.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := ejuo.mutation.Error(); ok {
+		_spec.SetField(exportjob.FieldError, field.TypeString, value)
+	}
+	if ejuo.mutation.ErrorCleared() {
+		_spec.ClearField(exportjob.FieldError, field.TypeString)
+	}
+	if value, ok := ejuo.mutation.Rows(); ok {
+		_spec.SetField(exportjob.FieldRows, field.TypeInt, value)
+	}
+	if value, ok := ejuo.mutation.AddedRows(); ok {
+		_spec.AddField(exportjob.FieldRows, field.TypeInt, value)
+	}
+	if value, ok := ejuo.mutation.ResultKey(); ok {
+		_spec.SetField(exportjob.FieldResultKey, field.TypeString, value)
+	}
+	if ejuo.mutation.ResultKeyCleared() {
+		_spec.ClearField(exportjob.FieldResultKey, field.TypeString)
+	}
+	if value, ok := ejuo.mutation.StartedAt(); ok {
+		_spec.SetField(exportjob.FieldStartedAt, field.TypeTime, value)
+	}
+	if ejuo.mutation.StartedAtCleared() {
+		_spec.ClearField(exportjob.FieldStartedAt, field.TypeTime)
+	}
+	if value, ok := ejuo.mutation.FinishedAt(); ok {
+		_spec.SetField(exportjob.FieldFinishedAt, field.TypeTime, value)
+	}
+	if ejuo.mutation.FinishedAtCleared() {
+		_spec.ClearField(exportjob.FieldFinishedAt, field.TypeTime)
+	}
+	_spec.AddModifiers(ejuo.modifiers...)
+	_node = &ExportJob{config: ejuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ejuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{exportjob.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ejuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,114 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+func (pr *Project) Tasks(
+	ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy *TaskOrder, where *TaskWhereInput,
+) (*TaskConnection, error) {
+	opts := []TaskPaginateOption{
+		WithTaskOrder(orderBy),
+		WithTaskFilter(where.Filter),
+	}
+	alias := graphql.GetFieldContext(ctx).Field.Alias
+	totalCount, hasTotalCount := pr.Edges.totalCount[0][alias]
+	if nodes, err := pr.NamedTasks(alias); err == nil || hasTotalCount {
+		pager, err := newTaskPager(opts, last != nil)
+		if err != nil {
+			return nil, err
+		}
+		conn := &TaskConnection{Edges: []*TaskEdge{}, TotalCount: totalCount}
+		conn.build(nodes, pager, after, first, before, last)
+		return conn, nil
+	}
+	return pr.QueryTasks().Paginate(ctx, after, first, before, last, opts...)
+}
+
+func (t *Task) Parent(ctx context.Context) (*Task, error) {
+	result, err := t.Edges.ParentOrErr()
+	if IsNotLoaded(err) {
+		result, err = t.QueryParent().Only(ctx)
+	}
+	return result, MaskNotFound(err)
+}
+
+func (t *Task) Children(ctx context.Context) (result []*Task, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = t.NamedChildren(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = t.Edges.ChildrenOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = t.QueryChildren().All(ctx)
+	}
+	return result, err
+}
+
+func (t *Task) BlockedBy(ctx context.Context) (result []*Task, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = t.NamedBlockedBy(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = t.Edges.BlockedByOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = t.QueryBlockedBy().All(ctx)
+	}
+	return result, err
+}
+
+func (t *Task) Blocks(ctx context.Context) (result []*Task, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = t.NamedBlocks(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = t.Edges.BlocksOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = t.QueryBlocks().All(ctx)
+	}
+	return result, err
+}
+
+func (t *Task) Status(ctx context.Context) (*WorkflowStatus, error) {
+	result, err := t.Edges.StatusOrErr()
+	if IsNotLoaded(err) {
+		result, err = t.QueryStatus().Only(ctx)
+	}
+	return result, MaskNotFound(err)
+}
+
+func (t *Task) Project(ctx context.Context) (*Project, error) {
+	result, err := t.Edges.ProjectOrErr()
+	if IsNotLoaded(err) {
+		result, err = t.QueryProject().Only(ctx)
+	}
+	return result, MaskNotFound(err)
+}
+
+func (t *Task) Assignees(ctx context.Context) (result []*User, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = t.NamedAssignees(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = t.Edges.AssigneesOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = t.QueryAssignees().All(ctx)
+	}
+	return result, err
+}
+
+func (t *Task) Watchers(ctx context.Context) (result []*User, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = t.NamedWatchers(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = t.Edges.WatchersOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = t.QueryWatchers().All(ctx)
+	}
+	return result, err
+}
@@ -0,0 +1,194 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/ent/idempotencykey"
+)
+
+// IdempotencyKey is the model entity for the IdempotencyKey schema.
+type IdempotencyKey struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Key holds the value of the "key" field.
+	Key string `json:"key,omitempty"`
+	// Caller holds the value of the "caller" field.
+	Caller string `json:"caller,omitempty"`
+	// Fingerprint holds the value of the "fingerprint" field.
+	Fingerprint string `json:"fingerprint,omitempty"`
+	// ResponseStatus holds the value of the "response_status" field.
+	ResponseStatus *int `json:"response_status,omitempty"`
+	// ResponseHeader holds the value of the "response_header" field.
+	ResponseHeader map[string][]string `json:"response_header,omitempty"`
+	// ResponseBody holds the value of the "response_body" field.
+	ResponseBody []byte `json:"response_body,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt    time.Time `json:"expires_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*IdempotencyKey) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case idempotencykey.FieldResponseHeader, idempotencykey.FieldResponseBody:
+			values[i] = new([]byte)
+		case idempotencykey.FieldResponseStatus:
+			values[i] = new(sql.NullInt64)
+		case idempotencykey.FieldKey, idempotencykey.FieldCaller, idempotencykey.FieldFingerprint:
+			values[i] = new(sql.NullString)
+		case idempotencykey.FieldCreatedAt, idempotencykey.FieldExpiresAt:
+			values[i] = new(sql.NullTime)
+		case idempotencykey.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the IdempotencyKey fields.
+func (ik *IdempotencyKey) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case idempotencykey.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				ik.ID = *value
+			}
+		case idempotencykey.FieldKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field key", values[i])
+			} else if value.Valid {
+				ik.Key = value.String
+			}
+		case idempotencykey.FieldCaller:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field caller", values[i])
+			} else if value.Valid {
+				ik.Caller = value.String
+			}
+		case idempotencykey.FieldFingerprint:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field fingerprint", values[i])
+			} else if value.Valid {
+				ik.Fingerprint = value.String
+			}
+		case idempotencykey.FieldResponseStatus:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field response_status", values[i])
+			} else if value.Valid {
+				ik.ResponseStatus = new(int)
+				*ik.ResponseStatus = int(value.Int64)
+			}
+		case idempotencykey.FieldResponseHeader:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field response_header", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ik.ResponseHeader); err != nil {
+					return fmt.Errorf("unmarshal field response_header: %w", err)
+				}
+			}
+		case idempotencykey.FieldResponseBody:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field response_body", values[i])
+			} else if value != nil {
+				ik.ResponseBody = *value
+			}
+		case idempotencykey.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ik.CreatedAt = value.Time
+			}
+		case idempotencykey.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				ik.ExpiresAt = value.Time
+			}
+		default:
+			ik.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the IdempotencyKey.
+// This includes values selected through modifiers, order, etc.
+func (ik *IdempotencyKey) Value(name string) (ent.Value, error) {
+	return ik.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this IdempotencyKey.
+// Note that you need to call IdempotencyKey.Unwrap() before calling this method if this IdempotencyKey
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ik *IdempotencyKey) Update() *IdempotencyKeyUpdateOne {
+	return NewIdempotencyKeyClient(ik.config).UpdateOne(ik)
+}
+
+// Unwrap unwraps the IdempotencyKey entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ik *IdempotencyKey) Unwrap() *IdempotencyKey {
+	_tx, ok := ik.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: IdempotencyKey is not a transactional entity")
+	}
+	ik.config.driver = _tx.drv
+	return ik
+}
+
+// String implements the fmt.Stringer.
+func (ik *IdempotencyKey) String() string {
+	var builder strings.Builder
+	builder.WriteString("IdempotencyKey(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ik.ID))
+	builder.WriteString("key=")
+	builder.WriteString(ik.Key)
+	builder.WriteString(", ")
+	builder.WriteString("caller=")
+	builder.WriteString(ik.Caller)
+	builder.WriteString(", ")
+	builder.WriteString("fingerprint=")
+	builder.WriteString(ik.Fingerprint)
+	builder.WriteString(", ")
+	if v := ik.ResponseStatus; v != nil {
+		builder.WriteString("response_status=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("response_header=")
+	builder.WriteString(fmt.Sprintf("%v", ik.ResponseHeader))
+	builder.WriteString(", ")
+	builder.WriteString("response_body=")
+	builder.WriteString(fmt.Sprintf("%v", ik.ResponseBody))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ik.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(ik.ExpiresAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// IdempotencyKeys is a parsable slice of IdempotencyKey.
+type IdempotencyKeys []*IdempotencyKey
@@ -0,0 +1,542 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/localopsco/go-sample/ent/idempotencykey"
+	"github.com/localopsco/go-sample/ent/predicate"
+)
+
+// IdempotencyKeyUpdate is the builder for updating IdempotencyKey entities.
+type IdempotencyKeyUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *IdempotencyKeyMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the IdempotencyKeyUpdate builder.
+func (iku *IdempotencyKeyUpdate) Where(ps ...predicate.IdempotencyKey) *IdempotencyKeyUpdate {
+	iku.mutation.Where(ps...)
+	return iku
+}
+
+// SetKey sets the "key" field.
+func (iku *IdempotencyKeyUpdate) SetKey(s string) *IdempotencyKeyUpdate {
+	iku.mutation.SetKey(s)
+	return iku
+}
+
+// SetNillableKey sets the "key" field if the given value is not nil.
+func (iku *IdempotencyKeyUpdate) SetNillableKey(s *string) *IdempotencyKeyUpdate {
+	if s != nil {
+		iku.SetKey(*s)
+	}
+	return iku
+}
+
+// SetCaller sets the "caller" field.
+func (iku *IdempotencyKeyUpdate) SetCaller(s string) *IdempotencyKeyUpdate {
+	iku.mutation.SetCaller(s)
+	return iku
+}
+
+// SetNillableCaller sets the "caller" field if the given value is not nil.
+func (iku *IdempotencyKeyUpdate) SetNillableCaller(s *string) *IdempotencyKeyUpdate {
+	if s != nil {
+		iku.SetCaller(*s)
+	}
+	return iku
+}
+
+// SetFingerprint sets the "fingerprint" field.
+func (iku *IdempotencyKeyUpdate) SetFingerprint(s string) *IdempotencyKeyUpdate {
+	iku.mutation.SetFingerprint(s)
+	return iku
+}
+
+// SetNillableFingerprint sets the "fingerprint" field if the given value is not nil.
+func (iku *IdempotencyKeyUpdate) SetNillableFingerprint(s *string) *IdempotencyKeyUpdate {
+	if s != nil {
+		iku.SetFingerprint(*s)
+	}
+	return iku
+}
+
+// SetResponseStatus sets the "response_status" field.
+func (iku *IdempotencyKeyUpdate) SetResponseStatus(i int) *IdempotencyKeyUpdate {
+	iku.mutation.ResetResponseStatus()
+	iku.mutation.SetResponseStatus(i)
+	return iku
+}
+
+// SetNillableResponseStatus sets the "response_status" field if the given value is not nil.
+func (iku *IdempotencyKeyUpdate) SetNillableResponseStatus(i *int) *IdempotencyKeyUpdate {
+	if i != nil {
+		iku.SetResponseStatus(*i)
+	}
+	return iku
+}
+
+// AddResponseStatus adds i to the "response_status" field.
+func (iku *IdempotencyKeyUpdate) AddResponseStatus(i int) *IdempotencyKeyUpdate {
+	iku.mutation.AddResponseStatus(i)
+	return iku
+}
+
+// ClearResponseStatus clears the value of the "response_status" field.
+func (iku *IdempotencyKeyUpdate) ClearResponseStatus() *IdempotencyKeyUpdate {
+	iku.mutation.ClearResponseStatus()
+	return iku
+}
+
+// SetResponseHeader sets the "response_header" field.
+func (iku *IdempotencyKeyUpdate) SetResponseHeader(m map[string][]string) *IdempotencyKeyUpdate {
+	iku.mutation.SetResponseHeader(m)
+	return iku
+}
+
+// ClearResponseHeader clears the value of the "response_header" field.
+func (iku *IdempotencyKeyUpdate) ClearResponseHeader() *IdempotencyKeyUpdate {
+	iku.mutation.ClearResponseHeader()
+	return iku
+}
+
+// SetResponseBody sets the "response_body" field.
+func (iku *IdempotencyKeyUpdate) SetResponseBody(b []byte) *IdempotencyKeyUpdate {
+	iku.mutation.SetResponseBody(b)
+	return iku
+}
+
+// ClearResponseBody clears the value of the "response_body" field.
+func (iku *IdempotencyKeyUpdate) ClearResponseBody() *IdempotencyKeyUpdate {
+	iku.mutation.ClearResponseBody()
+	return iku
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (iku *IdempotencyKeyUpdate) SetCreatedAt(t time.Time) *IdempotencyKeyUpdate {
+	iku.mutation.SetCreatedAt(t)
+	return iku
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (iku *IdempotencyKeyUpdate) SetNillableCreatedAt(t *time.Time) *IdempotencyKeyUpdate {
+	if t != nil {
+		iku.SetCreatedAt(*t)
+	}
+	return iku
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (iku *IdempotencyKeyUpdate) SetExpiresAt(t time.Time) *IdempotencyKeyUpdate {
+	iku.mutation.SetExpiresAt(t)
+	return iku
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (iku *IdempotencyKeyUpdate) SetNillableExpiresAt(t *time.Time) *IdempotencyKeyUpdate {
+	if t != nil {
+		iku.SetExpiresAt(*t)
+	}
+	return iku
+}
+
+// Mutation returns the IdempotencyKeyMutation object of the builder.
+func (iku *IdempotencyKeyUpdate) Mutation() *IdempotencyKeyMutation {
+	return iku.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (iku *IdempotencyKeyUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, iku.sqlSave, iku.mutation, iku.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iku *IdempotencyKeyUpdate) SaveX(ctx context.Context) int {
+	affected, err := iku.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (iku *IdempotencyKeyUpdate) Exec(ctx context.Context) error {
+	_, err := iku.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iku *IdempotencyKeyUpdate) ExecX(ctx context.Context) {
+	if err := iku.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (iku *IdempotencyKeyUpdate) check() error {
+	if v, ok := iku.mutation.Key(); ok {
+		if err := idempotencykey.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "IdempotencyKey.key": %w`, err)}
+		}
+	}
+	if v, ok := iku.mutation.Caller(); ok {
+		if err := idempotencykey.CallerValidator(v); err != nil {
+			return &ValidationError{Name: "caller", err: fmt.Errorf(`ent: validator failed for field "IdempotencyKey.caller": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (iku *IdempotencyKeyUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *IdempotencyKeyUpdate {
+	iku.modifiers = append(iku.modifiers, modifiers...)
+	return iku
+}
+
+func (iku *IdempotencyKeyUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := iku.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(idempotencykey.Table, idempotencykey.Columns, sqlgraph.NewFieldSpec(idempotencykey.FieldID, field.TypeUUID))
+	if ps := iku.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := iku.mutation.Key(); ok {
+		_spec.SetField(idempotencykey.FieldKey, field.TypeString, value)
+	}
+	if value, ok := iku.mutation.Caller(); ok {
+		_spec.SetField(idempotencykey.FieldCaller, field.TypeString, value)
+	}
+	if value, ok := iku.mutation.Fingerprint(); ok {
+		_spec.SetField(idempotencykey.FieldFingerprint, field.TypeString, value)
+	}
+	if value, ok := iku.mutation.ResponseStatus(); ok {
+		_spec.SetField(idempotencykey.FieldResponseStatus, field.TypeInt, value)
+	}
+	if value, ok := iku.mutation.AddedResponseStatus(); ok {
+		_spec.AddField(idempotencykey.FieldResponseStatus, field.TypeInt, value)
+	}
+	if iku.mutation.ResponseStatusCleared() {
+		_spec.ClearField(idempotencykey.FieldResponseStatus, field.TypeInt)
+	}
+	if value, ok := iku.mutation.ResponseHeader(); ok {
+		_spec.SetField(idempotencykey.FieldResponseHeader, field.TypeJSON, value)
+	}
+	if iku.mutation.ResponseHeaderCleared() {
+		_spec.ClearField(idempotencykey.FieldResponseHeader, field.TypeJSON)
+	}
+	if value, ok := iku.mutation.ResponseBody(); ok {
+		_spec.SetField(idempotencykey.FieldResponseBody, field.TypeBytes, value)
+	}
+	if iku.mutation.ResponseBodyCleared() {
+		_spec.ClearField(idempotencykey.FieldResponseBody, field.TypeBytes)
+	}
+	if value, ok := iku.mutation.CreatedAt(); ok {
+		_spec.SetField(idempotencykey.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := iku.mutation.ExpiresAt(); ok {
+		_spec.SetField(idempotencykey.FieldExpiresAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(iku.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, iku.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{idempotencykey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	iku.mutation.done = true
+	return n, nil
+}
+
+// IdempotencyKeyUpdateOne is the builder for updating a single IdempotencyKey entity.
+type IdempotencyKeyUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *IdempotencyKeyMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetKey sets the "key" field.
+func (ikuo *IdempotencyKeyUpdateOne) SetKey(s string) *IdempotencyKeyUpdateOne {
+	ikuo.mutation.SetKey(s)
+	return ikuo
+}
+
+// SetNillableKey sets the "key" field if the given value is not nil.
+func (ikuo *IdempotencyKeyUpdateOne) SetNillableKey(s *string) *IdempotencyKeyUpdateOne {
+	if s != nil {
+		ikuo.SetKey(*s)
+	}
+	return ikuo
+}
+
+// SetCaller sets the "caller" field.
+func (ikuo *IdempotencyKeyUpdateOne) SetCaller(s string) *IdempotencyKeyUpdateOne {
+	ikuo.mutation.SetCaller(s)
+	return ikuo
+}
+
+// SetNillableCaller sets the "caller" field if the given value is not nil.
+func (ikuo *IdempotencyKeyUpdateOne) SetNillableCaller(s *string) *IdempotencyKeyUpdateOne {
+	if s != nil {
+		ikuo.SetCaller(*s)
+	}
+	return ikuo
+}
+
+// SetFingerprint sets the "fingerprint" field.
+func (ikuo *IdempotencyKeyUpdateOne) SetFingerprint(s string) *IdempotencyKeyUpdateOne {
+	ikuo.mutation.SetFingerprint(s)
+	return ikuo
+}
+
+// SetNillableFingerprint sets the "fingerprint" field if the given value is not nil.
+func (ikuo *IdempotencyKeyUpdateOne) SetNillableFingerprint(s *string) *IdempotencyKeyUpdateOne {
+	if s != nil {
+		ikuo.SetFingerprint(*s)
+	}
+	return ikuo
+}
+
+// SetResponseStatus sets the "response_status" field.
+func (ikuo *IdempotencyKeyUpdateOne) SetResponseStatus(i int) *IdempotencyKeyUpdateOne {
+	ikuo.mutation.ResetResponseStatus()
+	ikuo.mutation.SetResponseStatus(i)
+	return ikuo
+}
+
+// SetNillableResponseStatus sets the "response_status" field if the given value is not nil.
+func (ikuo *IdempotencyKeyUpdateOne) SetNillableResponseStatus(i *int) *IdempotencyKeyUpdateOne {
+	if i != nil {
+		ikuo.SetResponseStatus(*i)
+	}
+	return ikuo
+}
+
+// AddResponseStatus adds i to the "response_status" field.
+func (ikuo *IdempotencyKeyUpdateOne) AddResponseStatus(i int) *IdempotencyKeyUpdateOne {
+	ikuo.mutation.AddResponseStatus(i)
+	return ikuo
+}
+
+// ClearResponseStatus clears the value of the "response_status" field.
+func (ikuo *IdempotencyKeyUpdateOne) ClearResponseStatus() *IdempotencyKeyUpdateOne {
+	ikuo.mutation.ClearResponseStatus()
+	return ikuo
+}
+
+// SetResponseHeader sets the "response_header" field.
+func (ikuo *IdempotencyKeyUpdateOne) SetResponseHeader(m map[string][]string) *IdempotencyKeyUpdateOne {
+	ikuo.mutation.SetResponseHeader(m)
+	return ikuo
+}
+
+// ClearResponseHeader clears the value of the "response_header" field.
+func (ikuo *IdempotencyKeyUpdateOne) ClearResponseHeader() *IdempotencyKeyUpdateOne {
+	ikuo.mutation.ClearResponseHeader()
+	return ikuo
+}
+
+// SetResponseBody sets the "response_body" field.
+func (ikuo *IdempotencyKeyUpdateOne) SetResponseBody(b []byte) *IdempotencyKeyUpdateOne {
+	ikuo.mutation.SetResponseBody(b)
+	return ikuo
+}
+
+// ClearResponseBody clears the value of the "response_body" field.
+func (ikuo *IdempotencyKeyUpdateOne) ClearResponseBody() *IdempotencyKeyUpdateOne {
+	ikuo.mutation.ClearResponseBody()
+	return ikuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ikuo *IdempotencyKeyUpdateOne) SetCreatedAt(t time.Time) *IdempotencyKeyUpdateOne {
+	ikuo.mutation.SetCreatedAt(t)
+	return ikuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ikuo *IdempotencyKeyUpdateOne) SetNillableCreatedAt(t *time.Time) *IdempotencyKeyUpdateOne {
+	if t != nil {
+		ikuo.SetCreatedAt(*t)
+	}
+	return ikuo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (ikuo *IdempotencyKeyUpdateOne) SetExpiresAt(t time.Time) *IdempotencyKeyUpdateOne {
+	ikuo.mutation.SetExpiresAt(t)
+	return ikuo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (ikuo *IdempotencyKeyUpdateOne) SetNillableExpiresAt(t *time.Time) *IdempotencyKeyUpdateOne {
+	if t != nil {
+		ikuo.SetExpiresAt(*t)
+	}
+	return ikuo
+}
+
+// Mutation returns the IdempotencyKeyMutation object of the builder.
+func (ikuo *IdempotencyKeyUpdateOne) Mutation() *IdempotencyKeyMutation {
+	return ikuo.mutation
+}
+
+// Where appends a list predicates to the IdempotencyKeyUpdate builder.
+func (ikuo *IdempotencyKeyUpdateOne) Where(ps ...predicate.IdempotencyKey) *IdempotencyKeyUpdateOne {
+	ikuo.mutation.Where(ps...)
+	return ikuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ikuo *IdempotencyKeyUpdateOne) Select(field string, fields ...string) *IdempotencyKeyUpdateOne {
+	ikuo.fields = append([]string{field}, fields...)
+	return ikuo
+}
+
+// Save executes the query and returns the updated IdempotencyKey entity.
+func (ikuo *IdempotencyKeyUpdateOne) Save(ctx context.Context) (*IdempotencyKey, error) {
+	return withHooks(ctx, ikuo.sqlSave, ikuo.mutation, ikuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ikuo *IdempotencyKeyUpdateOne) SaveX(ctx context.Context) *IdempotencyKey {
+	node, err := ikuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ikuo *IdempotencyKeyUpdateOne) Exec(ctx context.Context) error {
+	_, err := ikuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ikuo *IdempotencyKeyUpdateOne) ExecX(ctx context.Context) {
+	if err := ikuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ikuo *IdempotencyKeyUpdateOne) check() error {
+	if v, ok := ikuo.mutation.Key(); ok {
+		if err := idempotencykey.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "IdempotencyKey.key": %w`, err)}
+		}
+	}
+	if v, ok := ikuo.mutation.Caller(); ok {
+		if err := idempotencykey.CallerValidator(v); err != nil {
+			return &ValidationError{Name: "caller", err: fmt.Errorf(`ent: validator failed for field "IdempotencyKey.caller": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ikuo *IdempotencyKeyUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *IdempotencyKeyUpdateOne {
+	ikuo.modifiers = append(ikuo.modifiers, modifiers...)
+	return ikuo
+}
+
+func (ikuo *IdempotencyKeyUpdateOne) sqlSave(ctx context.Context) (_node *IdempotencyKey, err error) {
+	if err := ikuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(idempotencykey.Table, idempotencykey.Columns, sqlgraph.NewFieldSpec(idempotencykey.FieldID, field.TypeUUID))
+	id, ok := ikuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "IdempotencyKey.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ikuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, idempotencykey.FieldID)
+		for _, f := range fields {
+			if !idempotencykey.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != idempotencykey.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ikuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ikuo.mutation.Key(); ok {
+		_spec.SetField(idempotencykey.FieldKey, field.TypeString, value)
+	}
+	if value, ok := ikuo.mutation.Caller(); ok {
+		_spec.SetField(idempotencykey.FieldCaller, field.TypeString, value)
+	}
+	if value, ok := ikuo.mutation.Fingerprint(); ok {
+		_spec.SetField(idempotencykey.FieldFingerprint, field.TypeString, value)
+	}
+	if value, ok := ikuo.mutation.ResponseStatus(); ok {
+		_spec.SetField(idempotencykey.FieldResponseStatus, field.TypeInt, value)
+	}
+	if value, ok := ikuo.mutation.AddedResponseStatus(); ok {
+		_spec.AddField(idempotencykey.FieldResponseStatus, field.TypeInt, value)
+	}
+	if ikuo.mutation.ResponseStatusCleared() {
+		_spec.ClearField(idempotencykey.FieldResponseStatus, field.TypeInt)
+	}
+	if value, ok := ikuo.mutation.ResponseHeader(); ok {
+		_spec.SetField(idempotencykey.FieldResponseHeader, field.TypeJSON, value)
+	}
+	if ikuo.mutation.ResponseHeaderCleared() {
+		_spec.ClearField(idempotencykey.FieldResponseHeader, field.TypeJSON)
+	}
+	if value, ok := ikuo.mutation.ResponseBody(); ok {
+		_spec.SetField(idempotencykey.FieldResponseBody, field.TypeBytes, value)
+	}
+	if ikuo.mutation.ResponseBodyCleared() {
+		_spec.ClearField(idempotencykey.FieldResponseBody, field.TypeBytes)
+	}
+	if value, ok := ikuo.mutation.CreatedAt(); ok {
+		_spec.SetField(idempotencykey.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := ikuo.mutation.ExpiresAt(); ok {
+		_spec.SetField(idempotencykey.FieldExpiresAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(ikuo.modifiers...)
+	_node = &IdempotencyKey{config: ikuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ikuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{idempotencykey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ikuo.mutation.done = true
+	return _node, nil
+}
@@ -3,31 +3,706 @@
 package migrate
 
 import (
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/dialect/sql/schema"
 	"entgo.io/ent/schema/field"
 )
 
 var (
+	// ChangeSequencesColumns holds the columns for the "change_sequences" table.
+	ChangeSequencesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "value", Type: field.TypeInt64, Default: 0},
+	}
+	// ChangeSequencesTable holds the schema information for the "change_sequences" table.
+	ChangeSequencesTable = &schema.Table{
+		Name:       "change_sequences",
+		Columns:    ChangeSequencesColumns,
+		PrimaryKey: []*schema.Column{ChangeSequencesColumns[0]},
+	}
+	// CommentsColumns holds the columns for the "comments" table.
+	CommentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "body", Type: field.TypeString, Size: 2147483647},
+		{Name: "body_html", Type: field.TypeString, Size: 2147483647},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "edited_at", Type: field.TypeTime, Nullable: true},
+		{Name: "parent_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "task_id", Type: field.TypeUUID},
+		{Name: "author_id", Type: field.TypeUUID},
+	}
+	// CommentsTable holds the schema information for the "comments" table.
+	CommentsTable = &schema.Table{
+		Name:       "comments",
+		Columns:    CommentsColumns,
+		PrimaryKey: []*schema.Column{CommentsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "comments_comments_replies",
+				Columns:    []*schema.Column{CommentsColumns[5]},
+				RefColumns: []*schema.Column{CommentsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "comments_tasks_comments",
+				Columns:    []*schema.Column{CommentsColumns[6]},
+				RefColumns: []*schema.Column{TasksColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "comments_users_comments",
+				Columns:    []*schema.Column{CommentsColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "comment_task_id_parent_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{CommentsColumns[6], CommentsColumns[5], CommentsColumns[3]},
+			},
+		},
+	}
+	// ExportJobsColumns holds the columns for the "export_jobs" table.
+	ExportJobsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "user_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "format", Type: field.TypeEnum, Enums: []string{"csv", "json", "ndjson"}},
+		{Name: "columns", Type: field.TypeJSON},
+		{Name: "filter", Type: field.TypeJSON},
+		{Name: "timezone", Type: field.TypeString, Default: "UTC"},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "running", "succeeded", "failed"}, Default: "pending"},
+		{Name: "error", Type: field.TypeString, Nullable: true},
+		{Name: "rows", Type: field.TypeInt, Default: 0},
+		{Name: "result_key", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "started_at", Type: field.TypeTime, Nullable: true},
+		{Name: "finished_at", Type: field.TypeTime, Nullable: true},
+	}
+	// ExportJobsTable holds the schema information for the "export_jobs" table.
+	ExportJobsTable = &schema.Table{
+		Name:       "export_jobs",
+		Columns:    ExportJobsColumns,
+		PrimaryKey: []*schema.Column{ExportJobsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "exportjob_status_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{ExportJobsColumns[6], ExportJobsColumns[10]},
+			},
+		},
+	}
+	// IdempotencyKeysColumns holds the columns for the "idempotency_keys" table.
+	IdempotencyKeysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "key", Type: field.TypeString, Size: 255},
+		{Name: "caller", Type: field.TypeString},
+		{Name: "fingerprint", Type: field.TypeString},
+		{Name: "response_status", Type: field.TypeInt, Nullable: true},
+		{Name: "response_header", Type: field.TypeJSON, Nullable: true},
+		{Name: "response_body", Type: field.TypeBytes, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "expires_at", Type: field.TypeTime},
+	}
+	// IdempotencyKeysTable holds the schema information for the "idempotency_keys" table.
+	IdempotencyKeysTable = &schema.Table{
+		Name:       "idempotency_keys",
+		Columns:    IdempotencyKeysColumns,
+		PrimaryKey: []*schema.Column{IdempotencyKeysColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "idempotencykey_key_caller",
+				Unique:  true,
+				Columns: []*schema.Column{IdempotencyKeysColumns[1], IdempotencyKeysColumns[2]},
+			},
+			{
+				Name:    "idempotencykey_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{IdempotencyKeysColumns[8]},
+			},
+		},
+	}
+	// OutboxColumns holds the columns for the "outbox" table.
+	OutboxColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "event_id", Type: field.TypeUUID, Unique: true},
+		{Name: "event_type", Type: field.TypeString},
+		{Name: "task_id", Type: field.TypeUUID},
+		{Name: "payload", Type: field.TypeBytes},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "processed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "relayed_seq", Type: field.TypeInt, Unique: true, Nullable: true},
+		{Name: "available_at", Type: field.TypeTime},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "last_error", Type: field.TypeString, Nullable: true},
+	}
+	// OutboxTable holds the schema information for the "outbox" table.
+	OutboxTable = &schema.Table{
+		Name:       "outbox",
+		Columns:    OutboxColumns,
+		PrimaryKey: []*schema.Column{OutboxColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "outboxevent_processed_at",
+				Unique:  false,
+				Columns: []*schema.Column{OutboxColumns[6]},
+			},
+			{
+				Name:    "outboxevent_task_id_processed_at",
+				Unique:  false,
+				Columns: []*schema.Column{OutboxColumns[3], OutboxColumns[6]},
+			},
+		},
+	}
+	// ProjectsColumns holds the columns for the "projects" table.
+	ProjectsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "name", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "wip_limits", Type: field.TypeJSON, Nullable: true},
+		{Name: "wip_policy", Type: field.TypeEnum, Enums: []string{"warn", "enforce"}, Default: "warn"},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "workflow_id", Type: field.TypeUUID},
+	}
+	// ProjectsTable holds the schema information for the "projects" table.
+	ProjectsTable = &schema.Table{
+		Name:       "projects",
+		Columns:    ProjectsColumns,
+		PrimaryKey: []*schema.Column{ProjectsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "projects_workflows_projects",
+				Columns:    []*schema.Column{ProjectsColumns[6]},
+				RefColumns: []*schema.Column{WorkflowsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// SavedViewsColumns holds the columns for the "saved_views" table.
+	SavedViewsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "name", Type: field.TypeString},
+		{Name: "query", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "filters", Type: field.TypeJSON, Nullable: true},
+		{Name: "sort", Type: field.TypeString, Nullable: true},
+		{Name: "visibility", Type: field.TypeEnum, Enums: []string{"private", "shared"}, Default: "private"},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "owner_id", Type: field.TypeUUID},
+	}
+	// SavedViewsTable holds the schema information for the "saved_views" table.
+	SavedViewsTable = &schema.Table{
+		Name:       "saved_views",
+		Columns:    SavedViewsColumns,
+		PrimaryKey: []*schema.Column{SavedViewsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "saved_views_users_saved_views",
+				Columns:    []*schema.Column{SavedViewsColumns[8]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "savedview_owner_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{SavedViewsColumns[8], SavedViewsColumns[1]},
+			},
+			{
+				Name:    "savedview_visibility",
+				Unique:  false,
+				Columns: []*schema.Column{SavedViewsColumns[5]},
+			},
+		},
+	}
 	// TasksColumns holds the columns for the "tasks" table.
 	TasksColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
 		{Name: "title", Type: field.TypeString},
 		{Name: "description", Type: field.TypeString, Nullable: true},
-		{Name: "is_completed", Type: field.TypeBool, Default: false},
+		{Name: "status_category", Type: field.TypeEnum, Enums: []string{"todo", "in_progress", "done", "cancelled"}, Default: "todo"},
 		{Name: "attachment_url", Type: field.TypeString, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
+		{Name: "position", Type: field.TypeInt, Default: 0},
+		{Name: "start_at", Type: field.TypeTime, Nullable: true},
+		{Name: "due_at", Type: field.TypeTime, Nullable: true},
+		{Name: "priority", Type: field.TypeEnum, Enums: []string{"none", "low", "medium", "high", "urgent"}, Default: "none"},
+		{Name: "estimate_minutes", Type: field.TypeInt, Nullable: true},
+		{Name: "recurrence_rule", Type: field.TypeString, Nullable: true},
+		{Name: "recurrence_timezone", Type: field.TypeString, Nullable: true},
+		{Name: "recurrence_start", Type: field.TypeTime, Nullable: true},
+		{Name: "recurrence_paused", Type: field.TypeBool, Default: false},
+		{Name: "series_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "labels", Type: field.TypeJSON, Nullable: true},
+		{Name: "rank", Type: field.TypeString, Default: ""},
+		{Name: "completed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "version", Type: field.TypeInt, Default: 1},
+		{Name: "change_seq", Type: field.TypeInt64, Default: 0},
+		{Name: "external_id", Type: field.TypeString, Nullable: true},
+		{Name: "import_key", Type: field.TypeString, Unique: true, Nullable: true},
+		{Name: "ical_uid", Type: field.TypeString, Unique: true, Nullable: true},
+		{Name: "project_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "parent_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "status_id", Type: field.TypeUUID, Nullable: true},
 	}
 	// TasksTable holds the schema information for the "tasks" table.
 	TasksTable = &schema.Table{
 		Name:       "tasks",
 		Columns:    TasksColumns,
 		PrimaryKey: []*schema.Column{TasksColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "tasks_projects_tasks",
+				Columns:    []*schema.Column{TasksColumns[25]},
+				RefColumns: []*schema.Column{ProjectsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "tasks_tasks_children",
+				Columns:    []*schema.Column{TasksColumns[26]},
+				RefColumns: []*schema.Column{TasksColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "tasks_workflow_status_tasks",
+				Columns:    []*schema.Column{TasksColumns[27]},
+				RefColumns: []*schema.Column{WorkflowStatusColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "task_parent_id_position",
+				Unique:  false,
+				Columns: []*schema.Column{TasksColumns[26], TasksColumns[6]},
+			},
+			{
+				Name:    "task_due_at",
+				Unique:  false,
+				Columns: []*schema.Column{TasksColumns[8]},
+			},
+			{
+				Name:    "task_start_at",
+				Unique:  false,
+				Columns: []*schema.Column{TasksColumns[7]},
+			},
+			{
+				Name:    "task_priority_due_at",
+				Unique:  false,
+				Columns: []*schema.Column{TasksColumns[9], TasksColumns[8]},
+			},
+			{
+				Name:    "task_status_category",
+				Unique:  false,
+				Columns: []*schema.Column{TasksColumns[3]},
+			},
+			{
+				Name:    "task_project_id_status_id",
+				Unique:  false,
+				Columns: []*schema.Column{TasksColumns[25], TasksColumns[27]},
+			},
+			{
+				Name:    "task_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{TasksColumns[19]},
+			},
+			{
+				Name:    "task_completed_at",
+				Unique:  false,
+				Columns: []*schema.Column{TasksColumns[18]},
+			},
+			{
+				Name:    "task_project_id_status_id_rank",
+				Unique:  false,
+				Columns: []*schema.Column{TasksColumns[25], TasksColumns[27], TasksColumns[17]},
+			},
+			{
+				Name:    "task_change_seq_id",
+				Unique:  false,
+				Columns: []*schema.Column{TasksColumns[21], TasksColumns[0]},
+			},
+			{
+				Name:    "task_external_id",
+				Unique:  false,
+				Columns: []*schema.Column{TasksColumns[22]},
+			},
+		},
+	}
+	// TaskEventsColumns holds the columns for the "task_events" table.
+	TaskEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "task_id", Type: field.TypeUUID},
+		{Name: "task_title", Type: field.TypeString},
+		{Name: "project_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "action", Type: field.TypeEnum, Enums: []string{"created", "updated", "deleted", "restored", "purged", "attachment_added", "attachment_removed"}},
+		{Name: "changes", Type: field.TypeJSON},
+		{Name: "request_id", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "actor_id", Type: field.TypeUUID, Nullable: true},
+	}
+	// TaskEventsTable holds the schema information for the "task_events" table.
+	TaskEventsTable = &schema.Table{
+		Name:       "task_events",
+		Columns:    TaskEventsColumns,
+		PrimaryKey: []*schema.Column{TaskEventsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "task_events_users_task_events",
+				Columns:    []*schema.Column{TaskEventsColumns[8]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "taskevent_task_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{TaskEventsColumns[1], TaskEventsColumns[7]},
+			},
+			{
+				Name:    "taskevent_project_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{TaskEventsColumns[3], TaskEventsColumns[7]},
+			},
+			{
+				Name:    "taskevent_actor_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{TaskEventsColumns[8], TaskEventsColumns[7]},
+			},
+			{
+				Name:    "taskevent_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{TaskEventsColumns[7]},
+			},
+		},
+	}
+	// TaskTombstonesColumns holds the columns for the "task_tombstones" table.
+	TaskTombstonesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "task_id", Type: field.TypeUUID},
+		{Name: "change_seq", Type: field.TypeInt64},
+		{Name: "deleted_at", Type: field.TypeTime},
+	}
+	// TaskTombstonesTable holds the schema information for the "task_tombstones" table.
+	TaskTombstonesTable = &schema.Table{
+		Name:       "task_tombstones",
+		Columns:    TaskTombstonesColumns,
+		PrimaryKey: []*schema.Column{TaskTombstonesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "tasktombstone_change_seq_task_id",
+				Unique:  false,
+				Columns: []*schema.Column{TaskTombstonesColumns[2], TaskTombstonesColumns[1]},
+			},
+		},
+	}
+	// UsersColumns holds the columns for the "users" table.
+	UsersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "username", Type: field.TypeString, Unique: true},
+		{Name: "display_name", Type: field.TypeString, Nullable: true},
+		{Name: "api_token_hash", Type: field.TypeString, Unique: true},
+		{Name: "calendar_token_hash", Type: field.TypeString, Unique: true, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// UsersTable holds the schema information for the "users" table.
+	UsersTable = &schema.Table{
+		Name:       "users",
+		Columns:    UsersColumns,
+		PrimaryKey: []*schema.Column{UsersColumns[0]},
+	}
+	// WebhooksColumns holds the columns for the "webhooks" table.
+	WebhooksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "url", Type: field.TypeString},
+		{Name: "event_types", Type: field.TypeJSON},
+		{Name: "secret", Type: field.TypeString},
+		{Name: "active", Type: field.TypeBool, Default: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "owner_id", Type: field.TypeUUID, Nullable: true},
+	}
+	// WebhooksTable holds the schema information for the "webhooks" table.
+	WebhooksTable = &schema.Table{
+		Name:       "webhooks",
+		Columns:    WebhooksColumns,
+		PrimaryKey: []*schema.Column{WebhooksColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "webhooks_users_webhooks",
+				Columns:    []*schema.Column{WebhooksColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// WebhookDeliveriesColumns holds the columns for the "webhook_deliveries" table.
+	WebhookDeliveriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "event_id", Type: field.TypeUUID},
+		{Name: "event_type", Type: field.TypeString},
+		{Name: "payload", Type: field.TypeBytes},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "retrying", "succeeded", "dead"}, Default: "pending"},
+		{Name: "attempt_count", Type: field.TypeInt, Default: 0},
+		{Name: "next_attempt_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_status_code", Type: field.TypeInt, Nullable: true},
+		{Name: "attempts", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "webhook_id", Type: field.TypeUUID},
+	}
+	// WebhookDeliveriesTable holds the schema information for the "webhook_deliveries" table.
+	WebhookDeliveriesTable = &schema.Table{
+		Name:       "webhook_deliveries",
+		Columns:    WebhookDeliveriesColumns,
+		PrimaryKey: []*schema.Column{WebhookDeliveriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "webhook_deliveries_webhooks_deliveries",
+				Columns:    []*schema.Column{WebhookDeliveriesColumns[11]},
+				RefColumns: []*schema.Column{WebhooksColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webhookdelivery_webhook_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[11], WebhookDeliveriesColumns[9]},
+			},
+			{
+				Name:    "webhookdelivery_status_next_attempt_at",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[4], WebhookDeliveriesColumns[6]},
+			},
+			{
+				Name:    "webhookdelivery_webhook_id_event_id",
+				Unique:  true,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[11], WebhookDeliveriesColumns[1]},
+			},
+		},
+	}
+	// WorkflowsColumns holds the columns for the "workflows" table.
+	WorkflowsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "name", Type: field.TypeString},
+		{Name: "is_default", Type: field.TypeBool, Default: false},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// WorkflowsTable holds the schema information for the "workflows" table.
+	WorkflowsTable = &schema.Table{
+		Name:       "workflows",
+		Columns:    WorkflowsColumns,
+		PrimaryKey: []*schema.Column{WorkflowsColumns[0]},
+	}
+	// WorkflowStatusColumns holds the columns for the "workflow_status" table.
+	WorkflowStatusColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "key", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString},
+		{Name: "category", Type: field.TypeEnum, Enums: []string{"todo", "in_progress", "done", "cancelled"}},
+		{Name: "position", Type: field.TypeInt, Default: 0},
+		{Name: "workflow_id", Type: field.TypeUUID},
+	}
+	// WorkflowStatusTable holds the schema information for the "workflow_status" table.
+	WorkflowStatusTable = &schema.Table{
+		Name:       "workflow_status",
+		Columns:    WorkflowStatusColumns,
+		PrimaryKey: []*schema.Column{WorkflowStatusColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "workflow_status_workflows_statuses",
+				Columns:    []*schema.Column{WorkflowStatusColumns[5]},
+				RefColumns: []*schema.Column{WorkflowsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "workflowstatus_workflow_id_key",
+				Unique:  true,
+				Columns: []*schema.Column{WorkflowStatusColumns[5], WorkflowStatusColumns[1]},
+			},
+		},
+	}
+	// CommentMentionsColumns holds the columns for the "comment_mentions" table.
+	CommentMentionsColumns = []*schema.Column{
+		{Name: "comment_id", Type: field.TypeUUID},
+		{Name: "user_id", Type: field.TypeUUID},
+	}
+	// CommentMentionsTable holds the schema information for the "comment_mentions" table.
+	CommentMentionsTable = &schema.Table{
+		Name:       "comment_mentions",
+		Columns:    CommentMentionsColumns,
+		PrimaryKey: []*schema.Column{CommentMentionsColumns[0], CommentMentionsColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "comment_mentions_comment_id",
+				Columns:    []*schema.Column{CommentMentionsColumns[0]},
+				RefColumns: []*schema.Column{CommentsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "comment_mentions_user_id",
+				Columns:    []*schema.Column{CommentMentionsColumns[1]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// TaskBlocksColumns holds the columns for the "task_blocks" table.
+	TaskBlocksColumns = []*schema.Column{
+		{Name: "task_id", Type: field.TypeUUID},
+		{Name: "blocked_by_id", Type: field.TypeUUID},
+	}
+	// TaskBlocksTable holds the schema information for the "task_blocks" table.
+	TaskBlocksTable = &schema.Table{
+		Name:       "task_blocks",
+		Columns:    TaskBlocksColumns,
+		PrimaryKey: []*schema.Column{TaskBlocksColumns[0], TaskBlocksColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "task_blocks_task_id",
+				Columns:    []*schema.Column{TaskBlocksColumns[0]},
+				RefColumns: []*schema.Column{TasksColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "task_blocks_blocked_by_id",
+				Columns:    []*schema.Column{TaskBlocksColumns[1]},
+				RefColumns: []*schema.Column{TasksColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// TaskAssigneesColumns holds the columns for the "task_assignees" table.
+	TaskAssigneesColumns = []*schema.Column{
+		{Name: "task_id", Type: field.TypeUUID},
+		{Name: "user_id", Type: field.TypeUUID},
+	}
+	// TaskAssigneesTable holds the schema information for the "task_assignees" table.
+	TaskAssigneesTable = &schema.Table{
+		Name:       "task_assignees",
+		Columns:    TaskAssigneesColumns,
+		PrimaryKey: []*schema.Column{TaskAssigneesColumns[0], TaskAssigneesColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "task_assignees_task_id",
+				Columns:    []*schema.Column{TaskAssigneesColumns[0]},
+				RefColumns: []*schema.Column{TasksColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "task_assignees_user_id",
+				Columns:    []*schema.Column{TaskAssigneesColumns[1]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// TaskWatchersColumns holds the columns for the "task_watchers" table.
+	TaskWatchersColumns = []*schema.Column{
+		{Name: "task_id", Type: field.TypeUUID},
+		{Name: "user_id", Type: field.TypeUUID},
+	}
+	// TaskWatchersTable holds the schema information for the "task_watchers" table.
+	TaskWatchersTable = &schema.Table{
+		Name:       "task_watchers",
+		Columns:    TaskWatchersColumns,
+		PrimaryKey: []*schema.Column{TaskWatchersColumns[0], TaskWatchersColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "task_watchers_task_id",
+				Columns:    []*schema.Column{TaskWatchersColumns[0]},
+				RefColumns: []*schema.Column{TasksColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "task_watchers_user_id",
+				Columns:    []*schema.Column{TaskWatchersColumns[1]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// WorkflowStatusTransitionsColumns holds the columns for the "workflow_status_transitions" table.
+	WorkflowStatusTransitionsColumns = []*schema.Column{
+		{Name: "workflow_status_id", Type: field.TypeUUID},
+		{Name: "transition_id", Type: field.TypeUUID},
+	}
+	// WorkflowStatusTransitionsTable holds the schema information for the "workflow_status_transitions" table.
+	WorkflowStatusTransitionsTable = &schema.Table{
+		Name:       "workflow_status_transitions",
+		Columns:    WorkflowStatusTransitionsColumns,
+		PrimaryKey: []*schema.Column{WorkflowStatusTransitionsColumns[0], WorkflowStatusTransitionsColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "workflow_status_transitions_workflow_status_id",
+				Columns:    []*schema.Column{WorkflowStatusTransitionsColumns[0]},
+				RefColumns: []*schema.Column{WorkflowStatusColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "workflow_status_transitions_transition_id",
+				Columns:    []*schema.Column{WorkflowStatusTransitionsColumns[1]},
+				RefColumns: []*schema.Column{WorkflowStatusColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		ChangeSequencesTable,
+		CommentsTable,
+		ExportJobsTable,
+		IdempotencyKeysTable,
+		OutboxTable,
+		ProjectsTable,
+		SavedViewsTable,
 		TasksTable,
+		TaskEventsTable,
+		TaskTombstonesTable,
+		UsersTable,
+		WebhooksTable,
+		WebhookDeliveriesTable,
+		WorkflowsTable,
+		WorkflowStatusTable,
+		CommentMentionsTable,
+		TaskBlocksTable,
+		TaskAssigneesTable,
+		TaskWatchersTable,
+		WorkflowStatusTransitionsTable,
 	}
 )
 
 func init() {
+	CommentsTable.ForeignKeys[0].RefTable = CommentsTable
+	CommentsTable.ForeignKeys[1].RefTable = TasksTable
+	CommentsTable.ForeignKeys[2].RefTable = UsersTable
+	OutboxTable.Annotation = &entsql.Annotation{
+		Table: "outbox",
+	}
+	ProjectsTable.ForeignKeys[0].RefTable = WorkflowsTable
+	SavedViewsTable.ForeignKeys[0].RefTable = UsersTable
+	TasksTable.ForeignKeys[0].RefTable = ProjectsTable
+	TasksTable.ForeignKeys[1].RefTable = TasksTable
+	TasksTable.ForeignKeys[2].RefTable = WorkflowStatusTable
+	TaskEventsTable.ForeignKeys[0].RefTable = UsersTable
+	WebhooksTable.ForeignKeys[0].RefTable = UsersTable
+	WebhookDeliveriesTable.ForeignKeys[0].RefTable = WebhooksTable
+	WorkflowStatusTable.ForeignKeys[0].RefTable = WorkflowsTable
+	CommentMentionsTable.ForeignKeys[0].RefTable = CommentsTable
+	CommentMentionsTable.ForeignKeys[1].RefTable = UsersTable
+	TaskBlocksTable.ForeignKeys[0].RefTable = TasksTable
+	TaskBlocksTable.ForeignKeys[1].RefTable = TasksTable
+	TaskAssigneesTable.ForeignKeys[0].RefTable = TasksTable
+	TaskAssigneesTable.ForeignKeys[1].RefTable = UsersTable
+	TaskWatchersTable.ForeignKeys[0].RefTable = TasksTable
+	TaskWatchersTable.ForeignKeys[1].RefTable = UsersTable
+	WorkflowStatusTransitionsTable.ForeignKeys[0].RefTable = WorkflowStatusTable
+	WorkflowStatusTransitionsTable.ForeignKeys[1].RefTable = WorkflowStatusTable
 }
@@ -0,0 +1,359 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/ent/outboxevent"
+)
+
+// OutboxEventCreate is the builder for creating a OutboxEvent entity.
+type OutboxEventCreate struct {
+	config
+	mutation *OutboxEventMutation
+	hooks    []Hook
+}
+
+// SetEventID sets the "event_id" field.
+func (oec *OutboxEventCreate) SetEventID(u uuid.UUID) *OutboxEventCreate {
+	oec.mutation.SetEventID(u)
+	return oec
+}
+
+// SetEventType sets the "event_type" field.
+func (oec *OutboxEventCreate) SetEventType(s string) *OutboxEventCreate {
+	oec.mutation.SetEventType(s)
+	return oec
+}
+
+// SetTaskID sets the "task_id" field.
+func (oec *OutboxEventCreate) SetTaskID(u uuid.UUID) *OutboxEventCreate {
+	oec.mutation.SetTaskID(u)
+	return oec
+}
+
+// SetPayload sets the "payload" field.
+func (oec *OutboxEventCreate) SetPayload(b []byte) *OutboxEventCreate {
+	oec.mutation.SetPayload(b)
+	return oec
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (oec *OutboxEventCreate) SetCreatedAt(t time.Time) *OutboxEventCreate {
+	oec.mutation.SetCreatedAt(t)
+	return oec
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (oec *OutboxEventCreate) SetNillableCreatedAt(t *time.Time) *OutboxEventCreate {
+	if t != nil {
+		oec.SetCreatedAt(*t)
+	}
+	return oec
+}
+
+// SetProcessedAt sets the "processed_at" field.
+func (oec *OutboxEventCreate) SetProcessedAt(t time.Time) *OutboxEventCreate {
+	oec.mutation.SetProcessedAt(t)
+	return oec
+}
+
+// SetNillableProcessedAt sets the "processed_at" field if the given value is not nil.
+func (oec *OutboxEventCreate) SetNillableProcessedAt(t *time.Time) *OutboxEventCreate {
+	if t != nil {
+		oec.SetProcessedAt(*t)
+	}
+	return oec
+}
+
+// SetRelayedSeq sets the "relayed_seq" field.
+func (oec *OutboxEventCreate) SetRelayedSeq(i int) *OutboxEventCreate {
+	oec.mutation.SetRelayedSeq(i)
+	return oec
+}
+
+// SetNillableRelayedSeq sets the "relayed_seq" field if the given value is not nil.
+func (oec *OutboxEventCreate) SetNillableRelayedSeq(i *int) *OutboxEventCreate {
+	if i != nil {
+		oec.SetRelayedSeq(*i)
+	}
+	return oec
+}
+
+// SetAvailableAt sets the "available_at" field.
+func (oec *OutboxEventCreate) SetAvailableAt(t time.Time) *OutboxEventCreate {
+	oec.mutation.SetAvailableAt(t)
+	return oec
+}
+
+// SetNillableAvailableAt sets the "available_at" field if the given value is not nil.
+func (oec *OutboxEventCreate) SetNillableAvailableAt(t *time.Time) *OutboxEventCreate {
+	if t != nil {
+		oec.SetAvailableAt(*t)
+	}
+	return oec
+}
+
+// SetAttempts sets the "attempts" field.
+func (oec *OutboxEventCreate) SetAttempts(i int) *OutboxEventCreate {
+	oec.mutation.SetAttempts(i)
+	return oec
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (oec *OutboxEventCreate) SetNillableAttempts(i *int) *OutboxEventCreate {
+	if i != nil {
+		oec.SetAttempts(*i)
+	}
+	return oec
+}
+
+// SetLastError sets the "last_error" field.
+func (oec *OutboxEventCreate) SetLastError(s string) *OutboxEventCreate {
+	oec.mutation.SetLastError(s)
+	return oec
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (oec *OutboxEventCreate) SetNillableLastError(s *string) *OutboxEventCreate {
+	if s != nil {
+		oec.SetLastError(*s)
+	}
+	return oec
+}
+
+// Mutation returns the OutboxEventMutation object of the builder.
+func (oec *OutboxEventCreate) Mutation() *OutboxEventMutation {
+	return oec.mutation
+}
+
+// Save creates the OutboxEvent in the database.
+func (oec *OutboxEventCreate) Save(ctx context.Context) (*OutboxEvent, error) {
+	oec.defaults()
+	return withHooks(ctx, oec.sqlSave, oec.mutation, oec.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (oec *OutboxEventCreate) SaveX(ctx context.Context) *OutboxEvent {
+	v, err := oec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (oec *OutboxEventCreate) Exec(ctx context.Context) error {
+	_, err := oec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (oec *OutboxEventCreate) ExecX(ctx context.Context) {
+	if err := oec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (oec *OutboxEventCreate) defaults() {
+	if _, ok := oec.mutation.CreatedAt(); !ok {
+		v := outboxevent.DefaultCreatedAt()
+		oec.mutation.SetCreatedAt(v)
+	}
+	if _, ok := oec.mutation.AvailableAt(); !ok {
+		v := outboxevent.DefaultAvailableAt()
+		oec.mutation.SetAvailableAt(v)
+	}
+	if _, ok := oec.mutation.Attempts(); !ok {
+		v := outboxevent.DefaultAttempts
+		oec.mutation.SetAttempts(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (oec *OutboxEventCreate) check() error {
+	if _, ok := oec.mutation.EventID(); !ok {
+		return &ValidationError{Name: "event_id", err: errors.New(`ent: missing required field "OutboxEvent.event_id"`)}
+	}
+	if _, ok := oec.mutation.EventType(); !ok {
+		return &ValidationError{Name: "event_type", err: errors.New(`ent: missing required field "OutboxEvent.event_type"`)}
+	}
+	if _, ok := oec.mutation.TaskID(); !ok {
+		return &ValidationError{Name: "task_id", err: errors.New(`ent: missing required field "OutboxEvent.task_id"`)}
+	}
+	if _, ok := oec.mutation.Payload(); !ok {
+		return &ValidationError{Name: "payload", err: errors.New(`ent: missing required field "OutboxEvent.payload"`)}
+	}
+	if _, ok := oec.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "OutboxEvent.created_at"`)}
+	}
+	if _, ok := oec.mutation.AvailableAt(); !ok {
+		return &ValidationError{Name: "available_at", err: errors.New(`ent: missing required field "OutboxEvent.available_at"`)}
+	}
+	if _, ok := oec.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`ent: missing required field "OutboxEvent.attempts"`)}
+	}
+	return nil
+}
+
+func (oec *OutboxEventCreate) sqlSave(ctx context.Context) (*OutboxEvent, error) {
+	if err := oec.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := oec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, oec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	oec.mutation.id = &_node.ID
+	oec.mutation.done = true
+	return _node, nil
+}
+
+func (oec *OutboxEventCreate) createSpec() (*OutboxEvent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &OutboxEvent{config: oec.config}
+		_spec = sqlgraph.NewCreateSpec(outboxevent.Table, sqlgraph.NewFieldSpec(outboxevent.FieldID, field.TypeInt))
+	)
+	if value, ok := oec.mutation.EventID(); ok {
+		_spec.SetField(outboxevent.FieldEventID, field.TypeUUID, value)
+		_node.EventID = value
+	}
+	if value, ok := oec.mutation.EventType(); ok {
+		_spec.SetField(outboxevent.FieldEventType, field.TypeString, value)
+		_node.EventType = value
+	}
+	if value, ok := oec.mutation.TaskID(); ok {
+		_spec.SetField(outboxevent.FieldTaskID, field.TypeUUID, value)
+		_node.TaskID = value
+	}
+	if value, ok := oec.mutation.Payload(); ok {
+		_spec.SetField(outboxevent.FieldPayload, field.TypeBytes, value)
+		_node.Payload = value
+	}
+	if value, ok := oec.mutation.CreatedAt(); ok {
+		_spec.SetField(outboxevent.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := oec.mutation.ProcessedAt(); ok {
+		_spec.SetField(outboxevent.FieldProcessedAt, field.TypeTime, value)
+		_node.ProcessedAt = &value
+	}
+	if value, ok := oec.mutation.RelayedSeq(); ok {
+		_spec.SetField(outboxevent.FieldRelayedSeq, field.TypeInt, value)
+		_node.RelayedSeq = &value
+	}
+	if value, ok := oec.mutation.AvailableAt(); ok {
+		_spec.SetField(outboxevent.FieldAvailableAt, field.TypeTime, value)
+		_node.AvailableAt = value
+	}
+	if value, ok := oec.mutation.Attempts(); ok {
+		_spec.SetField(outboxevent.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := oec.mutation.LastError(); ok {
+		_spec.SetField(outboxevent.FieldLastError, field.TypeString, value)
+		_node.LastError = value
+	}
+	return _node, _spec
+}
+
+// OutboxEventCreateBulk is the builder for creating many OutboxEvent entities in bulk.
+type OutboxEventCreateBulk struct {
+	config
+	err      error
+	builders []*OutboxEventCreate
+}
+
+// Save creates the OutboxEvent entities in the database.
+func (oecb *OutboxEventCreateBulk) Save(ctx context.Context) ([]*OutboxEvent, error) {
+	if oecb.err != nil {
+		return nil, oecb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(oecb.builders))
+	nodes := make([]*OutboxEvent, len(oecb.builders))
+	mutators := make([]Mutator, len(oecb.builders))
+	for i := range oecb.builders {
+		func(i int, root context.Context) {
+			builder := oecb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*OutboxEventMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, oecb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, oecb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, oecb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (oecb *OutboxEventCreateBulk) SaveX(ctx context.Context) []*OutboxEvent {
+	v, err := oecb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (oecb *OutboxEventCreateBulk) Exec(ctx context.Context) error {
+	_, err := oecb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (oecb *OutboxEventCreateBulk) ExecX(ctx context.Context) {
+	if err := oecb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,555 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/localopsco/go-sample/ent/outboxevent"
+	"github.com/localopsco/go-sample/ent/predicate"
+)
+
+// OutboxEventQuery is the builder for querying OutboxEvent entities.
+type OutboxEventQuery struct {
+	config
+	ctx        *QueryContext
+	order      []outboxevent.OrderOption
+	inters     []Interceptor
+	predicates []predicate.OutboxEvent
+	loadTotal  []func(context.Context, []*OutboxEvent) error
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the OutboxEventQuery builder.
+func (oeq *OutboxEventQuery) Where(ps ...predicate.OutboxEvent) *OutboxEventQuery {
+	oeq.predicates = append(oeq.predicates, ps...)
+	return oeq
+}
+
+// Limit the number of records to be returned by this query.
+func (oeq *OutboxEventQuery) Limit(limit int) *OutboxEventQuery {
+	oeq.ctx.Limit = &limit
+	return oeq
+}
+
+// Offset to start from.
+func (oeq *OutboxEventQuery) Offset(offset int) *OutboxEventQuery {
+	oeq.ctx.Offset = &offset
+	return oeq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (oeq *OutboxEventQuery) Unique(unique bool) *OutboxEventQuery {
+	oeq.ctx.Unique = &unique
+	return oeq
+}
+
+// Order specifies how the records should be ordered.
+func (oeq *OutboxEventQuery) Order(o ...outboxevent.OrderOption) *OutboxEventQuery {
+	oeq.order = append(oeq.order, o...)
+	return oeq
+}
+
+// First returns the first OutboxEvent entity from the query.
+// Returns a *NotFoundError when no OutboxEvent was found.
+func (oeq *OutboxEventQuery) First(ctx context.Context) (*OutboxEvent, error) {
+	nodes, err := oeq.Limit(1).All(setContextOp(ctx, oeq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{outboxevent.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (oeq *OutboxEventQuery) FirstX(ctx context.Context) *OutboxEvent {
+	node, err := oeq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first OutboxEvent ID from the query.
+// Returns a *NotFoundError when no OutboxEvent ID was found.
+func (oeq *OutboxEventQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = oeq.Limit(1).IDs(setContextOp(ctx, oeq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{outboxevent.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (oeq *OutboxEventQuery) FirstIDX(ctx context.Context) int {
+	id, err := oeq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single OutboxEvent entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one OutboxEvent entity is found.
+// Returns a *NotFoundError when no OutboxEvent entities are found.
+func (oeq *OutboxEventQuery) Only(ctx context.Context) (*OutboxEvent, error) {
+	nodes, err := oeq.Limit(2).All(setContextOp(ctx, oeq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{outboxevent.Label}
+	default:
+		return nil, &NotSingularError{outboxevent.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (oeq *OutboxEventQuery) OnlyX(ctx context.Context) *OutboxEvent {
+	node, err := oeq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only OutboxEvent ID in the query.
+// Returns a *NotSingularError when more than one OutboxEvent ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (oeq *OutboxEventQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = oeq.Limit(2).IDs(setContextOp(ctx, oeq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{outboxevent.Label}
+	default:
+		err = &NotSingularError{outboxevent.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (oeq *OutboxEventQuery) OnlyIDX(ctx context.Context) int {
+	id, err := oeq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of OutboxEvents.
+func (oeq *OutboxEventQuery) All(ctx context.Context) ([]*OutboxEvent, error) {
+	ctx = setContextOp(ctx, oeq.ctx, ent.OpQueryAll)
+	if err := oeq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*OutboxEvent, *OutboxEventQuery]()
+	return withInterceptors[[]*OutboxEvent](ctx, oeq, qr, oeq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (oeq *OutboxEventQuery) AllX(ctx context.Context) []*OutboxEvent {
+	nodes, err := oeq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of OutboxEvent IDs.
+func (oeq *OutboxEventQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if oeq.ctx.Unique == nil && oeq.path != nil {
+		oeq.Unique(true)
+	}
+	ctx = setContextOp(ctx, oeq.ctx, ent.OpQueryIDs)
+	if err = oeq.Select(outboxevent.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (oeq *OutboxEventQuery) IDsX(ctx context.Context) []int {
+	ids, err := oeq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (oeq *OutboxEventQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, oeq.ctx, ent.OpQueryCount)
+	if err := oeq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, oeq, querierCount[*OutboxEventQuery](), oeq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (oeq *OutboxEventQuery) CountX(ctx context.Context) int {
+	count, err := oeq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (oeq *OutboxEventQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, oeq.ctx, ent.OpQueryExist)
+	switch _, err := oeq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (oeq *OutboxEventQuery) ExistX(ctx context.Context) bool {
+	exist, err := oeq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the OutboxEventQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (oeq *OutboxEventQuery) Clone() *OutboxEventQuery {
+	if oeq == nil {
+		return nil
+	}
+	return &OutboxEventQuery{
+		config:     oeq.config,
+		ctx:        oeq.ctx.Clone(),
+		order:      append([]outboxevent.OrderOption{}, oeq.order...),
+		inters:     append([]Interceptor{}, oeq.inters...),
+		predicates: append([]predicate.OutboxEvent{}, oeq.predicates...),
+		// clone intermediate query.
+		sql:  oeq.sql.Clone(),
+		path: oeq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		EventID uuid.UUID `json:"event_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.OutboxEvent.Query().
+//		GroupBy(outboxevent.FieldEventID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (oeq *OutboxEventQuery) GroupBy(field string, fields ...string) *OutboxEventGroupBy {
+	oeq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &OutboxEventGroupBy{build: oeq}
+	grbuild.flds = &oeq.ctx.Fields
+	grbuild.label = outboxevent.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		EventID uuid.UUID `json:"event_id,omitempty"`
+//	}
+//
+//	client.OutboxEvent.Query().
+//		Select(outboxevent.FieldEventID).
+//		Scan(ctx, &v)
+func (oeq *OutboxEventQuery) Select(fields ...string) *OutboxEventSelect {
+	oeq.ctx.Fields = append(oeq.ctx.Fields, fields...)
+	sbuild := &OutboxEventSelect{OutboxEventQuery: oeq}
+	sbuild.label = outboxevent.Label
+	sbuild.flds, sbuild.scan = &oeq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a OutboxEventSelect configured with the given aggregations.
+func (oeq *OutboxEventQuery) Aggregate(fns ...AggregateFunc) *OutboxEventSelect {
+	return oeq.Select().Aggregate(fns...)
+}
+
+func (oeq *OutboxEventQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range oeq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, oeq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range oeq.ctx.Fields {
+		if !outboxevent.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if oeq.path != nil {
+		prev, err := oeq.path(ctx)
+		if err != nil {
+			return err
+		}
+		oeq.sql = prev
+	}
+	return nil
+}
+
+func (oeq *OutboxEventQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*OutboxEvent, error) {
+	var (
+		nodes = []*OutboxEvent{}
+		_spec = oeq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*OutboxEvent).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &OutboxEvent{config: oeq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(oeq.modifiers) > 0 {
+		_spec.Modifiers = oeq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, oeq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	for i := range oeq.loadTotal {
+		if err := oeq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (oeq *OutboxEventQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := oeq.querySpec()
+	if len(oeq.modifiers) > 0 {
+		_spec.Modifiers = oeq.modifiers
+	}
+	_spec.Node.Columns = oeq.ctx.Fields
+	if len(oeq.ctx.Fields) > 0 {
+		_spec.Unique = oeq.ctx.Unique != nil && *oeq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, oeq.driver, _spec)
+}
+
+func (oeq *OutboxEventQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(outboxevent.Table, outboxevent.Columns, sqlgraph.NewFieldSpec(outboxevent.FieldID, field.TypeInt))
+	_spec.From = oeq.sql
+	if unique := oeq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if oeq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := oeq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, outboxevent.FieldID)
+		for i := range fields {
+			if fields[i] != outboxevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := oeq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := oeq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := oeq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := oeq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (oeq *OutboxEventQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(oeq.driver.Dialect())
+	t1 := builder.Table(outboxevent.Table)
+	columns := oeq.ctx.Fields
+	if len(columns) == 0 {
+		columns = outboxevent.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if oeq.sql != nil {
+		selector = oeq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if oeq.ctx.Unique != nil && *oeq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range oeq.modifiers {
+		m(selector)
+	}
+	for _, p := range oeq.predicates {
+		p(selector)
+	}
+	for _, p := range oeq.order {
+		p(selector)
+	}
+	if offset := oeq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := oeq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (oeq *OutboxEventQuery) Modify(modifiers ...func(s *sql.Selector)) *OutboxEventSelect {
+	oeq.modifiers = append(oeq.modifiers, modifiers...)
+	return oeq.Select()
+}
+
+// OutboxEventGroupBy is the group-by builder for OutboxEvent entities.
+type OutboxEventGroupBy struct {
+	selector
+	build *OutboxEventQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (oegb *OutboxEventGroupBy) Aggregate(fns ...AggregateFunc) *OutboxEventGroupBy {
+	oegb.fns = append(oegb.fns, fns...)
+	return oegb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (oegb *OutboxEventGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, oegb.build.ctx, ent.OpQueryGroupBy)
+	if err := oegb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*OutboxEventQuery, *OutboxEventGroupBy](ctx, oegb.build, oegb, oegb.build.inters, v)
+}
+
+func (oegb *OutboxEventGroupBy) sqlScan(ctx context.Context, root *OutboxEventQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(oegb.fns))
+	for _, fn := range oegb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*oegb.flds)+len(oegb.fns))
+		for _, f := range *oegb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*oegb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := oegb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// OutboxEventSelect is the builder for selecting fields of OutboxEvent entities.
+type OutboxEventSelect struct {
+	*OutboxEventQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (oes *OutboxEventSelect) Aggregate(fns ...AggregateFunc) *OutboxEventSelect {
+	oes.fns = append(oes.fns, fns...)
+	return oes
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (oes *OutboxEventSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, oes.ctx, ent.OpQuerySelect)
+	if err := oes.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*OutboxEventQuery, *OutboxEventSelect](ctx, oes.OutboxEventQuery, oes, oes.inters, v)
+}
+
+func (oes *OutboxEventSelect) sqlScan(ctx context.Context, root *OutboxEventQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(oes.fns))
+	for _, fn := range oes.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*oes.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := oes.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (oes *OutboxEventSelect) Modify(modifiers ...func(s *sql.Selector)) *OutboxEventSelect {
+	oes.modifiers = append(oes.modifiers, modifiers...)
+	return oes
+}
@@ -0,0 +1,456 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/localopsco/go-sample/ent/outboxevent"
+	"github.com/localopsco/go-sample/ent/predicate"
+)
+
+// OutboxEventUpdate is the builder for updating OutboxEvent entities.
+type OutboxEventUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *OutboxEventMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the OutboxEventUpdate builder.
+func (oeu *OutboxEventUpdate) Where(ps ...predicate.OutboxEvent) *OutboxEventUpdate {
+	oeu.mutation.Where(ps...)
+	return oeu
+}
+
+// SetProcessedAt sets the "processed_at" field.
+func (oeu *OutboxEventUpdate) SetProcessedAt(t time.Time) *OutboxEventUpdate {
+	oeu.mutation.SetProcessedAt(t)
+	return oeu
+}
+
+// SetNillableProcessedAt sets the "processed_at" field if the given value is not nil.
+func (oeu *OutboxEventUpdate) SetNillableProcessedAt(t *time.Time) *OutboxEventUpdate {
+	if t != nil {
+		oeu.SetProcessedAt(*t)
+	}
+	return oeu
+}
+
+// ClearProcessedAt clears the value of the "processed_at" field.
+func (oeu *OutboxEventUpdate) ClearProcessedAt() *OutboxEventUpdate {
+	oeu.mutation.ClearProcessedAt()
+	return oeu
+}
+
+// SetRelayedSeq sets the "relayed_seq" field.
+func (oeu *OutboxEventUpdate) SetRelayedSeq(i int) *OutboxEventUpdate {
+	oeu.mutation.ResetRelayedSeq()
+	oeu.mutation.SetRelayedSeq(i)
+	return oeu
+}
+
+// SetNillableRelayedSeq sets the "relayed_seq" field if the given value is not nil.
+func (oeu *OutboxEventUpdate) SetNillableRelayedSeq(i *int) *OutboxEventUpdate {
+	if i != nil {
+		oeu.SetRelayedSeq(*i)
+	}
+	return oeu
+}
+
+// AddRelayedSeq adds i to the "relayed_seq" field.
+func (oeu *OutboxEventUpdate) AddRelayedSeq(i int) *OutboxEventUpdate {
+	oeu.mutation.AddRelayedSeq(i)
+	return oeu
+}
+
+// ClearRelayedSeq clears the value of the "relayed_seq" field.
+func (oeu *OutboxEventUpdate) ClearRelayedSeq() *OutboxEventUpdate {
+	oeu.mutation.ClearRelayedSeq()
+	return oeu
+}
+
+// SetAvailableAt sets the "available_at" field.
+func (oeu *OutboxEventUpdate) SetAvailableAt(t time.Time) *OutboxEventUpdate {
+	oeu.mutation.SetAvailableAt(t)
+	return oeu
+}
+
+// SetNillableAvailableAt sets the "available_at" field if the given value is not nil.
+func (oeu *OutboxEventUpdate) SetNillableAvailableAt(t *time.Time) *OutboxEventUpdate {
+	if t != nil {
+		oeu.SetAvailableAt(*t)
+	}
+	return oeu
+}
+
+// SetAttempts sets the "attempts" field.
+func (oeu *OutboxEventUpdate) SetAttempts(i int) *OutboxEventUpdate {
+	oeu.mutation.ResetAttempts()
+	oeu.mutation.SetAttempts(i)
+	return oeu
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (oeu *OutboxEventUpdate) SetNillableAttempts(i *int) *OutboxEventUpdate {
+	if i != nil {
+		oeu.SetAttempts(*i)
+	}
+	return oeu
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (oeu *OutboxEventUpdate) AddAttempts(i int) *OutboxEventUpdate {
+	oeu.mutation.AddAttempts(i)
+	return oeu
+}
+
+// SetLastError sets the "last_error" field.
+func (oeu *OutboxEventUpdate) SetLastError(s string) *OutboxEventUpdate {
+	oeu.mutation.SetLastError(s)
+	return oeu
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (oeu *OutboxEventUpdate) SetNillableLastError(s *string) *OutboxEventUpdate {
+	if s != nil {
+		oeu.SetLastError(*s)
+	}
+	return oeu
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (oeu *OutboxEventUpdate) ClearLastError() *OutboxEventUpdate {
+	oeu.mutation.ClearLastError()
+	return oeu
+}
+
+// Mutation returns the OutboxEventMutation object of the builder.
+func (oeu *OutboxEventUpdate) Mutation() *OutboxEventMutation {
+	return oeu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (oeu *OutboxEventUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, oeu.sqlSave, oeu.mutation, oeu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (oeu *OutboxEventUpdate) SaveX(ctx context.Context) int {
+	affected, err := oeu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (oeu *OutboxEventUpdate) Exec(ctx context.Context) error {
+	_, err := oeu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (oeu *OutboxEventUpdate) ExecX(ctx context.Context) {
+	if err := oeu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (oeu *OutboxEventUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *OutboxEventUpdate {
+	oeu.modifiers = append(oeu.modifiers, modifiers...)
+	return oeu
+}
+
+func (oeu *OutboxEventUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(outboxevent.Table, outboxevent.Columns, sqlgraph.NewFieldSpec(outboxevent.FieldID, field.TypeInt))
+	if ps := oeu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := oeu.mutation.ProcessedAt(); ok {
+		_spec.SetField(outboxevent.FieldProcessedAt, field.TypeTime, value)
+	}
+	if oeu.mutation.ProcessedAtCleared() {
+		_spec.ClearField(outboxevent.FieldProcessedAt, field.TypeTime)
+	}
+	if value, ok := oeu.mutation.RelayedSeq(); ok {
+		_spec.SetField(outboxevent.FieldRelayedSeq, field.TypeInt, value)
+	}
+	if value, ok := oeu.mutation.AddedRelayedSeq(); ok {
+		_spec.AddField(outboxevent.FieldRelayedSeq, field.TypeInt, value)
+	}
+	if oeu.mutation.RelayedSeqCleared() {
+		_spec.ClearField(outboxevent.FieldRelayedSeq, field.TypeInt)
+	}
+	if value, ok := oeu.mutation.AvailableAt(); ok {
+		_spec.SetField(outboxevent.FieldAvailableAt, field.TypeTime, value)
+	}
+	if value, ok := oeu.mutation.Attempts(); ok {
+		_spec.SetField(outboxevent.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := oeu.mutation.AddedAttempts(); ok {
+		_spec.AddField(outboxevent.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := oeu.mutation.LastError(); ok {
+		_spec.SetField(outboxevent.FieldLastError, field.TypeString, value)
+	}
+	if oeu.mutation.LastErrorCleared() {
+		_spec.ClearField(outboxevent.FieldLastError, field.TypeString)
+	}
+	_spec.AddModifiers(oeu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, oeu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{outboxevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	oeu.mutation.done = true
+	return n, nil
+}
+
+// OutboxEventUpdateOne is the builder for updating a single OutboxEvent entity.
+type OutboxEventUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *OutboxEventMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetProcessedAt sets the "processed_at" field.
+func (oeuo *OutboxEventUpdateOne) SetProcessedAt(t time.Time) *OutboxEventUpdateOne {
+	oeuo.mutation.SetProcessedAt(t)
+	return oeuo
+}
+
+// SetNillableProcessedAt sets the "processed_at" field if the given value is not nil.
+func (oeuo *OutboxEventUpdateOne) SetNillableProcessedAt(t *time.Time) *OutboxEventUpdateOne {
+	if t != nil {
+		oeuo.SetProcessedAt(*t)
+	}
+	return oeuo
+}
+
+// ClearProcessedAt clears the value of the "processed_at" field.
+func (oeuo *OutboxEventUpdateOne) ClearProcessedAt() *OutboxEventUpdateOne {
+	oeuo.mutation.ClearProcessedAt()
+	return oeuo
+}
+
+// SetRelayedSeq sets the "relayed_seq" field.
+func (oeuo *OutboxEventUpdateOne) SetRelayedSeq(i int) *OutboxEventUpdateOne {
+	oeuo.mutation.ResetRelayedSeq()
+	oeuo.mutation.SetRelayedSeq(i)
+	return oeuo
+}
+
+// SetNillableRelayedSeq sets the "relayed_seq" field if the given value is not nil.
+func (oeuo *OutboxEventUpdateOne) SetNillableRelayedSeq(i *int) *OutboxEventUpdateOne {
+	if i != nil {
+		oeuo.SetRelayedSeq(*i)
+	}
+	return oeuo
+}
+
+// AddRelayedSeq adds i to the "relayed_seq" field.
+func (oeuo *OutboxEventUpdateOne) AddRelayedSeq(i int) *OutboxEventUpdateOne {
+	oeuo.mutation.AddRelayedSeq(i)
+	return oeuo
+}
+
+// ClearRelayedSeq clears the value of the "relayed_seq" field.
+func (oeuo *OutboxEventUpdateOne) ClearRelayedSeq() *OutboxEventUpdateOne {
+	oeuo.mutation.ClearRelayedSeq()
+	return oeuo
+}
+
+// SetAvailableAt sets the "available_at" field.
+func (oeuo *OutboxEventUpdateOne) SetAvailableAt(t time.Time) *OutboxEventUpdateOne {
+	oeuo.mutation.SetAvailableAt(t)
+	return oeuo
+}
+
+// SetNillableAvailableAt sets the "available_at" field if the given value is not nil.
+func (oeuo *OutboxEventUpdateOne) SetNillableAvailableAt(t *time.Time) *OutboxEventUpdateOne {
+	if t != nil {
+		oeuo.SetAvailableAt(*t)
+	}
+	return oeuo
+}
+
+// SetAttempts sets the "attempts" field.
+func (oeuo *OutboxEventUpdateOne) SetAttempts(i int) *OutboxEventUpdateOne {
+	oeuo.mutation.ResetAttempts()
+	oeuo.mutation.SetAttempts(i)
+	return oeuo
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (oeuo *OutboxEventUpdateOne) SetNillableAttempts(i *int) *OutboxEventUpdateOne {
+	if i != nil {
+		oeuo.SetAttempts(*i)
+	}
+	return oeuo
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (oeuo *OutboxEventUpdateOne) AddAttempts(i int) *OutboxEventUpdateOne {
+	oeuo.mutation.AddAttempts(i)
+	return oeuo
+}
+
+// SetLastError sets the "last_error" field.
+func (oeuo *OutboxEventUpdateOne) SetLastError(s string) *OutboxEventUpdateOne {
+	oeuo.mutation.SetLastError(s)
+	return oeuo
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (oeuo *OutboxEventUpdateOne) SetNillableLastError(s *string) *OutboxEventUpdateOne {
+	if s != nil {
+		oeuo.SetLastError(*s)
+	}
+	return oeuo
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (oeuo *OutboxEventUpdateOne) ClearLastError() *OutboxEventUpdateOne {
+	oeuo.mutation.ClearLastError()
+	return oeuo
+}
+
+// Mutation returns the OutboxEventMutation object of the builder.
+func (oeuo *OutboxEventUpdateOne) Mutation() *OutboxEventMutation {
+	return oeuo.mutation
+}
+
+// Where appends a list predicates to the OutboxEventUpdate builder.
+func (oeuo *OutboxEventUpdateOne) Where(ps ...predicate.OutboxEvent) *OutboxEventUpdateOne {
+	oeuo.mutation.Where(ps...)
+	return oeuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (oeuo *OutboxEventUpdateOne) Select(field string, fields ...string) *OutboxEventUpdateOne {
+	oeuo.fields = append([]string{field}, fields...)
+	return oeuo
+}
+
+// Save executes the query and returns the updated OutboxEvent entity.
+func (oeuo *OutboxEventUpdateOne) Save(ctx context.Context) (*OutboxEvent, error) {
+	return withHooks(ctx, oeuo.sqlSave, oeuo.mutation, oeuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (oeuo *OutboxEventUpdateOne) SaveX(ctx context.Context) *OutboxEvent {
+	node, err := oeuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (oeuo *OutboxEventUpdateOne) Exec(ctx context.Context) error {
+	_, err := oeuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (oeuo *OutboxEventUpdateOne) ExecX(ctx context.Context) {
+	if err := oeuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (oeuo *OutboxEventUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *OutboxEventUpdateOne {
+	oeuo.modifiers = append(oeuo.modifiers, modifiers...)
+	return oeuo
+}
+
+func (oeuo *OutboxEventUpdateOne) sqlSave(ctx context.Context) (_node *OutboxEvent, err error) {
+	_spec := sqlgraph.NewUpdateSpec(outboxevent.Table, outboxevent.Columns, sqlgraph.NewFieldSpec(outboxevent.FieldID, field.TypeInt))
+	id, ok := oeuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "OutboxEvent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := oeuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, outboxevent.FieldID)
+		for _, f := range fields {
+			if !outboxevent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != outboxevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := oeuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := oeuo.mutation.ProcessedAt(); ok {
+		_spec.SetField(outboxevent.FieldProcessedAt, field.TypeTime, value)
+	}
+	if oeuo.mutation.ProcessedAtCleared() {
+		_spec.ClearField(outboxevent.FieldProcessedAt, field.TypeTime)
+	}
+	if value, ok := oeuo.mutation.RelayedSeq(); ok {
+		_spec.SetField(outboxevent.FieldRelayedSeq, field.TypeInt, value)
+	}
+	if value, ok := oeuo.mutation.AddedRelayedSeq(); ok {
+		_spec.AddField(outboxevent.FieldRelayedSeq, field.TypeInt, value)
+	}
+	if oeuo.mutation.RelayedSeqCleared() {
+		_spec.ClearField(outboxevent.FieldRelayedSeq, field.TypeInt)
+	}
+	if value, ok := oeuo.mutation.AvailableAt(); ok {
+		_spec.SetField(outboxevent.FieldAvailableAt, field.TypeTime, value)
+	}
+	if value, ok := oeuo.mutation.Attempts(); ok {
+		_spec.SetField(outboxevent.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := oeuo.mutation.AddedAttempts(); ok {
+		_spec.AddField(outboxevent.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := oeuo.mutation.LastError(); ok {
+		_spec.SetField(outboxevent.FieldLastError, field.TypeString, value)
+	}
+	if oeuo.mutation.LastErrorCleared() {
+		_spec.ClearField(outboxevent.FieldLastError, field.TypeString)
+	}
+	_spec.AddModifiers(oeuo.modifiers...)
+	_node = &OutboxEvent{config: oeuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, oeuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{outboxevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	oeuo.mutation.done = true
+	return _node, nil
+}
@@ -6,5 +6,47 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// ChangeSequence is the predicate function for changesequence builders.
+type ChangeSequence func(*sql.Selector)
+
+// Comment is the predicate function for comment builders.
+type Comment func(*sql.Selector)
+
+// ExportJob is the predicate function for exportjob builders.
+type ExportJob func(*sql.Selector)
+
+// IdempotencyKey is the predicate function for idempotencykey builders.
+type IdempotencyKey func(*sql.Selector)
+
+// OutboxEvent is the predicate function for outboxevent builders.
+type OutboxEvent func(*sql.Selector)
+
+// Project is the predicate function for project builders.
+type Project func(*sql.Selector)
+
+// SavedView is the predicate function for savedview builders.
+type SavedView func(*sql.Selector)
+
 // Task is the predicate function for task builders.
 type Task func(*sql.Selector)
+
+// TaskEvent is the predicate function for taskevent builders.
+type TaskEvent func(*sql.Selector)
+
+// TaskTombstone is the predicate function for tasktombstone builders.
+type TaskTombstone func(*sql.Selector)
+
+// User is the predicate function for user builders.
+type User func(*sql.Selector)
+
+// Webhook is the predicate function for webhook builders.
+type Webhook func(*sql.Selector)
+
+// WebhookDelivery is the predicate function for webhookdelivery builders.
+type WebhookDelivery func(*sql.Selector)
+
+// Workflow is the predicate function for workflow builders.
+type Workflow func(*sql.Selector)
+
+// WorkflowStatus is the predicate function for workflowstatus builders.
+type WorkflowStatus func(*sql.Selector)
@@ -0,0 +1,240 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/ent/project"
+	"github.com/localopsco/go-sample/ent/workflow"
+)
+
+// Project is the model entity for the Project schema.
+type Project struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// WorkflowID holds the value of the "workflow_id" field.
+	WorkflowID uuid.UUID `json:"workflow_id,omitempty"`
+	// WipLimits holds the value of the "wip_limits" field.
+	WipLimits map[string]int `json:"wip_limits,omitempty"`
+	// WipPolicy holds the value of the "wip_policy" field.
+	WipPolicy project.WipPolicy `json:"wip_policy,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ProjectQuery when eager-loading is set.
+	Edges        ProjectEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ProjectEdges holds the relations/edges for other nodes in the graph.
+type ProjectEdges struct {
+	// Workflow holds the value of the workflow edge.
+	Workflow *Workflow `json:"workflow,omitempty"`
+	// Tasks holds the value of the tasks edge.
+	Tasks []*Task `json:"tasks,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+	// totalCount holds the count of the edges above.
+	totalCount [1]map[string]int
+
+	namedTasks map[string][]*Task
+}
+
+// WorkflowOrErr returns the Workflow value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ProjectEdges) WorkflowOrErr() (*Workflow, error) {
+	if e.Workflow != nil {
+		return e.Workflow, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: workflow.Label}
+	}
+	return nil, &NotLoadedError{edge: "workflow"}
+}
+
+// TasksOrErr returns the Tasks value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProjectEdges) TasksOrErr() ([]*Task, error) {
+	if e.loadedTypes[1] {
+		return e.Tasks, nil
+	}
+	return nil, &NotLoadedError{edge: "tasks"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Project) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case project.FieldWipLimits:
+			values[i] = new([]byte)
+		case project.FieldName, project.FieldDescription, project.FieldWipPolicy:
+			values[i] = new(sql.NullString)
+		case project.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case project.FieldID, project.FieldWorkflowID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Project fields.
+func (pr *Project) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case project.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				pr.ID = *value
+			}
+		case project.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				pr.Name = value.String
+			}
+		case project.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				pr.Description = value.String
+			}
+		case project.FieldWorkflowID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field workflow_id", values[i])
+			} else if value != nil {
+				pr.WorkflowID = *value
+			}
+		case project.FieldWipLimits:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field wip_limits", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &pr.WipLimits); err != nil {
+					return fmt.Errorf("unmarshal field wip_limits: %w", err)
+				}
+			}
+		case project.FieldWipPolicy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field wip_policy", values[i])
+			} else if value.Valid {
+				pr.WipPolicy = project.WipPolicy(value.String)
+			}
+		case project.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pr.CreatedAt = value.Time
+			}
+		default:
+			pr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Project.
+// This includes values selected through modifiers, order, etc.
+func (pr *Project) Value(name string) (ent.Value, error) {
+	return pr.selectValues.Get(name)
+}
+
+// QueryWorkflow queries the "workflow" edge of the Project entity.
+func (pr *Project) QueryWorkflow() *WorkflowQuery {
+	return NewProjectClient(pr.config).QueryWorkflow(pr)
+}
+
+// QueryTasks queries the "tasks" edge of the Project entity.
+func (pr *Project) QueryTasks() *TaskQuery {
+	return NewProjectClient(pr.config).QueryTasks(pr)
+}
+
+// Update returns a builder for updating this Project.
+// Note that you need to call Project.Unwrap() before calling this method if this Project
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pr *Project) Update() *ProjectUpdateOne {
+	return NewProjectClient(pr.config).UpdateOne(pr)
+}
+
+// Unwrap unwraps the Project entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pr *Project) Unwrap() *Project {
+	_tx, ok := pr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Project is not a transactional entity")
+	}
+	pr.config.driver = _tx.drv
+	return pr
+}
+
+// String implements the fmt.Stringer.
+func (pr *Project) String() string {
+	var builder strings.Builder
+	builder.WriteString("Project(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pr.ID))
+	builder.WriteString("name=")
+	builder.WriteString(pr.Name)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(pr.Description)
+	builder.WriteString(", ")
+	builder.WriteString("workflow_id=")
+	builder.WriteString(fmt.Sprintf("%v", pr.WorkflowID))
+	builder.WriteString(", ")
+	builder.WriteString("wip_limits=")
+	builder.WriteString(fmt.Sprintf("%v", pr.WipLimits))
+	builder.WriteString(", ")
+	builder.WriteString("wip_policy=")
+	builder.WriteString(fmt.Sprintf("%v", pr.WipPolicy))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(pr.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// NamedTasks returns the Tasks named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (pr *Project) NamedTasks(name string) ([]*Task, error) {
+	if pr.Edges.namedTasks == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := pr.Edges.namedTasks[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (pr *Project) appendNamedTasks(name string, edges ...*Task) {
+	if pr.Edges.namedTasks == nil {
+		pr.Edges.namedTasks = make(map[string][]*Task)
+	}
+	if len(edges) == 0 {
+		pr.Edges.namedTasks[name] = []*Task{}
+	} else {
+		pr.Edges.namedTasks[name] = append(pr.Edges.namedTasks[name], edges...)
+	}
+}
+
+// Projects is a parsable slice of Project.
+type Projects []*Project
@@ -0,0 +1,369 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/ent/project"
+	"github.com/localopsco/go-sample/ent/task"
+	"github.com/localopsco/go-sample/ent/workflow"
+)
+
+// ProjectCreate is the builder for creating a Project entity.
+type ProjectCreate struct {
+	config
+	mutation *ProjectMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (pc *ProjectCreate) SetName(s string) *ProjectCreate {
+	pc.mutation.SetName(s)
+	return pc
+}
+
+// SetDescription sets the "description" field.
+func (pc *ProjectCreate) SetDescription(s string) *ProjectCreate {
+	pc.mutation.SetDescription(s)
+	return pc
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (pc *ProjectCreate) SetNillableDescription(s *string) *ProjectCreate {
+	if s != nil {
+		pc.SetDescription(*s)
+	}
+	return pc
+}
+
+// SetWorkflowID sets the "workflow_id" field.
+func (pc *ProjectCreate) SetWorkflowID(u uuid.UUID) *ProjectCreate {
+	pc.mutation.SetWorkflowID(u)
+	return pc
+}
+
+// SetWipLimits sets the "wip_limits" field.
+func (pc *ProjectCreate) SetWipLimits(m map[string]int) *ProjectCreate {
+	pc.mutation.SetWipLimits(m)
+	return pc
+}
+
+// SetWipPolicy sets the "wip_policy" field.
+func (pc *ProjectCreate) SetWipPolicy(pp project.WipPolicy) *ProjectCreate {
+	pc.mutation.SetWipPolicy(pp)
+	return pc
+}
+
+// SetNillableWipPolicy sets the "wip_policy" field if the given value is not nil.
+func (pc *ProjectCreate) SetNillableWipPolicy(pp *project.WipPolicy) *ProjectCreate {
+	if pp != nil {
+		pc.SetWipPolicy(*pp)
+	}
+	return pc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (pc *ProjectCreate) SetCreatedAt(t time.Time) *ProjectCreate {
+	pc.mutation.SetCreatedAt(t)
+	return pc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (pc *ProjectCreate) SetNillableCreatedAt(t *time.Time) *ProjectCreate {
+	if t != nil {
+		pc.SetCreatedAt(*t)
+	}
+	return pc
+}
+
+// SetID sets the "id" field.
+func (pc *ProjectCreate) SetID(u uuid.UUID) *ProjectCreate {
+	pc.mutation.SetID(u)
+	return pc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (pc *ProjectCreate) SetNillableID(u *uuid.UUID) *ProjectCreate {
+	if u != nil {
+		pc.SetID(*u)
+	}
+	return pc
+}
+
+// SetWorkflow sets the "workflow" edge to the Workflow entity.
+func (pc *ProjectCreate) SetWorkflow(w *Workflow) *ProjectCreate {
+	return pc.SetWorkflowID(w.ID)
+}
+
+// AddTaskIDs adds the "tasks" edge to the Task entity by IDs.
+func (pc *ProjectCreate) AddTaskIDs(ids ...uuid.UUID) *ProjectCreate {
+	pc.mutation.AddTaskIDs(ids...)
+	return pc
+}
+
+// AddTasks adds the "tasks" edges to the Task entity.
+func (pc *ProjectCreate) AddTasks(t ...*Task) *ProjectCreate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return pc.AddTaskIDs(ids...)
+}
+
+// Mutation returns the ProjectMutation object of the builder.
+func (pc *ProjectCreate) Mutation() *ProjectMutation {
+	return pc.mutation
+}
+
+// Save creates the Project in the database.
+func (pc *ProjectCreate) Save(ctx context.Context) (*Project, error) {
+	pc.defaults()
+	return withHooks(ctx, pc.sqlSave, pc.mutation, pc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (pc *ProjectCreate) SaveX(ctx context.Context) *Project {
+	v, err := pc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pc *ProjectCreate) Exec(ctx context.Context) error {
+	_, err := pc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pc *ProjectCreate) ExecX(ctx context.Context) {
+	if err := pc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pc *ProjectCreate) defaults() {
+	if _, ok := pc.mutation.WipPolicy(); !ok {
+		v := project.DefaultWipPolicy
+		pc.mutation.SetWipPolicy(v)
+	}
+	if _, ok := pc.mutation.CreatedAt(); !ok {
+		v := project.DefaultCreatedAt()
+		pc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := pc.mutation.ID(); !ok {
+		v := project.DefaultID()
+		pc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pc *ProjectCreate) check() error {
+	if _, ok := pc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Project.name"`)}
+	}
+	if v, ok := pc.mutation.Name(); ok {
+		if err := project.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Project.name": %w`, err)}
+		}
+	}
+	if _, ok := pc.mutation.WorkflowID(); !ok {
+		return &ValidationError{Name: "workflow_id", err: errors.New(`ent: missing required field "Project.workflow_id"`)}
+	}
+	if _, ok := pc.mutation.WipPolicy(); !ok {
+		return &ValidationError{Name: "wip_policy", err: errors.New(`ent: missing required field "Project.wip_policy"`)}
+	}
+	if v, ok := pc.mutation.WipPolicy(); ok {
+		if err := project.WipPolicyValidator(v); err != nil {
+			return &ValidationError{Name: "wip_policy", err: fmt.Errorf(`ent: validator failed for field "Project.wip_policy": %w`, err)}
+		}
+	}
+	if _, ok := pc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Project.created_at"`)}
+	}
+	if len(pc.mutation.WorkflowIDs()) == 0 {
+		return &ValidationError{Name: "workflow", err: errors.New(`ent: missing required edge "Project.workflow"`)}
+	}
+	return nil
+}
+
+func (pc *ProjectCreate) sqlSave(ctx context.Context) (*Project, error) {
+	if err := pc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := pc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, pc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	pc.mutation.id = &_node.ID
+	pc.mutation.done = true
+	return _node, nil
+}
+
+func (pc *ProjectCreate) createSpec() (*Project, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Project{config: pc.config}
+		_spec = sqlgraph.NewCreateSpec(project.Table, sqlgraph.NewFieldSpec(project.FieldID, field.TypeUUID))
+	)
+	if id, ok := pc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := pc.mutation.Name(); ok {
+		_spec.SetField(project.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := pc.mutation.Description(); ok {
+		_spec.SetField(project.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := pc.mutation.WipLimits(); ok {
+		_spec.SetField(project.FieldWipLimits, field.TypeJSON, value)
+		_node.WipLimits = value
+	}
+	if value, ok := pc.mutation.WipPolicy(); ok {
+		_spec.SetField(project.FieldWipPolicy, field.TypeEnum, value)
+		_node.WipPolicy = value
+	}
+	if value, ok := pc.mutation.CreatedAt(); ok {
+		_spec.SetField(project.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := pc.mutation.WorkflowIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   project.WorkflowTable,
+			Columns: []string{project.WorkflowColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workflow.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.WorkflowID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.TasksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.TasksTable,
+			Columns: []string{project.TasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ProjectCreateBulk is the builder for creating many Project entities in bulk.
+type ProjectCreateBulk struct {
+	config
+	err      error
+	builders []*ProjectCreate
+}
+
+// Save creates the Project entities in the database.
+func (pcb *ProjectCreateBulk) Save(ctx context.Context) ([]*Project, error) {
+	if pcb.err != nil {
+		return nil, pcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(pcb.builders))
+	nodes := make([]*Project, len(pcb.builders))
+	mutators := make([]Mutator, len(pcb.builders))
+	for i := range pcb.builders {
+		func(i int, root context.Context) {
+			builder := pcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ProjectMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, pcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, pcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, pcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pcb *ProjectCreateBulk) SaveX(ctx context.Context) []*Project {
+	v, err := pcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pcb *ProjectCreateBulk) Exec(ctx context.Context) error {
+	_, err := pcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pcb *ProjectCreateBulk) ExecX(ctx context.Context) {
+	if err := pcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
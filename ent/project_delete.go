@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/localopsco/go-sample/ent/predicate"
+	"github.com/localopsco/go-sample/ent/project"
+)
+
+// ProjectDelete is the builder for deleting a Project entity.
+type ProjectDelete struct {
+	config
+	hooks    []Hook
+	mutation *ProjectMutation
+}
+
+// Where appends a list predicates to the ProjectDelete builder.
+func (pd *ProjectDelete) Where(ps ...predicate.Project) *ProjectDelete {
+	pd.mutation.Where(ps...)
+	return pd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (pd *ProjectDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, pd.sqlExec, pd.mutation, pd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pd *ProjectDelete) ExecX(ctx context.Context) int {
+	n, err := pd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (pd *ProjectDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(project.Table, sqlgraph.NewFieldSpec(project.FieldID, field.TypeUUID))
+	if ps := pd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, pd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	pd.mutation.done = true
+	return affected, err
+}
+
+// ProjectDeleteOne is the builder for deleting a single Project entity.
+type ProjectDeleteOne struct {
+	pd *ProjectDelete
+}
+
+// Where appends a list predicates to the ProjectDelete builder.
+func (pdo *ProjectDeleteOne) Where(ps ...predicate.Project) *ProjectDeleteOne {
+	pdo.pd.mutation.Where(ps...)
+	return pdo
+}
+
+// Exec executes the deletion query.
+func (pdo *ProjectDeleteOne) Exec(ctx context.Context) error {
+	n, err := pdo.pd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{project.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pdo *ProjectDeleteOne) ExecX(ctx context.Context) {
+	if err := pdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,697 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/ent/predicate"
+	"github.com/localopsco/go-sample/ent/project"
+	"github.com/localopsco/go-sample/ent/task"
+	"github.com/localopsco/go-sample/ent/workflow"
+)
+
+// ProjectUpdate is the builder for updating Project entities.
+type ProjectUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ProjectMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ProjectUpdate builder.
+func (pu *ProjectUpdate) Where(ps ...predicate.Project) *ProjectUpdate {
+	pu.mutation.Where(ps...)
+	return pu
+}
+
+// SetName sets the "name" field.
+func (pu *ProjectUpdate) SetName(s string) *ProjectUpdate {
+	pu.mutation.SetName(s)
+	return pu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (pu *ProjectUpdate) SetNillableName(s *string) *ProjectUpdate {
+	if s != nil {
+		pu.SetName(*s)
+	}
+	return pu
+}
+
+// SetDescription sets the "description" field.
+func (pu *ProjectUpdate) SetDescription(s string) *ProjectUpdate {
+	pu.mutation.SetDescription(s)
+	return pu
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (pu *ProjectUpdate) SetNillableDescription(s *string) *ProjectUpdate {
+	if s != nil {
+		pu.SetDescription(*s)
+	}
+	return pu
+}
+
+// ClearDescription clears the value of the "description" field.
+func (pu *ProjectUpdate) ClearDescription() *ProjectUpdate {
+	pu.mutation.ClearDescription()
+	return pu
+}
+
+// SetWorkflowID sets the "workflow_id" field.
+func (pu *ProjectUpdate) SetWorkflowID(u uuid.UUID) *ProjectUpdate {
+	pu.mutation.SetWorkflowID(u)
+	return pu
+}
+
+// SetNillableWorkflowID sets the "workflow_id" field if the given value is not nil.
+func (pu *ProjectUpdate) SetNillableWorkflowID(u *uuid.UUID) *ProjectUpdate {
+	if u != nil {
+		pu.SetWorkflowID(*u)
+	}
+	return pu
+}
+
+// SetWipLimits sets the "wip_limits" field.
+func (pu *ProjectUpdate) SetWipLimits(m map[string]int) *ProjectUpdate {
+	pu.mutation.SetWipLimits(m)
+	return pu
+}
+
+// ClearWipLimits clears the value of the "wip_limits" field.
+func (pu *ProjectUpdate) ClearWipLimits() *ProjectUpdate {
+	pu.mutation.ClearWipLimits()
+	return pu
+}
+
+// SetWipPolicy sets the "wip_policy" field.
+func (pu *ProjectUpdate) SetWipPolicy(pp project.WipPolicy) *ProjectUpdate {
+	pu.mutation.SetWipPolicy(pp)
+	return pu
+}
+
+// SetNillableWipPolicy sets the "wip_policy" field if the given value is not nil.
+func (pu *ProjectUpdate) SetNillableWipPolicy(pp *project.WipPolicy) *ProjectUpdate {
+	if pp != nil {
+		pu.SetWipPolicy(*pp)
+	}
+	return pu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (pu *ProjectUpdate) SetCreatedAt(t time.Time) *ProjectUpdate {
+	pu.mutation.SetCreatedAt(t)
+	return pu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (pu *ProjectUpdate) SetNillableCreatedAt(t *time.Time) *ProjectUpdate {
+	if t != nil {
+		pu.SetCreatedAt(*t)
+	}
+	return pu
+}
+
+// SetWorkflow sets the "workflow" edge to the Workflow entity.
+func (pu *ProjectUpdate) SetWorkflow(w *Workflow) *ProjectUpdate {
+	return pu.SetWorkflowID(w.ID)
+}
+
+// AddTaskIDs adds the "tasks" edge to the Task entity by IDs.
+func (pu *ProjectUpdate) AddTaskIDs(ids ...uuid.UUID) *ProjectUpdate {
+	pu.mutation.AddTaskIDs(ids...)
+	return pu
+}
+
+// AddTasks adds the "tasks" edges to the Task entity.
+func (pu *ProjectUpdate) AddTasks(t ...*Task) *ProjectUpdate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return pu.AddTaskIDs(ids...)
+}
+
+// Mutation returns the ProjectMutation object of the builder.
+func (pu *ProjectUpdate) Mutation() *ProjectMutation {
+	return pu.mutation
+}
+
+// ClearWorkflow clears the "workflow" edge to the Workflow entity.
+func (pu *ProjectUpdate) ClearWorkflow() *ProjectUpdate {
+	pu.mutation.ClearWorkflow()
+	return pu
+}
+
+// ClearTasks clears all "tasks" edges to the Task entity.
+func (pu *ProjectUpdate) ClearTasks() *ProjectUpdate {
+	pu.mutation.ClearTasks()
+	return pu
+}
+
+// RemoveTaskIDs removes the "tasks" edge to Task entities by IDs.
+func (pu *ProjectUpdate) RemoveTaskIDs(ids ...uuid.UUID) *ProjectUpdate {
+	pu.mutation.RemoveTaskIDs(ids...)
+	return pu
+}
+
+// RemoveTasks removes "tasks" edges to Task entities.
+func (pu *ProjectUpdate) RemoveTasks(t ...*Task) *ProjectUpdate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return pu.RemoveTaskIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pu *ProjectUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, pu.sqlSave, pu.mutation, pu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pu *ProjectUpdate) SaveX(ctx context.Context) int {
+	affected, err := pu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pu *ProjectUpdate) Exec(ctx context.Context) error {
+	_, err := pu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pu *ProjectUpdate) ExecX(ctx context.Context) {
+	if err := pu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pu *ProjectUpdate) check() error {
+	if v, ok := pu.mutation.Name(); ok {
+		if err := project.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Project.name": %w`, err)}
+		}
+	}
+	if v, ok := pu.mutation.WipPolicy(); ok {
+		if err := project.WipPolicyValidator(v); err != nil {
+			return &ValidationError{Name: "wip_policy", err: fmt.Errorf(`ent: validator failed for field "Project.wip_policy": %w`, err)}
+		}
+	}
+	if pu.mutation.WorkflowCleared() && len(pu.mutation.WorkflowIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Project.workflow"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (pu *ProjectUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ProjectUpdate {
+	pu.modifiers = append(pu.modifiers, modifiers...)
+	return pu
+}
+
+func (pu *ProjectUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := pu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(project.Table, project.Columns, sqlgraph.NewFieldSpec(project.FieldID, field.TypeUUID))
+	if ps := pu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pu.mutation.Name(); ok {
+		_spec.SetField(project.FieldName, field.TypeString, value)
+	}
+	if value, ok := pu.mutation.Description(); ok {
+		_spec.SetField(project.FieldDescription, field.TypeString, value)
+	}
+	if pu.mutation.DescriptionCleared() {
+		_spec.ClearField(project.FieldDescription, field.TypeString)
+	}
+	if value, ok := pu.mutation.WipLimits(); ok {
+		_spec.SetField(project.FieldWipLimits, field.TypeJSON, value)
+	}
+	if pu.mutation.WipLimitsCleared() {
+		_spec.ClearField(project.FieldWipLimits, field.TypeJSON)
+	}
+	if value, ok := pu.mutation.WipPolicy(); ok {
+		_spec.SetField(project.FieldWipPolicy, field.TypeEnum, value)
+	}
+	if value, ok := pu.mutation.CreatedAt(); ok {
+		_spec.SetField(project.FieldCreatedAt, field.TypeTime, value)
+	}
+	if pu.mutation.WorkflowCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   project.WorkflowTable,
+			Columns: []string{project.WorkflowColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workflow.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.WorkflowIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   project.WorkflowTable,
+			Columns: []string{project.WorkflowColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workflow.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.TasksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.TasksTable,
+			Columns: []string{project.TasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedTasksIDs(); len(nodes) > 0 && !pu.mutation.TasksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.TasksTable,
+			Columns: []string{project.TasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.TasksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.TasksTable,
+			Columns: []string{project.TasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(pu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, pu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{project.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	pu.mutation.done = true
+	return n, nil
+}
+
+// ProjectUpdateOne is the builder for updating a single Project entity.
+type ProjectUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ProjectMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetName sets the "name" field.
+func (puo *ProjectUpdateOne) SetName(s string) *ProjectUpdateOne {
+	puo.mutation.SetName(s)
+	return puo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (puo *ProjectUpdateOne) SetNillableName(s *string) *ProjectUpdateOne {
+	if s != nil {
+		puo.SetName(*s)
+	}
+	return puo
+}
+
+// SetDescription sets the "description" field.
+func (puo *ProjectUpdateOne) SetDescription(s string) *ProjectUpdateOne {
+	puo.mutation.SetDescription(s)
+	return puo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (puo *ProjectUpdateOne) SetNillableDescription(s *string) *ProjectUpdateOne {
+	if s != nil {
+		puo.SetDescription(*s)
+	}
+	return puo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (puo *ProjectUpdateOne) ClearDescription() *ProjectUpdateOne {
+	puo.mutation.ClearDescription()
+	return puo
+}
+
+// SetWorkflowID sets the "workflow_id" field.
+func (puo *ProjectUpdateOne) SetWorkflowID(u uuid.UUID) *ProjectUpdateOne {
+	puo.mutation.SetWorkflowID(u)
+	return puo
+}
+
+// SetNillableWorkflowID sets the "workflow_id" field if the given value is not nil.
+func (puo *ProjectUpdateOne) SetNillableWorkflowID(u *uuid.UUID) *ProjectUpdateOne {
+	if u != nil {
+		puo.SetWorkflowID(*u)
+	}
+	return puo
+}
+
+// SetWipLimits sets the "wip_limits" field.
+func (puo *ProjectUpdateOne) SetWipLimits(m map[string]int) *ProjectUpdateOne {
+	puo.mutation.SetWipLimits(m)
+	return puo
+}
+
+// ClearWipLimits clears the value of the "wip_limits" field.
+func (puo *ProjectUpdateOne) ClearWipLimits() *ProjectUpdateOne {
+	puo.mutation.ClearWipLimits()
+	return puo
+}
+
+// SetWipPolicy sets the "wip_policy" field.
+func (puo *ProjectUpdateOne) SetWipPolicy(pp project.WipPolicy) *ProjectUpdateOne {
+	puo.mutation.SetWipPolicy(pp)
+	return puo
+}
+
+// SetNillableWipPolicy sets the "wip_policy" field if the given value is not nil.
+func (puo *ProjectUpdateOne) SetNillableWipPolicy(pp *project.WipPolicy) *ProjectUpdateOne {
+	if pp != nil {
+		puo.SetWipPolicy(*pp)
+	}
+	return puo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (puo *ProjectUpdateOne) SetCreatedAt(t time.Time) *ProjectUpdateOne {
+	puo.mutation.SetCreatedAt(t)
+	return puo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (puo *ProjectUpdateOne) SetNillableCreatedAt(t *time.Time) *ProjectUpdateOne {
+	if t != nil {
+		puo.SetCreatedAt(*t)
+	}
+	return puo
+}
+
+// SetWorkflow sets the "workflow" edge to the Workflow entity.
+func (puo *ProjectUpdateOne) SetWorkflow(w *Workflow) *ProjectUpdateOne {
+	return puo.SetWorkflowID(w.ID)
+}
+
+// AddTaskIDs adds the "tasks" edge to the Task entity by IDs.
+func (puo *ProjectUpdateOne) AddTaskIDs(ids ...uuid.UUID) *ProjectUpdateOne {
+	puo.mutation.AddTaskIDs(ids...)
+	return puo
+}
+
+// AddTasks adds the "tasks" edges to the Task entity.
+func (puo *ProjectUpdateOne) AddTasks(t ...*Task) *ProjectUpdateOne {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return puo.AddTaskIDs(ids...)
+}
+
+// Mutation returns the ProjectMutation object of the builder.
+func (puo *ProjectUpdateOne) Mutation() *ProjectMutation {
+	return puo.mutation
+}
+
+// ClearWorkflow clears the "workflow" edge to the Workflow entity.
+func (puo *ProjectUpdateOne) ClearWorkflow() *ProjectUpdateOne {
+	puo.mutation.ClearWorkflow()
+	return puo
+}
+
+// ClearTasks clears all "tasks" edges to the Task entity.
+func (puo *ProjectUpdateOne) ClearTasks() *ProjectUpdateOne {
+	puo.mutation.ClearTasks()
+	return puo
+}
+
+// RemoveTaskIDs removes the "tasks" edge to Task entities by IDs.
+func (puo *ProjectUpdateOne) RemoveTaskIDs(ids ...uuid.UUID) *ProjectUpdateOne {
+	puo.mutation.RemoveTaskIDs(ids...)
+	return puo
+}
+
+// RemoveTasks removes "tasks" edges to Task entities.
+func (puo *ProjectUpdateOne) RemoveTasks(t ...*Task) *ProjectUpdateOne {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return puo.RemoveTaskIDs(ids...)
+}
+
+// Where appends a list predicates to the ProjectUpdate builder.
+func (puo *ProjectUpdateOne) Where(ps ...predicate.Project) *ProjectUpdateOne {
+	puo.mutation.Where(ps...)
+	return puo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (puo *ProjectUpdateOne) Select(field string, fields ...string) *ProjectUpdateOne {
+	puo.fields = append([]string{field}, fields...)
+	return puo
+}
+
+// Save executes the query and returns the updated Project entity.
+func (puo *ProjectUpdateOne) Save(ctx context.Context) (*Project, error) {
+	return withHooks(ctx, puo.sqlSave, puo.mutation, puo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (puo *ProjectUpdateOne) SaveX(ctx context.Context) *Project {
+	node, err := puo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (puo *ProjectUpdateOne) Exec(ctx context.Context) error {
+	_, err := puo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (puo *ProjectUpdateOne) ExecX(ctx context.Context) {
+	if err := puo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (puo *ProjectUpdateOne) check() error {
+	if v, ok := puo.mutation.Name(); ok {
+		if err := project.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Project.name": %w`, err)}
+		}
+	}
+	if v, ok := puo.mutation.WipPolicy(); ok {
+		if err := project.WipPolicyValidator(v); err != nil {
+			return &ValidationError{Name: "wip_policy", err: fmt.Errorf(`ent: validator failed for field "Project.wip_policy": %w`, err)}
+		}
+	}
+	if puo.mutation.WorkflowCleared() && len(puo.mutation.WorkflowIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Project.workflow"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (puo *ProjectUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ProjectUpdateOne {
+	puo.modifiers = append(puo.modifiers, modifiers...)
+	return puo
+}
+
+func (puo *ProjectUpdateOne) sqlSave(ctx context.Context) (_node *Project, err error) {
+	if err := puo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(project.Table, project.Columns, sqlgraph.NewFieldSpec(project.FieldID, field.TypeUUID))
+	id, ok := puo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Project.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := puo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, project.FieldID)
+		for _, f := range fields {
+			if !project.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != project.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := puo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := puo.mutation.Name(); ok {
+		_spec.SetField(project.FieldName, field.TypeString, value)
+	}
+	if value, ok := puo.mutation.Description(); ok {
+		_spec.SetField(project.FieldDescription, field.TypeString, value)
+	}
+	if puo.mutation.DescriptionCleared() {
+		_spec.ClearField(project.FieldDescription, field.TypeString)
+	}
+	if value, ok := puo.mutation.WipLimits(); ok {
+		_spec.SetField(project.FieldWipLimits, field.TypeJSON, value)
+	}
+	if puo.mutation.WipLimitsCleared() {
+		_spec.ClearField(project.FieldWipLimits, field.TypeJSON)
+	}
+	if value, ok := puo.mutation.WipPolicy(); ok {
+		_spec.SetField(project.FieldWipPolicy, field.TypeEnum, value)
+	}
+	if value, ok := puo.mutation.CreatedAt(); ok {
+		_spec.SetField(project.FieldCreatedAt, field.TypeTime, value)
+	}
+	if puo.mutation.WorkflowCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   project.WorkflowTable,
+			Columns: []string{project.WorkflowColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workflow.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.WorkflowIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   project.WorkflowTable,
+			Columns: []string{project.WorkflowColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workflow.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.TasksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.TasksTable,
+			Columns: []string{project.TasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedTasksIDs(); len(nodes) > 0 && !puo.mutation.TasksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.TasksTable,
+			Columns: []string{project.TasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.TasksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.TasksTable,
+			Columns: []string{project.TasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(puo.modifiers...)
+	_node = &Project{config: puo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, puo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{project.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	puo.mutation.done = true
+	return _node, nil
+}
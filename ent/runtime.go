@@ -6,26 +6,288 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/ent/changesequence"
+	"github.com/localopsco/go-sample/ent/comment"
+	"github.com/localopsco/go-sample/ent/exportjob"
+	"github.com/localopsco/go-sample/ent/idempotencykey"
+	"github.com/localopsco/go-sample/ent/outboxevent"
+	"github.com/localopsco/go-sample/ent/project"
+	"github.com/localopsco/go-sample/ent/savedview"
 	"github.com/localopsco/go-sample/ent/schema"
 	"github.com/localopsco/go-sample/ent/task"
+	"github.com/localopsco/go-sample/ent/taskevent"
+	"github.com/localopsco/go-sample/ent/tasktombstone"
+	"github.com/localopsco/go-sample/ent/user"
+	"github.com/localopsco/go-sample/ent/webhook"
+	"github.com/localopsco/go-sample/ent/webhookdelivery"
+	"github.com/localopsco/go-sample/ent/workflow"
+	"github.com/localopsco/go-sample/ent/workflowstatus"
 )
 
 // The init function reads all schema descriptors with runtime code
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	changesequenceFields := schema.ChangeSequence{}.Fields()
+	_ = changesequenceFields
+	// changesequenceDescValue is the schema descriptor for value field.
+	changesequenceDescValue := changesequenceFields[1].Descriptor()
+	// changesequence.DefaultValue holds the default value on creation for the value field.
+	changesequence.DefaultValue = changesequenceDescValue.Default.(int64)
+	commentFields := schema.Comment{}.Fields()
+	_ = commentFields
+	// commentDescCreatedAt is the schema descriptor for created_at field.
+	commentDescCreatedAt := commentFields[6].Descriptor()
+	// comment.DefaultCreatedAt holds the default value on creation for the created_at field.
+	comment.DefaultCreatedAt = commentDescCreatedAt.Default.(func() time.Time)
+	// commentDescID is the schema descriptor for id field.
+	commentDescID := commentFields[0].Descriptor()
+	// comment.DefaultID holds the default value on creation for the id field.
+	comment.DefaultID = commentDescID.Default.(func() uuid.UUID)
+	exportjobFields := schema.ExportJob{}.Fields()
+	_ = exportjobFields
+	// exportjobDescTimezone is the schema descriptor for timezone field.
+	exportjobDescTimezone := exportjobFields[5].Descriptor()
+	// exportjob.DefaultTimezone holds the default value on creation for the timezone field.
+	exportjob.DefaultTimezone = exportjobDescTimezone.Default.(string)
+	// exportjobDescRows is the schema descriptor for rows field.
+	exportjobDescRows := exportjobFields[8].Descriptor()
+	// exportjob.DefaultRows holds the default value on creation for the rows field.
+	exportjob.DefaultRows = exportjobDescRows.Default.(int)
+	// exportjobDescCreatedAt is the schema descriptor for created_at field.
+	exportjobDescCreatedAt := exportjobFields[10].Descriptor()
+	// exportjob.DefaultCreatedAt holds the default value on creation for the created_at field.
+	exportjob.DefaultCreatedAt = exportjobDescCreatedAt.Default.(func() time.Time)
+	// exportjobDescID is the schema descriptor for id field.
+	exportjobDescID := exportjobFields[0].Descriptor()
+	// exportjob.DefaultID holds the default value on creation for the id field.
+	exportjob.DefaultID = exportjobDescID.Default.(func() uuid.UUID)
+	idempotencykeyFields := schema.IdempotencyKey{}.Fields()
+	_ = idempotencykeyFields
+	// idempotencykeyDescKey is the schema descriptor for key field.
+	idempotencykeyDescKey := idempotencykeyFields[1].Descriptor()
+	// idempotencykey.KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	idempotencykey.KeyValidator = func() func(string) error {
+		validators := idempotencykeyDescKey.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(key string) error {
+			for _, fn := range fns {
+				if err := fn(key); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// idempotencykeyDescCaller is the schema descriptor for caller field.
+	idempotencykeyDescCaller := idempotencykeyFields[2].Descriptor()
+	// idempotencykey.CallerValidator is a validator for the "caller" field. It is called by the builders before save.
+	idempotencykey.CallerValidator = idempotencykeyDescCaller.Validators[0].(func(string) error)
+	// idempotencykeyDescCreatedAt is the schema descriptor for created_at field.
+	idempotencykeyDescCreatedAt := idempotencykeyFields[7].Descriptor()
+	// idempotencykey.DefaultCreatedAt holds the default value on creation for the created_at field.
+	idempotencykey.DefaultCreatedAt = idempotencykeyDescCreatedAt.Default.(func() time.Time)
+	// idempotencykeyDescID is the schema descriptor for id field.
+	idempotencykeyDescID := idempotencykeyFields[0].Descriptor()
+	// idempotencykey.DefaultID holds the default value on creation for the id field.
+	idempotencykey.DefaultID = idempotencykeyDescID.Default.(func() uuid.UUID)
+	outboxeventFields := schema.OutboxEvent{}.Fields()
+	_ = outboxeventFields
+	// outboxeventDescCreatedAt is the schema descriptor for created_at field.
+	outboxeventDescCreatedAt := outboxeventFields[4].Descriptor()
+	// outboxevent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	outboxevent.DefaultCreatedAt = outboxeventDescCreatedAt.Default.(func() time.Time)
+	// outboxeventDescAvailableAt is the schema descriptor for available_at field.
+	outboxeventDescAvailableAt := outboxeventFields[7].Descriptor()
+	// outboxevent.DefaultAvailableAt holds the default value on creation for the available_at field.
+	outboxevent.DefaultAvailableAt = outboxeventDescAvailableAt.Default.(func() time.Time)
+	// outboxeventDescAttempts is the schema descriptor for attempts field.
+	outboxeventDescAttempts := outboxeventFields[8].Descriptor()
+	// outboxevent.DefaultAttempts holds the default value on creation for the attempts field.
+	outboxevent.DefaultAttempts = outboxeventDescAttempts.Default.(int)
+	projectFields := schema.Project{}.Fields()
+	_ = projectFields
+	// projectDescName is the schema descriptor for name field.
+	projectDescName := projectFields[1].Descriptor()
+	// project.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	project.NameValidator = projectDescName.Validators[0].(func(string) error)
+	// projectDescCreatedAt is the schema descriptor for created_at field.
+	projectDescCreatedAt := projectFields[6].Descriptor()
+	// project.DefaultCreatedAt holds the default value on creation for the created_at field.
+	project.DefaultCreatedAt = projectDescCreatedAt.Default.(func() time.Time)
+	// projectDescID is the schema descriptor for id field.
+	projectDescID := projectFields[0].Descriptor()
+	// project.DefaultID holds the default value on creation for the id field.
+	project.DefaultID = projectDescID.Default.(func() uuid.UUID)
+	savedviewFields := schema.SavedView{}.Fields()
+	_ = savedviewFields
+	// savedviewDescName is the schema descriptor for name field.
+	savedviewDescName := savedviewFields[1].Descriptor()
+	// savedview.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	savedview.NameValidator = savedviewDescName.Validators[0].(func(string) error)
+	// savedviewDescCreatedAt is the schema descriptor for created_at field.
+	savedviewDescCreatedAt := savedviewFields[7].Descriptor()
+	// savedview.DefaultCreatedAt holds the default value on creation for the created_at field.
+	savedview.DefaultCreatedAt = savedviewDescCreatedAt.Default.(func() time.Time)
+	// savedviewDescUpdatedAt is the schema descriptor for updated_at field.
+	savedviewDescUpdatedAt := savedviewFields[8].Descriptor()
+	// savedview.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	savedview.DefaultUpdatedAt = savedviewDescUpdatedAt.Default.(func() time.Time)
+	// savedview.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	savedview.UpdateDefaultUpdatedAt = savedviewDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// savedviewDescID is the schema descriptor for id field.
+	savedviewDescID := savedviewFields[0].Descriptor()
+	// savedview.DefaultID holds the default value on creation for the id field.
+	savedview.DefaultID = savedviewDescID.Default.(func() uuid.UUID)
 	taskFields := schema.Task{}.Fields()
 	_ = taskFields
-	// taskDescIsCompleted is the schema descriptor for is_completed field.
-	taskDescIsCompleted := taskFields[3].Descriptor()
-	// task.DefaultIsCompleted holds the default value on creation for the is_completed field.
-	task.DefaultIsCompleted = taskDescIsCompleted.Default.(bool)
 	// taskDescCreatedAt is the schema descriptor for created_at field.
-	taskDescCreatedAt := taskFields[5].Descriptor()
+	taskDescCreatedAt := taskFields[7].Descriptor()
 	// task.DefaultCreatedAt holds the default value on creation for the created_at field.
 	task.DefaultCreatedAt = taskDescCreatedAt.Default.(func() time.Time)
+	// taskDescPosition is the schema descriptor for position field.
+	taskDescPosition := taskFields[9].Descriptor()
+	// task.DefaultPosition holds the default value on creation for the position field.
+	task.DefaultPosition = taskDescPosition.Default.(int)
+	// taskDescEstimateMinutes is the schema descriptor for estimate_minutes field.
+	taskDescEstimateMinutes := taskFields[13].Descriptor()
+	// task.EstimateMinutesValidator is a validator for the "estimate_minutes" field. It is called by the builders before save.
+	task.EstimateMinutesValidator = taskDescEstimateMinutes.Validators[0].(func(int) error)
+	// taskDescRecurrencePaused is the schema descriptor for recurrence_paused field.
+	taskDescRecurrencePaused := taskFields[17].Descriptor()
+	// task.DefaultRecurrencePaused holds the default value on creation for the recurrence_paused field.
+	task.DefaultRecurrencePaused = taskDescRecurrencePaused.Default.(bool)
+	// taskDescRank is the schema descriptor for rank field.
+	taskDescRank := taskFields[20].Descriptor()
+	// task.DefaultRank holds the default value on creation for the rank field.
+	task.DefaultRank = taskDescRank.Default.(string)
+	// taskDescVersion is the schema descriptor for version field.
+	taskDescVersion := taskFields[23].Descriptor()
+	// task.DefaultVersion holds the default value on creation for the version field.
+	task.DefaultVersion = taskDescVersion.Default.(int)
+	// taskDescChangeSeq is the schema descriptor for change_seq field.
+	taskDescChangeSeq := taskFields[24].Descriptor()
+	// task.DefaultChangeSeq holds the default value on creation for the change_seq field.
+	task.DefaultChangeSeq = taskDescChangeSeq.Default.(int64)
 	// taskDescID is the schema descriptor for id field.
 	taskDescID := taskFields[0].Descriptor()
 	// task.DefaultID holds the default value on creation for the id field.
 	task.DefaultID = taskDescID.Default.(func() uuid.UUID)
+	taskeventFields := schema.TaskEvent{}.Fields()
+	_ = taskeventFields
+	// taskeventDescCreatedAt is the schema descriptor for created_at field.
+	taskeventDescCreatedAt := taskeventFields[8].Descriptor()
+	// taskevent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	taskevent.DefaultCreatedAt = taskeventDescCreatedAt.Default.(func() time.Time)
+	// taskeventDescID is the schema descriptor for id field.
+	taskeventDescID := taskeventFields[0].Descriptor()
+	// taskevent.DefaultID holds the default value on creation for the id field.
+	taskevent.DefaultID = taskeventDescID.Default.(func() uuid.UUID)
+	tasktombstoneFields := schema.TaskTombstone{}.Fields()
+	_ = tasktombstoneFields
+	// tasktombstoneDescDeletedAt is the schema descriptor for deleted_at field.
+	tasktombstoneDescDeletedAt := tasktombstoneFields[2].Descriptor()
+	// tasktombstone.DefaultDeletedAt holds the default value on creation for the deleted_at field.
+	tasktombstone.DefaultDeletedAt = tasktombstoneDescDeletedAt.Default.(func() time.Time)
+	userFields := schema.User{}.Fields()
+	_ = userFields
+	// userDescUsername is the schema descriptor for username field.
+	userDescUsername := userFields[1].Descriptor()
+	// user.UsernameValidator is a validator for the "username" field. It is called by the builders before save.
+	user.UsernameValidator = userDescUsername.Validators[0].(func(string) error)
+	// userDescCreatedAt is the schema descriptor for created_at field.
+	userDescCreatedAt := userFields[5].Descriptor()
+	// user.DefaultCreatedAt holds the default value on creation for the created_at field.
+	user.DefaultCreatedAt = userDescCreatedAt.Default.(func() time.Time)
+	// userDescID is the schema descriptor for id field.
+	userDescID := userFields[0].Descriptor()
+	// user.DefaultID holds the default value on creation for the id field.
+	user.DefaultID = userDescID.Default.(func() uuid.UUID)
+	webhookFields := schema.Webhook{}.Fields()
+	_ = webhookFields
+	// webhookDescURL is the schema descriptor for url field.
+	webhookDescURL := webhookFields[1].Descriptor()
+	// webhook.URLValidator is a validator for the "url" field. It is called by the builders before save.
+	webhook.URLValidator = webhookDescURL.Validators[0].(func(string) error)
+	// webhookDescSecret is the schema descriptor for secret field.
+	webhookDescSecret := webhookFields[3].Descriptor()
+	// webhook.SecretValidator is a validator for the "secret" field. It is called by the builders before save.
+	webhook.SecretValidator = webhookDescSecret.Validators[0].(func(string) error)
+	// webhookDescActive is the schema descriptor for active field.
+	webhookDescActive := webhookFields[4].Descriptor()
+	// webhook.DefaultActive holds the default value on creation for the active field.
+	webhook.DefaultActive = webhookDescActive.Default.(bool)
+	// webhookDescCreatedAt is the schema descriptor for created_at field.
+	webhookDescCreatedAt := webhookFields[6].Descriptor()
+	// webhook.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhook.DefaultCreatedAt = webhookDescCreatedAt.Default.(func() time.Time)
+	// webhookDescUpdatedAt is the schema descriptor for updated_at field.
+	webhookDescUpdatedAt := webhookFields[7].Descriptor()
+	// webhook.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	webhook.DefaultUpdatedAt = webhookDescUpdatedAt.Default.(func() time.Time)
+	// webhook.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	webhook.UpdateDefaultUpdatedAt = webhookDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// webhookDescID is the schema descriptor for id field.
+	webhookDescID := webhookFields[0].Descriptor()
+	// webhook.DefaultID holds the default value on creation for the id field.
+	webhook.DefaultID = webhookDescID.Default.(func() uuid.UUID)
+	webhookdeliveryFields := schema.WebhookDelivery{}.Fields()
+	_ = webhookdeliveryFields
+	// webhookdeliveryDescAttemptCount is the schema descriptor for attempt_count field.
+	webhookdeliveryDescAttemptCount := webhookdeliveryFields[6].Descriptor()
+	// webhookdelivery.DefaultAttemptCount holds the default value on creation for the attempt_count field.
+	webhookdelivery.DefaultAttemptCount = webhookdeliveryDescAttemptCount.Default.(int)
+	// webhookdeliveryDescCreatedAt is the schema descriptor for created_at field.
+	webhookdeliveryDescCreatedAt := webhookdeliveryFields[10].Descriptor()
+	// webhookdelivery.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhookdelivery.DefaultCreatedAt = webhookdeliveryDescCreatedAt.Default.(func() time.Time)
+	// webhookdeliveryDescUpdatedAt is the schema descriptor for updated_at field.
+	webhookdeliveryDescUpdatedAt := webhookdeliveryFields[11].Descriptor()
+	// webhookdelivery.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	webhookdelivery.DefaultUpdatedAt = webhookdeliveryDescUpdatedAt.Default.(func() time.Time)
+	// webhookdelivery.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	webhookdelivery.UpdateDefaultUpdatedAt = webhookdeliveryDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// webhookdeliveryDescID is the schema descriptor for id field.
+	webhookdeliveryDescID := webhookdeliveryFields[0].Descriptor()
+	// webhookdelivery.DefaultID holds the default value on creation for the id field.
+	webhookdelivery.DefaultID = webhookdeliveryDescID.Default.(func() uuid.UUID)
+	workflowFields := schema.Workflow{}.Fields()
+	_ = workflowFields
+	// workflowDescName is the schema descriptor for name field.
+	workflowDescName := workflowFields[1].Descriptor()
+	// workflow.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	workflow.NameValidator = workflowDescName.Validators[0].(func(string) error)
+	// workflowDescIsDefault is the schema descriptor for is_default field.
+	workflowDescIsDefault := workflowFields[2].Descriptor()
+	// workflow.DefaultIsDefault holds the default value on creation for the is_default field.
+	workflow.DefaultIsDefault = workflowDescIsDefault.Default.(bool)
+	// workflowDescCreatedAt is the schema descriptor for created_at field.
+	workflowDescCreatedAt := workflowFields[3].Descriptor()
+	// workflow.DefaultCreatedAt holds the default value on creation for the created_at field.
+	workflow.DefaultCreatedAt = workflowDescCreatedAt.Default.(func() time.Time)
+	// workflowDescID is the schema descriptor for id field.
+	workflowDescID := workflowFields[0].Descriptor()
+	// workflow.DefaultID holds the default value on creation for the id field.
+	workflow.DefaultID = workflowDescID.Default.(func() uuid.UUID)
+	workflowstatusFields := schema.WorkflowStatus{}.Fields()
+	_ = workflowstatusFields
+	// workflowstatusDescKey is the schema descriptor for key field.
+	workflowstatusDescKey := workflowstatusFields[2].Descriptor()
+	// workflowstatus.KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	workflowstatus.KeyValidator = workflowstatusDescKey.Validators[0].(func(string) error)
+	// workflowstatusDescName is the schema descriptor for name field.
+	workflowstatusDescName := workflowstatusFields[3].Descriptor()
+	// workflowstatus.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	workflowstatus.NameValidator = workflowstatusDescName.Validators[0].(func(string) error)
+	// workflowstatusDescPosition is the schema descriptor for position field.
+	workflowstatusDescPosition := workflowstatusFields[5].Descriptor()
+	// workflowstatus.DefaultPosition holds the default value on creation for the position field.
+	workflowstatus.DefaultPosition = workflowstatusDescPosition.Default.(int)
+	// workflowstatusDescID is the schema descriptor for id field.
+	workflowstatusDescID := workflowstatusFields[0].Descriptor()
+	// workflowstatus.DefaultID holds the default value on creation for the id field.
+	workflowstatus.DefaultID = workflowstatusDescID.Default.(func() uuid.UUID)
 }
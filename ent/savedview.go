@@ -0,0 +1,221 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/ent/savedview"
+	"github.com/localopsco/go-sample/ent/user"
+	"github.com/localopsco/go-sample/models"
+)
+
+// SavedView is the model entity for the SavedView schema.
+type SavedView struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Query holds the value of the "query" field.
+	Query string `json:"query,omitempty"`
+	// Filters holds the value of the "filters" field.
+	Filters models.ViewFilters `json:"filters,omitempty"`
+	// Sort holds the value of the "sort" field.
+	Sort string `json:"sort,omitempty"`
+	// Visibility holds the value of the "visibility" field.
+	Visibility savedview.Visibility `json:"visibility,omitempty"`
+	// OwnerID holds the value of the "owner_id" field.
+	OwnerID uuid.UUID `json:"owner_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SavedViewQuery when eager-loading is set.
+	Edges        SavedViewEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// SavedViewEdges holds the relations/edges for other nodes in the graph.
+type SavedViewEdges struct {
+	// Owner holds the value of the owner edge.
+	Owner *User `json:"owner,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+	// totalCount holds the count of the edges above.
+	totalCount [1]map[string]int
+}
+
+// OwnerOrErr returns the Owner value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SavedViewEdges) OwnerOrErr() (*User, error) {
+	if e.Owner != nil {
+		return e.Owner, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "owner"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SavedView) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case savedview.FieldFilters:
+			values[i] = new([]byte)
+		case savedview.FieldName, savedview.FieldQuery, savedview.FieldSort, savedview.FieldVisibility:
+			values[i] = new(sql.NullString)
+		case savedview.FieldCreatedAt, savedview.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case savedview.FieldID, savedview.FieldOwnerID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SavedView fields.
+func (sv *SavedView) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case savedview.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				sv.ID = *value
+			}
+		case savedview.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				sv.Name = value.String
+			}
+		case savedview.FieldQuery:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field query", values[i])
+			} else if value.Valid {
+				sv.Query = value.String
+			}
+		case savedview.FieldFilters:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field filters", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &sv.Filters); err != nil {
+					return fmt.Errorf("unmarshal field filters: %w", err)
+				}
+			}
+		case savedview.FieldSort:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field sort", values[i])
+			} else if value.Valid {
+				sv.Sort = value.String
+			}
+		case savedview.FieldVisibility:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field visibility", values[i])
+			} else if value.Valid {
+				sv.Visibility = savedview.Visibility(value.String)
+			}
+		case savedview.FieldOwnerID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field owner_id", values[i])
+			} else if value != nil {
+				sv.OwnerID = *value
+			}
+		case savedview.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				sv.CreatedAt = value.Time
+			}
+		case savedview.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				sv.UpdatedAt = value.Time
+			}
+		default:
+			sv.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SavedView.
+// This includes values selected through modifiers, order, etc.
+func (sv *SavedView) Value(name string) (ent.Value, error) {
+	return sv.selectValues.Get(name)
+}
+
+// QueryOwner queries the "owner" edge of the SavedView entity.
+func (sv *SavedView) QueryOwner() *UserQuery {
+	return NewSavedViewClient(sv.config).QueryOwner(sv)
+}
+
+// Update returns a builder for updating this SavedView.
+// Note that you need to call SavedView.Unwrap() before calling this method if this SavedView
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (sv *SavedView) Update() *SavedViewUpdateOne {
+	return NewSavedViewClient(sv.config).UpdateOne(sv)
+}
+
+// Unwrap unwraps the SavedView entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (sv *SavedView) Unwrap() *SavedView {
+	_tx, ok := sv.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SavedView is not a transactional entity")
+	}
+	sv.config.driver = _tx.drv
+	return sv
+}
+
+// String implements the fmt.Stringer.
+func (sv *SavedView) String() string {
+	var builder strings.Builder
+	builder.WriteString("SavedView(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", sv.ID))
+	builder.WriteString("name=")
+	builder.WriteString(sv.Name)
+	builder.WriteString(", ")
+	builder.WriteString("query=")
+	builder.WriteString(sv.Query)
+	builder.WriteString(", ")
+	builder.WriteString("filters=")
+	builder.WriteString(fmt.Sprintf("%v", sv.Filters))
+	builder.WriteString(", ")
+	builder.WriteString("sort=")
+	builder.WriteString(sv.Sort)
+	builder.WriteString(", ")
+	builder.WriteString("visibility=")
+	builder.WriteString(fmt.Sprintf("%v", sv.Visibility))
+	builder.WriteString(", ")
+	builder.WriteString("owner_id=")
+	builder.WriteString(fmt.Sprintf("%v", sv.OwnerID))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(sv.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(sv.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SavedViews is a parsable slice of SavedView.
+type SavedViews []*SavedView
@@ -0,0 +1,389 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/ent/savedview"
+	"github.com/localopsco/go-sample/ent/user"
+	"github.com/localopsco/go-sample/models"
+)
+
+// SavedViewCreate is the builder for creating a SavedView entity.
+type SavedViewCreate struct {
+	config
+	mutation *SavedViewMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (svc *SavedViewCreate) SetName(s string) *SavedViewCreate {
+	svc.mutation.SetName(s)
+	return svc
+}
+
+// SetQuery sets the "query" field.
+func (svc *SavedViewCreate) SetQuery(s string) *SavedViewCreate {
+	svc.mutation.SetQuery(s)
+	return svc
+}
+
+// SetNillableQuery sets the "query" field if the given value is not nil.
+func (svc *SavedViewCreate) SetNillableQuery(s *string) *SavedViewCreate {
+	if s != nil {
+		svc.SetQuery(*s)
+	}
+	return svc
+}
+
+// SetFilters sets the "filters" field.
+func (svc *SavedViewCreate) SetFilters(mf models.ViewFilters) *SavedViewCreate {
+	svc.mutation.SetFilters(mf)
+	return svc
+}
+
+// SetNillableFilters sets the "filters" field if the given value is not nil.
+func (svc *SavedViewCreate) SetNillableFilters(mf *models.ViewFilters) *SavedViewCreate {
+	if mf != nil {
+		svc.SetFilters(*mf)
+	}
+	return svc
+}
+
+// SetSort sets the "sort" field.
+func (svc *SavedViewCreate) SetSort(s string) *SavedViewCreate {
+	svc.mutation.SetSort(s)
+	return svc
+}
+
+// SetNillableSort sets the "sort" field if the given value is not nil.
+func (svc *SavedViewCreate) SetNillableSort(s *string) *SavedViewCreate {
+	if s != nil {
+		svc.SetSort(*s)
+	}
+	return svc
+}
+
+// SetVisibility sets the "visibility" field.
+func (svc *SavedViewCreate) SetVisibility(s savedview.Visibility) *SavedViewCreate {
+	svc.mutation.SetVisibility(s)
+	return svc
+}
+
+// SetNillableVisibility sets the "visibility" field if the given value is not nil.
+func (svc *SavedViewCreate) SetNillableVisibility(s *savedview.Visibility) *SavedViewCreate {
+	if s != nil {
+		svc.SetVisibility(*s)
+	}
+	return svc
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (svc *SavedViewCreate) SetOwnerID(u uuid.UUID) *SavedViewCreate {
+	svc.mutation.SetOwnerID(u)
+	return svc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (svc *SavedViewCreate) SetCreatedAt(t time.Time) *SavedViewCreate {
+	svc.mutation.SetCreatedAt(t)
+	return svc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (svc *SavedViewCreate) SetNillableCreatedAt(t *time.Time) *SavedViewCreate {
+	if t != nil {
+		svc.SetCreatedAt(*t)
+	}
+	return svc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (svc *SavedViewCreate) SetUpdatedAt(t time.Time) *SavedViewCreate {
+	svc.mutation.SetUpdatedAt(t)
+	return svc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (svc *SavedViewCreate) SetNillableUpdatedAt(t *time.Time) *SavedViewCreate {
+	if t != nil {
+		svc.SetUpdatedAt(*t)
+	}
+	return svc
+}
+
+// SetID sets the "id" field.
+func (svc *SavedViewCreate) SetID(u uuid.UUID) *SavedViewCreate {
+	svc.mutation.SetID(u)
+	return svc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (svc *SavedViewCreate) SetNillableID(u *uuid.UUID) *SavedViewCreate {
+	if u != nil {
+		svc.SetID(*u)
+	}
+	return svc
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (svc *SavedViewCreate) SetOwner(u *User) *SavedViewCreate {
+	return svc.SetOwnerID(u.ID)
+}
+
+// Mutation returns the SavedViewMutation object of the builder.
+func (svc *SavedViewCreate) Mutation() *SavedViewMutation {
+	return svc.mutation
+}
+
+// Save creates the SavedView in the database.
+func (svc *SavedViewCreate) Save(ctx context.Context) (*SavedView, error) {
+	svc.defaults()
+	return withHooks(ctx, svc.sqlSave, svc.mutation, svc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (svc *SavedViewCreate) SaveX(ctx context.Context) *SavedView {
+	v, err := svc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (svc *SavedViewCreate) Exec(ctx context.Context) error {
+	_, err := svc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (svc *SavedViewCreate) ExecX(ctx context.Context) {
+	if err := svc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (svc *SavedViewCreate) defaults() {
+	if _, ok := svc.mutation.Visibility(); !ok {
+		v := savedview.DefaultVisibility
+		svc.mutation.SetVisibility(v)
+	}
+	if _, ok := svc.mutation.CreatedAt(); !ok {
+		v := savedview.DefaultCreatedAt()
+		svc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := svc.mutation.UpdatedAt(); !ok {
+		v := savedview.DefaultUpdatedAt()
+		svc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := svc.mutation.ID(); !ok {
+		v := savedview.DefaultID()
+		svc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (svc *SavedViewCreate) check() error {
+	if _, ok := svc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "SavedView.name"`)}
+	}
+	if v, ok := svc.mutation.Name(); ok {
+		if err := savedview.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "SavedView.name": %w`, err)}
+		}
+	}
+	if _, ok := svc.mutation.Visibility(); !ok {
+		return &ValidationError{Name: "visibility", err: errors.New(`ent: missing required field "SavedView.visibility"`)}
+	}
+	if v, ok := svc.mutation.Visibility(); ok {
+		if err := savedview.VisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "visibility", err: fmt.Errorf(`ent: validator failed for field "SavedView.visibility": %w`, err)}
+		}
+	}
+	if _, ok := svc.mutation.OwnerID(); !ok {
+		return &ValidationError{Name: "owner_id", err: errors.New(`ent: missing required field "SavedView.owner_id"`)}
+	}
+	if _, ok := svc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "SavedView.created_at"`)}
+	}
+	if _, ok := svc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "SavedView.updated_at"`)}
+	}
+	if len(svc.mutation.OwnerIDs()) == 0 {
+		return &ValidationError{Name: "owner", err: errors.New(`ent: missing required edge "SavedView.owner"`)}
+	}
+	return nil
+}
+
+func (svc *SavedViewCreate) sqlSave(ctx context.Context) (*SavedView, error) {
+	if err := svc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := svc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, svc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	svc.mutation.id = &_node.ID
+	svc.mutation.done = true
+	return _node, nil
+}
+
+func (svc *SavedViewCreate) createSpec() (*SavedView, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SavedView{config: svc.config}
+		_spec = sqlgraph.NewCreateSpec(savedview.Table, sqlgraph.NewFieldSpec(savedview.FieldID, field.TypeUUID))
+	)
+	if id, ok := svc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := svc.mutation.Name(); ok {
+		_spec.SetField(savedview.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := svc.mutation.Query(); ok {
+		_spec.SetField(savedview.FieldQuery, field.TypeString, value)
+		_node.Query = value
+	}
+	if value, ok := svc.mutation.Filters(); ok {
+		_spec.SetField(savedview.FieldFilters, field.TypeJSON, value)
+		_node.Filters = value
+	}
+	if value, ok := svc.mutation.Sort(); ok {
+		_spec.SetField(savedview.FieldSort, field.TypeString, value)
+		_node.Sort = value
+	}
+	if value, ok := svc.mutation.Visibility(); ok {
+		_spec.SetField(savedview.FieldVisibility, field.TypeEnum, value)
+		_node.Visibility = value
+	}
+	if value, ok := svc.mutation.CreatedAt(); ok {
+		_spec.SetField(savedview.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := svc.mutation.UpdatedAt(); ok {
+		_spec.SetField(savedview.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := svc.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   savedview.OwnerTable,
+			Columns: []string{savedview.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.OwnerID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// SavedViewCreateBulk is the builder for creating many SavedView entities in bulk.
+type SavedViewCreateBulk struct {
+	config
+	err      error
+	builders []*SavedViewCreate
+}
+
+// Save creates the SavedView entities in the database.
+func (svcb *SavedViewCreateBulk) Save(ctx context.Context) ([]*SavedView, error) {
+	if svcb.err != nil {
+		return nil, svcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(svcb.builders))
+	nodes := make([]*SavedView, len(svcb.builders))
+	mutators := make([]Mutator, len(svcb.builders))
+	for i := range svcb.builders {
+		func(i int, root context.Context) {
+			builder := svcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SavedViewMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, svcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, svcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, svcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (svcb *SavedViewCreateBulk) SaveX(ctx context.Context) []*SavedView {
+	v, err := svcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (svcb *SavedViewCreateBulk) Exec(ctx context.Context) error {
+	_, err := svcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (svcb *SavedViewCreateBulk) ExecX(ctx context.Context) {
+	if err := svcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
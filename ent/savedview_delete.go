@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/localopsco/go-sample/ent/predicate"
+	"github.com/localopsco/go-sample/ent/savedview"
+)
+
+// SavedViewDelete is the builder for deleting a SavedView entity.
+type SavedViewDelete struct {
+	config
+	hooks    []Hook
+	mutation *SavedViewMutation
+}
+
+// Where appends a list predicates to the SavedViewDelete builder.
+func (svd *SavedViewDelete) Where(ps ...predicate.SavedView) *SavedViewDelete {
+	svd.mutation.Where(ps...)
+	return svd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (svd *SavedViewDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, svd.sqlExec, svd.mutation, svd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (svd *SavedViewDelete) ExecX(ctx context.Context) int {
+	n, err := svd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (svd *SavedViewDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(savedview.Table, sqlgraph.NewFieldSpec(savedview.FieldID, field.TypeUUID))
+	if ps := svd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, svd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	svd.mutation.done = true
+	return affected, err
+}
+
+// SavedViewDeleteOne is the builder for deleting a single SavedView entity.
+type SavedViewDeleteOne struct {
+	svd *SavedViewDelete
+}
+
+// Where appends a list predicates to the SavedViewDelete builder.
+func (svdo *SavedViewDeleteOne) Where(ps ...predicate.SavedView) *SavedViewDeleteOne {
+	svdo.svd.mutation.Where(ps...)
+	return svdo
+}
+
+// Exec executes the deletion query.
+func (svdo *SavedViewDeleteOne) Exec(ctx context.Context) error {
+	n, err := svdo.svd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{savedview.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (svdo *SavedViewDeleteOne) ExecX(ctx context.Context) {
+	if err := svdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,639 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/ent/predicate"
+	"github.com/localopsco/go-sample/ent/savedview"
+	"github.com/localopsco/go-sample/ent/user"
+	"github.com/localopsco/go-sample/models"
+)
+
+// SavedViewUpdate is the builder for updating SavedView entities.
+type SavedViewUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *SavedViewMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the SavedViewUpdate builder.
+func (svu *SavedViewUpdate) Where(ps ...predicate.SavedView) *SavedViewUpdate {
+	svu.mutation.Where(ps...)
+	return svu
+}
+
+// SetName sets the "name" field.
+func (svu *SavedViewUpdate) SetName(s string) *SavedViewUpdate {
+	svu.mutation.SetName(s)
+	return svu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (svu *SavedViewUpdate) SetNillableName(s *string) *SavedViewUpdate {
+	if s != nil {
+		svu.SetName(*s)
+	}
+	return svu
+}
+
+// SetQuery sets the "query" field.
+func (svu *SavedViewUpdate) SetQuery(s string) *SavedViewUpdate {
+	svu.mutation.SetQuery(s)
+	return svu
+}
+
+// SetNillableQuery sets the "query" field if the given value is not nil.
+func (svu *SavedViewUpdate) SetNillableQuery(s *string) *SavedViewUpdate {
+	if s != nil {
+		svu.SetQuery(*s)
+	}
+	return svu
+}
+
+// ClearQuery clears the value of the "query" field.
+func (svu *SavedViewUpdate) ClearQuery() *SavedViewUpdate {
+	svu.mutation.ClearQuery()
+	return svu
+}
+
+// SetFilters sets the "filters" field.
+func (svu *SavedViewUpdate) SetFilters(mf models.ViewFilters) *SavedViewUpdate {
+	svu.mutation.SetFilters(mf)
+	return svu
+}
+
+// SetNillableFilters sets the "filters" field if the given value is not nil.
+func (svu *SavedViewUpdate) SetNillableFilters(mf *models.ViewFilters) *SavedViewUpdate {
+	if mf != nil {
+		svu.SetFilters(*mf)
+	}
+	return svu
+}
+
+// ClearFilters clears the value of the "filters" field.
+func (svu *SavedViewUpdate) ClearFilters() *SavedViewUpdate {
+	svu.mutation.ClearFilters()
+	return svu
+}
+
+// SetSort sets the "sort" field.
+func (svu *SavedViewUpdate) SetSort(s string) *SavedViewUpdate {
+	svu.mutation.SetSort(s)
+	return svu
+}
+
+// SetNillableSort sets the "sort" field if the given value is not nil.
+func (svu *SavedViewUpdate) SetNillableSort(s *string) *SavedViewUpdate {
+	if s != nil {
+		svu.SetSort(*s)
+	}
+	return svu
+}
+
+// ClearSort clears the value of the "sort" field.
+func (svu *SavedViewUpdate) ClearSort() *SavedViewUpdate {
+	svu.mutation.ClearSort()
+	return svu
+}
+
+// SetVisibility sets the "visibility" field.
+func (svu *SavedViewUpdate) SetVisibility(s savedview.Visibility) *SavedViewUpdate {
+	svu.mutation.SetVisibility(s)
+	return svu
+}
+
+// SetNillableVisibility sets the "visibility" field if the given value is not nil.
+func (svu *SavedViewUpdate) SetNillableVisibility(s *savedview.Visibility) *SavedViewUpdate {
+	if s != nil {
+		svu.SetVisibility(*s)
+	}
+	return svu
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (svu *SavedViewUpdate) SetOwnerID(u uuid.UUID) *SavedViewUpdate {
+	svu.mutation.SetOwnerID(u)
+	return svu
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (svu *SavedViewUpdate) SetNillableOwnerID(u *uuid.UUID) *SavedViewUpdate {
+	if u != nil {
+		svu.SetOwnerID(*u)
+	}
+	return svu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (svu *SavedViewUpdate) SetCreatedAt(t time.Time) *SavedViewUpdate {
+	svu.mutation.SetCreatedAt(t)
+	return svu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (svu *SavedViewUpdate) SetNillableCreatedAt(t *time.Time) *SavedViewUpdate {
+	if t != nil {
+		svu.SetCreatedAt(*t)
+	}
+	return svu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (svu *SavedViewUpdate) SetUpdatedAt(t time.Time) *SavedViewUpdate {
+	svu.mutation.SetUpdatedAt(t)
+	return svu
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (svu *SavedViewUpdate) SetOwner(u *User) *SavedViewUpdate {
+	return svu.SetOwnerID(u.ID)
+}
+
+// Mutation returns the SavedViewMutation object of the builder.
+func (svu *SavedViewUpdate) Mutation() *SavedViewMutation {
+	return svu.mutation
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (svu *SavedViewUpdate) ClearOwner() *SavedViewUpdate {
+	svu.mutation.ClearOwner()
+	return svu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (svu *SavedViewUpdate) Save(ctx context.Context) (int, error) {
+	svu.defaults()
+	return withHooks(ctx, svu.sqlSave, svu.mutation, svu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (svu *SavedViewUpdate) SaveX(ctx context.Context) int {
+	affected, err := svu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (svu *SavedViewUpdate) Exec(ctx context.Context) error {
+	_, err := svu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (svu *SavedViewUpdate) ExecX(ctx context.Context) {
+	if err := svu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (svu *SavedViewUpdate) defaults() {
+	if _, ok := svu.mutation.UpdatedAt(); !ok {
+		v := savedview.UpdateDefaultUpdatedAt()
+		svu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (svu *SavedViewUpdate) check() error {
+	if v, ok := svu.mutation.Name(); ok {
+		if err := savedview.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "SavedView.name": %w`, err)}
+		}
+	}
+	if v, ok := svu.mutation.Visibility(); ok {
+		if err := savedview.VisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "visibility", err: fmt.Errorf(`ent: validator failed for field "SavedView.visibility": %w`, err)}
+		}
+	}
+	if svu.mutation.OwnerCleared() && len(svu.mutation.OwnerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SavedView.owner"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (svu *SavedViewUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SavedViewUpdate {
+	svu.modifiers = append(svu.modifiers, modifiers...)
+	return svu
+}
+
+func (svu *SavedViewUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := svu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(savedview.Table, savedview.Columns, sqlgraph.NewFieldSpec(savedview.FieldID, field.TypeUUID))
+	if ps := svu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := svu.mutation.Name(); ok {
+		_spec.SetField(savedview.FieldName, field.TypeString, value)
+	}
+	if value, ok := svu.mutation.Query(); ok {
+		_spec.SetField(savedview.FieldQuery, field.TypeString, value)
+	}
+	if svu.mutation.QueryCleared() {
+		_spec.ClearField(savedview.FieldQuery, field.TypeString)
+	}
+	if value, ok := svu.mutation.Filters(); ok {
+		_spec.SetField(savedview.FieldFilters, field.TypeJSON, value)
+	}
+	if svu.mutation.FiltersCleared() {
+		_spec.ClearField(savedview.FieldFilters, field.TypeJSON)
+	}
+	if value, ok := svu.mutation.Sort(); ok {
+		_spec.SetField(savedview.FieldSort, field.TypeString, value)
+	}
+	if svu.mutation.SortCleared() {
+		_spec.ClearField(savedview.FieldSort, field.TypeString)
+	}
+	if value, ok := svu.mutation.Visibility(); ok {
+		_spec.SetField(savedview.FieldVisibility, field.TypeEnum, value)
+	}
+	if value, ok := svu.mutation.CreatedAt(); ok {
+		_spec.SetField(savedview.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := svu.mutation.UpdatedAt(); ok {
+		_spec.SetField(savedview.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if svu.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   savedview.OwnerTable,
+			Columns: []string{savedview.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := svu.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   savedview.OwnerTable,
+			Columns: []string{savedview.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(svu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, svu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{savedview.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	svu.mutation.done = true
+	return n, nil
+}
+
+// SavedViewUpdateOne is the builder for updating a single SavedView entity.
+type SavedViewUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *SavedViewMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetName sets the "name" field.
+func (svuo *SavedViewUpdateOne) SetName(s string) *SavedViewUpdateOne {
+	svuo.mutation.SetName(s)
+	return svuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (svuo *SavedViewUpdateOne) SetNillableName(s *string) *SavedViewUpdateOne {
+	if s != nil {
+		svuo.SetName(*s)
+	}
+	return svuo
+}
+
+// SetQuery sets the "query" field.
+func (svuo *SavedViewUpdateOne) SetQuery(s string) *SavedViewUpdateOne {
+	svuo.mutation.SetQuery(s)
+	return svuo
+}
+
+// SetNillableQuery sets the "query" field if the given value is not nil.
+func (svuo *SavedViewUpdateOne) SetNillableQuery(s *string) *SavedViewUpdateOne {
+	if s != nil {
+		svuo.SetQuery(*s)
+	}
+	return svuo
+}
+
+// ClearQuery clears the value of the "query" field.
+func (svuo *SavedViewUpdateOne) ClearQuery() *SavedViewUpdateOne {
+	svuo.mutation.ClearQuery()
+	return svuo
+}
+
+// SetFilters sets the "filters" field.
+func (svuo *SavedViewUpdateOne) SetFilters(mf models.ViewFilters) *SavedViewUpdateOne {
+	svuo.mutation.SetFilters(mf)
+	return svuo
+}
+
+// SetNillableFilters sets the "filters" field if the given value is not nil.
+func (svuo *SavedViewUpdateOne) SetNillableFilters(mf *models.ViewFilters) *SavedViewUpdateOne {
+	if mf != nil {
+		svuo.SetFilters(*mf)
+	}
+	return svuo
+}
+
+// ClearFilters clears the value of the "filters" field.
+func (svuo *SavedViewUpdateOne) ClearFilters() *SavedViewUpdateOne {
+	svuo.mutation.ClearFilters()
+	return svuo
+}
+
+// SetSort sets the "sort" field.
+func (svuo *SavedViewUpdateOne) SetSort(s string) *SavedViewUpdateOne {
+	svuo.mutation.SetSort(s)
+	return svuo
+}
+
+// SetNillableSort sets the "sort" field if the given value is not nil.
+func (svuo *SavedViewUpdateOne) SetNillableSort(s *string) *SavedViewUpdateOne {
+	if s != nil {
+		svuo.SetSort(*s)
+	}
+	return svuo
+}
+
+// ClearSort clears the value of the "sort" field.
+func (svuo *SavedViewUpdateOne) ClearSort() *SavedViewUpdateOne {
+	svuo.mutation.ClearSort()
+	return svuo
+}
+
+// SetVisibility sets the "visibility" field.
+func (svuo *SavedViewUpdateOne) SetVisibility(s savedview.Visibility) *SavedViewUpdateOne {
+	svuo.mutation.SetVisibility(s)
+	return svuo
+}
+
+// SetNillableVisibility sets the "visibility" field if the given value is not nil.
+func (svuo *SavedViewUpdateOne) SetNillableVisibility(s *savedview.Visibility) *SavedViewUpdateOne {
+	if s != nil {
+		svuo.SetVisibility(*s)
+	}
+	return svuo
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (svuo *SavedViewUpdateOne) SetOwnerID(u uuid.UUID) *SavedViewUpdateOne {
+	svuo.mutation.SetOwnerID(u)
+	return svuo
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (svuo *SavedViewUpdateOne) SetNillableOwnerID(u *uuid.UUID) *SavedViewUpdateOne {
+	if u != nil {
+		svuo.SetOwnerID(*u)
+	}
+	return svuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (svuo *SavedViewUpdateOne) SetCreatedAt(t time.Time) *SavedViewUpdateOne {
+	svuo.mutation.SetCreatedAt(t)
+	return svuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (svuo *SavedViewUpdateOne) SetNillableCreatedAt(t *time.Time) *SavedViewUpdateOne {
+	if t != nil {
+		svuo.SetCreatedAt(*t)
+	}
+	return svuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (svuo *SavedViewUpdateOne) SetUpdatedAt(t time.Time) *SavedViewUpdateOne {
+	svuo.mutation.SetUpdatedAt(t)
+	return svuo
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (svuo *SavedViewUpdateOne) SetOwner(u *User) *SavedViewUpdateOne {
+	return svuo.SetOwnerID(u.ID)
+}
+
+// Mutation returns the SavedViewMutation object of the builder.
+func (svuo *SavedViewUpdateOne) Mutation() *SavedViewMutation {
+	return svuo.mutation
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (svuo *SavedViewUpdateOne) ClearOwner() *SavedViewUpdateOne {
+	svuo.mutation.ClearOwner()
+	return svuo
+}
+
+// Where appends a list predicates to the SavedViewUpdate builder.
+func (svuo *SavedViewUpdateOne) Where(ps ...predicate.SavedView) *SavedViewUpdateOne {
+	svuo.mutation.Where(ps...)
+	return svuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (svuo *SavedViewUpdateOne) Select(field string, fields ...string) *SavedViewUpdateOne {
+	svuo.fields = append([]string{field}, fields...)
+	return svuo
+}
+
+// Save executes the query and returns the updated SavedView entity.
+func (svuo *SavedViewUpdateOne) Save(ctx context.Context) (*SavedView, error) {
+	svuo.defaults()
+	return withHooks(ctx, svuo.sqlSave, svuo.mutation, svuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (svuo *SavedViewUpdateOne) SaveX(ctx context.Context) *SavedView {
+	node, err := svuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (svuo *SavedViewUpdateOne) Exec(ctx context.Context) error {
+	_, err := svuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (svuo *SavedViewUpdateOne) ExecX(ctx context.Context) {
+	if err := svuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (svuo *SavedViewUpdateOne) defaults() {
+	if _, ok := svuo.mutation.UpdatedAt(); !ok {
+		v := savedview.UpdateDefaultUpdatedAt()
+		svuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (svuo *SavedViewUpdateOne) check() error {
+	if v, ok := svuo.mutation.Name(); ok {
+		if err := savedview.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "SavedView.name": %w`, err)}
+		}
+	}
+	if v, ok := svuo.mutation.Visibility(); ok {
+		if err := savedview.VisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "visibility", err: fmt.Errorf(`ent: validator failed for field "SavedView.visibility": %w`, err)}
+		}
+	}
+	if svuo.mutation.OwnerCleared() && len(svuo.mutation.OwnerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SavedView.owner"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (svuo *SavedViewUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SavedViewUpdateOne {
+	svuo.modifiers = append(svuo.modifiers, modifiers...)
+	return svuo
+}
+
+func (svuo *SavedViewUpdateOne) sqlSave(ctx context.Context) (_node *SavedView, err error) {
+	if err := svuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(savedview.Table, savedview.Columns, sqlgraph.NewFieldSpec(savedview.FieldID, field.TypeUUID))
+	id, ok := svuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SavedView.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := svuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, savedview.FieldID)
+		for _, f := range fields {
+			if !savedview.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != savedview.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := svuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := svuo.mutation.Name(); ok {
+		_spec.SetField(savedview.FieldName, field.TypeString, value)
+	}
+	if value, ok := svuo.mutation.Query(); ok {
+		_spec.SetField(savedview.FieldQuery, field.TypeString, value)
+	}
+	if svuo.mutation.QueryCleared() {
+		_spec.ClearField(savedview.FieldQuery, field.TypeString)
+	}
+	if value, ok := svuo.mutation.Filters(); ok {
+		_spec.SetField(savedview.FieldFilters, field.TypeJSON, value)
+	}
+	if svuo.mutation.FiltersCleared() {
+		_spec.ClearField(savedview.FieldFilters, field.TypeJSON)
+	}
+	if value, ok := svuo.mutation.Sort(); ok {
+		_spec.SetField(savedview.FieldSort, field.TypeString, value)
+	}
+	if svuo.mutation.SortCleared() {
+		_spec.ClearField(savedview.FieldSort, field.TypeString)
+	}
+	if value, ok := svuo.mutation.Visibility(); ok {
+		_spec.SetField(savedview.FieldVisibility, field.TypeEnum, value)
+	}
+	if value, ok := svuo.mutation.CreatedAt(); ok {
+		_spec.SetField(savedview.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := svuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(savedview.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if svuo.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   savedview.OwnerTable,
+			Columns: []string{savedview.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := svuo.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   savedview.OwnerTable,
+			Columns: []string{savedview.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(svuo.modifiers...)
+	_node = &SavedView{config: svuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, svuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{savedview.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	svuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,29 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+)
+
+// ChangeSequence holds the schema definition for the ChangeSequence entity,
+// a named counter that is incremented inside the transactions it numbers.
+type ChangeSequence struct {
+	ent.Schema
+}
+
+// Annotations of the ChangeSequence.
+func (ChangeSequence) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Skip(),
+	}
+}
+
+// Fields of the ChangeSequence.
+func (ChangeSequence) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").Unique().Immutable(),
+		field.Int64("value").Default(0),
+	}
+}
@@ -0,0 +1,69 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Comment holds the schema definition for the Comment entity.
+type Comment struct {
+	ent.Schema
+}
+
+// Annotations of the Comment.
+func (Comment) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Skip(),
+	}
+}
+
+// Fields of the Comment.
+func (Comment) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.New()).Default(uuid.New),
+		field.UUID("task_id", uuid.UUID{}),
+		field.UUID("author_id", uuid.UUID{}),
+		field.UUID("parent_id", uuid.UUID{}).Optional().Nillable(),
+		field.Text("body"),
+		field.Text("body_html"),
+		field.Time("created_at").Default(time.Now),
+		field.Time("edited_at").Optional().Nillable(),
+	}
+}
+
+// Edges of the Comment.
+func (Comment) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("task", Task.Type).
+			Ref("comments").
+			Field("task_id").
+			Unique().
+			Required(),
+		edge.From("author", User.Type).
+			Ref("comments").
+			Field("author_id").
+			Unique().
+			Required(),
+		edge.To("replies", Comment.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)).
+			From("parent").
+			Field("parent_id").
+			Unique(),
+		edge.To("mentions", User.Type),
+	}
+}
+
+// Indexes of the Comment.
+func (Comment) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("task_id", "parent_id", "created_at"),
+	}
+}
@@ -0,0 +1,60 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/models"
+)
+
+// ExportJob holds the schema definition for the ExportJob entity: a task
+// export run in the background, whose result is kept for download.
+type ExportJob struct {
+	ent.Schema
+}
+
+// Annotations of the ExportJob.
+func (ExportJob) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Skip(),
+	}
+}
+
+// Fields of the ExportJob.
+func (ExportJob) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.New()).Default(uuid.New),
+		// user_id is the user who asked for the export. The job runs
+		// on their behalf and only they can download it.
+		field.UUID("user_id", uuid.UUID{}).Optional().Nillable().Immutable(),
+		field.Enum("format").Values("csv", "json", "ndjson").Immutable(),
+		field.JSON("columns", []string{}).Immutable(),
+		// filter is stored without its location, which is kept by name
+		// in timezone.
+		field.JSON("filter", models.TaskFilter{}).Immutable(),
+		field.String("timezone").Default("UTC").Immutable(),
+		field.Enum("status").
+			Values("pending", "running", "succeeded", "failed").
+			Default("pending"),
+		field.String("error").Optional(),
+		field.Int("rows").Default(0),
+		// result_key is the S3 object holding the result of a job that
+		// succeeded.
+		field.String("result_key").Optional(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("started_at").Optional().Nillable(),
+		field.Time("finished_at").Optional().Nillable(),
+	}
+}
+
+// Indexes of the ExportJob.
+func (ExportJob) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "created_at"),
+	}
+}
@@ -0,0 +1,63 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// IdempotencyKey holds the schema definition for the IdempotencyKey entity.
+// It records the first response to a request sent with an Idempotency-Key
+// header, so that retries of the request replay it.
+type IdempotencyKey struct {
+	ent.Schema
+}
+
+// Annotations of the IdempotencyKey.
+func (IdempotencyKey) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Skip(),
+	}
+}
+
+// Fields of the IdempotencyKey.
+func (IdempotencyKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.New()).Default(uuid.New),
+		field.String("key").
+			NotEmpty().
+			MaxLen(255),
+		// caller is the user ID, or the client address of anonymous
+		// requests, so that callers cannot replay each other's responses.
+		field.String("caller").
+			NotEmpty(),
+		// fingerprint is a hash of the method, path and payload.
+		field.String("fingerprint"),
+		// The response fields stay empty while the first request is in
+		// progress.
+		field.Int("response_status").
+			Optional().
+			Nillable(),
+		field.JSON("response_header", map[string][]string{}).
+			Optional(),
+		field.Bytes("response_body").
+			Optional(),
+		field.Time("created_at").
+			Default(time.Now),
+		field.Time("expires_at"),
+	}
+}
+
+// Indexes of the IdempotencyKey.
+func (IdempotencyKey) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("key", "caller").
+			Unique(),
+		index.Fields("expires_at"),
+	}
+}
@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// OutboxEvent holds the schema definition for the OutboxEvent entity. Task
+// events are written to the outbox in the transaction of the change they
+// describe and published afterwards by a relay; the auto-incremented id
+// orders the events of a task. Events that failed to publish are retried
+// from available_at on. relayed_seq numbers events in the order they were
+// published, which is what streams resume from.
+type OutboxEvent struct {
+	ent.Schema
+}
+
+// Annotations of the OutboxEvent.
+func (OutboxEvent) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "outbox"},
+		entgql.Skip(),
+	}
+}
+
+// Fields of the OutboxEvent.
+func (OutboxEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("event_id", uuid.UUID{}).Unique().Immutable(),
+		field.String("event_type").Immutable(),
+		field.UUID("task_id", uuid.UUID{}).Immutable(),
+		field.Bytes("payload").Immutable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("processed_at").Optional().Nillable(),
+		field.Int("relayed_seq").Optional().Nillable().Unique(),
+		field.Time("available_at").Default(time.Now),
+		field.Int("attempts").Default(0),
+		field.String("last_error").Optional(),
+	}
+}
+
+// Indexes of the OutboxEvent.
+func (OutboxEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("processed_at"),
+		index.Fields("task_id", "processed_at"),
+	}
+}
@@ -0,0 +1,62 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// Project holds the schema definition for the Project entity.
+type Project struct {
+	ent.Schema
+}
+
+// Annotations of the Project.
+func (Project) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.QueryField(),
+		entgql.RelayConnection(),
+	}
+}
+
+// Fields of the Project.
+func (Project) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.New()).Default(uuid.New),
+		field.String("name").NotEmpty().
+			Annotations(entgql.OrderField("NAME")),
+		field.String("description").Optional(),
+		field.UUID("workflow_id", uuid.UUID{}).
+			Annotations(entgql.Skip()),
+		// wip_limits caps the unfinished tasks of board columns, keyed by
+		// status key or, for boards grouped by label, by label.
+		field.JSON("wip_limits", map[string]int{}).
+			Optional().
+			Annotations(entgql.Skip()),
+		field.Enum("wip_policy").
+			Values("warn", "enforce").
+			Default("warn").
+			Annotations(entgql.Skip()),
+		field.Time("created_at").Default(time.Now).
+			Annotations(entgql.OrderField("CREATED_AT")),
+	}
+}
+
+// Edges of the Project.
+func (Project) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("workflow", Workflow.Type).
+			Ref("projects").
+			Field("workflow_id").
+			Unique().
+			Required().
+			Annotations(entgql.Skip()),
+		edge.To("tasks", Task.Type).
+			Annotations(entgql.RelayConnection()),
+	}
+}
@@ -0,0 +1,63 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/models"
+)
+
+// SavedView holds the schema definition for the SavedView entity, a named
+// task listing that its owner can keep to themselves or share.
+type SavedView struct {
+	ent.Schema
+}
+
+// Annotations of the SavedView.
+func (SavedView) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Skip(),
+	}
+}
+
+// Fields of the SavedView.
+func (SavedView) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.New()).Default(uuid.New),
+		field.String("name").NotEmpty(),
+		field.Text("query").Optional(),
+		field.JSON("filters", models.ViewFilters{}).Optional(),
+		field.String("sort").Optional(),
+		field.Enum("visibility").
+			Values("private", "shared").
+			Default("private"),
+		field.UUID("owner_id", uuid.UUID{}),
+		field.Time("created_at").Default(time.Now),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the SavedView.
+func (SavedView) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("owner", User.Type).
+			Ref("saved_views").
+			Field("owner_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the SavedView.
+func (SavedView) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("owner_id", "name").Unique(),
+		index.Fields("visibility"),
+	}
+}
@@ -3,8 +3,13 @@ package schema
 import (
 	"time"
 
+	"entgo.io/contrib/entgql"
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"github.com/google/uuid"
 )
 
@@ -13,19 +18,134 @@ type Task struct {
 	ent.Schema
 }
 
+// Annotations of the Task.
+func (Task) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.QueryField(),
+		entgql.RelayConnection(),
+	}
+}
+
 // Fields of the Task.
 func (Task) Fields() []ent.Field {
 	return []ent.Field{
 		field.UUID("id", uuid.New()).Default(uuid.New),
-		field.String("title"),
+		field.String("title").
+			Annotations(entgql.OrderField("TITLE")),
 		field.String("description").Optional(),
-		field.Bool("is_completed").Default(false),
-		field.String("attachment_url").Optional(),
-		field.Time("created_at").Default(time.Now),
+		field.UUID("status_id", uuid.UUID{}).Optional().
+			Annotations(entgql.Skip()),
+		field.Enum("status_category").
+			Values("todo", "in_progress", "done", "cancelled").
+			Default("todo"),
+		field.UUID("project_id", uuid.UUID{}).Optional().Nillable(),
+		// attachment_url is exposed in GraphQL by a resolver, which
+		// returns null for tasks without an attachment.
+		field.String("attachment_url").Optional().
+			Annotations(entgql.Skip()),
+		field.Time("created_at").Default(time.Now).
+			Annotations(entgql.OrderField("CREATED_AT")),
+		field.UUID("parent_id", uuid.UUID{}).Optional().Nillable(),
+		field.Int("position").Default(0).
+			Annotations(entgql.Skip()),
+		field.Time("start_at").Optional().Nillable().
+			Annotations(entgql.OrderField("START_AT")),
+		field.Time("due_at").Optional().Nillable().
+			Annotations(entgql.OrderField("DUE_AT")),
+		field.Enum("priority").
+			Values("none", "low", "medium", "high", "urgent").
+			Default("none"),
+		field.Int("estimate_minutes").Optional().Nillable().NonNegative(),
+		field.String("recurrence_rule").Optional().Nillable().
+			Annotations(entgql.Skip()),
+		field.String("recurrence_timezone").Optional().
+			Annotations(entgql.Skip()),
+		field.Time("recurrence_start").Optional().Nillable().
+			Annotations(entgql.Skip()),
+		field.Bool("recurrence_paused").Default(false).
+			Annotations(entgql.Skip()),
+		field.UUID("series_id", uuid.UUID{}).Optional().Nillable().
+			Annotations(entgql.Skip()),
+		// Labels are lower-case tags. A label of the form "group:value",
+		// such as "area:backend", belongs to the group before the colon.
+		field.Strings("labels").Optional(),
+		// rank orders tasks within their project and status; see
+		// datastore/rank.go.
+		// Tasks can be ordered by rank in GraphQL, but the rank itself
+		// is not exposed.
+		field.String("rank").Default("").
+			Annotations(
+				entgql.OrderField("RANK"),
+				entgql.Skip(entgql.SkipType, entgql.SkipWhereInput),
+			),
+		// completed_at is set when a task enters a closed status category
+		// and cleared when it is reopened.
+		field.Time("completed_at").Optional().Nillable().
+			Annotations(entgql.OrderField("COMPLETED_AT")),
+		// Soft-deleted tasks keep their row until purged. Tasks deleted
+		// together, such as a task and its subtasks, share a deleted_at.
+		field.Time("deleted_at").Optional().Nillable().
+			Annotations(entgql.Skip()),
+		// version counts the changes made to a task, and change_seq is
+		// the position of its latest change in the change sequence; see
+		// datastore/changes.go.
+		field.Int("version").Default(1),
+		field.Int64("change_seq").Default(0).
+			Annotations(entgql.Skip()),
+		// external_id identifies a task imported from another tool.
+		// import_key scopes it to the user who imported the task and the
+		// format it came from, so that importing the same data again does
+		// not duplicate it, while the same ID from another user or tool
+		// is a different task. Tasks imported before keys were scoped
+		// have only an external_id.
+		field.String("external_id").Optional().Nillable().Immutable().
+			Annotations(entgql.Skip()),
+		field.String("import_key").Optional().Nillable().Unique().Immutable().
+			Annotations(entgql.Skip()),
+		// ical_uid is the UID a CalDAV client gave a task it created, which
+		// the task keeps in iCalendar and in its resource name.
+		field.String("ical_uid").Optional().Nillable().Unique().Immutable().
+			Annotations(entgql.Skip()),
 	}
 }
 
 // Edges of the Task.
 func (Task) Edges() []ent.Edge {
-	return nil
+	return []ent.Edge{
+		edge.To("children", Task.Type).
+			From("parent").
+			Unique().
+			Field("parent_id"),
+		edge.To("blocks", Task.Type).
+			From("blocked_by"),
+		edge.From("status", WorkflowStatus.Type).
+			Ref("tasks").
+			Field("status_id").
+			Unique(),
+		edge.From("project", Project.Type).
+			Ref("tasks").
+			Field("project_id").
+			Unique(),
+		edge.To("comments", Comment.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade), entgql.Skip()),
+		edge.To("assignees", User.Type),
+		edge.To("watchers", User.Type),
+	}
+}
+
+// Indexes of the Task.
+func (Task) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("parent_id", "position"),
+		index.Fields("due_at"),
+		index.Fields("start_at"),
+		index.Fields("priority", "due_at"),
+		index.Fields("status_category"),
+		index.Fields("project_id", "status_id"),
+		index.Fields("deleted_at"),
+		index.Fields("completed_at"),
+		index.Fields("project_id", "status_id", "rank"),
+		index.Fields("change_seq", "id"),
+		index.Fields("external_id"),
+	}
 }
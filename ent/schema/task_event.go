@@ -0,0 +1,66 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/models"
+)
+
+// TaskEvent holds the schema definition for the TaskEvent entity. Task
+// events are written by an audit hook and never change afterwards; task_id
+// is deliberately not a foreign key so that events outlive their task.
+type TaskEvent struct {
+	ent.Schema
+}
+
+// Annotations of the TaskEvent.
+func (TaskEvent) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Skip(),
+	}
+}
+
+// Fields of the TaskEvent.
+func (TaskEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.New()).Default(uuid.New),
+		field.UUID("task_id", uuid.UUID{}).Immutable(),
+		field.String("task_title").Immutable(),
+		field.UUID("project_id", uuid.UUID{}).Optional().Nillable().Immutable(),
+		field.Enum("action").
+			Values("created", "updated", "deleted", "restored", "purged", "attachment_added", "attachment_removed").
+			Immutable(),
+		field.UUID("actor_id", uuid.UUID{}).Optional().Nillable().Immutable(),
+		field.JSON("changes", []models.FieldChange{}).Immutable(),
+		field.String("request_id").Optional().Immutable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the TaskEvent.
+func (TaskEvent) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("actor", User.Type).
+			Ref("task_events").
+			Field("actor_id").
+			Unique().
+			Immutable(),
+	}
+}
+
+// Indexes of the TaskEvent.
+func (TaskEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("task_id", "created_at"),
+		index.Fields("project_id", "created_at"),
+		index.Fields("actor_id", "created_at"),
+		index.Fields("created_at"),
+	}
+}
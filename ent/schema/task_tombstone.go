@@ -0,0 +1,42 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// TaskTombstone holds the schema definition for the TaskTombstone entity.
+// Tombstones record the tasks purged from the database, so that clients
+// syncing changes learn about them.
+type TaskTombstone struct {
+	ent.Schema
+}
+
+// Annotations of the TaskTombstone.
+func (TaskTombstone) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Skip(),
+	}
+}
+
+// Fields of the TaskTombstone.
+func (TaskTombstone) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("task_id", uuid.UUID{}).Immutable(),
+		field.Int64("change_seq").Immutable(),
+		field.Time("deleted_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Indexes of the TaskTombstone.
+func (TaskTombstone) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("change_seq", "task_id"),
+	}
+}
@@ -0,0 +1,56 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// User holds the schema definition for the User entity.
+type User struct {
+	ent.Schema
+}
+
+// Fields of the User.
+func (User) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.New()).Default(uuid.New),
+		field.String("username").NotEmpty().Unique(),
+		field.String("display_name").Optional(),
+		field.String("api_token_hash").Sensitive().Unique().
+			Annotations(entgql.Skip()),
+		// calendar_token_hash authenticates the user's calendar feed. It is
+		// separate from the API token since feed URLs end up in calendar
+		// apps, and is cleared when the feed is revoked.
+		field.String("calendar_token_hash").Optional().Nillable().Sensitive().Unique().
+			Annotations(entgql.Skip()),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+// Edges of the User.
+func (User) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("comments", Comment.Type).
+			Annotations(entgql.Skip()),
+		edge.From("mentioned_in", Comment.Type).
+			Ref("mentions").
+			Annotations(entgql.Skip()),
+		edge.From("assigned_tasks", Task.Type).
+			Ref("assignees").
+			Annotations(entgql.Skip()),
+		edge.From("watched_tasks", Task.Type).
+			Ref("watchers").
+			Annotations(entgql.Skip()),
+		edge.To("task_events", TaskEvent.Type).
+			Annotations(entgql.Skip()),
+		edge.To("saved_views", SavedView.Type).
+			Annotations(entgql.Skip()),
+		edge.To("webhooks", Webhook.Type).
+			Annotations(entgql.Skip()),
+	}
+}
@@ -0,0 +1,61 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// Webhook holds the schema definition for the Webhook entity. Webhooks
+// receive the task lifecycle events they subscribe to.
+type Webhook struct {
+	ent.Schema
+}
+
+// Annotations of the Webhook.
+func (Webhook) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Skip(),
+	}
+}
+
+// Fields of the Webhook.
+func (Webhook) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.New()).Default(uuid.New),
+		field.String("url").NotEmpty(),
+		field.Strings("event_types"),
+		// secret signs the payloads sent to the webhook.
+		field.String("secret").
+			NotEmpty().
+			Sensitive(),
+		field.Bool("active").Default(true),
+		// owner_id is the user who registered the webhook, the only one
+		// who can see and change it. Webhooks registered before owners
+		// were recorded have none, and are only managed in the database.
+		field.UUID("owner_id", uuid.UUID{}).Optional().Nillable().Immutable(),
+		field.Time("created_at").Default(time.Now),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Webhook.
+func (Webhook) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("deliveries", WebhookDelivery.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.From("owner", User.Type).
+			Ref("webhooks").
+			Field("owner_id").
+			Unique().
+			Immutable(),
+	}
+}
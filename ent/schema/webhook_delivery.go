@@ -0,0 +1,72 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/models"
+)
+
+// WebhookDelivery holds the schema definition for the WebhookDelivery
+// entity: one event to send to one webhook, with the log of its attempts.
+type WebhookDelivery struct {
+	ent.Schema
+}
+
+// Annotations of the WebhookDelivery.
+func (WebhookDelivery) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Skip(),
+	}
+}
+
+// Fields of the WebhookDelivery.
+func (WebhookDelivery) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.New()).Default(uuid.New),
+		field.UUID("webhook_id", uuid.UUID{}),
+		field.UUID("event_id", uuid.UUID{}),
+		field.String("event_type"),
+		// payload is sent as is on every attempt, so that retries and
+		// redeliveries carry the same body and signature input.
+		field.Bytes("payload"),
+		field.Enum("status").
+			Values("pending", "retrying", "succeeded", "dead").
+			Default("pending"),
+		field.Int("attempt_count").Default(0),
+		field.Time("next_attempt_at").Optional().Nillable(),
+		field.Int("last_status_code").Optional().Nillable(),
+		field.JSON("attempts", []models.DeliveryAttempt{}).Optional(),
+		field.Time("created_at").Default(time.Now),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the WebhookDelivery.
+func (WebhookDelivery) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("webhook", Webhook.Type).
+			Ref("deliveries").
+			Field("webhook_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the WebhookDelivery.
+func (WebhookDelivery) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("webhook_id", "created_at"),
+		index.Fields("status", "next_attempt_at"),
+		// An event that is relayed again must not be queued twice.
+		index.Fields("webhook_id", "event_id").Unique(),
+	}
+}
@@ -0,0 +1,85 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Workflow holds the schema definition for the Workflow entity.
+type Workflow struct {
+	ent.Schema
+}
+
+// Annotations of the Workflow.
+func (Workflow) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Skip(),
+	}
+}
+
+// Fields of the Workflow.
+func (Workflow) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.New()).Default(uuid.New),
+		field.String("name").NotEmpty(),
+		field.Bool("is_default").Default(false),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+// Edges of the Workflow.
+func (Workflow) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("statuses", WorkflowStatus.Type),
+		edge.To("projects", Project.Type),
+	}
+}
+
+// WorkflowStatus holds the schema definition for the WorkflowStatus entity.
+type WorkflowStatus struct {
+	ent.Schema
+}
+
+// Fields of the WorkflowStatus.
+func (WorkflowStatus) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.New()).Default(uuid.New),
+		field.UUID("workflow_id", uuid.UUID{}).
+			Annotations(entgql.Skip()),
+		field.String("key").NotEmpty(),
+		field.String("name").NotEmpty(),
+		field.Enum("category").Values("todo", "in_progress", "done", "cancelled"),
+		field.Int("position").Default(0).
+			Annotations(entgql.Skip()),
+	}
+}
+
+// Edges of the WorkflowStatus.
+func (WorkflowStatus) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("workflow", Workflow.Type).
+			Ref("statuses").
+			Field("workflow_id").
+			Unique().
+			Required().
+			Annotations(entgql.Skip()),
+		edge.To("transitions", WorkflowStatus.Type).
+			Annotations(entgql.Skip()),
+		edge.To("tasks", Task.Type).
+			Annotations(entgql.Skip()),
+	}
+}
+
+// Indexes of the WorkflowStatus.
+func (WorkflowStatus) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("workflow_id", "key").Unique(),
+	}
+}
@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -10,7 +11,9 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/ent/project"
 	"github.com/localopsco/go-sample/ent/task"
+	"github.com/localopsco/go-sample/ent/workflowstatus"
 )
 
 // Task is the model entity for the Task schema.
@@ -22,27 +25,201 @@ type Task struct {
 	Title string `json:"title,omitempty"`
 	// Description holds the value of the "description" field.
 	Description string `json:"description,omitempty"`
-	// IsCompleted holds the value of the "is_completed" field.
-	IsCompleted bool `json:"is_completed,omitempty"`
+	// StatusID holds the value of the "status_id" field.
+	StatusID uuid.UUID `json:"status_id,omitempty"`
+	// StatusCategory holds the value of the "status_category" field.
+	StatusCategory task.StatusCategory `json:"status_category,omitempty"`
+	// ProjectID holds the value of the "project_id" field.
+	ProjectID *uuid.UUID `json:"project_id,omitempty"`
 	// AttachmentURL holds the value of the "attachment_url" field.
 	AttachmentURL string `json:"attachment_url,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
-	CreatedAt    time.Time `json:"created_at,omitempty"`
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// ParentID holds the value of the "parent_id" field.
+	ParentID *uuid.UUID `json:"parent_id,omitempty"`
+	// Position holds the value of the "position" field.
+	Position int `json:"position,omitempty"`
+	// StartAt holds the value of the "start_at" field.
+	StartAt *time.Time `json:"start_at,omitempty"`
+	// DueAt holds the value of the "due_at" field.
+	DueAt *time.Time `json:"due_at,omitempty"`
+	// Priority holds the value of the "priority" field.
+	Priority task.Priority `json:"priority,omitempty"`
+	// EstimateMinutes holds the value of the "estimate_minutes" field.
+	EstimateMinutes *int `json:"estimate_minutes,omitempty"`
+	// RecurrenceRule holds the value of the "recurrence_rule" field.
+	RecurrenceRule *string `json:"recurrence_rule,omitempty"`
+	// RecurrenceTimezone holds the value of the "recurrence_timezone" field.
+	RecurrenceTimezone string `json:"recurrence_timezone,omitempty"`
+	// RecurrenceStart holds the value of the "recurrence_start" field.
+	RecurrenceStart *time.Time `json:"recurrence_start,omitempty"`
+	// RecurrencePaused holds the value of the "recurrence_paused" field.
+	RecurrencePaused bool `json:"recurrence_paused,omitempty"`
+	// SeriesID holds the value of the "series_id" field.
+	SeriesID *uuid.UUID `json:"series_id,omitempty"`
+	// Labels holds the value of the "labels" field.
+	Labels []string `json:"labels,omitempty"`
+	// Rank holds the value of the "rank" field.
+	Rank string `json:"rank,omitempty"`
+	// CompletedAt holds the value of the "completed_at" field.
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// Version holds the value of the "version" field.
+	Version int `json:"version,omitempty"`
+	// ChangeSeq holds the value of the "change_seq" field.
+	ChangeSeq int64 `json:"change_seq,omitempty"`
+	// ExternalID holds the value of the "external_id" field.
+	ExternalID *string `json:"external_id,omitempty"`
+	// ImportKey holds the value of the "import_key" field.
+	ImportKey *string `json:"import_key,omitempty"`
+	// IcalUID holds the value of the "ical_uid" field.
+	IcalUID *string `json:"ical_uid,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TaskQuery when eager-loading is set.
+	Edges        TaskEdges `json:"edges"`
 	selectValues sql.SelectValues
 }
 
+// TaskEdges holds the relations/edges for other nodes in the graph.
+type TaskEdges struct {
+	// Parent holds the value of the parent edge.
+	Parent *Task `json:"parent,omitempty"`
+	// Children holds the value of the children edge.
+	Children []*Task `json:"children,omitempty"`
+	// BlockedBy holds the value of the blocked_by edge.
+	BlockedBy []*Task `json:"blocked_by,omitempty"`
+	// Blocks holds the value of the blocks edge.
+	Blocks []*Task `json:"blocks,omitempty"`
+	// Status holds the value of the status edge.
+	Status *WorkflowStatus `json:"status,omitempty"`
+	// Project holds the value of the project edge.
+	Project *Project `json:"project,omitempty"`
+	// Comments holds the value of the comments edge.
+	Comments []*Comment `json:"comments,omitempty"`
+	// Assignees holds the value of the assignees edge.
+	Assignees []*User `json:"assignees,omitempty"`
+	// Watchers holds the value of the watchers edge.
+	Watchers []*User `json:"watchers,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [9]bool
+	// totalCount holds the count of the edges above.
+	totalCount [8]map[string]int
+
+	namedChildren  map[string][]*Task
+	namedBlockedBy map[string][]*Task
+	namedBlocks    map[string][]*Task
+	namedComments  map[string][]*Comment
+	namedAssignees map[string][]*User
+	namedWatchers  map[string][]*User
+}
+
+// ParentOrErr returns the Parent value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TaskEdges) ParentOrErr() (*Task, error) {
+	if e.Parent != nil {
+		return e.Parent, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: task.Label}
+	}
+	return nil, &NotLoadedError{edge: "parent"}
+}
+
+// ChildrenOrErr returns the Children value or an error if the edge
+// was not loaded in eager-loading.
+func (e TaskEdges) ChildrenOrErr() ([]*Task, error) {
+	if e.loadedTypes[1] {
+		return e.Children, nil
+	}
+	return nil, &NotLoadedError{edge: "children"}
+}
+
+// BlockedByOrErr returns the BlockedBy value or an error if the edge
+// was not loaded in eager-loading.
+func (e TaskEdges) BlockedByOrErr() ([]*Task, error) {
+	if e.loadedTypes[2] {
+		return e.BlockedBy, nil
+	}
+	return nil, &NotLoadedError{edge: "blocked_by"}
+}
+
+// BlocksOrErr returns the Blocks value or an error if the edge
+// was not loaded in eager-loading.
+func (e TaskEdges) BlocksOrErr() ([]*Task, error) {
+	if e.loadedTypes[3] {
+		return e.Blocks, nil
+	}
+	return nil, &NotLoadedError{edge: "blocks"}
+}
+
+// StatusOrErr returns the Status value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TaskEdges) StatusOrErr() (*WorkflowStatus, error) {
+	if e.Status != nil {
+		return e.Status, nil
+	} else if e.loadedTypes[4] {
+		return nil, &NotFoundError{label: workflowstatus.Label}
+	}
+	return nil, &NotLoadedError{edge: "status"}
+}
+
+// ProjectOrErr returns the Project value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TaskEdges) ProjectOrErr() (*Project, error) {
+	if e.Project != nil {
+		return e.Project, nil
+	} else if e.loadedTypes[5] {
+		return nil, &NotFoundError{label: project.Label}
+	}
+	return nil, &NotLoadedError{edge: "project"}
+}
+
+// CommentsOrErr returns the Comments value or an error if the edge
+// was not loaded in eager-loading.
+func (e TaskEdges) CommentsOrErr() ([]*Comment, error) {
+	if e.loadedTypes[6] {
+		return e.Comments, nil
+	}
+	return nil, &NotLoadedError{edge: "comments"}
+}
+
+// AssigneesOrErr returns the Assignees value or an error if the edge
+// was not loaded in eager-loading.
+func (e TaskEdges) AssigneesOrErr() ([]*User, error) {
+	if e.loadedTypes[7] {
+		return e.Assignees, nil
+	}
+	return nil, &NotLoadedError{edge: "assignees"}
+}
+
+// WatchersOrErr returns the Watchers value or an error if the edge
+// was not loaded in eager-loading.
+func (e TaskEdges) WatchersOrErr() ([]*User, error) {
+	if e.loadedTypes[8] {
+		return e.Watchers, nil
+	}
+	return nil, &NotLoadedError{edge: "watchers"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Task) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case task.FieldIsCompleted:
+		case task.FieldProjectID, task.FieldParentID, task.FieldSeriesID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case task.FieldLabels:
+			values[i] = new([]byte)
+		case task.FieldRecurrencePaused:
 			values[i] = new(sql.NullBool)
-		case task.FieldTitle, task.FieldDescription, task.FieldAttachmentURL:
+		case task.FieldPosition, task.FieldEstimateMinutes, task.FieldVersion, task.FieldChangeSeq:
+			values[i] = new(sql.NullInt64)
+		case task.FieldTitle, task.FieldDescription, task.FieldStatusCategory, task.FieldAttachmentURL, task.FieldPriority, task.FieldRecurrenceRule, task.FieldRecurrenceTimezone, task.FieldRank, task.FieldExternalID, task.FieldImportKey, task.FieldIcalUID:
 			values[i] = new(sql.NullString)
-		case task.FieldCreatedAt:
+		case task.FieldCreatedAt, task.FieldStartAt, task.FieldDueAt, task.FieldRecurrenceStart, task.FieldCompletedAt, task.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
-		case task.FieldID:
+		case task.FieldID, task.FieldStatusID:
 			values[i] = new(uuid.UUID)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -77,11 +254,24 @@ func (t *Task) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				t.Description = value.String
 			}
-		case task.FieldIsCompleted:
-			if value, ok := values[i].(*sql.NullBool); !ok {
-				return fmt.Errorf("unexpected type %T for field is_completed", values[i])
+		case task.FieldStatusID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field status_id", values[i])
+			} else if value != nil {
+				t.StatusID = *value
+			}
+		case task.FieldStatusCategory:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status_category", values[i])
 			} else if value.Valid {
-				t.IsCompleted = value.Bool
+				t.StatusCategory = task.StatusCategory(value.String)
+			}
+		case task.FieldProjectID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field project_id", values[i])
+			} else if value.Valid {
+				t.ProjectID = new(uuid.UUID)
+				*t.ProjectID = *value.S.(*uuid.UUID)
 			}
 		case task.FieldAttachmentURL:
 			if value, ok := values[i].(*sql.NullString); !ok {
@@ -95,6 +285,140 @@ func (t *Task) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				t.CreatedAt = value.Time
 			}
+		case task.FieldParentID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field parent_id", values[i])
+			} else if value.Valid {
+				t.ParentID = new(uuid.UUID)
+				*t.ParentID = *value.S.(*uuid.UUID)
+			}
+		case task.FieldPosition:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field position", values[i])
+			} else if value.Valid {
+				t.Position = int(value.Int64)
+			}
+		case task.FieldStartAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field start_at", values[i])
+			} else if value.Valid {
+				t.StartAt = new(time.Time)
+				*t.StartAt = value.Time
+			}
+		case task.FieldDueAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field due_at", values[i])
+			} else if value.Valid {
+				t.DueAt = new(time.Time)
+				*t.DueAt = value.Time
+			}
+		case task.FieldPriority:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field priority", values[i])
+			} else if value.Valid {
+				t.Priority = task.Priority(value.String)
+			}
+		case task.FieldEstimateMinutes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field estimate_minutes", values[i])
+			} else if value.Valid {
+				t.EstimateMinutes = new(int)
+				*t.EstimateMinutes = int(value.Int64)
+			}
+		case task.FieldRecurrenceRule:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field recurrence_rule", values[i])
+			} else if value.Valid {
+				t.RecurrenceRule = new(string)
+				*t.RecurrenceRule = value.String
+			}
+		case task.FieldRecurrenceTimezone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field recurrence_timezone", values[i])
+			} else if value.Valid {
+				t.RecurrenceTimezone = value.String
+			}
+		case task.FieldRecurrenceStart:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field recurrence_start", values[i])
+			} else if value.Valid {
+				t.RecurrenceStart = new(time.Time)
+				*t.RecurrenceStart = value.Time
+			}
+		case task.FieldRecurrencePaused:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field recurrence_paused", values[i])
+			} else if value.Valid {
+				t.RecurrencePaused = value.Bool
+			}
+		case task.FieldSeriesID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field series_id", values[i])
+			} else if value.Valid {
+				t.SeriesID = new(uuid.UUID)
+				*t.SeriesID = *value.S.(*uuid.UUID)
+			}
+		case task.FieldLabels:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field labels", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &t.Labels); err != nil {
+					return fmt.Errorf("unmarshal field labels: %w", err)
+				}
+			}
+		case task.FieldRank:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field rank", values[i])
+			} else if value.Valid {
+				t.Rank = value.String
+			}
+		case task.FieldCompletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field completed_at", values[i])
+			} else if value.Valid {
+				t.CompletedAt = new(time.Time)
+				*t.CompletedAt = value.Time
+			}
+		case task.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				t.DeletedAt = new(time.Time)
+				*t.DeletedAt = value.Time
+			}
+		case task.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				t.Version = int(value.Int64)
+			}
+		case task.FieldChangeSeq:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field change_seq", values[i])
+			} else if value.Valid {
+				t.ChangeSeq = value.Int64
+			}
+		case task.FieldExternalID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field external_id", values[i])
+			} else if value.Valid {
+				t.ExternalID = new(string)
+				*t.ExternalID = value.String
+			}
+		case task.FieldImportKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field import_key", values[i])
+			} else if value.Valid {
+				t.ImportKey = new(string)
+				*t.ImportKey = value.String
+			}
+		case task.FieldIcalUID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ical_uid", values[i])
+			} else if value.Valid {
+				t.IcalUID = new(string)
+				*t.IcalUID = value.String
+			}
 		default:
 			t.selectValues.Set(columns[i], values[i])
 		}
@@ -108,6 +432,51 @@ func (t *Task) Value(name string) (ent.Value, error) {
 	return t.selectValues.Get(name)
 }
 
+// QueryParent queries the "parent" edge of the Task entity.
+func (t *Task) QueryParent() *TaskQuery {
+	return NewTaskClient(t.config).QueryParent(t)
+}
+
+// QueryChildren queries the "children" edge of the Task entity.
+func (t *Task) QueryChildren() *TaskQuery {
+	return NewTaskClient(t.config).QueryChildren(t)
+}
+
+// QueryBlockedBy queries the "blocked_by" edge of the Task entity.
+func (t *Task) QueryBlockedBy() *TaskQuery {
+	return NewTaskClient(t.config).QueryBlockedBy(t)
+}
+
+// QueryBlocks queries the "blocks" edge of the Task entity.
+func (t *Task) QueryBlocks() *TaskQuery {
+	return NewTaskClient(t.config).QueryBlocks(t)
+}
+
+// QueryStatus queries the "status" edge of the Task entity.
+func (t *Task) QueryStatus() *WorkflowStatusQuery {
+	return NewTaskClient(t.config).QueryStatus(t)
+}
+
+// QueryProject queries the "project" edge of the Task entity.
+func (t *Task) QueryProject() *ProjectQuery {
+	return NewTaskClient(t.config).QueryProject(t)
+}
+
+// QueryComments queries the "comments" edge of the Task entity.
+func (t *Task) QueryComments() *CommentQuery {
+	return NewTaskClient(t.config).QueryComments(t)
+}
+
+// QueryAssignees queries the "assignees" edge of the Task entity.
+func (t *Task) QueryAssignees() *UserQuery {
+	return NewTaskClient(t.config).QueryAssignees(t)
+}
+
+// QueryWatchers queries the "watchers" edge of the Task entity.
+func (t *Task) QueryWatchers() *UserQuery {
+	return NewTaskClient(t.config).QueryWatchers(t)
+}
+
 // Update returns a builder for updating this Task.
 // Note that you need to call Task.Unwrap() before calling this method if this Task
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -137,17 +506,253 @@ func (t *Task) String() string {
 	builder.WriteString("description=")
 	builder.WriteString(t.Description)
 	builder.WriteString(", ")
-	builder.WriteString("is_completed=")
-	builder.WriteString(fmt.Sprintf("%v", t.IsCompleted))
+	builder.WriteString("status_id=")
+	builder.WriteString(fmt.Sprintf("%v", t.StatusID))
+	builder.WriteString(", ")
+	builder.WriteString("status_category=")
+	builder.WriteString(fmt.Sprintf("%v", t.StatusCategory))
+	builder.WriteString(", ")
+	if v := t.ProjectID; v != nil {
+		builder.WriteString("project_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteString(", ")
 	builder.WriteString("attachment_url=")
 	builder.WriteString(t.AttachmentURL)
 	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(t.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := t.ParentID; v != nil {
+		builder.WriteString("parent_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("position=")
+	builder.WriteString(fmt.Sprintf("%v", t.Position))
+	builder.WriteString(", ")
+	if v := t.StartAt; v != nil {
+		builder.WriteString("start_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := t.DueAt; v != nil {
+		builder.WriteString("due_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("priority=")
+	builder.WriteString(fmt.Sprintf("%v", t.Priority))
+	builder.WriteString(", ")
+	if v := t.EstimateMinutes; v != nil {
+		builder.WriteString("estimate_minutes=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := t.RecurrenceRule; v != nil {
+		builder.WriteString("recurrence_rule=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("recurrence_timezone=")
+	builder.WriteString(t.RecurrenceTimezone)
+	builder.WriteString(", ")
+	if v := t.RecurrenceStart; v != nil {
+		builder.WriteString("recurrence_start=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("recurrence_paused=")
+	builder.WriteString(fmt.Sprintf("%v", t.RecurrencePaused))
+	builder.WriteString(", ")
+	if v := t.SeriesID; v != nil {
+		builder.WriteString("series_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("labels=")
+	builder.WriteString(fmt.Sprintf("%v", t.Labels))
+	builder.WriteString(", ")
+	builder.WriteString("rank=")
+	builder.WriteString(t.Rank)
+	builder.WriteString(", ")
+	if v := t.CompletedAt; v != nil {
+		builder.WriteString("completed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := t.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", t.Version))
+	builder.WriteString(", ")
+	builder.WriteString("change_seq=")
+	builder.WriteString(fmt.Sprintf("%v", t.ChangeSeq))
+	builder.WriteString(", ")
+	if v := t.ExternalID; v != nil {
+		builder.WriteString("external_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := t.ImportKey; v != nil {
+		builder.WriteString("import_key=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := t.IcalUID; v != nil {
+		builder.WriteString("ical_uid=")
+		builder.WriteString(*v)
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
 
+// NamedChildren returns the Children named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (t *Task) NamedChildren(name string) ([]*Task, error) {
+	if t.Edges.namedChildren == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := t.Edges.namedChildren[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (t *Task) appendNamedChildren(name string, edges ...*Task) {
+	if t.Edges.namedChildren == nil {
+		t.Edges.namedChildren = make(map[string][]*Task)
+	}
+	if len(edges) == 0 {
+		t.Edges.namedChildren[name] = []*Task{}
+	} else {
+		t.Edges.namedChildren[name] = append(t.Edges.namedChildren[name], edges...)
+	}
+}
+
+// NamedBlockedBy returns the BlockedBy named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (t *Task) NamedBlockedBy(name string) ([]*Task, error) {
+	if t.Edges.namedBlockedBy == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := t.Edges.namedBlockedBy[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (t *Task) appendNamedBlockedBy(name string, edges ...*Task) {
+	if t.Edges.namedBlockedBy == nil {
+		t.Edges.namedBlockedBy = make(map[string][]*Task)
+	}
+	if len(edges) == 0 {
+		t.Edges.namedBlockedBy[name] = []*Task{}
+	} else {
+		t.Edges.namedBlockedBy[name] = append(t.Edges.namedBlockedBy[name], edges...)
+	}
+}
+
+// NamedBlocks returns the Blocks named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (t *Task) NamedBlocks(name string) ([]*Task, error) {
+	if t.Edges.namedBlocks == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := t.Edges.namedBlocks[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (t *Task) appendNamedBlocks(name string, edges ...*Task) {
+	if t.Edges.namedBlocks == nil {
+		t.Edges.namedBlocks = make(map[string][]*Task)
+	}
+	if len(edges) == 0 {
+		t.Edges.namedBlocks[name] = []*Task{}
+	} else {
+		t.Edges.namedBlocks[name] = append(t.Edges.namedBlocks[name], edges...)
+	}
+}
+
+// NamedComments returns the Comments named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (t *Task) NamedComments(name string) ([]*Comment, error) {
+	if t.Edges.namedComments == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := t.Edges.namedComments[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (t *Task) appendNamedComments(name string, edges ...*Comment) {
+	if t.Edges.namedComments == nil {
+		t.Edges.namedComments = make(map[string][]*Comment)
+	}
+	if len(edges) == 0 {
+		t.Edges.namedComments[name] = []*Comment{}
+	} else {
+		t.Edges.namedComments[name] = append(t.Edges.namedComments[name], edges...)
+	}
+}
+
+// NamedAssignees returns the Assignees named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (t *Task) NamedAssignees(name string) ([]*User, error) {
+	if t.Edges.namedAssignees == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := t.Edges.namedAssignees[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (t *Task) appendNamedAssignees(name string, edges ...*User) {
+	if t.Edges.namedAssignees == nil {
+		t.Edges.namedAssignees = make(map[string][]*User)
+	}
+	if len(edges) == 0 {
+		t.Edges.namedAssignees[name] = []*User{}
+	} else {
+		t.Edges.namedAssignees[name] = append(t.Edges.namedAssignees[name], edges...)
+	}
+}
+
+// NamedWatchers returns the Watchers named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (t *Task) NamedWatchers(name string) ([]*User, error) {
+	if t.Edges.namedWatchers == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := t.Edges.namedWatchers[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (t *Task) appendNamedWatchers(name string, edges ...*User) {
+	if t.Edges.namedWatchers == nil {
+		t.Edges.namedWatchers = make(map[string][]*User)
+	}
+	if len(edges) == 0 {
+		t.Edges.namedWatchers[name] = []*User{}
+	} else {
+		t.Edges.namedWatchers[name] = append(t.Edges.namedWatchers[name], edges...)
+	}
+}
+
 // Tasks is a parsable slice of Task.
 type Tasks []*Task
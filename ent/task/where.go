@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/google/uuid"
 	"github.com/localopsco/go-sample/ent/predicate"
 )
@@ -65,9 +66,14 @@ func Description(v string) predicate.Task {
 	return predicate.Task(sql.FieldEQ(FieldDescription, v))
 }
 
-// IsCompleted applies equality check predicate on the "is_completed" field. It's identical to IsCompletedEQ.
-func IsCompleted(v bool) predicate.Task {
-	return predicate.Task(sql.FieldEQ(FieldIsCompleted, v))
+// StatusID applies equality check predicate on the "status_id" field. It's identical to StatusIDEQ.
+func StatusID(v uuid.UUID) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldStatusID, v))
+}
+
+// ProjectID applies equality check predicate on the "project_id" field. It's identical to ProjectIDEQ.
+func ProjectID(v uuid.UUID) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldProjectID, v))
 }
 
 // AttachmentURL applies equality check predicate on the "attachment_url" field. It's identical to AttachmentURLEQ.
@@ -80,6 +86,96 @@ func CreatedAt(v time.Time) predicate.Task {
 	return predicate.Task(sql.FieldEQ(FieldCreatedAt, v))
 }
 
+// ParentID applies equality check predicate on the "parent_id" field. It's identical to ParentIDEQ.
+func ParentID(v uuid.UUID) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldParentID, v))
+}
+
+// Position applies equality check predicate on the "position" field. It's identical to PositionEQ.
+func Position(v int) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldPosition, v))
+}
+
+// StartAt applies equality check predicate on the "start_at" field. It's identical to StartAtEQ.
+func StartAt(v time.Time) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldStartAt, v))
+}
+
+// DueAt applies equality check predicate on the "due_at" field. It's identical to DueAtEQ.
+func DueAt(v time.Time) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldDueAt, v))
+}
+
+// EstimateMinutes applies equality check predicate on the "estimate_minutes" field. It's identical to EstimateMinutesEQ.
+func EstimateMinutes(v int) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldEstimateMinutes, v))
+}
+
+// RecurrenceRule applies equality check predicate on the "recurrence_rule" field. It's identical to RecurrenceRuleEQ.
+func RecurrenceRule(v string) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldRecurrenceRule, v))
+}
+
+// RecurrenceTimezone applies equality check predicate on the "recurrence_timezone" field. It's identical to RecurrenceTimezoneEQ.
+func RecurrenceTimezone(v string) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldRecurrenceTimezone, v))
+}
+
+// RecurrenceStart applies equality check predicate on the "recurrence_start" field. It's identical to RecurrenceStartEQ.
+func RecurrenceStart(v time.Time) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldRecurrenceStart, v))
+}
+
+// RecurrencePaused applies equality check predicate on the "recurrence_paused" field. It's identical to RecurrencePausedEQ.
+func RecurrencePaused(v bool) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldRecurrencePaused, v))
+}
+
+// SeriesID applies equality check predicate on the "series_id" field. It's identical to SeriesIDEQ.
+func SeriesID(v uuid.UUID) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldSeriesID, v))
+}
+
+// Rank applies equality check predicate on the "rank" field. It's identical to RankEQ.
+func Rank(v string) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldRank, v))
+}
+
+// CompletedAt applies equality check predicate on the "completed_at" field. It's identical to CompletedAtEQ.
+func CompletedAt(v time.Time) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldCompletedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v int) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldVersion, v))
+}
+
+// ChangeSeq applies equality check predicate on the "change_seq" field. It's identical to ChangeSeqEQ.
+func ChangeSeq(v int64) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldChangeSeq, v))
+}
+
+// ExternalID applies equality check predicate on the "external_id" field. It's identical to ExternalIDEQ.
+func ExternalID(v string) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldExternalID, v))
+}
+
+// ImportKey applies equality check predicate on the "import_key" field. It's identical to ImportKeyEQ.
+func ImportKey(v string) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldImportKey, v))
+}
+
+// IcalUID applies equality check predicate on the "ical_uid" field. It's identical to IcalUIDEQ.
+func IcalUID(v string) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldIcalUID, v))
+}
+
 // TitleEQ applies the EQ predicate on the "title" field.
 func TitleEQ(v string) predicate.Task {
 	return predicate.Task(sql.FieldEQ(FieldTitle, v))
@@ -220,14 +316,84 @@ func DescriptionContainsFold(v string) predicate.Task {
 	return predicate.Task(sql.FieldContainsFold(FieldDescription, v))
 }
 
-// IsCompletedEQ applies the EQ predicate on the "is_completed" field.
-func IsCompletedEQ(v bool) predicate.Task {
-	return predicate.Task(sql.FieldEQ(FieldIsCompleted, v))
+// StatusIDEQ applies the EQ predicate on the "status_id" field.
+func StatusIDEQ(v uuid.UUID) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldStatusID, v))
 }
 
-// IsCompletedNEQ applies the NEQ predicate on the "is_completed" field.
-func IsCompletedNEQ(v bool) predicate.Task {
-	return predicate.Task(sql.FieldNEQ(FieldIsCompleted, v))
+// StatusIDNEQ applies the NEQ predicate on the "status_id" field.
+func StatusIDNEQ(v uuid.UUID) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldStatusID, v))
+}
+
+// StatusIDIn applies the In predicate on the "status_id" field.
+func StatusIDIn(vs ...uuid.UUID) predicate.Task {
+	return predicate.Task(sql.FieldIn(FieldStatusID, vs...))
+}
+
+// StatusIDNotIn applies the NotIn predicate on the "status_id" field.
+func StatusIDNotIn(vs ...uuid.UUID) predicate.Task {
+	return predicate.Task(sql.FieldNotIn(FieldStatusID, vs...))
+}
+
+// StatusIDIsNil applies the IsNil predicate on the "status_id" field.
+func StatusIDIsNil() predicate.Task {
+	return predicate.Task(sql.FieldIsNull(FieldStatusID))
+}
+
+// StatusIDNotNil applies the NotNil predicate on the "status_id" field.
+func StatusIDNotNil() predicate.Task {
+	return predicate.Task(sql.FieldNotNull(FieldStatusID))
+}
+
+// StatusCategoryEQ applies the EQ predicate on the "status_category" field.
+func StatusCategoryEQ(v StatusCategory) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldStatusCategory, v))
+}
+
+// StatusCategoryNEQ applies the NEQ predicate on the "status_category" field.
+func StatusCategoryNEQ(v StatusCategory) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldStatusCategory, v))
+}
+
+// StatusCategoryIn applies the In predicate on the "status_category" field.
+func StatusCategoryIn(vs ...StatusCategory) predicate.Task {
+	return predicate.Task(sql.FieldIn(FieldStatusCategory, vs...))
+}
+
+// StatusCategoryNotIn applies the NotIn predicate on the "status_category" field.
+func StatusCategoryNotIn(vs ...StatusCategory) predicate.Task {
+	return predicate.Task(sql.FieldNotIn(FieldStatusCategory, vs...))
+}
+
+// ProjectIDEQ applies the EQ predicate on the "project_id" field.
+func ProjectIDEQ(v uuid.UUID) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldProjectID, v))
+}
+
+// ProjectIDNEQ applies the NEQ predicate on the "project_id" field.
+func ProjectIDNEQ(v uuid.UUID) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldProjectID, v))
+}
+
+// ProjectIDIn applies the In predicate on the "project_id" field.
+func ProjectIDIn(vs ...uuid.UUID) predicate.Task {
+	return predicate.Task(sql.FieldIn(FieldProjectID, vs...))
+}
+
+// ProjectIDNotIn applies the NotIn predicate on the "project_id" field.
+func ProjectIDNotIn(vs ...uuid.UUID) predicate.Task {
+	return predicate.Task(sql.FieldNotIn(FieldProjectID, vs...))
+}
+
+// ProjectIDIsNil applies the IsNil predicate on the "project_id" field.
+func ProjectIDIsNil() predicate.Task {
+	return predicate.Task(sql.FieldIsNull(FieldProjectID))
+}
+
+// ProjectIDNotNil applies the NotNil predicate on the "project_id" field.
+func ProjectIDNotNil() predicate.Task {
+	return predicate.Task(sql.FieldNotNull(FieldProjectID))
 }
 
 // AttachmentURLEQ applies the EQ predicate on the "attachment_url" field.
@@ -345,6 +511,1193 @@ func CreatedAtLTE(v time.Time) predicate.Task {
 	return predicate.Task(sql.FieldLTE(FieldCreatedAt, v))
 }
 
+// ParentIDEQ applies the EQ predicate on the "parent_id" field.
+func ParentIDEQ(v uuid.UUID) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldParentID, v))
+}
+
+// ParentIDNEQ applies the NEQ predicate on the "parent_id" field.
+func ParentIDNEQ(v uuid.UUID) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldParentID, v))
+}
+
+// ParentIDIn applies the In predicate on the "parent_id" field.
+func ParentIDIn(vs ...uuid.UUID) predicate.Task {
+	return predicate.Task(sql.FieldIn(FieldParentID, vs...))
+}
+
+// ParentIDNotIn applies the NotIn predicate on the "parent_id" field.
+func ParentIDNotIn(vs ...uuid.UUID) predicate.Task {
+	return predicate.Task(sql.FieldNotIn(FieldParentID, vs...))
+}
+
+// ParentIDIsNil applies the IsNil predicate on the "parent_id" field.
+func ParentIDIsNil() predicate.Task {
+	return predicate.Task(sql.FieldIsNull(FieldParentID))
+}
+
+// ParentIDNotNil applies the NotNil predicate on the "parent_id" field.
+func ParentIDNotNil() predicate.Task {
+	return predicate.Task(sql.FieldNotNull(FieldParentID))
+}
+
+// PositionEQ applies the EQ predicate on the "position" field.
+func PositionEQ(v int) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldPosition, v))
+}
+
+// PositionNEQ applies the NEQ predicate on the "position" field.
+func PositionNEQ(v int) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldPosition, v))
+}
+
+// PositionIn applies the In predicate on the "position" field.
+func PositionIn(vs ...int) predicate.Task {
+	return predicate.Task(sql.FieldIn(FieldPosition, vs...))
+}
+
+// PositionNotIn applies the NotIn predicate on the "position" field.
+func PositionNotIn(vs ...int) predicate.Task {
+	return predicate.Task(sql.FieldNotIn(FieldPosition, vs...))
+}
+
+// PositionGT applies the GT predicate on the "position" field.
+func PositionGT(v int) predicate.Task {
+	return predicate.Task(sql.FieldGT(FieldPosition, v))
+}
+
+// PositionGTE applies the GTE predicate on the "position" field.
+func PositionGTE(v int) predicate.Task {
+	return predicate.Task(sql.FieldGTE(FieldPosition, v))
+}
+
+// PositionLT applies the LT predicate on the "position" field.
+func PositionLT(v int) predicate.Task {
+	return predicate.Task(sql.FieldLT(FieldPosition, v))
+}
+
+// PositionLTE applies the LTE predicate on the "position" field.
+func PositionLTE(v int) predicate.Task {
+	return predicate.Task(sql.FieldLTE(FieldPosition, v))
+}
+
+// StartAtEQ applies the EQ predicate on the "start_at" field.
+func StartAtEQ(v time.Time) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldStartAt, v))
+}
+
+// StartAtNEQ applies the NEQ predicate on the "start_at" field.
+func StartAtNEQ(v time.Time) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldStartAt, v))
+}
+
+// StartAtIn applies the In predicate on the "start_at" field.
+func StartAtIn(vs ...time.Time) predicate.Task {
+	return predicate.Task(sql.FieldIn(FieldStartAt, vs...))
+}
+
+// StartAtNotIn applies the NotIn predicate on the "start_at" field.
+func StartAtNotIn(vs ...time.Time) predicate.Task {
+	return predicate.Task(sql.FieldNotIn(FieldStartAt, vs...))
+}
+
+// StartAtGT applies the GT predicate on the "start_at" field.
+func StartAtGT(v time.Time) predicate.Task {
+	return predicate.Task(sql.FieldGT(FieldStartAt, v))
+}
+
+// StartAtGTE applies the GTE predicate on the "start_at" field.
+func StartAtGTE(v time.Time) predicate.Task {
+	return predicate.Task(sql.FieldGTE(FieldStartAt, v))
+}
+
+// StartAtLT applies the LT predicate on the "start_at" field.
+func StartAtLT(v time.Time) predicate.Task {
+	return predicate.Task(sql.FieldLT(FieldStartAt, v))
+}
+
+// StartAtLTE applies the LTE predicate on the "start_at" field.
+func StartAtLTE(v time.Time) predicate.Task {
+	return predicate.Task(sql.FieldLTE(FieldStartAt, v))
+}
+
+// StartAtIsNil applies the IsNil predicate on the "start_at" field.
+func StartAtIsNil() predicate.Task {
+	return predicate.Task(sql.FieldIsNull(FieldStartAt))
+}
+
+// StartAtNotNil applies the NotNil predicate on the "start_at" field.
+func StartAtNotNil() predicate.Task {
+	return predicate.Task(sql.FieldNotNull(FieldStartAt))
+}
+
+// DueAtEQ applies the EQ predicate on the "due_at" field.
+func DueAtEQ(v time.Time) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldDueAt, v))
+}
+
+// DueAtNEQ applies the NEQ predicate on the "due_at" field.
+func DueAtNEQ(v time.Time) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldDueAt, v))
+}
+
+// DueAtIn applies the In predicate on the "due_at" field.
+func DueAtIn(vs ...time.Time) predicate.Task {
+	return predicate.Task(sql.FieldIn(FieldDueAt, vs...))
+}
+
+// DueAtNotIn applies the NotIn predicate on the "due_at" field.
+func DueAtNotIn(vs ...time.Time) predicate.Task {
+	return predicate.Task(sql.FieldNotIn(FieldDueAt, vs...))
+}
+
+// DueAtGT applies the GT predicate on the "due_at" field.
+func DueAtGT(v time.Time) predicate.Task {
+	return predicate.Task(sql.FieldGT(FieldDueAt, v))
+}
+
+// DueAtGTE applies the GTE predicate on the "due_at" field.
+func DueAtGTE(v time.Time) predicate.Task {
+	return predicate.Task(sql.FieldGTE(FieldDueAt, v))
+}
+
+// DueAtLT applies the LT predicate on the "due_at" field.
+func DueAtLT(v time.Time) predicate.Task {
+	return predicate.Task(sql.FieldLT(FieldDueAt, v))
+}
+
+// DueAtLTE applies the LTE predicate on the "due_at" field.
+func DueAtLTE(v time.Time) predicate.Task {
+	return predicate.Task(sql.FieldLTE(FieldDueAt, v))
+}
+
+// DueAtIsNil applies the IsNil predicate on the "due_at" field.
+func DueAtIsNil() predicate.Task {
+	return predicate.Task(sql.FieldIsNull(FieldDueAt))
+}
+
+// DueAtNotNil applies the NotNil predicate on the "due_at" field.
+func DueAtNotNil() predicate.Task {
+	return predicate.Task(sql.FieldNotNull(FieldDueAt))
+}
+
+// PriorityEQ applies the EQ predicate on the "priority" field.
+func PriorityEQ(v Priority) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldPriority, v))
+}
+
+// PriorityNEQ applies the NEQ predicate on the "priority" field.
+func PriorityNEQ(v Priority) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldPriority, v))
+}
+
+// PriorityIn applies the In predicate on the "priority" field.
+func PriorityIn(vs ...Priority) predicate.Task {
+	return predicate.Task(sql.FieldIn(FieldPriority, vs...))
+}
+
+// PriorityNotIn applies the NotIn predicate on the "priority" field.
+func PriorityNotIn(vs ...Priority) predicate.Task {
+	return predicate.Task(sql.FieldNotIn(FieldPriority, vs...))
+}
+
+// EstimateMinutesEQ applies the EQ predicate on the "estimate_minutes" field.
+func EstimateMinutesEQ(v int) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldEstimateMinutes, v))
+}
+
+// EstimateMinutesNEQ applies the NEQ predicate on the "estimate_minutes" field.
+func EstimateMinutesNEQ(v int) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldEstimateMinutes, v))
+}
+
+// EstimateMinutesIn applies the In predicate on the "estimate_minutes" field.
+func EstimateMinutesIn(vs ...int) predicate.Task {
+	return predicate.Task(sql.FieldIn(FieldEstimateMinutes, vs...))
+}
+
+// EstimateMinutesNotIn applies the NotIn predicate on the "estimate_minutes" field.
+func EstimateMinutesNotIn(vs ...int) predicate.Task {
+	return predicate.Task(sql.FieldNotIn(FieldEstimateMinutes, vs...))
+}
+
+// EstimateMinutesGT applies the GT predicate on the "estimate_minutes" field.
+func EstimateMinutesGT(v int) predicate.Task {
+	return predicate.Task(sql.FieldGT(FieldEstimateMinutes, v))
+}
+
+// EstimateMinutesGTE applies the GTE predicate on the "estimate_minutes" field.
+func EstimateMinutesGTE(v int) predicate.Task {
+	return predicate.Task(sql.FieldGTE(FieldEstimateMinutes, v))
+}
+
+// EstimateMinutesLT applies the LT predicate on the "estimate_minutes" field.
+func EstimateMinutesLT(v int) predicate.Task {
+	return predicate.Task(sql.FieldLT(FieldEstimateMinutes, v))
+}
+
+// EstimateMinutesLTE applies the LTE predicate on the "estimate_minutes" field.
+func EstimateMinutesLTE(v int) predicate.Task {
+	return predicate.Task(sql.FieldLTE(FieldEstimateMinutes, v))
+}
+
+// EstimateMinutesIsNil applies the IsNil predicate on the "estimate_minutes" field.
+func EstimateMinutesIsNil() predicate.Task {
+	return predicate.Task(sql.FieldIsNull(FieldEstimateMinutes))
+}
+
+// EstimateMinutesNotNil applies the NotNil predicate on the "estimate_minutes" field.
+func EstimateMinutesNotNil() predicate.Task {
+	return predicate.Task(sql.FieldNotNull(FieldEstimateMinutes))
+}
+
+// RecurrenceRuleEQ applies the EQ predicate on the "recurrence_rule" field.
+func RecurrenceRuleEQ(v string) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldRecurrenceRule, v))
+}
+
+// RecurrenceRuleNEQ applies the NEQ predicate on the "recurrence_rule" field.
+func RecurrenceRuleNEQ(v string) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldRecurrenceRule, v))
+}
+
+// RecurrenceRuleIn applies the In predicate on the "recurrence_rule" field.
+func RecurrenceRuleIn(vs ...string) predicate.Task {
+	return predicate.Task(sql.FieldIn(FieldRecurrenceRule, vs...))
+}
+
+// RecurrenceRuleNotIn applies the NotIn predicate on the "recurrence_rule" field.
+func RecurrenceRuleNotIn(vs ...string) predicate.Task {
+	return predicate.Task(sql.FieldNotIn(FieldRecurrenceRule, vs...))
+}
+
+// RecurrenceRuleGT applies the GT predicate on the "recurrence_rule" field.
+func RecurrenceRuleGT(v string) predicate.Task {
+	return predicate.Task(sql.FieldGT(FieldRecurrenceRule, v))
+}
+
+// RecurrenceRuleGTE applies the GTE predicate on the "recurrence_rule" field.
+func RecurrenceRuleGTE(v string) predicate.Task {
+	return predicate.Task(sql.FieldGTE(FieldRecurrenceRule, v))
+}
+
+// RecurrenceRuleLT applies the LT predicate on the "recurrence_rule" field.
+func RecurrenceRuleLT(v string) predicate.Task {
+	return predicate.Task(sql.FieldLT(FieldRecurrenceRule, v))
+}
+
+// RecurrenceRuleLTE applies the LTE predicate on the "recurrence_rule" field.
+func RecurrenceRuleLTE(v string) predicate.Task {
+	return predicate.Task(sql.FieldLTE(FieldRecurrenceRule, v))
+}
+
+// RecurrenceRuleContains applies the Contains predicate on the "recurrence_rule" field.
+func RecurrenceRuleContains(v string) predicate.Task {
+	return predicate.Task(sql.FieldContains(FieldRecurrenceRule, v))
+}
+
+// RecurrenceRuleHasPrefix applies the HasPrefix predicate on the "recurrence_rule" field.
+func RecurrenceRuleHasPrefix(v string) predicate.Task {
+	return predicate.Task(sql.FieldHasPrefix(FieldRecurrenceRule, v))
+}
+
+// RecurrenceRuleHasSuffix applies the HasSuffix predicate on the "recurrence_rule" field.
+func RecurrenceRuleHasSuffix(v string) predicate.Task {
+	return predicate.Task(sql.FieldHasSuffix(FieldRecurrenceRule, v))
+}
+
+// RecurrenceRuleIsNil applies the IsNil predicate on the "recurrence_rule" field.
+func RecurrenceRuleIsNil() predicate.Task {
+	return predicate.Task(sql.FieldIsNull(FieldRecurrenceRule))
+}
+
+// RecurrenceRuleNotNil applies the NotNil predicate on the "recurrence_rule" field.
+func RecurrenceRuleNotNil() predicate.Task {
+	return predicate.Task(sql.FieldNotNull(FieldRecurrenceRule))
+}
+
+// RecurrenceRuleEqualFold applies the EqualFold predicate on the "recurrence_rule" field.
+func RecurrenceRuleEqualFold(v string) predicate.Task {
+	return predicate.Task(sql.FieldEqualFold(FieldRecurrenceRule, v))
+}
+
+// RecurrenceRuleContainsFold applies the ContainsFold predicate on the "recurrence_rule" field.
+func RecurrenceRuleContainsFold(v string) predicate.Task {
+	return predicate.Task(sql.FieldContainsFold(FieldRecurrenceRule, v))
+}
+
+// RecurrenceTimezoneEQ applies the EQ predicate on the "recurrence_timezone" field.
+func RecurrenceTimezoneEQ(v string) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldRecurrenceTimezone, v))
+}
+
+// RecurrenceTimezoneNEQ applies the NEQ predicate on the "recurrence_timezone" field.
+func RecurrenceTimezoneNEQ(v string) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldRecurrenceTimezone, v))
+}
+
+// RecurrenceTimezoneIn applies the In predicate on the "recurrence_timezone" field.
+func RecurrenceTimezoneIn(vs ...string) predicate.Task {
+	return predicate.Task(sql.FieldIn(FieldRecurrenceTimezone, vs...))
+}
+
+// RecurrenceTimezoneNotIn applies the NotIn predicate on the "recurrence_timezone" field.
+func RecurrenceTimezoneNotIn(vs ...string) predicate.Task {
+	return predicate.Task(sql.FieldNotIn(FieldRecurrenceTimezone, vs...))
+}
+
+// RecurrenceTimezoneGT applies the GT predicate on the "recurrence_timezone" field.
+func RecurrenceTimezoneGT(v string) predicate.Task {
+	return predicate.Task(sql.FieldGT(FieldRecurrenceTimezone, v))
+}
+
+// RecurrenceTimezoneGTE applies the GTE predicate on the "recurrence_timezone" field.
+func RecurrenceTimezoneGTE(v string) predicate.Task {
+	return predicate.Task(sql.FieldGTE(FieldRecurrenceTimezone, v))
+}
+
+// RecurrenceTimezoneLT applies the LT predicate on the "recurrence_timezone" field.
+func RecurrenceTimezoneLT(v string) predicate.Task {
+	return predicate.Task(sql.FieldLT(FieldRecurrenceTimezone, v))
+}
+
+// RecurrenceTimezoneLTE applies the LTE predicate on the "recurrence_timezone" field.
+func RecurrenceTimezoneLTE(v string) predicate.Task {
+	return predicate.Task(sql.FieldLTE(FieldRecurrenceTimezone, v))
+}
+
+// RecurrenceTimezoneContains applies the Contains predicate on the "recurrence_timezone" field.
+func RecurrenceTimezoneContains(v string) predicate.Task {
+	return predicate.Task(sql.FieldContains(FieldRecurrenceTimezone, v))
+}
+
+// RecurrenceTimezoneHasPrefix applies the HasPrefix predicate on the "recurrence_timezone" field.
+func RecurrenceTimezoneHasPrefix(v string) predicate.Task {
+	return predicate.Task(sql.FieldHasPrefix(FieldRecurrenceTimezone, v))
+}
+
+// RecurrenceTimezoneHasSuffix applies the HasSuffix predicate on the "recurrence_timezone" field.
+func RecurrenceTimezoneHasSuffix(v string) predicate.Task {
+	return predicate.Task(sql.FieldHasSuffix(FieldRecurrenceTimezone, v))
+}
+
+// RecurrenceTimezoneIsNil applies the IsNil predicate on the "recurrence_timezone" field.
+func RecurrenceTimezoneIsNil() predicate.Task {
+	return predicate.Task(sql.FieldIsNull(FieldRecurrenceTimezone))
+}
+
+// RecurrenceTimezoneNotNil applies the NotNil predicate on the "recurrence_timezone" field.
+func RecurrenceTimezoneNotNil() predicate.Task {
+	return predicate.Task(sql.FieldNotNull(FieldRecurrenceTimezone))
+}
+
+// RecurrenceTimezoneEqualFold applies the EqualFold predicate on the "recurrence_timezone" field.
+func RecurrenceTimezoneEqualFold(v string) predicate.Task {
+	return predicate.Task(sql.FieldEqualFold(FieldRecurrenceTimezone, v))
+}
+
+// RecurrenceTimezoneContainsFold applies the ContainsFold predicate on the "recurrence_timezone" field.
+func RecurrenceTimezoneContainsFold(v string) predicate.Task {
+	return predicate.Task(sql.FieldContainsFold(FieldRecurrenceTimezone, v))
+}
+
+// RecurrenceStartEQ applies the EQ predicate on the "recurrence_start" field.
+func RecurrenceStartEQ(v time.Time) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldRecurrenceStart, v))
+}
+
+// RecurrenceStartNEQ applies the NEQ predicate on the "recurrence_start" field.
+func RecurrenceStartNEQ(v time.Time) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldRecurrenceStart, v))
+}
+
+// RecurrenceStartIn applies the In predicate on the "recurrence_start" field.
+func RecurrenceStartIn(vs ...time.Time) predicate.Task {
+	return predicate.Task(sql.FieldIn(FieldRecurrenceStart, vs...))
+}
+
+// RecurrenceStartNotIn applies the NotIn predicate on the "recurrence_start" field.
+func RecurrenceStartNotIn(vs ...time.Time) predicate.Task {
+	return predicate.Task(sql.FieldNotIn(FieldRecurrenceStart, vs...))
+}
+
+// RecurrenceStartGT applies the GT predicate on the "recurrence_start" field.
+func RecurrenceStartGT(v time.Time) predicate.Task {
+	return predicate.Task(sql.FieldGT(FieldRecurrenceStart, v))
+}
+
+// RecurrenceStartGTE applies the GTE predicate on the "recurrence_start" field.
+func RecurrenceStartGTE(v time.Time) predicate.Task {
+	return predicate.Task(sql.FieldGTE(FieldRecurrenceStart, v))
+}
+
+// RecurrenceStartLT applies the LT predicate on the "recurrence_start" field.
+func RecurrenceStartLT(v time.Time) predicate.Task {
+	return predicate.Task(sql.FieldLT(FieldRecurrenceStart, v))
+}
+
+// RecurrenceStartLTE applies the LTE predicate on the "recurrence_start" field.
+func RecurrenceStartLTE(v time.Time) predicate.Task {
+	return predicate.Task(sql.FieldLTE(FieldRecurrenceStart, v))
+}
+
+// RecurrenceStartIsNil applies the IsNil predicate on the "recurrence_start" field.
+func RecurrenceStartIsNil() predicate.Task {
+	return predicate.Task(sql.FieldIsNull(FieldRecurrenceStart))
+}
+
+// RecurrenceStartNotNil applies the NotNil predicate on the "recurrence_start" field.
+func RecurrenceStartNotNil() predicate.Task {
+	return predicate.Task(sql.FieldNotNull(FieldRecurrenceStart))
+}
+
+// RecurrencePausedEQ applies the EQ predicate on the "recurrence_paused" field.
+func RecurrencePausedEQ(v bool) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldRecurrencePaused, v))
+}
+
+// RecurrencePausedNEQ applies the NEQ predicate on the "recurrence_paused" field.
+func RecurrencePausedNEQ(v bool) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldRecurrencePaused, v))
+}
+
+// SeriesIDEQ applies the EQ predicate on the "series_id" field.
+func SeriesIDEQ(v uuid.UUID) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldSeriesID, v))
+}
+
+// SeriesIDNEQ applies the NEQ predicate on the "series_id" field.
+func SeriesIDNEQ(v uuid.UUID) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldSeriesID, v))
+}
+
+// SeriesIDIn applies the In predicate on the "series_id" field.
+func SeriesIDIn(vs ...uuid.UUID) predicate.Task {
+	return predicate.Task(sql.FieldIn(FieldSeriesID, vs...))
+}
+
+// SeriesIDNotIn applies the NotIn predicate on the "series_id" field.
+func SeriesIDNotIn(vs ...uuid.UUID) predicate.Task {
+	return predicate.Task(sql.FieldNotIn(FieldSeriesID, vs...))
+}
+
+// SeriesIDGT applies the GT predicate on the "series_id" field.
+func SeriesIDGT(v uuid.UUID) predicate.Task {
+	return predicate.Task(sql.FieldGT(FieldSeriesID, v))
+}
+
+// SeriesIDGTE applies the GTE predicate on the "series_id" field.
+func SeriesIDGTE(v uuid.UUID) predicate.Task {
+	return predicate.Task(sql.FieldGTE(FieldSeriesID, v))
+}
+
+// SeriesIDLT applies the LT predicate on the "series_id" field.
+func SeriesIDLT(v uuid.UUID) predicate.Task {
+	return predicate.Task(sql.FieldLT(FieldSeriesID, v))
+}
+
+// SeriesIDLTE applies the LTE predicate on the "series_id" field.
+func SeriesIDLTE(v uuid.UUID) predicate.Task {
+	return predicate.Task(sql.FieldLTE(FieldSeriesID, v))
+}
+
+// SeriesIDIsNil applies the IsNil predicate on the "series_id" field.
+func SeriesIDIsNil() predicate.Task {
+	return predicate.Task(sql.FieldIsNull(FieldSeriesID))
+}
+
+// SeriesIDNotNil applies the NotNil predicate on the "series_id" field.
+func SeriesIDNotNil() predicate.Task {
+	return predicate.Task(sql.FieldNotNull(FieldSeriesID))
+}
+
+// LabelsIsNil applies the IsNil predicate on the "labels" field.
+func LabelsIsNil() predicate.Task {
+	return predicate.Task(sql.FieldIsNull(FieldLabels))
+}
+
+// LabelsNotNil applies the NotNil predicate on the "labels" field.
+func LabelsNotNil() predicate.Task {
+	return predicate.Task(sql.FieldNotNull(FieldLabels))
+}
+
+// RankEQ applies the EQ predicate on the "rank" field.
+func RankEQ(v string) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldRank, v))
+}
+
+// RankNEQ applies the NEQ predicate on the "rank" field.
+func RankNEQ(v string) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldRank, v))
+}
+
+// RankIn applies the In predicate on the "rank" field.
+func RankIn(vs ...string) predicate.Task {
+	return predicate.Task(sql.FieldIn(FieldRank, vs...))
+}
+
+// RankNotIn applies the NotIn predicate on the "rank" field.
+func RankNotIn(vs ...string) predicate.Task {
+	return predicate.Task(sql.FieldNotIn(FieldRank, vs...))
+}
+
+// RankGT applies the GT predicate on the "rank" field.
+func RankGT(v string) predicate.Task {
+	return predicate.Task(sql.FieldGT(FieldRank, v))
+}
+
+// RankGTE applies the GTE predicate on the "rank" field.
+func RankGTE(v string) predicate.Task {
+	return predicate.Task(sql.FieldGTE(FieldRank, v))
+}
+
+// RankLT applies the LT predicate on the "rank" field.
+func RankLT(v string) predicate.Task {
+	return predicate.Task(sql.FieldLT(FieldRank, v))
+}
+
+// RankLTE applies the LTE predicate on the "rank" field.
+func RankLTE(v string) predicate.Task {
+	return predicate.Task(sql.FieldLTE(FieldRank, v))
+}
+
+// RankContains applies the Contains predicate on the "rank" field.
+func RankContains(v string) predicate.Task {
+	return predicate.Task(sql.FieldContains(FieldRank, v))
+}
+
+// RankHasPrefix applies the HasPrefix predicate on the "rank" field.
+func RankHasPrefix(v string) predicate.Task {
+	return predicate.Task(sql.FieldHasPrefix(FieldRank, v))
+}
+
+// RankHasSuffix applies the HasSuffix predicate on the "rank" field.
+func RankHasSuffix(v string) predicate.Task {
+	return predicate.Task(sql.FieldHasSuffix(FieldRank, v))
+}
+
+// RankEqualFold applies the EqualFold predicate on the "rank" field.
+func RankEqualFold(v string) predicate.Task {
+	return predicate.Task(sql.FieldEqualFold(FieldRank, v))
+}
+
+// RankContainsFold applies the ContainsFold predicate on the "rank" field.
+func RankContainsFold(v string) predicate.Task {
+	return predicate.Task(sql.FieldContainsFold(FieldRank, v))
+}
+
+// CompletedAtEQ applies the EQ predicate on the "completed_at" field.
+func CompletedAtEQ(v time.Time) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldCompletedAt, v))
+}
+
+// CompletedAtNEQ applies the NEQ predicate on the "completed_at" field.
+func CompletedAtNEQ(v time.Time) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldCompletedAt, v))
+}
+
+// CompletedAtIn applies the In predicate on the "completed_at" field.
+func CompletedAtIn(vs ...time.Time) predicate.Task {
+	return predicate.Task(sql.FieldIn(FieldCompletedAt, vs...))
+}
+
+// CompletedAtNotIn applies the NotIn predicate on the "completed_at" field.
+func CompletedAtNotIn(vs ...time.Time) predicate.Task {
+	return predicate.Task(sql.FieldNotIn(FieldCompletedAt, vs...))
+}
+
+// CompletedAtGT applies the GT predicate on the "completed_at" field.
+func CompletedAtGT(v time.Time) predicate.Task {
+	return predicate.Task(sql.FieldGT(FieldCompletedAt, v))
+}
+
+// CompletedAtGTE applies the GTE predicate on the "completed_at" field.
+func CompletedAtGTE(v time.Time) predicate.Task {
+	return predicate.Task(sql.FieldGTE(FieldCompletedAt, v))
+}
+
+// CompletedAtLT applies the LT predicate on the "completed_at" field.
+func CompletedAtLT(v time.Time) predicate.Task {
+	return predicate.Task(sql.FieldLT(FieldCompletedAt, v))
+}
+
+// CompletedAtLTE applies the LTE predicate on the "completed_at" field.
+func CompletedAtLTE(v time.Time) predicate.Task {
+	return predicate.Task(sql.FieldLTE(FieldCompletedAt, v))
+}
+
+// CompletedAtIsNil applies the IsNil predicate on the "completed_at" field.
+func CompletedAtIsNil() predicate.Task {
+	return predicate.Task(sql.FieldIsNull(FieldCompletedAt))
+}
+
+// CompletedAtNotNil applies the NotNil predicate on the "completed_at" field.
+func CompletedAtNotNil() predicate.Task {
+	return predicate.Task(sql.FieldNotNull(FieldCompletedAt))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.Task {
+	return predicate.Task(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.Task {
+	return predicate.Task(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.Task {
+	return predicate.Task(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.Task {
+	return predicate.Task(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.Task {
+	return predicate.Task(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.Task {
+	return predicate.Task(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.Task {
+	return predicate.Task(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.Task {
+	return predicate.Task(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v int) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v int) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...int) predicate.Task {
+	return predicate.Task(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...int) predicate.Task {
+	return predicate.Task(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v int) predicate.Task {
+	return predicate.Task(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v int) predicate.Task {
+	return predicate.Task(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v int) predicate.Task {
+	return predicate.Task(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v int) predicate.Task {
+	return predicate.Task(sql.FieldLTE(FieldVersion, v))
+}
+
+// ChangeSeqEQ applies the EQ predicate on the "change_seq" field.
+func ChangeSeqEQ(v int64) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldChangeSeq, v))
+}
+
+// ChangeSeqNEQ applies the NEQ predicate on the "change_seq" field.
+func ChangeSeqNEQ(v int64) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldChangeSeq, v))
+}
+
+// ChangeSeqIn applies the In predicate on the "change_seq" field.
+func ChangeSeqIn(vs ...int64) predicate.Task {
+	return predicate.Task(sql.FieldIn(FieldChangeSeq, vs...))
+}
+
+// ChangeSeqNotIn applies the NotIn predicate on the "change_seq" field.
+func ChangeSeqNotIn(vs ...int64) predicate.Task {
+	return predicate.Task(sql.FieldNotIn(FieldChangeSeq, vs...))
+}
+
+// ChangeSeqGT applies the GT predicate on the "change_seq" field.
+func ChangeSeqGT(v int64) predicate.Task {
+	return predicate.Task(sql.FieldGT(FieldChangeSeq, v))
+}
+
+// ChangeSeqGTE applies the GTE predicate on the "change_seq" field.
+func ChangeSeqGTE(v int64) predicate.Task {
+	return predicate.Task(sql.FieldGTE(FieldChangeSeq, v))
+}
+
+// ChangeSeqLT applies the LT predicate on the "change_seq" field.
+func ChangeSeqLT(v int64) predicate.Task {
+	return predicate.Task(sql.FieldLT(FieldChangeSeq, v))
+}
+
+// ChangeSeqLTE applies the LTE predicate on the "change_seq" field.
+func ChangeSeqLTE(v int64) predicate.Task {
+	return predicate.Task(sql.FieldLTE(FieldChangeSeq, v))
+}
+
+// ExternalIDEQ applies the EQ predicate on the "external_id" field.
+func ExternalIDEQ(v string) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldExternalID, v))
+}
+
+// ExternalIDNEQ applies the NEQ predicate on the "external_id" field.
+func ExternalIDNEQ(v string) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldExternalID, v))
+}
+
+// ExternalIDIn applies the In predicate on the "external_id" field.
+func ExternalIDIn(vs ...string) predicate.Task {
+	return predicate.Task(sql.FieldIn(FieldExternalID, vs...))
+}
+
+// ExternalIDNotIn applies the NotIn predicate on the "external_id" field.
+func ExternalIDNotIn(vs ...string) predicate.Task {
+	return predicate.Task(sql.FieldNotIn(FieldExternalID, vs...))
+}
+
+// ExternalIDGT applies the GT predicate on the "external_id" field.
+func ExternalIDGT(v string) predicate.Task {
+	return predicate.Task(sql.FieldGT(FieldExternalID, v))
+}
+
+// ExternalIDGTE applies the GTE predicate on the "external_id" field.
+func ExternalIDGTE(v string) predicate.Task {
+	return predicate.Task(sql.FieldGTE(FieldExternalID, v))
+}
+
+// ExternalIDLT applies the LT predicate on the "external_id" field.
+func ExternalIDLT(v string) predicate.Task {
+	return predicate.Task(sql.FieldLT(FieldExternalID, v))
+}
+
+// ExternalIDLTE applies the LTE predicate on the "external_id" field.
+func ExternalIDLTE(v string) predicate.Task {
+	return predicate.Task(sql.FieldLTE(FieldExternalID, v))
+}
+
+// ExternalIDContains applies the Contains predicate on the "external_id" field.
+func ExternalIDContains(v string) predicate.Task {
+	return predicate.Task(sql.FieldContains(FieldExternalID, v))
+}
+
+// ExternalIDHasPrefix applies the HasPrefix predicate on the "external_id" field.
+func ExternalIDHasPrefix(v string) predicate.Task {
+	return predicate.Task(sql.FieldHasPrefix(FieldExternalID, v))
+}
+
+// ExternalIDHasSuffix applies the HasSuffix predicate on the "external_id" field.
+func ExternalIDHasSuffix(v string) predicate.Task {
+	return predicate.Task(sql.FieldHasSuffix(FieldExternalID, v))
+}
+
+// ExternalIDIsNil applies the IsNil predicate on the "external_id" field.
+func ExternalIDIsNil() predicate.Task {
+	return predicate.Task(sql.FieldIsNull(FieldExternalID))
+}
+
+// ExternalIDNotNil applies the NotNil predicate on the "external_id" field.
+func ExternalIDNotNil() predicate.Task {
+	return predicate.Task(sql.FieldNotNull(FieldExternalID))
+}
+
+// ExternalIDEqualFold applies the EqualFold predicate on the "external_id" field.
+func ExternalIDEqualFold(v string) predicate.Task {
+	return predicate.Task(sql.FieldEqualFold(FieldExternalID, v))
+}
+
+// ExternalIDContainsFold applies the ContainsFold predicate on the "external_id" field.
+func ExternalIDContainsFold(v string) predicate.Task {
+	return predicate.Task(sql.FieldContainsFold(FieldExternalID, v))
+}
+
+// ImportKeyEQ applies the EQ predicate on the "import_key" field.
+func ImportKeyEQ(v string) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldImportKey, v))
+}
+
+// ImportKeyNEQ applies the NEQ predicate on the "import_key" field.
+func ImportKeyNEQ(v string) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldImportKey, v))
+}
+
+// ImportKeyIn applies the In predicate on the "import_key" field.
+func ImportKeyIn(vs ...string) predicate.Task {
+	return predicate.Task(sql.FieldIn(FieldImportKey, vs...))
+}
+
+// ImportKeyNotIn applies the NotIn predicate on the "import_key" field.
+func ImportKeyNotIn(vs ...string) predicate.Task {
+	return predicate.Task(sql.FieldNotIn(FieldImportKey, vs...))
+}
+
+// ImportKeyGT applies the GT predicate on the "import_key" field.
+func ImportKeyGT(v string) predicate.Task {
+	return predicate.Task(sql.FieldGT(FieldImportKey, v))
+}
+
+// ImportKeyGTE applies the GTE predicate on the "import_key" field.
+func ImportKeyGTE(v string) predicate.Task {
+	return predicate.Task(sql.FieldGTE(FieldImportKey, v))
+}
+
+// ImportKeyLT applies the LT predicate on the "import_key" field.
+func ImportKeyLT(v string) predicate.Task {
+	return predicate.Task(sql.FieldLT(FieldImportKey, v))
+}
+
+// ImportKeyLTE applies the LTE predicate on the "import_key" field.
+func ImportKeyLTE(v string) predicate.Task {
+	return predicate.Task(sql.FieldLTE(FieldImportKey, v))
+}
+
+// ImportKeyContains applies the Contains predicate on the "import_key" field.
+func ImportKeyContains(v string) predicate.Task {
+	return predicate.Task(sql.FieldContains(FieldImportKey, v))
+}
+
+// ImportKeyHasPrefix applies the HasPrefix predicate on the "import_key" field.
+func ImportKeyHasPrefix(v string) predicate.Task {
+	return predicate.Task(sql.FieldHasPrefix(FieldImportKey, v))
+}
+
+// ImportKeyHasSuffix applies the HasSuffix predicate on the "import_key" field.
+func ImportKeyHasSuffix(v string) predicate.Task {
+	return predicate.Task(sql.FieldHasSuffix(FieldImportKey, v))
+}
+
+// ImportKeyIsNil applies the IsNil predicate on the "import_key" field.
+func ImportKeyIsNil() predicate.Task {
+	return predicate.Task(sql.FieldIsNull(FieldImportKey))
+}
+
+// ImportKeyNotNil applies the NotNil predicate on the "import_key" field.
+func ImportKeyNotNil() predicate.Task {
+	return predicate.Task(sql.FieldNotNull(FieldImportKey))
+}
+
+// ImportKeyEqualFold applies the EqualFold predicate on the "import_key" field.
+func ImportKeyEqualFold(v string) predicate.Task {
+	return predicate.Task(sql.FieldEqualFold(FieldImportKey, v))
+}
+
+// ImportKeyContainsFold applies the ContainsFold predicate on the "import_key" field.
+func ImportKeyContainsFold(v string) predicate.Task {
+	return predicate.Task(sql.FieldContainsFold(FieldImportKey, v))
+}
+
+// IcalUIDEQ applies the EQ predicate on the "ical_uid" field.
+func IcalUIDEQ(v string) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldIcalUID, v))
+}
+
+// IcalUIDNEQ applies the NEQ predicate on the "ical_uid" field.
+func IcalUIDNEQ(v string) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldIcalUID, v))
+}
+
+// IcalUIDIn applies the In predicate on the "ical_uid" field.
+func IcalUIDIn(vs ...string) predicate.Task {
+	return predicate.Task(sql.FieldIn(FieldIcalUID, vs...))
+}
+
+// IcalUIDNotIn applies the NotIn predicate on the "ical_uid" field.
+func IcalUIDNotIn(vs ...string) predicate.Task {
+	return predicate.Task(sql.FieldNotIn(FieldIcalUID, vs...))
+}
+
+// IcalUIDGT applies the GT predicate on the "ical_uid" field.
+func IcalUIDGT(v string) predicate.Task {
+	return predicate.Task(sql.FieldGT(FieldIcalUID, v))
+}
+
+// IcalUIDGTE applies the GTE predicate on the "ical_uid" field.
+func IcalUIDGTE(v string) predicate.Task {
+	return predicate.Task(sql.FieldGTE(FieldIcalUID, v))
+}
+
+// IcalUIDLT applies the LT predicate on the "ical_uid" field.
+func IcalUIDLT(v string) predicate.Task {
+	return predicate.Task(sql.FieldLT(FieldIcalUID, v))
+}
+
+// IcalUIDLTE applies the LTE predicate on the "ical_uid" field.
+func IcalUIDLTE(v string) predicate.Task {
+	return predicate.Task(sql.FieldLTE(FieldIcalUID, v))
+}
+
+// IcalUIDContains applies the Contains predicate on the "ical_uid" field.
+func IcalUIDContains(v string) predicate.Task {
+	return predicate.Task(sql.FieldContains(FieldIcalUID, v))
+}
+
+// IcalUIDHasPrefix applies the HasPrefix predicate on the "ical_uid" field.
+func IcalUIDHasPrefix(v string) predicate.Task {
+	return predicate.Task(sql.FieldHasPrefix(FieldIcalUID, v))
+}
+
+// IcalUIDHasSuffix applies the HasSuffix predicate on the "ical_uid" field.
+func IcalUIDHasSuffix(v string) predicate.Task {
+	return predicate.Task(sql.FieldHasSuffix(FieldIcalUID, v))
+}
+
+// IcalUIDIsNil applies the IsNil predicate on the "ical_uid" field.
+func IcalUIDIsNil() predicate.Task {
+	return predicate.Task(sql.FieldIsNull(FieldIcalUID))
+}
+
+// IcalUIDNotNil applies the NotNil predicate on the "ical_uid" field.
+func IcalUIDNotNil() predicate.Task {
+	return predicate.Task(sql.FieldNotNull(FieldIcalUID))
+}
+
+// IcalUIDEqualFold applies the EqualFold predicate on the "ical_uid" field.
+func IcalUIDEqualFold(v string) predicate.Task {
+	return predicate.Task(sql.FieldEqualFold(FieldIcalUID, v))
+}
+
+// IcalUIDContainsFold applies the ContainsFold predicate on the "ical_uid" field.
+func IcalUIDContainsFold(v string) predicate.Task {
+	return predicate.Task(sql.FieldContainsFold(FieldIcalUID, v))
+}
+
+// HasParent applies the HasEdge predicate on the "parent" edge.
+func HasParent() predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ParentTable, ParentColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasParentWith applies the HasEdge predicate on the "parent" edge with a given conditions (other predicates).
+func HasParentWith(preds ...predicate.Task) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := newParentStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasChildren applies the HasEdge predicate on the "children" edge.
+func HasChildren() predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ChildrenTable, ChildrenColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasChildrenWith applies the HasEdge predicate on the "children" edge with a given conditions (other predicates).
+func HasChildrenWith(preds ...predicate.Task) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := newChildrenStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasBlockedBy applies the HasEdge predicate on the "blocked_by" edge.
+func HasBlockedBy() predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, BlockedByTable, BlockedByPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBlockedByWith applies the HasEdge predicate on the "blocked_by" edge with a given conditions (other predicates).
+func HasBlockedByWith(preds ...predicate.Task) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := newBlockedByStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasBlocks applies the HasEdge predicate on the "blocks" edge.
+func HasBlocks() predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, BlocksTable, BlocksPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBlocksWith applies the HasEdge predicate on the "blocks" edge with a given conditions (other predicates).
+func HasBlocksWith(preds ...predicate.Task) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := newBlocksStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasStatus applies the HasEdge predicate on the "status" edge.
+func HasStatus() predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, StatusTable, StatusColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasStatusWith applies the HasEdge predicate on the "status" edge with a given conditions (other predicates).
+func HasStatusWith(preds ...predicate.WorkflowStatus) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := newStatusStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasProject applies the HasEdge predicate on the "project" edge.
+func HasProject() predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ProjectTable, ProjectColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasProjectWith applies the HasEdge predicate on the "project" edge with a given conditions (other predicates).
+func HasProjectWith(preds ...predicate.Project) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := newProjectStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasComments applies the HasEdge predicate on the "comments" edge.
+func HasComments() predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, CommentsTable, CommentsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCommentsWith applies the HasEdge predicate on the "comments" edge with a given conditions (other predicates).
+func HasCommentsWith(preds ...predicate.Comment) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := newCommentsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAssignees applies the HasEdge predicate on the "assignees" edge.
+func HasAssignees() predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, AssigneesTable, AssigneesPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAssigneesWith applies the HasEdge predicate on the "assignees" edge with a given conditions (other predicates).
+func HasAssigneesWith(preds ...predicate.User) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := newAssigneesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasWatchers applies the HasEdge predicate on the "watchers" edge.
+func HasWatchers() predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, WatchersTable, WatchersPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWatchersWith applies the HasEdge predicate on the "watchers" edge with a given conditions (other predicates).
+func HasWatchersWith(preds ...predicate.User) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := newWatchersStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Task) predicate.Task {
 	return predicate.Task(sql.AndPredicates(predicates...))
@@ -11,7 +11,11 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/ent/comment"
+	"github.com/localopsco/go-sample/ent/project"
 	"github.com/localopsco/go-sample/ent/task"
+	"github.com/localopsco/go-sample/ent/user"
+	"github.com/localopsco/go-sample/ent/workflowstatus"
 )
 
 // TaskCreate is the builder for creating a Task entity.
@@ -41,16 +45,44 @@ func (tc *TaskCreate) SetNillableDescription(s *string) *TaskCreate {
 	return tc
 }
 
-// SetIsCompleted sets the "is_completed" field.
-func (tc *TaskCreate) SetIsCompleted(b bool) *TaskCreate {
-	tc.mutation.SetIsCompleted(b)
+// SetStatusID sets the "status_id" field.
+func (tc *TaskCreate) SetStatusID(u uuid.UUID) *TaskCreate {
+	tc.mutation.SetStatusID(u)
 	return tc
 }
 
-// SetNillableIsCompleted sets the "is_completed" field if the given value is not nil.
-func (tc *TaskCreate) SetNillableIsCompleted(b *bool) *TaskCreate {
-	if b != nil {
-		tc.SetIsCompleted(*b)
+// SetNillableStatusID sets the "status_id" field if the given value is not nil.
+func (tc *TaskCreate) SetNillableStatusID(u *uuid.UUID) *TaskCreate {
+	if u != nil {
+		tc.SetStatusID(*u)
+	}
+	return tc
+}
+
+// SetStatusCategory sets the "status_category" field.
+func (tc *TaskCreate) SetStatusCategory(value task.StatusCategory) *TaskCreate {
+	tc.mutation.SetStatusCategory(value)
+	return tc
+}
+
+// SetNillableStatusCategory sets the "status_category" field if the given value is not nil.
+func (tc *TaskCreate) SetNillableStatusCategory(value *task.StatusCategory) *TaskCreate {
+	if value != nil {
+		tc.SetStatusCategory(*value)
+	}
+	return tc
+}
+
+// SetProjectID sets the "project_id" field.
+func (tc *TaskCreate) SetProjectID(u uuid.UUID) *TaskCreate {
+	tc.mutation.SetProjectID(u)
+	return tc
+}
+
+// SetNillableProjectID sets the "project_id" field if the given value is not nil.
+func (tc *TaskCreate) SetNillableProjectID(u *uuid.UUID) *TaskCreate {
+	if u != nil {
+		tc.SetProjectID(*u)
 	}
 	return tc
 }
@@ -83,6 +115,278 @@ func (tc *TaskCreate) SetNillableCreatedAt(t *time.Time) *TaskCreate {
 	return tc
 }
 
+// SetParentID sets the "parent_id" field.
+func (tc *TaskCreate) SetParentID(u uuid.UUID) *TaskCreate {
+	tc.mutation.SetParentID(u)
+	return tc
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (tc *TaskCreate) SetNillableParentID(u *uuid.UUID) *TaskCreate {
+	if u != nil {
+		tc.SetParentID(*u)
+	}
+	return tc
+}
+
+// SetPosition sets the "position" field.
+func (tc *TaskCreate) SetPosition(i int) *TaskCreate {
+	tc.mutation.SetPosition(i)
+	return tc
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (tc *TaskCreate) SetNillablePosition(i *int) *TaskCreate {
+	if i != nil {
+		tc.SetPosition(*i)
+	}
+	return tc
+}
+
+// SetStartAt sets the "start_at" field.
+func (tc *TaskCreate) SetStartAt(t time.Time) *TaskCreate {
+	tc.mutation.SetStartAt(t)
+	return tc
+}
+
+// SetNillableStartAt sets the "start_at" field if the given value is not nil.
+func (tc *TaskCreate) SetNillableStartAt(t *time.Time) *TaskCreate {
+	if t != nil {
+		tc.SetStartAt(*t)
+	}
+	return tc
+}
+
+// SetDueAt sets the "due_at" field.
+func (tc *TaskCreate) SetDueAt(t time.Time) *TaskCreate {
+	tc.mutation.SetDueAt(t)
+	return tc
+}
+
+// SetNillableDueAt sets the "due_at" field if the given value is not nil.
+func (tc *TaskCreate) SetNillableDueAt(t *time.Time) *TaskCreate {
+	if t != nil {
+		tc.SetDueAt(*t)
+	}
+	return tc
+}
+
+// SetPriority sets the "priority" field.
+func (tc *TaskCreate) SetPriority(t task.Priority) *TaskCreate {
+	tc.mutation.SetPriority(t)
+	return tc
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (tc *TaskCreate) SetNillablePriority(t *task.Priority) *TaskCreate {
+	if t != nil {
+		tc.SetPriority(*t)
+	}
+	return tc
+}
+
+// SetEstimateMinutes sets the "estimate_minutes" field.
+func (tc *TaskCreate) SetEstimateMinutes(i int) *TaskCreate {
+	tc.mutation.SetEstimateMinutes(i)
+	return tc
+}
+
+// SetNillableEstimateMinutes sets the "estimate_minutes" field if the given value is not nil.
+func (tc *TaskCreate) SetNillableEstimateMinutes(i *int) *TaskCreate {
+	if i != nil {
+		tc.SetEstimateMinutes(*i)
+	}
+	return tc
+}
+
+// SetRecurrenceRule sets the "recurrence_rule" field.
+func (tc *TaskCreate) SetRecurrenceRule(s string) *TaskCreate {
+	tc.mutation.SetRecurrenceRule(s)
+	return tc
+}
+
+// SetNillableRecurrenceRule sets the "recurrence_rule" field if the given value is not nil.
+func (tc *TaskCreate) SetNillableRecurrenceRule(s *string) *TaskCreate {
+	if s != nil {
+		tc.SetRecurrenceRule(*s)
+	}
+	return tc
+}
+
+// SetRecurrenceTimezone sets the "recurrence_timezone" field.
+func (tc *TaskCreate) SetRecurrenceTimezone(s string) *TaskCreate {
+	tc.mutation.SetRecurrenceTimezone(s)
+	return tc
+}
+
+// SetNillableRecurrenceTimezone sets the "recurrence_timezone" field if the given value is not nil.
+func (tc *TaskCreate) SetNillableRecurrenceTimezone(s *string) *TaskCreate {
+	if s != nil {
+		tc.SetRecurrenceTimezone(*s)
+	}
+	return tc
+}
+
+// SetRecurrenceStart sets the "recurrence_start" field.
+func (tc *TaskCreate) SetRecurrenceStart(t time.Time) *TaskCreate {
+	tc.mutation.SetRecurrenceStart(t)
+	return tc
+}
+
+// SetNillableRecurrenceStart sets the "recurrence_start" field if the given value is not nil.
+func (tc *TaskCreate) SetNillableRecurrenceStart(t *time.Time) *TaskCreate {
+	if t != nil {
+		tc.SetRecurrenceStart(*t)
+	}
+	return tc
+}
+
+// SetRecurrencePaused sets the "recurrence_paused" field.
+func (tc *TaskCreate) SetRecurrencePaused(b bool) *TaskCreate {
+	tc.mutation.SetRecurrencePaused(b)
+	return tc
+}
+
+// SetNillableRecurrencePaused sets the "recurrence_paused" field if the given value is not nil.
+func (tc *TaskCreate) SetNillableRecurrencePaused(b *bool) *TaskCreate {
+	if b != nil {
+		tc.SetRecurrencePaused(*b)
+	}
+	return tc
+}
+
+// SetSeriesID sets the "series_id" field.
+func (tc *TaskCreate) SetSeriesID(u uuid.UUID) *TaskCreate {
+	tc.mutation.SetSeriesID(u)
+	return tc
+}
+
+// SetNillableSeriesID sets the "series_id" field if the given value is not nil.
+func (tc *TaskCreate) SetNillableSeriesID(u *uuid.UUID) *TaskCreate {
+	if u != nil {
+		tc.SetSeriesID(*u)
+	}
+	return tc
+}
+
+// SetLabels sets the "labels" field.
+func (tc *TaskCreate) SetLabels(s []string) *TaskCreate {
+	tc.mutation.SetLabels(s)
+	return tc
+}
+
+// SetRank sets the "rank" field.
+func (tc *TaskCreate) SetRank(s string) *TaskCreate {
+	tc.mutation.SetRank(s)
+	return tc
+}
+
+// SetNillableRank sets the "rank" field if the given value is not nil.
+func (tc *TaskCreate) SetNillableRank(s *string) *TaskCreate {
+	if s != nil {
+		tc.SetRank(*s)
+	}
+	return tc
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (tc *TaskCreate) SetCompletedAt(t time.Time) *TaskCreate {
+	tc.mutation.SetCompletedAt(t)
+	return tc
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (tc *TaskCreate) SetNillableCompletedAt(t *time.Time) *TaskCreate {
+	if t != nil {
+		tc.SetCompletedAt(*t)
+	}
+	return tc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (tc *TaskCreate) SetDeletedAt(t time.Time) *TaskCreate {
+	tc.mutation.SetDeletedAt(t)
+	return tc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (tc *TaskCreate) SetNillableDeletedAt(t *time.Time) *TaskCreate {
+	if t != nil {
+		tc.SetDeletedAt(*t)
+	}
+	return tc
+}
+
+// SetVersion sets the "version" field.
+func (tc *TaskCreate) SetVersion(i int) *TaskCreate {
+	tc.mutation.SetVersion(i)
+	return tc
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (tc *TaskCreate) SetNillableVersion(i *int) *TaskCreate {
+	if i != nil {
+		tc.SetVersion(*i)
+	}
+	return tc
+}
+
+// SetChangeSeq sets the "change_seq" field.
+func (tc *TaskCreate) SetChangeSeq(i int64) *TaskCreate {
+	tc.mutation.SetChangeSeq(i)
+	return tc
+}
+
+// SetNillableChangeSeq sets the "change_seq" field if the given value is not nil.
+func (tc *TaskCreate) SetNillableChangeSeq(i *int64) *TaskCreate {
+	if i != nil {
+		tc.SetChangeSeq(*i)
+	}
+	return tc
+}
+
+// SetExternalID sets the "external_id" field.
+func (tc *TaskCreate) SetExternalID(s string) *TaskCreate {
+	tc.mutation.SetExternalID(s)
+	return tc
+}
+
+// SetNillableExternalID sets the "external_id" field if the given value is not nil.
+func (tc *TaskCreate) SetNillableExternalID(s *string) *TaskCreate {
+	if s != nil {
+		tc.SetExternalID(*s)
+	}
+	return tc
+}
+
+// SetImportKey sets the "import_key" field.
+func (tc *TaskCreate) SetImportKey(s string) *TaskCreate {
+	tc.mutation.SetImportKey(s)
+	return tc
+}
+
+// SetNillableImportKey sets the "import_key" field if the given value is not nil.
+func (tc *TaskCreate) SetNillableImportKey(s *string) *TaskCreate {
+	if s != nil {
+		tc.SetImportKey(*s)
+	}
+	return tc
+}
+
+// SetIcalUID sets the "ical_uid" field.
+func (tc *TaskCreate) SetIcalUID(s string) *TaskCreate {
+	tc.mutation.SetIcalUID(s)
+	return tc
+}
+
+// SetNillableIcalUID sets the "ical_uid" field if the given value is not nil.
+func (tc *TaskCreate) SetNillableIcalUID(s *string) *TaskCreate {
+	if s != nil {
+		tc.SetIcalUID(*s)
+	}
+	return tc
+}
+
 // SetID sets the "id" field.
 func (tc *TaskCreate) SetID(u uuid.UUID) *TaskCreate {
 	tc.mutation.SetID(u)
@@ -97,6 +401,111 @@ func (tc *TaskCreate) SetNillableID(u *uuid.UUID) *TaskCreate {
 	return tc
 }
 
+// SetParent sets the "parent" edge to the Task entity.
+func (tc *TaskCreate) SetParent(t *Task) *TaskCreate {
+	return tc.SetParentID(t.ID)
+}
+
+// AddChildIDs adds the "children" edge to the Task entity by IDs.
+func (tc *TaskCreate) AddChildIDs(ids ...uuid.UUID) *TaskCreate {
+	tc.mutation.AddChildIDs(ids...)
+	return tc
+}
+
+// AddChildren adds the "children" edges to the Task entity.
+func (tc *TaskCreate) AddChildren(t ...*Task) *TaskCreate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tc.AddChildIDs(ids...)
+}
+
+// AddBlockedByIDs adds the "blocked_by" edge to the Task entity by IDs.
+func (tc *TaskCreate) AddBlockedByIDs(ids ...uuid.UUID) *TaskCreate {
+	tc.mutation.AddBlockedByIDs(ids...)
+	return tc
+}
+
+// AddBlockedBy adds the "blocked_by" edges to the Task entity.
+func (tc *TaskCreate) AddBlockedBy(t ...*Task) *TaskCreate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tc.AddBlockedByIDs(ids...)
+}
+
+// AddBlockIDs adds the "blocks" edge to the Task entity by IDs.
+func (tc *TaskCreate) AddBlockIDs(ids ...uuid.UUID) *TaskCreate {
+	tc.mutation.AddBlockIDs(ids...)
+	return tc
+}
+
+// AddBlocks adds the "blocks" edges to the Task entity.
+func (tc *TaskCreate) AddBlocks(t ...*Task) *TaskCreate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tc.AddBlockIDs(ids...)
+}
+
+// SetStatus sets the "status" edge to the WorkflowStatus entity.
+func (tc *TaskCreate) SetStatus(w *WorkflowStatus) *TaskCreate {
+	return tc.SetStatusID(w.ID)
+}
+
+// SetProject sets the "project" edge to the Project entity.
+func (tc *TaskCreate) SetProject(p *Project) *TaskCreate {
+	return tc.SetProjectID(p.ID)
+}
+
+// AddCommentIDs adds the "comments" edge to the Comment entity by IDs.
+func (tc *TaskCreate) AddCommentIDs(ids ...uuid.UUID) *TaskCreate {
+	tc.mutation.AddCommentIDs(ids...)
+	return tc
+}
+
+// AddComments adds the "comments" edges to the Comment entity.
+func (tc *TaskCreate) AddComments(c ...*Comment) *TaskCreate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return tc.AddCommentIDs(ids...)
+}
+
+// AddAssigneeIDs adds the "assignees" edge to the User entity by IDs.
+func (tc *TaskCreate) AddAssigneeIDs(ids ...uuid.UUID) *TaskCreate {
+	tc.mutation.AddAssigneeIDs(ids...)
+	return tc
+}
+
+// AddAssignees adds the "assignees" edges to the User entity.
+func (tc *TaskCreate) AddAssignees(u ...*User) *TaskCreate {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return tc.AddAssigneeIDs(ids...)
+}
+
+// AddWatcherIDs adds the "watchers" edge to the User entity by IDs.
+func (tc *TaskCreate) AddWatcherIDs(ids ...uuid.UUID) *TaskCreate {
+	tc.mutation.AddWatcherIDs(ids...)
+	return tc
+}
+
+// AddWatchers adds the "watchers" edges to the User entity.
+func (tc *TaskCreate) AddWatchers(u ...*User) *TaskCreate {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return tc.AddWatcherIDs(ids...)
+}
+
 // Mutation returns the TaskMutation object of the builder.
 func (tc *TaskCreate) Mutation() *TaskMutation {
 	return tc.mutation
@@ -132,14 +541,38 @@ func (tc *TaskCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (tc *TaskCreate) defaults() {
-	if _, ok := tc.mutation.IsCompleted(); !ok {
-		v := task.DefaultIsCompleted
-		tc.mutation.SetIsCompleted(v)
+	if _, ok := tc.mutation.StatusCategory(); !ok {
+		v := task.DefaultStatusCategory
+		tc.mutation.SetStatusCategory(v)
 	}
 	if _, ok := tc.mutation.CreatedAt(); !ok {
 		v := task.DefaultCreatedAt()
 		tc.mutation.SetCreatedAt(v)
 	}
+	if _, ok := tc.mutation.Position(); !ok {
+		v := task.DefaultPosition
+		tc.mutation.SetPosition(v)
+	}
+	if _, ok := tc.mutation.Priority(); !ok {
+		v := task.DefaultPriority
+		tc.mutation.SetPriority(v)
+	}
+	if _, ok := tc.mutation.RecurrencePaused(); !ok {
+		v := task.DefaultRecurrencePaused
+		tc.mutation.SetRecurrencePaused(v)
+	}
+	if _, ok := tc.mutation.Rank(); !ok {
+		v := task.DefaultRank
+		tc.mutation.SetRank(v)
+	}
+	if _, ok := tc.mutation.Version(); !ok {
+		v := task.DefaultVersion
+		tc.mutation.SetVersion(v)
+	}
+	if _, ok := tc.mutation.ChangeSeq(); !ok {
+		v := task.DefaultChangeSeq
+		tc.mutation.SetChangeSeq(v)
+	}
 	if _, ok := tc.mutation.ID(); !ok {
 		v := task.DefaultID()
 		tc.mutation.SetID(v)
@@ -151,12 +584,45 @@ func (tc *TaskCreate) check() error {
 	if _, ok := tc.mutation.Title(); !ok {
 		return &ValidationError{Name: "title", err: errors.New(`ent: missing required field "Task.title"`)}
 	}
-	if _, ok := tc.mutation.IsCompleted(); !ok {
-		return &ValidationError{Name: "is_completed", err: errors.New(`ent: missing required field "Task.is_completed"`)}
+	if _, ok := tc.mutation.StatusCategory(); !ok {
+		return &ValidationError{Name: "status_category", err: errors.New(`ent: missing required field "Task.status_category"`)}
+	}
+	if v, ok := tc.mutation.StatusCategory(); ok {
+		if err := task.StatusCategoryValidator(v); err != nil {
+			return &ValidationError{Name: "status_category", err: fmt.Errorf(`ent: validator failed for field "Task.status_category": %w`, err)}
+		}
 	}
 	if _, ok := tc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Task.created_at"`)}
 	}
+	if _, ok := tc.mutation.Position(); !ok {
+		return &ValidationError{Name: "position", err: errors.New(`ent: missing required field "Task.position"`)}
+	}
+	if _, ok := tc.mutation.Priority(); !ok {
+		return &ValidationError{Name: "priority", err: errors.New(`ent: missing required field "Task.priority"`)}
+	}
+	if v, ok := tc.mutation.Priority(); ok {
+		if err := task.PriorityValidator(v); err != nil {
+			return &ValidationError{Name: "priority", err: fmt.Errorf(`ent: validator failed for field "Task.priority": %w`, err)}
+		}
+	}
+	if v, ok := tc.mutation.EstimateMinutes(); ok {
+		if err := task.EstimateMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "estimate_minutes", err: fmt.Errorf(`ent: validator failed for field "Task.estimate_minutes": %w`, err)}
+		}
+	}
+	if _, ok := tc.mutation.RecurrencePaused(); !ok {
+		return &ValidationError{Name: "recurrence_paused", err: errors.New(`ent: missing required field "Task.recurrence_paused"`)}
+	}
+	if _, ok := tc.mutation.Rank(); !ok {
+		return &ValidationError{Name: "rank", err: errors.New(`ent: missing required field "Task.rank"`)}
+	}
+	if _, ok := tc.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "Task.version"`)}
+	}
+	if _, ok := tc.mutation.ChangeSeq(); !ok {
+		return &ValidationError{Name: "change_seq", err: errors.New(`ent: missing required field "Task.change_seq"`)}
+	}
 	return nil
 }
 
@@ -200,9 +666,9 @@ func (tc *TaskCreate) createSpec() (*Task, *sqlgraph.CreateSpec) {
 		_spec.SetField(task.FieldDescription, field.TypeString, value)
 		_node.Description = value
 	}
-	if value, ok := tc.mutation.IsCompleted(); ok {
-		_spec.SetField(task.FieldIsCompleted, field.TypeBool, value)
-		_node.IsCompleted = value
+	if value, ok := tc.mutation.StatusCategory(); ok {
+		_spec.SetField(task.FieldStatusCategory, field.TypeEnum, value)
+		_node.StatusCategory = value
 	}
 	if value, ok := tc.mutation.AttachmentURL(); ok {
 		_spec.SetField(task.FieldAttachmentURL, field.TypeString, value)
@@ -212,6 +678,229 @@ func (tc *TaskCreate) createSpec() (*Task, *sqlgraph.CreateSpec) {
 		_spec.SetField(task.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
 	}
+	if value, ok := tc.mutation.Position(); ok {
+		_spec.SetField(task.FieldPosition, field.TypeInt, value)
+		_node.Position = value
+	}
+	if value, ok := tc.mutation.StartAt(); ok {
+		_spec.SetField(task.FieldStartAt, field.TypeTime, value)
+		_node.StartAt = &value
+	}
+	if value, ok := tc.mutation.DueAt(); ok {
+		_spec.SetField(task.FieldDueAt, field.TypeTime, value)
+		_node.DueAt = &value
+	}
+	if value, ok := tc.mutation.Priority(); ok {
+		_spec.SetField(task.FieldPriority, field.TypeEnum, value)
+		_node.Priority = value
+	}
+	if value, ok := tc.mutation.EstimateMinutes(); ok {
+		_spec.SetField(task.FieldEstimateMinutes, field.TypeInt, value)
+		_node.EstimateMinutes = &value
+	}
+	if value, ok := tc.mutation.RecurrenceRule(); ok {
+		_spec.SetField(task.FieldRecurrenceRule, field.TypeString, value)
+		_node.RecurrenceRule = &value
+	}
+	if value, ok := tc.mutation.RecurrenceTimezone(); ok {
+		_spec.SetField(task.FieldRecurrenceTimezone, field.TypeString, value)
+		_node.RecurrenceTimezone = value
+	}
+	if value, ok := tc.mutation.RecurrenceStart(); ok {
+		_spec.SetField(task.FieldRecurrenceStart, field.TypeTime, value)
+		_node.RecurrenceStart = &value
+	}
+	if value, ok := tc.mutation.RecurrencePaused(); ok {
+		_spec.SetField(task.FieldRecurrencePaused, field.TypeBool, value)
+		_node.RecurrencePaused = value
+	}
+	if value, ok := tc.mutation.SeriesID(); ok {
+		_spec.SetField(task.FieldSeriesID, field.TypeUUID, value)
+		_node.SeriesID = &value
+	}
+	if value, ok := tc.mutation.Labels(); ok {
+		_spec.SetField(task.FieldLabels, field.TypeJSON, value)
+		_node.Labels = value
+	}
+	if value, ok := tc.mutation.Rank(); ok {
+		_spec.SetField(task.FieldRank, field.TypeString, value)
+		_node.Rank = value
+	}
+	if value, ok := tc.mutation.CompletedAt(); ok {
+		_spec.SetField(task.FieldCompletedAt, field.TypeTime, value)
+		_node.CompletedAt = &value
+	}
+	if value, ok := tc.mutation.DeletedAt(); ok {
+		_spec.SetField(task.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := tc.mutation.Version(); ok {
+		_spec.SetField(task.FieldVersion, field.TypeInt, value)
+		_node.Version = value
+	}
+	if value, ok := tc.mutation.ChangeSeq(); ok {
+		_spec.SetField(task.FieldChangeSeq, field.TypeInt64, value)
+		_node.ChangeSeq = value
+	}
+	if value, ok := tc.mutation.ExternalID(); ok {
+		_spec.SetField(task.FieldExternalID, field.TypeString, value)
+		_node.ExternalID = &value
+	}
+	if value, ok := tc.mutation.ImportKey(); ok {
+		_spec.SetField(task.FieldImportKey, field.TypeString, value)
+		_node.ImportKey = &value
+	}
+	if value, ok := tc.mutation.IcalUID(); ok {
+		_spec.SetField(task.FieldIcalUID, field.TypeString, value)
+		_node.IcalUID = &value
+	}
+	if nodes := tc.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   task.ParentTable,
+			Columns: []string{task.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ParentID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tc.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.ChildrenTable,
+			Columns: []string{task.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tc.mutation.BlockedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   task.BlockedByTable,
+			Columns: task.BlockedByPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tc.mutation.BlocksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   task.BlocksTable,
+			Columns: task.BlocksPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tc.mutation.StatusIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   task.StatusTable,
+			Columns: []string{task.StatusColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workflowstatus.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.StatusID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tc.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   task.ProjectTable,
+			Columns: []string{task.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ProjectID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tc.mutation.CommentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.CommentsTable,
+			Columns: []string{task.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tc.mutation.AssigneesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   task.AssigneesTable,
+			Columns: task.AssigneesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tc.mutation.WatchersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   task.WatchersTable,
+			Columns: task.WatchersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
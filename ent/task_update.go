@@ -10,16 +10,23 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/ent/comment"
 	"github.com/localopsco/go-sample/ent/predicate"
+	"github.com/localopsco/go-sample/ent/project"
 	"github.com/localopsco/go-sample/ent/task"
+	"github.com/localopsco/go-sample/ent/user"
+	"github.com/localopsco/go-sample/ent/workflowstatus"
 )
 
 // TaskUpdate is the builder for updating Task entities.
 type TaskUpdate struct {
 	config
-	hooks    []Hook
-	mutation *TaskMutation
+	hooks     []Hook
+	mutation  *TaskMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the TaskUpdate builder.
@@ -62,221 +69,1951 @@ func (tu *TaskUpdate) ClearDescription() *TaskUpdate {
 	return tu
 }
 
-// SetIsCompleted sets the "is_completed" field.
-func (tu *TaskUpdate) SetIsCompleted(b bool) *TaskUpdate {
-	tu.mutation.SetIsCompleted(b)
+// SetStatusID sets the "status_id" field.
+func (tu *TaskUpdate) SetStatusID(u uuid.UUID) *TaskUpdate {
+	tu.mutation.SetStatusID(u)
 	return tu
 }
 
-// SetNillableIsCompleted sets the "is_completed" field if the given value is not nil.
-func (tu *TaskUpdate) SetNillableIsCompleted(b *bool) *TaskUpdate {
-	if b != nil {
-		tu.SetIsCompleted(*b)
+// SetNillableStatusID sets the "status_id" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableStatusID(u *uuid.UUID) *TaskUpdate {
+	if u != nil {
+		tu.SetStatusID(*u)
+	}
+	return tu
+}
+
+// ClearStatusID clears the value of the "status_id" field.
+func (tu *TaskUpdate) ClearStatusID() *TaskUpdate {
+	tu.mutation.ClearStatusID()
+	return tu
+}
+
+// SetStatusCategory sets the "status_category" field.
+func (tu *TaskUpdate) SetStatusCategory(tc task.StatusCategory) *TaskUpdate {
+	tu.mutation.SetStatusCategory(tc)
+	return tu
+}
+
+// SetNillableStatusCategory sets the "status_category" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableStatusCategory(tc *task.StatusCategory) *TaskUpdate {
+	if tc != nil {
+		tu.SetStatusCategory(*tc)
+	}
+	return tu
+}
+
+// SetProjectID sets the "project_id" field.
+func (tu *TaskUpdate) SetProjectID(u uuid.UUID) *TaskUpdate {
+	tu.mutation.SetProjectID(u)
+	return tu
+}
+
+// SetNillableProjectID sets the "project_id" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableProjectID(u *uuid.UUID) *TaskUpdate {
+	if u != nil {
+		tu.SetProjectID(*u)
 	}
 	return tu
 }
 
+// ClearProjectID clears the value of the "project_id" field.
+func (tu *TaskUpdate) ClearProjectID() *TaskUpdate {
+	tu.mutation.ClearProjectID()
+	return tu
+}
+
 // SetAttachmentURL sets the "attachment_url" field.
 func (tu *TaskUpdate) SetAttachmentURL(s string) *TaskUpdate {
 	tu.mutation.SetAttachmentURL(s)
 	return tu
 }
 
-// SetNillableAttachmentURL sets the "attachment_url" field if the given value is not nil.
-func (tu *TaskUpdate) SetNillableAttachmentURL(s *string) *TaskUpdate {
-	if s != nil {
-		tu.SetAttachmentURL(*s)
+// SetNillableAttachmentURL sets the "attachment_url" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableAttachmentURL(s *string) *TaskUpdate {
+	if s != nil {
+		tu.SetAttachmentURL(*s)
+	}
+	return tu
+}
+
+// ClearAttachmentURL clears the value of the "attachment_url" field.
+func (tu *TaskUpdate) ClearAttachmentURL() *TaskUpdate {
+	tu.mutation.ClearAttachmentURL()
+	return tu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tu *TaskUpdate) SetCreatedAt(t time.Time) *TaskUpdate {
+	tu.mutation.SetCreatedAt(t)
+	return tu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableCreatedAt(t *time.Time) *TaskUpdate {
+	if t != nil {
+		tu.SetCreatedAt(*t)
+	}
+	return tu
+}
+
+// SetParentID sets the "parent_id" field.
+func (tu *TaskUpdate) SetParentID(u uuid.UUID) *TaskUpdate {
+	tu.mutation.SetParentID(u)
+	return tu
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableParentID(u *uuid.UUID) *TaskUpdate {
+	if u != nil {
+		tu.SetParentID(*u)
+	}
+	return tu
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (tu *TaskUpdate) ClearParentID() *TaskUpdate {
+	tu.mutation.ClearParentID()
+	return tu
+}
+
+// SetPosition sets the "position" field.
+func (tu *TaskUpdate) SetPosition(i int) *TaskUpdate {
+	tu.mutation.ResetPosition()
+	tu.mutation.SetPosition(i)
+	return tu
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillablePosition(i *int) *TaskUpdate {
+	if i != nil {
+		tu.SetPosition(*i)
+	}
+	return tu
+}
+
+// AddPosition adds i to the "position" field.
+func (tu *TaskUpdate) AddPosition(i int) *TaskUpdate {
+	tu.mutation.AddPosition(i)
+	return tu
+}
+
+// SetStartAt sets the "start_at" field.
+func (tu *TaskUpdate) SetStartAt(t time.Time) *TaskUpdate {
+	tu.mutation.SetStartAt(t)
+	return tu
+}
+
+// SetNillableStartAt sets the "start_at" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableStartAt(t *time.Time) *TaskUpdate {
+	if t != nil {
+		tu.SetStartAt(*t)
+	}
+	return tu
+}
+
+// ClearStartAt clears the value of the "start_at" field.
+func (tu *TaskUpdate) ClearStartAt() *TaskUpdate {
+	tu.mutation.ClearStartAt()
+	return tu
+}
+
+// SetDueAt sets the "due_at" field.
+func (tu *TaskUpdate) SetDueAt(t time.Time) *TaskUpdate {
+	tu.mutation.SetDueAt(t)
+	return tu
+}
+
+// SetNillableDueAt sets the "due_at" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableDueAt(t *time.Time) *TaskUpdate {
+	if t != nil {
+		tu.SetDueAt(*t)
+	}
+	return tu
+}
+
+// ClearDueAt clears the value of the "due_at" field.
+func (tu *TaskUpdate) ClearDueAt() *TaskUpdate {
+	tu.mutation.ClearDueAt()
+	return tu
+}
+
+// SetPriority sets the "priority" field.
+func (tu *TaskUpdate) SetPriority(t task.Priority) *TaskUpdate {
+	tu.mutation.SetPriority(t)
+	return tu
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillablePriority(t *task.Priority) *TaskUpdate {
+	if t != nil {
+		tu.SetPriority(*t)
+	}
+	return tu
+}
+
+// SetEstimateMinutes sets the "estimate_minutes" field.
+func (tu *TaskUpdate) SetEstimateMinutes(i int) *TaskUpdate {
+	tu.mutation.ResetEstimateMinutes()
+	tu.mutation.SetEstimateMinutes(i)
+	return tu
+}
+
+// SetNillableEstimateMinutes sets the "estimate_minutes" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableEstimateMinutes(i *int) *TaskUpdate {
+	if i != nil {
+		tu.SetEstimateMinutes(*i)
+	}
+	return tu
+}
+
+// AddEstimateMinutes adds i to the "estimate_minutes" field.
+func (tu *TaskUpdate) AddEstimateMinutes(i int) *TaskUpdate {
+	tu.mutation.AddEstimateMinutes(i)
+	return tu
+}
+
+// ClearEstimateMinutes clears the value of the "estimate_minutes" field.
+func (tu *TaskUpdate) ClearEstimateMinutes() *TaskUpdate {
+	tu.mutation.ClearEstimateMinutes()
+	return tu
+}
+
+// SetRecurrenceRule sets the "recurrence_rule" field.
+func (tu *TaskUpdate) SetRecurrenceRule(s string) *TaskUpdate {
+	tu.mutation.SetRecurrenceRule(s)
+	return tu
+}
+
+// SetNillableRecurrenceRule sets the "recurrence_rule" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableRecurrenceRule(s *string) *TaskUpdate {
+	if s != nil {
+		tu.SetRecurrenceRule(*s)
+	}
+	return tu
+}
+
+// ClearRecurrenceRule clears the value of the "recurrence_rule" field.
+func (tu *TaskUpdate) ClearRecurrenceRule() *TaskUpdate {
+	tu.mutation.ClearRecurrenceRule()
+	return tu
+}
+
+// SetRecurrenceTimezone sets the "recurrence_timezone" field.
+func (tu *TaskUpdate) SetRecurrenceTimezone(s string) *TaskUpdate {
+	tu.mutation.SetRecurrenceTimezone(s)
+	return tu
+}
+
+// SetNillableRecurrenceTimezone sets the "recurrence_timezone" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableRecurrenceTimezone(s *string) *TaskUpdate {
+	if s != nil {
+		tu.SetRecurrenceTimezone(*s)
+	}
+	return tu
+}
+
+// ClearRecurrenceTimezone clears the value of the "recurrence_timezone" field.
+func (tu *TaskUpdate) ClearRecurrenceTimezone() *TaskUpdate {
+	tu.mutation.ClearRecurrenceTimezone()
+	return tu
+}
+
+// SetRecurrenceStart sets the "recurrence_start" field.
+func (tu *TaskUpdate) SetRecurrenceStart(t time.Time) *TaskUpdate {
+	tu.mutation.SetRecurrenceStart(t)
+	return tu
+}
+
+// SetNillableRecurrenceStart sets the "recurrence_start" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableRecurrenceStart(t *time.Time) *TaskUpdate {
+	if t != nil {
+		tu.SetRecurrenceStart(*t)
+	}
+	return tu
+}
+
+// ClearRecurrenceStart clears the value of the "recurrence_start" field.
+func (tu *TaskUpdate) ClearRecurrenceStart() *TaskUpdate {
+	tu.mutation.ClearRecurrenceStart()
+	return tu
+}
+
+// SetRecurrencePaused sets the "recurrence_paused" field.
+func (tu *TaskUpdate) SetRecurrencePaused(b bool) *TaskUpdate {
+	tu.mutation.SetRecurrencePaused(b)
+	return tu
+}
+
+// SetNillableRecurrencePaused sets the "recurrence_paused" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableRecurrencePaused(b *bool) *TaskUpdate {
+	if b != nil {
+		tu.SetRecurrencePaused(*b)
+	}
+	return tu
+}
+
+// SetSeriesID sets the "series_id" field.
+func (tu *TaskUpdate) SetSeriesID(u uuid.UUID) *TaskUpdate {
+	tu.mutation.SetSeriesID(u)
+	return tu
+}
+
+// SetNillableSeriesID sets the "series_id" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableSeriesID(u *uuid.UUID) *TaskUpdate {
+	if u != nil {
+		tu.SetSeriesID(*u)
+	}
+	return tu
+}
+
+// ClearSeriesID clears the value of the "series_id" field.
+func (tu *TaskUpdate) ClearSeriesID() *TaskUpdate {
+	tu.mutation.ClearSeriesID()
+	return tu
+}
+
+// SetLabels sets the "labels" field.
+func (tu *TaskUpdate) SetLabels(s []string) *TaskUpdate {
+	tu.mutation.SetLabels(s)
+	return tu
+}
+
+// AppendLabels appends s to the "labels" field.
+func (tu *TaskUpdate) AppendLabels(s []string) *TaskUpdate {
+	tu.mutation.AppendLabels(s)
+	return tu
+}
+
+// ClearLabels clears the value of the "labels" field.
+func (tu *TaskUpdate) ClearLabels() *TaskUpdate {
+	tu.mutation.ClearLabels()
+	return tu
+}
+
+// SetRank sets the "rank" field.
+func (tu *TaskUpdate) SetRank(s string) *TaskUpdate {
+	tu.mutation.SetRank(s)
+	return tu
+}
+
+// SetNillableRank sets the "rank" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableRank(s *string) *TaskUpdate {
+	if s != nil {
+		tu.SetRank(*s)
+	}
+	return tu
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (tu *TaskUpdate) SetCompletedAt(t time.Time) *TaskUpdate {
+	tu.mutation.SetCompletedAt(t)
+	return tu
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableCompletedAt(t *time.Time) *TaskUpdate {
+	if t != nil {
+		tu.SetCompletedAt(*t)
+	}
+	return tu
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (tu *TaskUpdate) ClearCompletedAt() *TaskUpdate {
+	tu.mutation.ClearCompletedAt()
+	return tu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (tu *TaskUpdate) SetDeletedAt(t time.Time) *TaskUpdate {
+	tu.mutation.SetDeletedAt(t)
+	return tu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableDeletedAt(t *time.Time) *TaskUpdate {
+	if t != nil {
+		tu.SetDeletedAt(*t)
+	}
+	return tu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (tu *TaskUpdate) ClearDeletedAt() *TaskUpdate {
+	tu.mutation.ClearDeletedAt()
+	return tu
+}
+
+// SetVersion sets the "version" field.
+func (tu *TaskUpdate) SetVersion(i int) *TaskUpdate {
+	tu.mutation.ResetVersion()
+	tu.mutation.SetVersion(i)
+	return tu
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableVersion(i *int) *TaskUpdate {
+	if i != nil {
+		tu.SetVersion(*i)
+	}
+	return tu
+}
+
+// AddVersion adds i to the "version" field.
+func (tu *TaskUpdate) AddVersion(i int) *TaskUpdate {
+	tu.mutation.AddVersion(i)
+	return tu
+}
+
+// SetChangeSeq sets the "change_seq" field.
+func (tu *TaskUpdate) SetChangeSeq(i int64) *TaskUpdate {
+	tu.mutation.ResetChangeSeq()
+	tu.mutation.SetChangeSeq(i)
+	return tu
+}
+
+// SetNillableChangeSeq sets the "change_seq" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableChangeSeq(i *int64) *TaskUpdate {
+	if i != nil {
+		tu.SetChangeSeq(*i)
+	}
+	return tu
+}
+
+// AddChangeSeq adds i to the "change_seq" field.
+func (tu *TaskUpdate) AddChangeSeq(i int64) *TaskUpdate {
+	tu.mutation.AddChangeSeq(i)
+	return tu
+}
+
+// SetParent sets the "parent" edge to the Task entity.
+func (tu *TaskUpdate) SetParent(t *Task) *TaskUpdate {
+	return tu.SetParentID(t.ID)
+}
+
+// AddChildIDs adds the "children" edge to the Task entity by IDs.
+func (tu *TaskUpdate) AddChildIDs(ids ...uuid.UUID) *TaskUpdate {
+	tu.mutation.AddChildIDs(ids...)
+	return tu
+}
+
+// AddChildren adds the "children" edges to the Task entity.
+func (tu *TaskUpdate) AddChildren(t ...*Task) *TaskUpdate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tu.AddChildIDs(ids...)
+}
+
+// AddBlockedByIDs adds the "blocked_by" edge to the Task entity by IDs.
+func (tu *TaskUpdate) AddBlockedByIDs(ids ...uuid.UUID) *TaskUpdate {
+	tu.mutation.AddBlockedByIDs(ids...)
+	return tu
+}
+
+// AddBlockedBy adds the "blocked_by" edges to the Task entity.
+func (tu *TaskUpdate) AddBlockedBy(t ...*Task) *TaskUpdate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tu.AddBlockedByIDs(ids...)
+}
+
+// AddBlockIDs adds the "blocks" edge to the Task entity by IDs.
+func (tu *TaskUpdate) AddBlockIDs(ids ...uuid.UUID) *TaskUpdate {
+	tu.mutation.AddBlockIDs(ids...)
+	return tu
+}
+
+// AddBlocks adds the "blocks" edges to the Task entity.
+func (tu *TaskUpdate) AddBlocks(t ...*Task) *TaskUpdate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tu.AddBlockIDs(ids...)
+}
+
+// SetStatus sets the "status" edge to the WorkflowStatus entity.
+func (tu *TaskUpdate) SetStatus(w *WorkflowStatus) *TaskUpdate {
+	return tu.SetStatusID(w.ID)
+}
+
+// SetProject sets the "project" edge to the Project entity.
+func (tu *TaskUpdate) SetProject(p *Project) *TaskUpdate {
+	return tu.SetProjectID(p.ID)
+}
+
+// AddCommentIDs adds the "comments" edge to the Comment entity by IDs.
+func (tu *TaskUpdate) AddCommentIDs(ids ...uuid.UUID) *TaskUpdate {
+	tu.mutation.AddCommentIDs(ids...)
+	return tu
+}
+
+// AddComments adds the "comments" edges to the Comment entity.
+func (tu *TaskUpdate) AddComments(c ...*Comment) *TaskUpdate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return tu.AddCommentIDs(ids...)
+}
+
+// AddAssigneeIDs adds the "assignees" edge to the User entity by IDs.
+func (tu *TaskUpdate) AddAssigneeIDs(ids ...uuid.UUID) *TaskUpdate {
+	tu.mutation.AddAssigneeIDs(ids...)
+	return tu
+}
+
+// AddAssignees adds the "assignees" edges to the User entity.
+func (tu *TaskUpdate) AddAssignees(u ...*User) *TaskUpdate {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return tu.AddAssigneeIDs(ids...)
+}
+
+// AddWatcherIDs adds the "watchers" edge to the User entity by IDs.
+func (tu *TaskUpdate) AddWatcherIDs(ids ...uuid.UUID) *TaskUpdate {
+	tu.mutation.AddWatcherIDs(ids...)
+	return tu
+}
+
+// AddWatchers adds the "watchers" edges to the User entity.
+func (tu *TaskUpdate) AddWatchers(u ...*User) *TaskUpdate {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return tu.AddWatcherIDs(ids...)
+}
+
+// Mutation returns the TaskMutation object of the builder.
+func (tu *TaskUpdate) Mutation() *TaskMutation {
+	return tu.mutation
+}
+
+// ClearParent clears the "parent" edge to the Task entity.
+func (tu *TaskUpdate) ClearParent() *TaskUpdate {
+	tu.mutation.ClearParent()
+	return tu
+}
+
+// ClearChildren clears all "children" edges to the Task entity.
+func (tu *TaskUpdate) ClearChildren() *TaskUpdate {
+	tu.mutation.ClearChildren()
+	return tu
+}
+
+// RemoveChildIDs removes the "children" edge to Task entities by IDs.
+func (tu *TaskUpdate) RemoveChildIDs(ids ...uuid.UUID) *TaskUpdate {
+	tu.mutation.RemoveChildIDs(ids...)
+	return tu
+}
+
+// RemoveChildren removes "children" edges to Task entities.
+func (tu *TaskUpdate) RemoveChildren(t ...*Task) *TaskUpdate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tu.RemoveChildIDs(ids...)
+}
+
+// ClearBlockedBy clears all "blocked_by" edges to the Task entity.
+func (tu *TaskUpdate) ClearBlockedBy() *TaskUpdate {
+	tu.mutation.ClearBlockedBy()
+	return tu
+}
+
+// RemoveBlockedByIDs removes the "blocked_by" edge to Task entities by IDs.
+func (tu *TaskUpdate) RemoveBlockedByIDs(ids ...uuid.UUID) *TaskUpdate {
+	tu.mutation.RemoveBlockedByIDs(ids...)
+	return tu
+}
+
+// RemoveBlockedBy removes "blocked_by" edges to Task entities.
+func (tu *TaskUpdate) RemoveBlockedBy(t ...*Task) *TaskUpdate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tu.RemoveBlockedByIDs(ids...)
+}
+
+// ClearBlocks clears all "blocks" edges to the Task entity.
+func (tu *TaskUpdate) ClearBlocks() *TaskUpdate {
+	tu.mutation.ClearBlocks()
+	return tu
+}
+
+// RemoveBlockIDs removes the "blocks" edge to Task entities by IDs.
+func (tu *TaskUpdate) RemoveBlockIDs(ids ...uuid.UUID) *TaskUpdate {
+	tu.mutation.RemoveBlockIDs(ids...)
+	return tu
+}
+
+// RemoveBlocks removes "blocks" edges to Task entities.
+func (tu *TaskUpdate) RemoveBlocks(t ...*Task) *TaskUpdate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tu.RemoveBlockIDs(ids...)
+}
+
+// ClearStatus clears the "status" edge to the WorkflowStatus entity.
+func (tu *TaskUpdate) ClearStatus() *TaskUpdate {
+	tu.mutation.ClearStatus()
+	return tu
+}
+
+// ClearProject clears the "project" edge to the Project entity.
+func (tu *TaskUpdate) ClearProject() *TaskUpdate {
+	tu.mutation.ClearProject()
+	return tu
+}
+
+// ClearComments clears all "comments" edges to the Comment entity.
+func (tu *TaskUpdate) ClearComments() *TaskUpdate {
+	tu.mutation.ClearComments()
+	return tu
+}
+
+// RemoveCommentIDs removes the "comments" edge to Comment entities by IDs.
+func (tu *TaskUpdate) RemoveCommentIDs(ids ...uuid.UUID) *TaskUpdate {
+	tu.mutation.RemoveCommentIDs(ids...)
+	return tu
+}
+
+// RemoveComments removes "comments" edges to Comment entities.
+func (tu *TaskUpdate) RemoveComments(c ...*Comment) *TaskUpdate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return tu.RemoveCommentIDs(ids...)
+}
+
+// ClearAssignees clears all "assignees" edges to the User entity.
+func (tu *TaskUpdate) ClearAssignees() *TaskUpdate {
+	tu.mutation.ClearAssignees()
+	return tu
+}
+
+// RemoveAssigneeIDs removes the "assignees" edge to User entities by IDs.
+func (tu *TaskUpdate) RemoveAssigneeIDs(ids ...uuid.UUID) *TaskUpdate {
+	tu.mutation.RemoveAssigneeIDs(ids...)
+	return tu
+}
+
+// RemoveAssignees removes "assignees" edges to User entities.
+func (tu *TaskUpdate) RemoveAssignees(u ...*User) *TaskUpdate {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return tu.RemoveAssigneeIDs(ids...)
+}
+
+// ClearWatchers clears all "watchers" edges to the User entity.
+func (tu *TaskUpdate) ClearWatchers() *TaskUpdate {
+	tu.mutation.ClearWatchers()
+	return tu
+}
+
+// RemoveWatcherIDs removes the "watchers" edge to User entities by IDs.
+func (tu *TaskUpdate) RemoveWatcherIDs(ids ...uuid.UUID) *TaskUpdate {
+	tu.mutation.RemoveWatcherIDs(ids...)
+	return tu
+}
+
+// RemoveWatchers removes "watchers" edges to User entities.
+func (tu *TaskUpdate) RemoveWatchers(u ...*User) *TaskUpdate {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return tu.RemoveWatcherIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (tu *TaskUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, tu.sqlSave, tu.mutation, tu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tu *TaskUpdate) SaveX(ctx context.Context) int {
+	affected, err := tu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (tu *TaskUpdate) Exec(ctx context.Context) error {
+	_, err := tu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tu *TaskUpdate) ExecX(ctx context.Context) {
+	if err := tu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tu *TaskUpdate) check() error {
+	if v, ok := tu.mutation.StatusCategory(); ok {
+		if err := task.StatusCategoryValidator(v); err != nil {
+			return &ValidationError{Name: "status_category", err: fmt.Errorf(`ent: validator failed for field "Task.status_category": %w`, err)}
+		}
+	}
+	if v, ok := tu.mutation.Priority(); ok {
+		if err := task.PriorityValidator(v); err != nil {
+			return &ValidationError{Name: "priority", err: fmt.Errorf(`ent: validator failed for field "Task.priority": %w`, err)}
+		}
+	}
+	if v, ok := tu.mutation.EstimateMinutes(); ok {
+		if err := task.EstimateMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "estimate_minutes", err: fmt.Errorf(`ent: validator failed for field "Task.estimate_minutes": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (tu *TaskUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TaskUpdate {
+	tu.modifiers = append(tu.modifiers, modifiers...)
+	return tu
+}
+
+func (tu *TaskUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := tu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(task.Table, task.Columns, sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID))
+	if ps := tu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tu.mutation.Title(); ok {
+		_spec.SetField(task.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := tu.mutation.Description(); ok {
+		_spec.SetField(task.FieldDescription, field.TypeString, value)
+	}
+	if tu.mutation.DescriptionCleared() {
+		_spec.ClearField(task.FieldDescription, field.TypeString)
+	}
+	if value, ok := tu.mutation.StatusCategory(); ok {
+		_spec.SetField(task.FieldStatusCategory, field.TypeEnum, value)
+	}
+	if value, ok := tu.mutation.AttachmentURL(); ok {
+		_spec.SetField(task.FieldAttachmentURL, field.TypeString, value)
+	}
+	if tu.mutation.AttachmentURLCleared() {
+		_spec.ClearField(task.FieldAttachmentURL, field.TypeString)
+	}
+	if value, ok := tu.mutation.CreatedAt(); ok {
+		_spec.SetField(task.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := tu.mutation.Position(); ok {
+		_spec.SetField(task.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := tu.mutation.AddedPosition(); ok {
+		_spec.AddField(task.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := tu.mutation.StartAt(); ok {
+		_spec.SetField(task.FieldStartAt, field.TypeTime, value)
+	}
+	if tu.mutation.StartAtCleared() {
+		_spec.ClearField(task.FieldStartAt, field.TypeTime)
+	}
+	if value, ok := tu.mutation.DueAt(); ok {
+		_spec.SetField(task.FieldDueAt, field.TypeTime, value)
+	}
+	if tu.mutation.DueAtCleared() {
+		_spec.ClearField(task.FieldDueAt, field.TypeTime)
+	}
+	if value, ok := tu.mutation.Priority(); ok {
+		_spec.SetField(task.FieldPriority, field.TypeEnum, value)
+	}
+	if value, ok := tu.mutation.EstimateMinutes(); ok {
+		_spec.SetField(task.FieldEstimateMinutes, field.TypeInt, value)
+	}
+	if value, ok := tu.mutation.AddedEstimateMinutes(); ok {
+		_spec.AddField(task.FieldEstimateMinutes, field.TypeInt, value)
+	}
+	if tu.mutation.EstimateMinutesCleared() {
+		_spec.ClearField(task.FieldEstimateMinutes, field.TypeInt)
+	}
+	if value, ok := tu.mutation.RecurrenceRule(); ok {
+		_spec.SetField(task.FieldRecurrenceRule, field.TypeString, value)
+	}
+	if tu.mutation.RecurrenceRuleCleared() {
+		_spec.ClearField(task.FieldRecurrenceRule, field.TypeString)
+	}
+	if value, ok := tu.mutation.RecurrenceTimezone(); ok {
+		_spec.SetField(task.FieldRecurrenceTimezone, field.TypeString, value)
+	}
+	if tu.mutation.RecurrenceTimezoneCleared() {
+		_spec.ClearField(task.FieldRecurrenceTimezone, field.TypeString)
+	}
+	if value, ok := tu.mutation.RecurrenceStart(); ok {
+		_spec.SetField(task.FieldRecurrenceStart, field.TypeTime, value)
+	}
+	if tu.mutation.RecurrenceStartCleared() {
+		_spec.ClearField(task.FieldRecurrenceStart, field.TypeTime)
+	}
+	if value, ok := tu.mutation.RecurrencePaused(); ok {
+		_spec.SetField(task.FieldRecurrencePaused, field.TypeBool, value)
+	}
+	if value, ok := tu.mutation.SeriesID(); ok {
+		_spec.SetField(task.FieldSeriesID, field.TypeUUID, value)
+	}
+	if tu.mutation.SeriesIDCleared() {
+		_spec.ClearField(task.FieldSeriesID, field.TypeUUID)
+	}
+	if value, ok := tu.mutation.Labels(); ok {
+		_spec.SetField(task.FieldLabels, field.TypeJSON, value)
+	}
+	if value, ok := tu.mutation.AppendedLabels(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, task.FieldLabels, value)
+		})
+	}
+	if tu.mutation.LabelsCleared() {
+		_spec.ClearField(task.FieldLabels, field.TypeJSON)
+	}
+	if value, ok := tu.mutation.Rank(); ok {
+		_spec.SetField(task.FieldRank, field.TypeString, value)
+	}
+	if value, ok := tu.mutation.CompletedAt(); ok {
+		_spec.SetField(task.FieldCompletedAt, field.TypeTime, value)
+	}
+	if tu.mutation.CompletedAtCleared() {
+		_spec.ClearField(task.FieldCompletedAt, field.TypeTime)
+	}
+	if value, ok := tu.mutation.DeletedAt(); ok {
+		_spec.SetField(task.FieldDeletedAt, field.TypeTime, value)
+	}
+	if tu.mutation.DeletedAtCleared() {
+		_spec.ClearField(task.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := tu.mutation.Version(); ok {
+		_spec.SetField(task.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := tu.mutation.AddedVersion(); ok {
+		_spec.AddField(task.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := tu.mutation.ChangeSeq(); ok {
+		_spec.SetField(task.FieldChangeSeq, field.TypeInt64, value)
+	}
+	if value, ok := tu.mutation.AddedChangeSeq(); ok {
+		_spec.AddField(task.FieldChangeSeq, field.TypeInt64, value)
+	}
+	if tu.mutation.ExternalIDCleared() {
+		_spec.ClearField(task.FieldExternalID, field.TypeString)
+	}
+	if tu.mutation.ImportKeyCleared() {
+		_spec.ClearField(task.FieldImportKey, field.TypeString)
+	}
+	if tu.mutation.IcalUIDCleared() {
+		_spec.ClearField(task.FieldIcalUID, field.TypeString)
+	}
+	if tu.mutation.ParentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   task.ParentTable,
+			Columns: []string{task.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   task.ParentTable,
+			Columns: []string{task.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tu.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.ChildrenTable,
+			Columns: []string{task.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemovedChildrenIDs(); len(nodes) > 0 && !tu.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.ChildrenTable,
+			Columns: []string{task.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.ChildrenTable,
+			Columns: []string{task.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tu.mutation.BlockedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   task.BlockedByTable,
+			Columns: task.BlockedByPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemovedBlockedByIDs(); len(nodes) > 0 && !tu.mutation.BlockedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   task.BlockedByTable,
+			Columns: task.BlockedByPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.BlockedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   task.BlockedByTable,
+			Columns: task.BlockedByPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tu.mutation.BlocksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   task.BlocksTable,
+			Columns: task.BlocksPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemovedBlocksIDs(); len(nodes) > 0 && !tu.mutation.BlocksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   task.BlocksTable,
+			Columns: task.BlocksPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.BlocksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   task.BlocksTable,
+			Columns: task.BlocksPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tu.mutation.StatusCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   task.StatusTable,
+			Columns: []string{task.StatusColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workflowstatus.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.StatusIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   task.StatusTable,
+			Columns: []string{task.StatusColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workflowstatus.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tu.mutation.ProjectCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   task.ProjectTable,
+			Columns: []string{task.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   task.ProjectTable,
+			Columns: []string{task.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tu.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.CommentsTable,
+			Columns: []string{task.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemovedCommentsIDs(); len(nodes) > 0 && !tu.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.CommentsTable,
+			Columns: []string{task.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.CommentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.CommentsTable,
+			Columns: []string{task.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tu.mutation.AssigneesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   task.AssigneesTable,
+			Columns: task.AssigneesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemovedAssigneesIDs(); len(nodes) > 0 && !tu.mutation.AssigneesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   task.AssigneesTable,
+			Columns: task.AssigneesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.AssigneesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   task.AssigneesTable,
+			Columns: task.AssigneesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tu.mutation.WatchersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   task.WatchersTable,
+			Columns: task.WatchersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemovedWatchersIDs(); len(nodes) > 0 && !tu.mutation.WatchersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   task.WatchersTable,
+			Columns: task.WatchersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.WatchersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   task.WatchersTable,
+			Columns: task.WatchersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(tu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, tu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{task.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	tu.mutation.done = true
+	return n, nil
+}
+
+// TaskUpdateOne is the builder for updating a single Task entity.
+type TaskUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *TaskMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetTitle sets the "title" field.
+func (tuo *TaskUpdateOne) SetTitle(s string) *TaskUpdateOne {
+	tuo.mutation.SetTitle(s)
+	return tuo
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableTitle(s *string) *TaskUpdateOne {
+	if s != nil {
+		tuo.SetTitle(*s)
+	}
+	return tuo
+}
+
+// SetDescription sets the "description" field.
+func (tuo *TaskUpdateOne) SetDescription(s string) *TaskUpdateOne {
+	tuo.mutation.SetDescription(s)
+	return tuo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableDescription(s *string) *TaskUpdateOne {
+	if s != nil {
+		tuo.SetDescription(*s)
+	}
+	return tuo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (tuo *TaskUpdateOne) ClearDescription() *TaskUpdateOne {
+	tuo.mutation.ClearDescription()
+	return tuo
+}
+
+// SetStatusID sets the "status_id" field.
+func (tuo *TaskUpdateOne) SetStatusID(u uuid.UUID) *TaskUpdateOne {
+	tuo.mutation.SetStatusID(u)
+	return tuo
+}
+
+// SetNillableStatusID sets the "status_id" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableStatusID(u *uuid.UUID) *TaskUpdateOne {
+	if u != nil {
+		tuo.SetStatusID(*u)
+	}
+	return tuo
+}
+
+// ClearStatusID clears the value of the "status_id" field.
+func (tuo *TaskUpdateOne) ClearStatusID() *TaskUpdateOne {
+	tuo.mutation.ClearStatusID()
+	return tuo
+}
+
+// SetStatusCategory sets the "status_category" field.
+func (tuo *TaskUpdateOne) SetStatusCategory(tc task.StatusCategory) *TaskUpdateOne {
+	tuo.mutation.SetStatusCategory(tc)
+	return tuo
+}
+
+// SetNillableStatusCategory sets the "status_category" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableStatusCategory(tc *task.StatusCategory) *TaskUpdateOne {
+	if tc != nil {
+		tuo.SetStatusCategory(*tc)
+	}
+	return tuo
+}
+
+// SetProjectID sets the "project_id" field.
+func (tuo *TaskUpdateOne) SetProjectID(u uuid.UUID) *TaskUpdateOne {
+	tuo.mutation.SetProjectID(u)
+	return tuo
+}
+
+// SetNillableProjectID sets the "project_id" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableProjectID(u *uuid.UUID) *TaskUpdateOne {
+	if u != nil {
+		tuo.SetProjectID(*u)
+	}
+	return tuo
+}
+
+// ClearProjectID clears the value of the "project_id" field.
+func (tuo *TaskUpdateOne) ClearProjectID() *TaskUpdateOne {
+	tuo.mutation.ClearProjectID()
+	return tuo
+}
+
+// SetAttachmentURL sets the "attachment_url" field.
+func (tuo *TaskUpdateOne) SetAttachmentURL(s string) *TaskUpdateOne {
+	tuo.mutation.SetAttachmentURL(s)
+	return tuo
+}
+
+// SetNillableAttachmentURL sets the "attachment_url" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableAttachmentURL(s *string) *TaskUpdateOne {
+	if s != nil {
+		tuo.SetAttachmentURL(*s)
+	}
+	return tuo
+}
+
+// ClearAttachmentURL clears the value of the "attachment_url" field.
+func (tuo *TaskUpdateOne) ClearAttachmentURL() *TaskUpdateOne {
+	tuo.mutation.ClearAttachmentURL()
+	return tuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tuo *TaskUpdateOne) SetCreatedAt(t time.Time) *TaskUpdateOne {
+	tuo.mutation.SetCreatedAt(t)
+	return tuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableCreatedAt(t *time.Time) *TaskUpdateOne {
+	if t != nil {
+		tuo.SetCreatedAt(*t)
+	}
+	return tuo
+}
+
+// SetParentID sets the "parent_id" field.
+func (tuo *TaskUpdateOne) SetParentID(u uuid.UUID) *TaskUpdateOne {
+	tuo.mutation.SetParentID(u)
+	return tuo
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableParentID(u *uuid.UUID) *TaskUpdateOne {
+	if u != nil {
+		tuo.SetParentID(*u)
+	}
+	return tuo
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (tuo *TaskUpdateOne) ClearParentID() *TaskUpdateOne {
+	tuo.mutation.ClearParentID()
+	return tuo
+}
+
+// SetPosition sets the "position" field.
+func (tuo *TaskUpdateOne) SetPosition(i int) *TaskUpdateOne {
+	tuo.mutation.ResetPosition()
+	tuo.mutation.SetPosition(i)
+	return tuo
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillablePosition(i *int) *TaskUpdateOne {
+	if i != nil {
+		tuo.SetPosition(*i)
+	}
+	return tuo
+}
+
+// AddPosition adds i to the "position" field.
+func (tuo *TaskUpdateOne) AddPosition(i int) *TaskUpdateOne {
+	tuo.mutation.AddPosition(i)
+	return tuo
+}
+
+// SetStartAt sets the "start_at" field.
+func (tuo *TaskUpdateOne) SetStartAt(t time.Time) *TaskUpdateOne {
+	tuo.mutation.SetStartAt(t)
+	return tuo
+}
+
+// SetNillableStartAt sets the "start_at" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableStartAt(t *time.Time) *TaskUpdateOne {
+	if t != nil {
+		tuo.SetStartAt(*t)
+	}
+	return tuo
+}
+
+// ClearStartAt clears the value of the "start_at" field.
+func (tuo *TaskUpdateOne) ClearStartAt() *TaskUpdateOne {
+	tuo.mutation.ClearStartAt()
+	return tuo
+}
+
+// SetDueAt sets the "due_at" field.
+func (tuo *TaskUpdateOne) SetDueAt(t time.Time) *TaskUpdateOne {
+	tuo.mutation.SetDueAt(t)
+	return tuo
+}
+
+// SetNillableDueAt sets the "due_at" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableDueAt(t *time.Time) *TaskUpdateOne {
+	if t != nil {
+		tuo.SetDueAt(*t)
+	}
+	return tuo
+}
+
+// ClearDueAt clears the value of the "due_at" field.
+func (tuo *TaskUpdateOne) ClearDueAt() *TaskUpdateOne {
+	tuo.mutation.ClearDueAt()
+	return tuo
+}
+
+// SetPriority sets the "priority" field.
+func (tuo *TaskUpdateOne) SetPriority(t task.Priority) *TaskUpdateOne {
+	tuo.mutation.SetPriority(t)
+	return tuo
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillablePriority(t *task.Priority) *TaskUpdateOne {
+	if t != nil {
+		tuo.SetPriority(*t)
+	}
+	return tuo
+}
+
+// SetEstimateMinutes sets the "estimate_minutes" field.
+func (tuo *TaskUpdateOne) SetEstimateMinutes(i int) *TaskUpdateOne {
+	tuo.mutation.ResetEstimateMinutes()
+	tuo.mutation.SetEstimateMinutes(i)
+	return tuo
+}
+
+// SetNillableEstimateMinutes sets the "estimate_minutes" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableEstimateMinutes(i *int) *TaskUpdateOne {
+	if i != nil {
+		tuo.SetEstimateMinutes(*i)
+	}
+	return tuo
+}
+
+// AddEstimateMinutes adds i to the "estimate_minutes" field.
+func (tuo *TaskUpdateOne) AddEstimateMinutes(i int) *TaskUpdateOne {
+	tuo.mutation.AddEstimateMinutes(i)
+	return tuo
+}
+
+// ClearEstimateMinutes clears the value of the "estimate_minutes" field.
+func (tuo *TaskUpdateOne) ClearEstimateMinutes() *TaskUpdateOne {
+	tuo.mutation.ClearEstimateMinutes()
+	return tuo
+}
+
+// SetRecurrenceRule sets the "recurrence_rule" field.
+func (tuo *TaskUpdateOne) SetRecurrenceRule(s string) *TaskUpdateOne {
+	tuo.mutation.SetRecurrenceRule(s)
+	return tuo
+}
+
+// SetNillableRecurrenceRule sets the "recurrence_rule" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableRecurrenceRule(s *string) *TaskUpdateOne {
+	if s != nil {
+		tuo.SetRecurrenceRule(*s)
+	}
+	return tuo
+}
+
+// ClearRecurrenceRule clears the value of the "recurrence_rule" field.
+func (tuo *TaskUpdateOne) ClearRecurrenceRule() *TaskUpdateOne {
+	tuo.mutation.ClearRecurrenceRule()
+	return tuo
+}
+
+// SetRecurrenceTimezone sets the "recurrence_timezone" field.
+func (tuo *TaskUpdateOne) SetRecurrenceTimezone(s string) *TaskUpdateOne {
+	tuo.mutation.SetRecurrenceTimezone(s)
+	return tuo
+}
+
+// SetNillableRecurrenceTimezone sets the "recurrence_timezone" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableRecurrenceTimezone(s *string) *TaskUpdateOne {
+	if s != nil {
+		tuo.SetRecurrenceTimezone(*s)
+	}
+	return tuo
+}
+
+// ClearRecurrenceTimezone clears the value of the "recurrence_timezone" field.
+func (tuo *TaskUpdateOne) ClearRecurrenceTimezone() *TaskUpdateOne {
+	tuo.mutation.ClearRecurrenceTimezone()
+	return tuo
+}
+
+// SetRecurrenceStart sets the "recurrence_start" field.
+func (tuo *TaskUpdateOne) SetRecurrenceStart(t time.Time) *TaskUpdateOne {
+	tuo.mutation.SetRecurrenceStart(t)
+	return tuo
+}
+
+// SetNillableRecurrenceStart sets the "recurrence_start" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableRecurrenceStart(t *time.Time) *TaskUpdateOne {
+	if t != nil {
+		tuo.SetRecurrenceStart(*t)
+	}
+	return tuo
+}
+
+// ClearRecurrenceStart clears the value of the "recurrence_start" field.
+func (tuo *TaskUpdateOne) ClearRecurrenceStart() *TaskUpdateOne {
+	tuo.mutation.ClearRecurrenceStart()
+	return tuo
+}
+
+// SetRecurrencePaused sets the "recurrence_paused" field.
+func (tuo *TaskUpdateOne) SetRecurrencePaused(b bool) *TaskUpdateOne {
+	tuo.mutation.SetRecurrencePaused(b)
+	return tuo
+}
+
+// SetNillableRecurrencePaused sets the "recurrence_paused" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableRecurrencePaused(b *bool) *TaskUpdateOne {
+	if b != nil {
+		tuo.SetRecurrencePaused(*b)
+	}
+	return tuo
+}
+
+// SetSeriesID sets the "series_id" field.
+func (tuo *TaskUpdateOne) SetSeriesID(u uuid.UUID) *TaskUpdateOne {
+	tuo.mutation.SetSeriesID(u)
+	return tuo
+}
+
+// SetNillableSeriesID sets the "series_id" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableSeriesID(u *uuid.UUID) *TaskUpdateOne {
+	if u != nil {
+		tuo.SetSeriesID(*u)
+	}
+	return tuo
+}
+
+// ClearSeriesID clears the value of the "series_id" field.
+func (tuo *TaskUpdateOne) ClearSeriesID() *TaskUpdateOne {
+	tuo.mutation.ClearSeriesID()
+	return tuo
+}
+
+// SetLabels sets the "labels" field.
+func (tuo *TaskUpdateOne) SetLabels(s []string) *TaskUpdateOne {
+	tuo.mutation.SetLabels(s)
+	return tuo
+}
+
+// AppendLabels appends s to the "labels" field.
+func (tuo *TaskUpdateOne) AppendLabels(s []string) *TaskUpdateOne {
+	tuo.mutation.AppendLabels(s)
+	return tuo
+}
+
+// ClearLabels clears the value of the "labels" field.
+func (tuo *TaskUpdateOne) ClearLabels() *TaskUpdateOne {
+	tuo.mutation.ClearLabels()
+	return tuo
+}
+
+// SetRank sets the "rank" field.
+func (tuo *TaskUpdateOne) SetRank(s string) *TaskUpdateOne {
+	tuo.mutation.SetRank(s)
+	return tuo
+}
+
+// SetNillableRank sets the "rank" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableRank(s *string) *TaskUpdateOne {
+	if s != nil {
+		tuo.SetRank(*s)
+	}
+	return tuo
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (tuo *TaskUpdateOne) SetCompletedAt(t time.Time) *TaskUpdateOne {
+	tuo.mutation.SetCompletedAt(t)
+	return tuo
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableCompletedAt(t *time.Time) *TaskUpdateOne {
+	if t != nil {
+		tuo.SetCompletedAt(*t)
+	}
+	return tuo
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (tuo *TaskUpdateOne) ClearCompletedAt() *TaskUpdateOne {
+	tuo.mutation.ClearCompletedAt()
+	return tuo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (tuo *TaskUpdateOne) SetDeletedAt(t time.Time) *TaskUpdateOne {
+	tuo.mutation.SetDeletedAt(t)
+	return tuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableDeletedAt(t *time.Time) *TaskUpdateOne {
+	if t != nil {
+		tuo.SetDeletedAt(*t)
+	}
+	return tuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (tuo *TaskUpdateOne) ClearDeletedAt() *TaskUpdateOne {
+	tuo.mutation.ClearDeletedAt()
+	return tuo
+}
+
+// SetVersion sets the "version" field.
+func (tuo *TaskUpdateOne) SetVersion(i int) *TaskUpdateOne {
+	tuo.mutation.ResetVersion()
+	tuo.mutation.SetVersion(i)
+	return tuo
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableVersion(i *int) *TaskUpdateOne {
+	if i != nil {
+		tuo.SetVersion(*i)
+	}
+	return tuo
+}
+
+// AddVersion adds i to the "version" field.
+func (tuo *TaskUpdateOne) AddVersion(i int) *TaskUpdateOne {
+	tuo.mutation.AddVersion(i)
+	return tuo
+}
+
+// SetChangeSeq sets the "change_seq" field.
+func (tuo *TaskUpdateOne) SetChangeSeq(i int64) *TaskUpdateOne {
+	tuo.mutation.ResetChangeSeq()
+	tuo.mutation.SetChangeSeq(i)
+	return tuo
+}
+
+// SetNillableChangeSeq sets the "change_seq" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableChangeSeq(i *int64) *TaskUpdateOne {
+	if i != nil {
+		tuo.SetChangeSeq(*i)
+	}
+	return tuo
+}
+
+// AddChangeSeq adds i to the "change_seq" field.
+func (tuo *TaskUpdateOne) AddChangeSeq(i int64) *TaskUpdateOne {
+	tuo.mutation.AddChangeSeq(i)
+	return tuo
+}
+
+// SetParent sets the "parent" edge to the Task entity.
+func (tuo *TaskUpdateOne) SetParent(t *Task) *TaskUpdateOne {
+	return tuo.SetParentID(t.ID)
+}
+
+// AddChildIDs adds the "children" edge to the Task entity by IDs.
+func (tuo *TaskUpdateOne) AddChildIDs(ids ...uuid.UUID) *TaskUpdateOne {
+	tuo.mutation.AddChildIDs(ids...)
+	return tuo
+}
+
+// AddChildren adds the "children" edges to the Task entity.
+func (tuo *TaskUpdateOne) AddChildren(t ...*Task) *TaskUpdateOne {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tuo.AddChildIDs(ids...)
+}
+
+// AddBlockedByIDs adds the "blocked_by" edge to the Task entity by IDs.
+func (tuo *TaskUpdateOne) AddBlockedByIDs(ids ...uuid.UUID) *TaskUpdateOne {
+	tuo.mutation.AddBlockedByIDs(ids...)
+	return tuo
+}
+
+// AddBlockedBy adds the "blocked_by" edges to the Task entity.
+func (tuo *TaskUpdateOne) AddBlockedBy(t ...*Task) *TaskUpdateOne {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tuo.AddBlockedByIDs(ids...)
+}
+
+// AddBlockIDs adds the "blocks" edge to the Task entity by IDs.
+func (tuo *TaskUpdateOne) AddBlockIDs(ids ...uuid.UUID) *TaskUpdateOne {
+	tuo.mutation.AddBlockIDs(ids...)
+	return tuo
+}
+
+// AddBlocks adds the "blocks" edges to the Task entity.
+func (tuo *TaskUpdateOne) AddBlocks(t ...*Task) *TaskUpdateOne {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
 	}
-	return tu
+	return tuo.AddBlockIDs(ids...)
 }
 
-// ClearAttachmentURL clears the value of the "attachment_url" field.
-func (tu *TaskUpdate) ClearAttachmentURL() *TaskUpdate {
-	tu.mutation.ClearAttachmentURL()
-	return tu
+// SetStatus sets the "status" edge to the WorkflowStatus entity.
+func (tuo *TaskUpdateOne) SetStatus(w *WorkflowStatus) *TaskUpdateOne {
+	return tuo.SetStatusID(w.ID)
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (tu *TaskUpdate) SetCreatedAt(t time.Time) *TaskUpdate {
-	tu.mutation.SetCreatedAt(t)
-	return tu
+// SetProject sets the "project" edge to the Project entity.
+func (tuo *TaskUpdateOne) SetProject(p *Project) *TaskUpdateOne {
+	return tuo.SetProjectID(p.ID)
 }
 
-// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
-func (tu *TaskUpdate) SetNillableCreatedAt(t *time.Time) *TaskUpdate {
-	if t != nil {
-		tu.SetCreatedAt(*t)
-	}
-	return tu
+// AddCommentIDs adds the "comments" edge to the Comment entity by IDs.
+func (tuo *TaskUpdateOne) AddCommentIDs(ids ...uuid.UUID) *TaskUpdateOne {
+	tuo.mutation.AddCommentIDs(ids...)
+	return tuo
 }
 
-// Mutation returns the TaskMutation object of the builder.
-func (tu *TaskUpdate) Mutation() *TaskMutation {
-	return tu.mutation
+// AddComments adds the "comments" edges to the Comment entity.
+func (tuo *TaskUpdateOne) AddComments(c ...*Comment) *TaskUpdateOne {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return tuo.AddCommentIDs(ids...)
 }
 
-// Save executes the query and returns the number of nodes affected by the update operation.
-func (tu *TaskUpdate) Save(ctx context.Context) (int, error) {
-	return withHooks(ctx, tu.sqlSave, tu.mutation, tu.hooks)
+// AddAssigneeIDs adds the "assignees" edge to the User entity by IDs.
+func (tuo *TaskUpdateOne) AddAssigneeIDs(ids ...uuid.UUID) *TaskUpdateOne {
+	tuo.mutation.AddAssigneeIDs(ids...)
+	return tuo
 }
 
-// SaveX is like Save, but panics if an error occurs.
-func (tu *TaskUpdate) SaveX(ctx context.Context) int {
-	affected, err := tu.Save(ctx)
-	if err != nil {
-		panic(err)
+// AddAssignees adds the "assignees" edges to the User entity.
+func (tuo *TaskUpdateOne) AddAssignees(u ...*User) *TaskUpdateOne {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
 	}
-	return affected
+	return tuo.AddAssigneeIDs(ids...)
 }
 
-// Exec executes the query.
-func (tu *TaskUpdate) Exec(ctx context.Context) error {
-	_, err := tu.Save(ctx)
-	return err
+// AddWatcherIDs adds the "watchers" edge to the User entity by IDs.
+func (tuo *TaskUpdateOne) AddWatcherIDs(ids ...uuid.UUID) *TaskUpdateOne {
+	tuo.mutation.AddWatcherIDs(ids...)
+	return tuo
 }
 
-// ExecX is like Exec, but panics if an error occurs.
-func (tu *TaskUpdate) ExecX(ctx context.Context) {
-	if err := tu.Exec(ctx); err != nil {
-		panic(err)
+// AddWatchers adds the "watchers" edges to the User entity.
+func (tuo *TaskUpdateOne) AddWatchers(u ...*User) *TaskUpdateOne {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
 	}
+	return tuo.AddWatcherIDs(ids...)
 }
 
-func (tu *TaskUpdate) sqlSave(ctx context.Context) (n int, err error) {
-	_spec := sqlgraph.NewUpdateSpec(task.Table, task.Columns, sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID))
-	if ps := tu.mutation.predicates; len(ps) > 0 {
-		_spec.Predicate = func(selector *sql.Selector) {
-			for i := range ps {
-				ps[i](selector)
-			}
-		}
-	}
-	if value, ok := tu.mutation.Title(); ok {
-		_spec.SetField(task.FieldTitle, field.TypeString, value)
-	}
-	if value, ok := tu.mutation.Description(); ok {
-		_spec.SetField(task.FieldDescription, field.TypeString, value)
-	}
-	if tu.mutation.DescriptionCleared() {
-		_spec.ClearField(task.FieldDescription, field.TypeString)
-	}
-	if value, ok := tu.mutation.IsCompleted(); ok {
-		_spec.SetField(task.FieldIsCompleted, field.TypeBool, value)
-	}
-	if value, ok := tu.mutation.AttachmentURL(); ok {
-		_spec.SetField(task.FieldAttachmentURL, field.TypeString, value)
-	}
-	if tu.mutation.AttachmentURLCleared() {
-		_spec.ClearField(task.FieldAttachmentURL, field.TypeString)
-	}
-	if value, ok := tu.mutation.CreatedAt(); ok {
-		_spec.SetField(task.FieldCreatedAt, field.TypeTime, value)
-	}
-	if n, err = sqlgraph.UpdateNodes(ctx, tu.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{task.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
-		}
-		return 0, err
-	}
-	tu.mutation.done = true
-	return n, nil
+// Mutation returns the TaskMutation object of the builder.
+func (tuo *TaskUpdateOne) Mutation() *TaskMutation {
+	return tuo.mutation
 }
 
-// TaskUpdateOne is the builder for updating a single Task entity.
-type TaskUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *TaskMutation
+// ClearParent clears the "parent" edge to the Task entity.
+func (tuo *TaskUpdateOne) ClearParent() *TaskUpdateOne {
+	tuo.mutation.ClearParent()
+	return tuo
 }
 
-// SetTitle sets the "title" field.
-func (tuo *TaskUpdateOne) SetTitle(s string) *TaskUpdateOne {
-	tuo.mutation.SetTitle(s)
+// ClearChildren clears all "children" edges to the Task entity.
+func (tuo *TaskUpdateOne) ClearChildren() *TaskUpdateOne {
+	tuo.mutation.ClearChildren()
 	return tuo
 }
 
-// SetNillableTitle sets the "title" field if the given value is not nil.
-func (tuo *TaskUpdateOne) SetNillableTitle(s *string) *TaskUpdateOne {
-	if s != nil {
-		tuo.SetTitle(*s)
+// RemoveChildIDs removes the "children" edge to Task entities by IDs.
+func (tuo *TaskUpdateOne) RemoveChildIDs(ids ...uuid.UUID) *TaskUpdateOne {
+	tuo.mutation.RemoveChildIDs(ids...)
+	return tuo
+}
+
+// RemoveChildren removes "children" edges to Task entities.
+func (tuo *TaskUpdateOne) RemoveChildren(t ...*Task) *TaskUpdateOne {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
 	}
+	return tuo.RemoveChildIDs(ids...)
+}
+
+// ClearBlockedBy clears all "blocked_by" edges to the Task entity.
+func (tuo *TaskUpdateOne) ClearBlockedBy() *TaskUpdateOne {
+	tuo.mutation.ClearBlockedBy()
 	return tuo
 }
 
-// SetDescription sets the "description" field.
-func (tuo *TaskUpdateOne) SetDescription(s string) *TaskUpdateOne {
-	tuo.mutation.SetDescription(s)
+// RemoveBlockedByIDs removes the "blocked_by" edge to Task entities by IDs.
+func (tuo *TaskUpdateOne) RemoveBlockedByIDs(ids ...uuid.UUID) *TaskUpdateOne {
+	tuo.mutation.RemoveBlockedByIDs(ids...)
 	return tuo
 }
 
-// SetNillableDescription sets the "description" field if the given value is not nil.
-func (tuo *TaskUpdateOne) SetNillableDescription(s *string) *TaskUpdateOne {
-	if s != nil {
-		tuo.SetDescription(*s)
+// RemoveBlockedBy removes "blocked_by" edges to Task entities.
+func (tuo *TaskUpdateOne) RemoveBlockedBy(t ...*Task) *TaskUpdateOne {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
 	}
-	return tuo
+	return tuo.RemoveBlockedByIDs(ids...)
 }
 
-// ClearDescription clears the value of the "description" field.
-func (tuo *TaskUpdateOne) ClearDescription() *TaskUpdateOne {
-	tuo.mutation.ClearDescription()
+// ClearBlocks clears all "blocks" edges to the Task entity.
+func (tuo *TaskUpdateOne) ClearBlocks() *TaskUpdateOne {
+	tuo.mutation.ClearBlocks()
 	return tuo
 }
 
-// SetIsCompleted sets the "is_completed" field.
-func (tuo *TaskUpdateOne) SetIsCompleted(b bool) *TaskUpdateOne {
-	tuo.mutation.SetIsCompleted(b)
+// RemoveBlockIDs removes the "blocks" edge to Task entities by IDs.
+func (tuo *TaskUpdateOne) RemoveBlockIDs(ids ...uuid.UUID) *TaskUpdateOne {
+	tuo.mutation.RemoveBlockIDs(ids...)
 	return tuo
 }
 
-// SetNillableIsCompleted sets the "is_completed" field if the given value is not nil.
-func (tuo *TaskUpdateOne) SetNillableIsCompleted(b *bool) *TaskUpdateOne {
-	if b != nil {
-		tuo.SetIsCompleted(*b)
+// RemoveBlocks removes "blocks" edges to Task entities.
+func (tuo *TaskUpdateOne) RemoveBlocks(t ...*Task) *TaskUpdateOne {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
 	}
+	return tuo.RemoveBlockIDs(ids...)
+}
+
+// ClearStatus clears the "status" edge to the WorkflowStatus entity.
+func (tuo *TaskUpdateOne) ClearStatus() *TaskUpdateOne {
+	tuo.mutation.ClearStatus()
 	return tuo
 }
 
-// SetAttachmentURL sets the "attachment_url" field.
-func (tuo *TaskUpdateOne) SetAttachmentURL(s string) *TaskUpdateOne {
-	tuo.mutation.SetAttachmentURL(s)
+// ClearProject clears the "project" edge to the Project entity.
+func (tuo *TaskUpdateOne) ClearProject() *TaskUpdateOne {
+	tuo.mutation.ClearProject()
 	return tuo
 }
 
-// SetNillableAttachmentURL sets the "attachment_url" field if the given value is not nil.
-func (tuo *TaskUpdateOne) SetNillableAttachmentURL(s *string) *TaskUpdateOne {
-	if s != nil {
-		tuo.SetAttachmentURL(*s)
-	}
+// ClearComments clears all "comments" edges to the Comment entity.
+func (tuo *TaskUpdateOne) ClearComments() *TaskUpdateOne {
+	tuo.mutation.ClearComments()
 	return tuo
 }
 
-// ClearAttachmentURL clears the value of the "attachment_url" field.
-func (tuo *TaskUpdateOne) ClearAttachmentURL() *TaskUpdateOne {
-	tuo.mutation.ClearAttachmentURL()
+// RemoveCommentIDs removes the "comments" edge to Comment entities by IDs.
+func (tuo *TaskUpdateOne) RemoveCommentIDs(ids ...uuid.UUID) *TaskUpdateOne {
+	tuo.mutation.RemoveCommentIDs(ids...)
 	return tuo
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (tuo *TaskUpdateOne) SetCreatedAt(t time.Time) *TaskUpdateOne {
-	tuo.mutation.SetCreatedAt(t)
+// RemoveComments removes "comments" edges to Comment entities.
+func (tuo *TaskUpdateOne) RemoveComments(c ...*Comment) *TaskUpdateOne {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return tuo.RemoveCommentIDs(ids...)
+}
+
+// ClearAssignees clears all "assignees" edges to the User entity.
+func (tuo *TaskUpdateOne) ClearAssignees() *TaskUpdateOne {
+	tuo.mutation.ClearAssignees()
 	return tuo
 }
 
-// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
-func (tuo *TaskUpdateOne) SetNillableCreatedAt(t *time.Time) *TaskUpdateOne {
-	if t != nil {
-		tuo.SetCreatedAt(*t)
+// RemoveAssigneeIDs removes the "assignees" edge to User entities by IDs.
+func (tuo *TaskUpdateOne) RemoveAssigneeIDs(ids ...uuid.UUID) *TaskUpdateOne {
+	tuo.mutation.RemoveAssigneeIDs(ids...)
+	return tuo
+}
+
+// RemoveAssignees removes "assignees" edges to User entities.
+func (tuo *TaskUpdateOne) RemoveAssignees(u ...*User) *TaskUpdateOne {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
 	}
+	return tuo.RemoveAssigneeIDs(ids...)
+}
+
+// ClearWatchers clears all "watchers" edges to the User entity.
+func (tuo *TaskUpdateOne) ClearWatchers() *TaskUpdateOne {
+	tuo.mutation.ClearWatchers()
 	return tuo
 }
 
-// Mutation returns the TaskMutation object of the builder.
-func (tuo *TaskUpdateOne) Mutation() *TaskMutation {
-	return tuo.mutation
+// RemoveWatcherIDs removes the "watchers" edge to User entities by IDs.
+func (tuo *TaskUpdateOne) RemoveWatcherIDs(ids ...uuid.UUID) *TaskUpdateOne {
+	tuo.mutation.RemoveWatcherIDs(ids...)
+	return tuo
+}
+
+// RemoveWatchers removes "watchers" edges to User entities.
+func (tuo *TaskUpdateOne) RemoveWatchers(u ...*User) *TaskUpdateOne {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return tuo.RemoveWatcherIDs(ids...)
 }
 
 // Where appends a list predicates to the TaskUpdate builder.
@@ -319,7 +2056,36 @@ func (tuo *TaskUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (tuo *TaskUpdateOne) check() error {
+	if v, ok := tuo.mutation.StatusCategory(); ok {
+		if err := task.StatusCategoryValidator(v); err != nil {
+			return &ValidationError{Name: "status_category", err: fmt.Errorf(`ent: validator failed for field "Task.status_category": %w`, err)}
+		}
+	}
+	if v, ok := tuo.mutation.Priority(); ok {
+		if err := task.PriorityValidator(v); err != nil {
+			return &ValidationError{Name: "priority", err: fmt.Errorf(`ent: validator failed for field "Task.priority": %w`, err)}
+		}
+	}
+	if v, ok := tuo.mutation.EstimateMinutes(); ok {
+		if err := task.EstimateMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "estimate_minutes", err: fmt.Errorf(`ent: validator failed for field "Task.estimate_minutes": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (tuo *TaskUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TaskUpdateOne {
+	tuo.modifiers = append(tuo.modifiers, modifiers...)
+	return tuo
+}
+
 func (tuo *TaskUpdateOne) sqlSave(ctx context.Context) (_node *Task, err error) {
+	if err := tuo.check(); err != nil {
+		return _node, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(task.Table, task.Columns, sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID))
 	id, ok := tuo.mutation.ID()
 	if !ok {
@@ -354,8 +2120,8 @@ func (tuo *TaskUpdateOne) sqlSave(ctx context.Context) (_node *Task, err error)
 	if tuo.mutation.DescriptionCleared() {
 		_spec.ClearField(task.FieldDescription, field.TypeString)
 	}
-	if value, ok := tuo.mutation.IsCompleted(); ok {
-		_spec.SetField(task.FieldIsCompleted, field.TypeBool, value)
+	if value, ok := tuo.mutation.StatusCategory(); ok {
+		_spec.SetField(task.FieldStatusCategory, field.TypeEnum, value)
 	}
 	if value, ok := tuo.mutation.AttachmentURL(); ok {
 		_spec.SetField(task.FieldAttachmentURL, field.TypeString, value)
@@ -366,6 +2132,468 @@ func (tuo *TaskUpdateOne) sqlSave(ctx context.Context) (_node *Task, err error)
 	if value, ok := tuo.mutation.CreatedAt(); ok {
 		_spec.SetField(task.FieldCreatedAt, field.TypeTime, value)
 	}
+	if value, ok := tuo.mutation.Position(); ok {
+		_spec.SetField(task.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := tuo.mutation.AddedPosition(); ok {
+		_spec.AddField(task.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := tuo.mutation.StartAt(); ok {
+		_spec.SetField(task.FieldStartAt, field.TypeTime, value)
+	}
+	if tuo.mutation.StartAtCleared() {
+		_spec.ClearField(task.FieldStartAt, field.TypeTime)
+	}
+	if value, ok := tuo.mutation.DueAt(); ok {
+		_spec.SetField(task.FieldDueAt, field.TypeTime, value)
+	}
+	if tuo.mutation.DueAtCleared() {
+		_spec.ClearField(task.FieldDueAt, field.TypeTime)
+	}
+	if value, ok := tuo.mutation.Priority(); ok {
+		_spec.SetField(task.FieldPriority, field.TypeEnum, value)
+	}
+	if value, ok := tuo.mutation.EstimateMinutes(); ok {
+		_spec.SetField(task.FieldEstimateMinutes, field.TypeInt, value)
+	}
+	if value, ok := tuo.mutation.AddedEstimateMinutes(); ok {
+		_spec.AddField(task.FieldEstimateMinutes, field.TypeInt, value)
+	}
+	if tuo.mutation.EstimateMinutesCleared() {
+		_spec.ClearField(task.FieldEstimateMinutes, field.TypeInt)
+	}
+	if value, ok := tuo.mutation.RecurrenceRule(); ok {
+		_spec.SetField(task.FieldRecurrenceRule, field.TypeString, value)
+	}
+	if tuo.mutation.RecurrenceRuleCleared() {
+		_spec.ClearField(task.FieldRecurrenceRule, field.TypeString)
+	}
+	if value, ok := tuo.mutation.RecurrenceTimezone(); ok {
+		_spec.SetField(task.FieldRecurrenceTimezone, field.TypeString, value)
+	}
+	if tuo.mutation.RecurrenceTimezoneCleared() {
+		_spec.ClearField(task.FieldRecurrenceTimezone, field.TypeString)
+	}
+	if value, ok := tuo.mutation.RecurrenceStart(); ok {
+		_spec.SetField(task.FieldRecurrenceStart, field.TypeTime, value)
+	}
+	if tuo.mutation.RecurrenceStartCleared() {
+		_spec.ClearField(task.FieldRecurrenceStart, field.TypeTime)
+	}
+	if value, ok := tuo.mutation.RecurrencePaused(); ok {
+		_spec.SetField(task.FieldRecurrencePaused, field.TypeBool, value)
+	}
+	if value, ok := tuo.mutation.SeriesID(); ok {
+		_spec.SetField(task.FieldSeriesID, field.TypeUUID, value)
+	}
+	if tuo.mutation.SeriesIDCleared() {
+		_spec.ClearField(task.FieldSeriesID, field.TypeUUID)
+	}
+	if value, ok := tuo.mutation.Labels(); ok {
+		_spec.SetField(task.FieldLabels, field.TypeJSON, value)
+	}
+	if value, ok := tuo.mutation.AppendedLabels(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, task.FieldLabels, value)
+		})
+	}
+	if tuo.mutation.LabelsCleared() {
+		_spec.ClearField(task.FieldLabels, field.TypeJSON)
+	}
+	if value, ok := tuo.mutation.Rank(); ok {
+		_spec.SetField(task.FieldRank, field.TypeString, value)
+	}
+	if value, ok := tuo.mutation.CompletedAt(); ok {
+		_spec.SetField(task.FieldCompletedAt, field.TypeTime, value)
+	}
+	if tuo.mutation.CompletedAtCleared() {
+		_spec.ClearField(task.FieldCompletedAt, field.TypeTime)
+	}
+	if value, ok := tuo.mutation.DeletedAt(); ok {
+		_spec.SetField(task.FieldDeletedAt, field.TypeTime, value)
+	}
+	if tuo.mutation.DeletedAtCleared() {
+		_spec.ClearField(task.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := tuo.mutation.Version(); ok {
+		_spec.SetField(task.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := tuo.mutation.AddedVersion(); ok {
+		_spec.AddField(task.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := tuo.mutation.ChangeSeq(); ok {
+		_spec.SetField(task.FieldChangeSeq, field.TypeInt64, value)
+	}
+	if value, ok := tuo.mutation.AddedChangeSeq(); ok {
+		_spec.AddField(task.FieldChangeSeq, field.TypeInt64, value)
+	}
+	if tuo.mutation.ExternalIDCleared() {
+		_spec.ClearField(task.FieldExternalID, field.TypeString)
+	}
+	if tuo.mutation.ImportKeyCleared() {
+		_spec.ClearField(task.FieldImportKey, field.TypeString)
+	}
+	if tuo.mutation.IcalUIDCleared() {
+		_spec.ClearField(task.FieldIcalUID, field.TypeString)
+	}
+	if tuo.mutation.ParentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   task.ParentTable,
+			Columns: []string{task.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   task.ParentTable,
+			Columns: []string{task.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tuo.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.ChildrenTable,
+			Columns: []string{task.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.RemovedChildrenIDs(); len(nodes) > 0 && !tuo.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.ChildrenTable,
+			Columns: []string{task.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.ChildrenTable,
+			Columns: []string{task.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tuo.mutation.BlockedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   task.BlockedByTable,
+			Columns: task.BlockedByPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.RemovedBlockedByIDs(); len(nodes) > 0 && !tuo.mutation.BlockedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   task.BlockedByTable,
+			Columns: task.BlockedByPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.BlockedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   task.BlockedByTable,
+			Columns: task.BlockedByPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tuo.mutation.BlocksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   task.BlocksTable,
+			Columns: task.BlocksPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.RemovedBlocksIDs(); len(nodes) > 0 && !tuo.mutation.BlocksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   task.BlocksTable,
+			Columns: task.BlocksPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.BlocksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   task.BlocksTable,
+			Columns: task.BlocksPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tuo.mutation.StatusCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   task.StatusTable,
+			Columns: []string{task.StatusColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workflowstatus.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.StatusIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   task.StatusTable,
+			Columns: []string{task.StatusColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workflowstatus.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tuo.mutation.ProjectCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   task.ProjectTable,
+			Columns: []string{task.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   task.ProjectTable,
+			Columns: []string{task.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tuo.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.CommentsTable,
+			Columns: []string{task.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.RemovedCommentsIDs(); len(nodes) > 0 && !tuo.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.CommentsTable,
+			Columns: []string{task.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.CommentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.CommentsTable,
+			Columns: []string{task.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tuo.mutation.AssigneesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   task.AssigneesTable,
+			Columns: task.AssigneesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.RemovedAssigneesIDs(); len(nodes) > 0 && !tuo.mutation.AssigneesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   task.AssigneesTable,
+			Columns: task.AssigneesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.AssigneesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   task.AssigneesTable,
+			Columns: task.AssigneesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tuo.mutation.WatchersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   task.WatchersTable,
+			Columns: task.WatchersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.RemovedWatchersIDs(); len(nodes) > 0 && !tuo.mutation.WatchersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   task.WatchersTable,
+			Columns: task.WatchersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.WatchersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   task.WatchersTable,
+			Columns: task.WatchersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(tuo.modifiers...)
 	_node = &Task{config: tuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,229 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/ent/taskevent"
+	"github.com/localopsco/go-sample/ent/user"
+	"github.com/localopsco/go-sample/models"
+)
+
+// TaskEvent is the model entity for the TaskEvent schema.
+type TaskEvent struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// TaskID holds the value of the "task_id" field.
+	TaskID uuid.UUID `json:"task_id,omitempty"`
+	// TaskTitle holds the value of the "task_title" field.
+	TaskTitle string `json:"task_title,omitempty"`
+	// ProjectID holds the value of the "project_id" field.
+	ProjectID *uuid.UUID `json:"project_id,omitempty"`
+	// Action holds the value of the "action" field.
+	Action taskevent.Action `json:"action,omitempty"`
+	// ActorID holds the value of the "actor_id" field.
+	ActorID *uuid.UUID `json:"actor_id,omitempty"`
+	// Changes holds the value of the "changes" field.
+	Changes []models.FieldChange `json:"changes,omitempty"`
+	// RequestID holds the value of the "request_id" field.
+	RequestID string `json:"request_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TaskEventQuery when eager-loading is set.
+	Edges        TaskEventEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// TaskEventEdges holds the relations/edges for other nodes in the graph.
+type TaskEventEdges struct {
+	// Actor holds the value of the actor edge.
+	Actor *User `json:"actor,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+	// totalCount holds the count of the edges above.
+	totalCount [1]map[string]int
+}
+
+// ActorOrErr returns the Actor value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TaskEventEdges) ActorOrErr() (*User, error) {
+	if e.Actor != nil {
+		return e.Actor, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "actor"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TaskEvent) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case taskevent.FieldProjectID, taskevent.FieldActorID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case taskevent.FieldChanges:
+			values[i] = new([]byte)
+		case taskevent.FieldTaskTitle, taskevent.FieldAction, taskevent.FieldRequestID:
+			values[i] = new(sql.NullString)
+		case taskevent.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case taskevent.FieldID, taskevent.FieldTaskID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TaskEvent fields.
+func (te *TaskEvent) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case taskevent.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				te.ID = *value
+			}
+		case taskevent.FieldTaskID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field task_id", values[i])
+			} else if value != nil {
+				te.TaskID = *value
+			}
+		case taskevent.FieldTaskTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field task_title", values[i])
+			} else if value.Valid {
+				te.TaskTitle = value.String
+			}
+		case taskevent.FieldProjectID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field project_id", values[i])
+			} else if value.Valid {
+				te.ProjectID = new(uuid.UUID)
+				*te.ProjectID = *value.S.(*uuid.UUID)
+			}
+		case taskevent.FieldAction:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field action", values[i])
+			} else if value.Valid {
+				te.Action = taskevent.Action(value.String)
+			}
+		case taskevent.FieldActorID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field actor_id", values[i])
+			} else if value.Valid {
+				te.ActorID = new(uuid.UUID)
+				*te.ActorID = *value.S.(*uuid.UUID)
+			}
+		case taskevent.FieldChanges:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field changes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &te.Changes); err != nil {
+					return fmt.Errorf("unmarshal field changes: %w", err)
+				}
+			}
+		case taskevent.FieldRequestID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field request_id", values[i])
+			} else if value.Valid {
+				te.RequestID = value.String
+			}
+		case taskevent.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				te.CreatedAt = value.Time
+			}
+		default:
+			te.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TaskEvent.
+// This includes values selected through modifiers, order, etc.
+func (te *TaskEvent) Value(name string) (ent.Value, error) {
+	return te.selectValues.Get(name)
+}
+
+// QueryActor queries the "actor" edge of the TaskEvent entity.
+func (te *TaskEvent) QueryActor() *UserQuery {
+	return NewTaskEventClient(te.config).QueryActor(te)
+}
+
+// Update returns a builder for updating this TaskEvent.
+// Note that you need to call TaskEvent.Unwrap() before calling this method if this TaskEvent
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (te *TaskEvent) Update() *TaskEventUpdateOne {
+	return NewTaskEventClient(te.config).UpdateOne(te)
+}
+
+// Unwrap unwraps the TaskEvent entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (te *TaskEvent) Unwrap() *TaskEvent {
+	_tx, ok := te.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TaskEvent is not a transactional entity")
+	}
+	te.config.driver = _tx.drv
+	return te
+}
+
+// String implements the fmt.Stringer.
+func (te *TaskEvent) String() string {
+	var builder strings.Builder
+	builder.WriteString("TaskEvent(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", te.ID))
+	builder.WriteString("task_id=")
+	builder.WriteString(fmt.Sprintf("%v", te.TaskID))
+	builder.WriteString(", ")
+	builder.WriteString("task_title=")
+	builder.WriteString(te.TaskTitle)
+	builder.WriteString(", ")
+	if v := te.ProjectID; v != nil {
+		builder.WriteString("project_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("action=")
+	builder.WriteString(fmt.Sprintf("%v", te.Action))
+	builder.WriteString(", ")
+	if v := te.ActorID; v != nil {
+		builder.WriteString("actor_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("changes=")
+	builder.WriteString(fmt.Sprintf("%v", te.Changes))
+	builder.WriteString(", ")
+	builder.WriteString("request_id=")
+	builder.WriteString(te.RequestID)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(te.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TaskEvents is a parsable slice of TaskEvent.
+type TaskEvents []*TaskEvent
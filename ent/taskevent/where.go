@@ -0,0 +1,445 @@
+// Code generated by ent, DO NOT EDIT.
+
+package taskevent
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.TaskEvent {
+	return predicate.TaskEvent(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.TaskEvent {
+	return predicate.TaskEvent(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.TaskEvent {
+	return predicate.TaskEvent(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.TaskEvent {
+	return predicate.TaskEvent(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.TaskEvent {
+	return predicate.TaskEvent(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.TaskEvent {
+	return predicate.TaskEvent(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.TaskEvent {
+	return predicate.TaskEvent(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.TaskEvent {
+	return predicate.TaskEvent(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.TaskEvent {
+	return predicate.TaskEvent(sql.FieldLTE(FieldID, id))
+}
+
+// TaskID applies equality check predicate on the "task_id" field. It's identical to TaskIDEQ.
+func TaskID(v uuid.UUID) predicate.TaskEvent {
+	return predicate.TaskEvent(sql.FieldEQ(FieldTaskID, v))
+}
+
+// TaskTitle applies equality check predicate on the "task_title" field. It's identical to TaskTitleEQ.
+func TaskTitle(v string) predicate.TaskEvent {
+	return predicate.TaskEvent(sql.FieldEQ(FieldTaskTitle, v))
+}
+
+// ProjectID applies equality check predicate on the "project_id" field. It's identical to ProjectIDEQ.
+func ProjectID(v uuid.UUID) predicate.TaskEvent {
+	return predicate.TaskEvent(sql.FieldEQ(FieldProjectID, v))
+}
+
+// ActorID applies equality check predicate on the "actor_id" field. It's identical to ActorIDEQ.
+func ActorID(v uuid.UUID) predicate.TaskEvent {
+	return predicate.TaskEvent(sql.FieldEQ(FieldActorID, v))
+}
+
+// RequestID applies equality check predicate on the "request_id" field. It's identical to RequestIDEQ.
+func RequestID(v string) predicate.TaskEvent {
+	return predicate.TaskEvent(sql.FieldEQ(FieldRequestID, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.TaskEvent {
+	return predicate.TaskEvent(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// TaskIDEQ applies the EQ predicate on the "task_id" field.
+func TaskIDEQ(v uuid.UUID) predicate.TaskEvent {
+	return predicate.TaskEvent(sql.FieldEQ(FieldTaskID, v))
+}
+
+// TaskIDNEQ applies the NEQ predicate on the "task_id" field.
+func TaskIDNEQ(v uuid.UUID) predicate.TaskEvent {
+	return predicate.TaskEvent(sql.FieldNEQ(FieldTaskID, v))
+}
+
+// TaskIDIn applies the In predicate on the "task_id" field.
+func TaskIDIn(vs ...uuid.UUID) predicate.TaskEvent {
+	return predicate.TaskEvent(sql.FieldIn(FieldTaskID, vs...))
+}
+
+// TaskIDNotIn applies the NotIn predicate on the "task_id" field.
+func TaskIDNotIn(vs ...uuid.UUID) predicate.TaskEvent {
+	return predicate.TaskEvent(sql.FieldNotIn(FieldTaskID, vs...))
+}
+
+// TaskIDGT applies the GT predicate on the "task_id" field.
+func TaskIDGT(v uuid.UUID) predicate.TaskEvent {
+	return predicate.TaskEvent(sql.FieldGT(FieldTaskID, v))
+}
+
+// TaskIDGTE applies the GTE predicate on the "task_id" field.
+func TaskIDGTE(v uuid.UUID) predicate.TaskEvent {
+	return predicate.TaskEvent(sql.FieldGTE(FieldTaskID, v))
+}
+
+// TaskIDLT applies the LT predicate on the "task_id" field.
+func TaskIDLT(v uuid.UUID) predicate.TaskEvent {
+	return predicate.TaskEvent(sql.FieldLT(FieldTaskID, v))
+}
+
+// TaskIDLTE applies the LTE predicate on the "task_id" field.
+func TaskIDLTE(v uuid.UUID) predicate.TaskEvent {
+	return predicate.TaskEvent(sql.FieldLTE(FieldTaskID, v))
+}
+
+// TaskTitleEQ applies the EQ predicate on the "task_title" field.
+func TaskTitleEQ(v string) predicate.TaskEvent {
+	return predicate.TaskEvent(sql.FieldEQ(FieldTaskTitle, v))
+}
+
+// TaskTitleNEQ applies the NEQ predicate on the "task_title" field.
+func TaskTitleNEQ(v string) predicate.TaskEvent {
+	return predicate.TaskEvent(sql.FieldNEQ(FieldTaskTitle, v))
+}
+
+// TaskTitleIn applies the In predicate on the "task_title" field.
+func TaskTitleIn(vs ...string) predicate.TaskEvent {
+	return predicate.TaskEvent(sql.FieldIn(FieldTaskTitle, vs...))
+}
+
+// TaskTitleNotIn applies the NotIn predicate on the "task_title" field.
+func TaskTitleNotIn(vs ...string) predicate.TaskEvent {
+	return predicate.TaskEvent(sql.FieldNotIn(FieldTaskTitle, vs...))
+}
+
+// TaskTitleGT applies the GT predicate on the "task_title" field.
+func TaskTitleGT(v string) predicate.TaskEvent {
+	return predicate.TaskEvent(sql.FieldGT(FieldTaskTitle, v))
+}
+
+// TaskTitleGTE applies the GTE predicate on the "task_title" field.
+func TaskTitleGTE(v string) predicate.TaskEvent {
+	return predicate.TaskEvent(sql.FieldGTE(FieldTaskTitle, v))
+}
+
+// TaskTitleLT applies the LT predicate on the "task_title" field.
+func TaskTitleLT(v string) predicate.TaskEvent {
+	return predicate.TaskEvent(sql.FieldLT(FieldTaskTitle, v))
+}
+
+// TaskTitleLTE applies the LTE predicate on the "task_title" field.
+func TaskTitleLTE(v string) predicate.TaskEvent {
+	return predicate.TaskEvent(sql.FieldLTE(FieldTaskTitle, v))
+}
+
+// TaskTitleContains applies the Contains predicate on the "task_title" field.
+func TaskTitleContains(v string) predicate.TaskEvent {
+	return predicate.TaskEvent(sql.FieldContains(FieldTaskTitle, v))
+}
+
+// TaskTitleHasPrefix applies the HasPrefix predicate on the "task_title" field.
+func TaskTitleHasPrefix(v string) predicate.TaskEvent {
+	return predicate.TaskEvent(sql.FieldHasPrefix(FieldTaskTitle, v))
+}
+
+// TaskTitleHasSuffix applies the HasSuffix predicate on the "task_title" field.
+func TaskTitleHasSuffix(v string) predicate.TaskEvent {
+	return predicate.TaskEvent(sql.FieldHasSuffix(FieldTaskTitle, v))
+}
+
+// TaskTitleEqualFold applies the EqualFold predicate on the "task_title" field.
+func TaskTitleEqualFold(v string) predicate.TaskEvent {
+	return predicate.TaskEvent(sql.FieldEqualFold(FieldTaskTitle, v))
+}
+
+// TaskTitleContainsFold applies the ContainsFold predicate on the "task_title" field.
+func TaskTitleContainsFold(v string) predicate.TaskEvent {
+	return predicate.TaskEvent(sql.FieldContainsFold(FieldTaskTitle, v))
+}
+
+// ProjectIDEQ applies the EQ predicate on the "project_id" field.
+func ProjectIDEQ(v uuid.UUID) predicate.TaskEvent {
+	return predicate.TaskEvent(sql.FieldEQ(FieldProjectID, v))
+}
+
+// ProjectIDNEQ applies the NEQ predicate on the "project_id" field.
+func ProjectIDNEQ(v uuid.UUID) predicate.TaskEvent {
+	return predicate.TaskEvent(sql.FieldNEQ(FieldProjectID, v))
+}
+
+// ProjectIDIn applies the In predicate on the "project_id" field.
+func ProjectIDIn(vs ...uuid.UUID) predicate.TaskEvent {
+	return predicate.TaskEvent(sql.FieldIn(FieldProjectID, vs...))
+}
+
+// ProjectIDNotIn applies the NotIn predicate on the "project_id" field.
+func ProjectIDNotIn(vs ...uuid.UUID) predicate.TaskEvent {
+	return predicate.TaskEvent(sql.FieldNotIn(FieldProjectID, vs...))
+}
+
+// ProjectIDGT applies the GT predicate on the "project_id" field.
+func ProjectIDGT(v uuid.UUID) predicate.TaskEvent {
+	return predicate.TaskEvent(sql.FieldGT(FieldProjectID, v))
+}
+
+// ProjectIDGTE applies the GTE predicate on the "project_id" field.
+func ProjectIDGTE(v uuid.UUID) predicate.TaskEvent {
+	return predicate.TaskEvent(sql.FieldGTE(FieldProjectID, v))
+}
+
+// ProjectIDLT applies the LT predicate on the "project_id" field.
+func ProjectIDLT(v uuid.UUID) predicate.TaskEvent {
+	return predicate.TaskEvent(sql.FieldLT(FieldProjectID, v))
+}
+
+// ProjectIDLTE applies the LTE predicate on the "project_id" field.
+func ProjectIDLTE(v uuid.UUID) predicate.TaskEvent {
+	return predicate.TaskEvent(sql.FieldLTE(FieldProjectID, v))
+}
+
+// ProjectIDIsNil applies the IsNil predicate on the "project_id" field.
+func ProjectIDIsNil() predicate.TaskEvent {
+	return predicate.TaskEvent(sql.FieldIsNull(FieldProjectID))
+}
+
+// ProjectIDNotNil applies the NotNil predicate on the "project_id" field.
+func ProjectIDNotNil() predicate.TaskEvent {
+	return predicate.TaskEvent(sql.FieldNotNull(FieldProjectID))
+}
+
+// ActionEQ applies the EQ predicate on the "action" field.
+func ActionEQ(v Action) predicate.TaskEvent {
+	return predicate.TaskEvent(sql.FieldEQ(FieldAction, v))
+}
+
+// ActionNEQ applies the NEQ predicate on the "action" field.
+func ActionNEQ(v Action) predicate.TaskEvent {
+	return predicate.TaskEvent(sql.FieldNEQ(FieldAction, v))
+}
+
+// ActionIn applies the In predicate on the "action" field.
+func ActionIn(vs ...Action) predicate.TaskEvent {
+	return predicate.TaskEvent(sql.FieldIn(FieldAction, vs...))
+}
+
+// ActionNotIn applies the NotIn predicate on the "action" field.
+func ActionNotIn(vs ...Action) predicate.TaskEvent {
+	return predicate.TaskEvent(sql.FieldNotIn(FieldAction, vs...))
+}
+
+// ActorIDEQ applies the EQ predicate on the "actor_id" field.
+func ActorIDEQ(v uuid.UUID) predicate.TaskEvent {
+	return predicate.TaskEvent(sql.FieldEQ(FieldActorID, v))
+}
+
+// ActorIDNEQ applies the NEQ predicate on the "actor_id" field.
+func ActorIDNEQ(v uuid.UUID) predicate.TaskEvent {
+	return predicate.TaskEvent(sql.FieldNEQ(FieldActorID, v))
+}
+
+// ActorIDIn applies the In predicate on the "actor_id" field.
+func ActorIDIn(vs ...uuid.UUID) predicate.TaskEvent {
+	return predicate.TaskEvent(sql.FieldIn(FieldActorID, vs...))
+}
+
+// ActorIDNotIn applies the NotIn predicate on the "actor_id" field.
+func ActorIDNotIn(vs ...uuid.UUID) predicate.TaskEvent {
+	return predicate.TaskEvent(sql.FieldNotIn(FieldActorID, vs...))
+}
+
+// ActorIDIsNil applies the IsNil predicate on the "actor_id" field.
+func ActorIDIsNil() predicate.TaskEvent {
+	return predicate.TaskEvent(sql.FieldIsNull(FieldActorID))
+}
+
+// ActorIDNotNil applies the NotNil predicate on the "actor_id" field.
+func ActorIDNotNil() predicate.TaskEvent {
+	return predicate.TaskEvent(sql.FieldNotNull(FieldActorID))
+}
+
+// RequestIDEQ applies the EQ predicate on the "request_id" field.
+func RequestIDEQ(v string) predicate.TaskEvent {
+	return predicate.TaskEvent(sql.FieldEQ(FieldRequestID, v))
+}
+
+// RequestIDNEQ applies the NEQ predicate on the "request_id" field.
+func RequestIDNEQ(v string) predicate.TaskEvent {
+	return predicate.TaskEvent(sql.FieldNEQ(FieldRequestID, v))
+}
+
+// RequestIDIn applies the In predicate on the "request_id" field.
+func RequestIDIn(vs ...string) predicate.TaskEvent {
+	return predicate.TaskEvent(sql.FieldIn(FieldRequestID, vs...))
+}
+
+// RequestIDNotIn applies the NotIn predicate on the "request_id" field.
+func RequestIDNotIn(vs ...string) predicate.TaskEvent {
+	return predicate.TaskEvent(sql.FieldNotIn(FieldRequestID, vs...))
+}
+
+// RequestIDGT applies the GT predicate on the "request_id" field.
+func RequestIDGT(v string) predicate.TaskEvent {
+	return predicate.TaskEvent(sql.FieldGT(FieldRequestID, v))
+}
+
+// RequestIDGTE applies the GTE predicate on the "request_id" field.
+func RequestIDGTE(v string) predicate.TaskEvent {
+	return predicate.TaskEvent(sql.FieldGTE(FieldRequestID, v))
+}
+
+// RequestIDLT applies the LT predicate on the "request_id" field.
+func RequestIDLT(v string) predicate.TaskEvent {
+	return predicate.TaskEvent(sql.FieldLT(FieldRequestID, v))
+}
+
+// RequestIDLTE applies the LTE predicate on the "request_id" field.
+func RequestIDLTE(v string) predicate.TaskEvent {
+	return predicate.TaskEvent(sql.FieldLTE(FieldRequestID, v))
+}
+
+// RequestIDContains applies the Contains predicate on the "request_id" field.
+func RequestIDContains(v string) predicate.TaskEvent {
+	return predicate.TaskEvent(sql.FieldContains(FieldRequestID, v))
+}
+
+// RequestIDHasPrefix applies the HasPrefix predicate on the "request_id" field.
+func RequestIDHasPrefix(v string) predicate.TaskEvent {
+	return predicate.TaskEvent(sql.FieldHasPrefix(FieldRequestID, v))
+}
+
+// RequestIDHasSuffix applies the HasSuffix predicate on the "request_id" field.
+func RequestIDHasSuffix(v string) predicate.TaskEvent {
+	return predicate.TaskEvent(sql.FieldHasSuffix(FieldRequestID, v))
+}
+
+// RequestIDIsNil applies the IsNil predicate on the "request_id" field.
+func RequestIDIsNil() predicate.TaskEvent {
+	return predicate.TaskEvent(sql.FieldIsNull(FieldRequestID))
+}
+
+// RequestIDNotNil applies the NotNil predicate on the "request_id" field.
+func RequestIDNotNil() predicate.TaskEvent {
+	return predicate.TaskEvent(sql.FieldNotNull(FieldRequestID))
+}
+
+// RequestIDEqualFold applies the EqualFold predicate on the "request_id" field.
+func RequestIDEqualFold(v string) predicate.TaskEvent {
+	return predicate.TaskEvent(sql.FieldEqualFold(FieldRequestID, v))
+}
+
+// RequestIDContainsFold applies the ContainsFold predicate on the "request_id" field.
+func RequestIDContainsFold(v string) predicate.TaskEvent {
+	return predicate.TaskEvent(sql.FieldContainsFold(FieldRequestID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.TaskEvent {
+	return predicate.TaskEvent(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.TaskEvent {
+	return predicate.TaskEvent(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.TaskEvent {
+	return predicate.TaskEvent(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.TaskEvent {
+	return predicate.TaskEvent(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.TaskEvent {
+	return predicate.TaskEvent(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.TaskEvent {
+	return predicate.TaskEvent(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.TaskEvent {
+	return predicate.TaskEvent(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.TaskEvent {
+	return predicate.TaskEvent(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasActor applies the HasEdge predicate on the "actor" edge.
+func HasActor() predicate.TaskEvent {
+	return predicate.TaskEvent(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ActorTable, ActorColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasActorWith applies the HasEdge predicate on the "actor" edge with a given conditions (other predicates).
+func HasActorWith(preds ...predicate.User) predicate.TaskEvent {
+	return predicate.TaskEvent(func(s *sql.Selector) {
+		step := newActorStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TaskEvent) predicate.TaskEvent {
+	return predicate.TaskEvent(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TaskEvent) predicate.TaskEvent {
+	return predicate.TaskEvent(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TaskEvent) predicate.TaskEvent {
+	return predicate.TaskEvent(sql.NotPredicates(p))
+}
@@ -0,0 +1,357 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/ent/taskevent"
+	"github.com/localopsco/go-sample/ent/user"
+	"github.com/localopsco/go-sample/models"
+)
+
+// TaskEventCreate is the builder for creating a TaskEvent entity.
+type TaskEventCreate struct {
+	config
+	mutation *TaskEventMutation
+	hooks    []Hook
+}
+
+// SetTaskID sets the "task_id" field.
+func (tec *TaskEventCreate) SetTaskID(u uuid.UUID) *TaskEventCreate {
+	tec.mutation.SetTaskID(u)
+	return tec
+}
+
+// SetTaskTitle sets the "task_title" field.
+func (tec *TaskEventCreate) SetTaskTitle(s string) *TaskEventCreate {
+	tec.mutation.SetTaskTitle(s)
+	return tec
+}
+
+// SetProjectID sets the "project_id" field.
+func (tec *TaskEventCreate) SetProjectID(u uuid.UUID) *TaskEventCreate {
+	tec.mutation.SetProjectID(u)
+	return tec
+}
+
+// SetNillableProjectID sets the "project_id" field if the given value is not nil.
+func (tec *TaskEventCreate) SetNillableProjectID(u *uuid.UUID) *TaskEventCreate {
+	if u != nil {
+		tec.SetProjectID(*u)
+	}
+	return tec
+}
+
+// SetAction sets the "action" field.
+func (tec *TaskEventCreate) SetAction(t taskevent.Action) *TaskEventCreate {
+	tec.mutation.SetAction(t)
+	return tec
+}
+
+// SetActorID sets the "actor_id" field.
+func (tec *TaskEventCreate) SetActorID(u uuid.UUID) *TaskEventCreate {
+	tec.mutation.SetActorID(u)
+	return tec
+}
+
+// SetNillableActorID sets the "actor_id" field if the given value is not nil.
+func (tec *TaskEventCreate) SetNillableActorID(u *uuid.UUID) *TaskEventCreate {
+	if u != nil {
+		tec.SetActorID(*u)
+	}
+	return tec
+}
+
+// SetChanges sets the "changes" field.
+func (tec *TaskEventCreate) SetChanges(mc []models.FieldChange) *TaskEventCreate {
+	tec.mutation.SetChanges(mc)
+	return tec
+}
+
+// SetRequestID sets the "request_id" field.
+func (tec *TaskEventCreate) SetRequestID(s string) *TaskEventCreate {
+	tec.mutation.SetRequestID(s)
+	return tec
+}
+
+// SetNillableRequestID sets the "request_id" field if the given value is not nil.
+func (tec *TaskEventCreate) SetNillableRequestID(s *string) *TaskEventCreate {
+	if s != nil {
+		tec.SetRequestID(*s)
+	}
+	return tec
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tec *TaskEventCreate) SetCreatedAt(t time.Time) *TaskEventCreate {
+	tec.mutation.SetCreatedAt(t)
+	return tec
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tec *TaskEventCreate) SetNillableCreatedAt(t *time.Time) *TaskEventCreate {
+	if t != nil {
+		tec.SetCreatedAt(*t)
+	}
+	return tec
+}
+
+// SetID sets the "id" field.
+func (tec *TaskEventCreate) SetID(u uuid.UUID) *TaskEventCreate {
+	tec.mutation.SetID(u)
+	return tec
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (tec *TaskEventCreate) SetNillableID(u *uuid.UUID) *TaskEventCreate {
+	if u != nil {
+		tec.SetID(*u)
+	}
+	return tec
+}
+
+// SetActor sets the "actor" edge to the User entity.
+func (tec *TaskEventCreate) SetActor(u *User) *TaskEventCreate {
+	return tec.SetActorID(u.ID)
+}
+
+// Mutation returns the TaskEventMutation object of the builder.
+func (tec *TaskEventCreate) Mutation() *TaskEventMutation {
+	return tec.mutation
+}
+
+// Save creates the TaskEvent in the database.
+func (tec *TaskEventCreate) Save(ctx context.Context) (*TaskEvent, error) {
+	tec.defaults()
+	return withHooks(ctx, tec.sqlSave, tec.mutation, tec.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (tec *TaskEventCreate) SaveX(ctx context.Context) *TaskEvent {
+	v, err := tec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tec *TaskEventCreate) Exec(ctx context.Context) error {
+	_, err := tec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tec *TaskEventCreate) ExecX(ctx context.Context) {
+	if err := tec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tec *TaskEventCreate) defaults() {
+	if _, ok := tec.mutation.CreatedAt(); !ok {
+		v := taskevent.DefaultCreatedAt()
+		tec.mutation.SetCreatedAt(v)
+	}
+	if _, ok := tec.mutation.ID(); !ok {
+		v := taskevent.DefaultID()
+		tec.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tec *TaskEventCreate) check() error {
+	if _, ok := tec.mutation.TaskID(); !ok {
+		return &ValidationError{Name: "task_id", err: errors.New(`ent: missing required field "TaskEvent.task_id"`)}
+	}
+	if _, ok := tec.mutation.TaskTitle(); !ok {
+		return &ValidationError{Name: "task_title", err: errors.New(`ent: missing required field "TaskEvent.task_title"`)}
+	}
+	if _, ok := tec.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`ent: missing required field "TaskEvent.action"`)}
+	}
+	if v, ok := tec.mutation.Action(); ok {
+		if err := taskevent.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "TaskEvent.action": %w`, err)}
+		}
+	}
+	if _, ok := tec.mutation.Changes(); !ok {
+		return &ValidationError{Name: "changes", err: errors.New(`ent: missing required field "TaskEvent.changes"`)}
+	}
+	if _, ok := tec.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TaskEvent.created_at"`)}
+	}
+	return nil
+}
+
+func (tec *TaskEventCreate) sqlSave(ctx context.Context) (*TaskEvent, error) {
+	if err := tec.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := tec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, tec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	tec.mutation.id = &_node.ID
+	tec.mutation.done = true
+	return _node, nil
+}
+
+func (tec *TaskEventCreate) createSpec() (*TaskEvent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TaskEvent{config: tec.config}
+		_spec = sqlgraph.NewCreateSpec(taskevent.Table, sqlgraph.NewFieldSpec(taskevent.FieldID, field.TypeUUID))
+	)
+	if id, ok := tec.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := tec.mutation.TaskID(); ok {
+		_spec.SetField(taskevent.FieldTaskID, field.TypeUUID, value)
+		_node.TaskID = value
+	}
+	if value, ok := tec.mutation.TaskTitle(); ok {
+		_spec.SetField(taskevent.FieldTaskTitle, field.TypeString, value)
+		_node.TaskTitle = value
+	}
+	if value, ok := tec.mutation.ProjectID(); ok {
+		_spec.SetField(taskevent.FieldProjectID, field.TypeUUID, value)
+		_node.ProjectID = &value
+	}
+	if value, ok := tec.mutation.Action(); ok {
+		_spec.SetField(taskevent.FieldAction, field.TypeEnum, value)
+		_node.Action = value
+	}
+	if value, ok := tec.mutation.Changes(); ok {
+		_spec.SetField(taskevent.FieldChanges, field.TypeJSON, value)
+		_node.Changes = value
+	}
+	if value, ok := tec.mutation.RequestID(); ok {
+		_spec.SetField(taskevent.FieldRequestID, field.TypeString, value)
+		_node.RequestID = value
+	}
+	if value, ok := tec.mutation.CreatedAt(); ok {
+		_spec.SetField(taskevent.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := tec.mutation.ActorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskevent.ActorTable,
+			Columns: []string{taskevent.ActorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ActorID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// TaskEventCreateBulk is the builder for creating many TaskEvent entities in bulk.
+type TaskEventCreateBulk struct {
+	config
+	err      error
+	builders []*TaskEventCreate
+}
+
+// Save creates the TaskEvent entities in the database.
+func (tecb *TaskEventCreateBulk) Save(ctx context.Context) ([]*TaskEvent, error) {
+	if tecb.err != nil {
+		return nil, tecb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(tecb.builders))
+	nodes := make([]*TaskEvent, len(tecb.builders))
+	mutators := make([]Mutator, len(tecb.builders))
+	for i := range tecb.builders {
+		func(i int, root context.Context) {
+			builder := tecb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TaskEventMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, tecb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, tecb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, tecb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tecb *TaskEventCreateBulk) SaveX(ctx context.Context) []*TaskEvent {
+	v, err := tecb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tecb *TaskEventCreateBulk) Exec(ctx context.Context) error {
+	_, err := tecb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tecb *TaskEventCreateBulk) ExecX(ctx context.Context) {
+	if err := tecb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/localopsco/go-sample/ent/predicate"
+	"github.com/localopsco/go-sample/ent/taskevent"
+)
+
+// TaskEventDelete is the builder for deleting a TaskEvent entity.
+type TaskEventDelete struct {
+	config
+	hooks    []Hook
+	mutation *TaskEventMutation
+}
+
+// Where appends a list predicates to the TaskEventDelete builder.
+func (ted *TaskEventDelete) Where(ps ...predicate.TaskEvent) *TaskEventDelete {
+	ted.mutation.Where(ps...)
+	return ted
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ted *TaskEventDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, ted.sqlExec, ted.mutation, ted.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ted *TaskEventDelete) ExecX(ctx context.Context) int {
+	n, err := ted.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ted *TaskEventDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(taskevent.Table, sqlgraph.NewFieldSpec(taskevent.FieldID, field.TypeUUID))
+	if ps := ted.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ted.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	ted.mutation.done = true
+	return affected, err
+}
+
+// TaskEventDeleteOne is the builder for deleting a single TaskEvent entity.
+type TaskEventDeleteOne struct {
+	ted *TaskEventDelete
+}
+
+// Where appends a list predicates to the TaskEventDelete builder.
+func (tedo *TaskEventDeleteOne) Where(ps ...predicate.TaskEvent) *TaskEventDeleteOne {
+	tedo.ted.mutation.Where(ps...)
+	return tedo
+}
+
+// Exec executes the deletion query.
+func (tedo *TaskEventDeleteOne) Exec(ctx context.Context) error {
+	n, err := tedo.ted.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{taskevent.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tedo *TaskEventDeleteOne) ExecX(ctx context.Context) {
+	if err := tedo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
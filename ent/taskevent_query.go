@@ -0,0 +1,638 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/ent/predicate"
+	"github.com/localopsco/go-sample/ent/taskevent"
+	"github.com/localopsco/go-sample/ent/user"
+)
+
+// TaskEventQuery is the builder for querying TaskEvent entities.
+type TaskEventQuery struct {
+	config
+	ctx        *QueryContext
+	order      []taskevent.OrderOption
+	inters     []Interceptor
+	predicates []predicate.TaskEvent
+	withActor  *UserQuery
+	loadTotal  []func(context.Context, []*TaskEvent) error
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the TaskEventQuery builder.
+func (teq *TaskEventQuery) Where(ps ...predicate.TaskEvent) *TaskEventQuery {
+	teq.predicates = append(teq.predicates, ps...)
+	return teq
+}
+
+// Limit the number of records to be returned by this query.
+func (teq *TaskEventQuery) Limit(limit int) *TaskEventQuery {
+	teq.ctx.Limit = &limit
+	return teq
+}
+
+// Offset to start from.
+func (teq *TaskEventQuery) Offset(offset int) *TaskEventQuery {
+	teq.ctx.Offset = &offset
+	return teq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (teq *TaskEventQuery) Unique(unique bool) *TaskEventQuery {
+	teq.ctx.Unique = &unique
+	return teq
+}
+
+// Order specifies how the records should be ordered.
+func (teq *TaskEventQuery) Order(o ...taskevent.OrderOption) *TaskEventQuery {
+	teq.order = append(teq.order, o...)
+	return teq
+}
+
+// QueryActor chains the current query on the "actor" edge.
+func (teq *TaskEventQuery) QueryActor() *UserQuery {
+	query := (&UserClient{config: teq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := teq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := teq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(taskevent.Table, taskevent.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, taskevent.ActorTable, taskevent.ActorColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(teq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first TaskEvent entity from the query.
+// Returns a *NotFoundError when no TaskEvent was found.
+func (teq *TaskEventQuery) First(ctx context.Context) (*TaskEvent, error) {
+	nodes, err := teq.Limit(1).All(setContextOp(ctx, teq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{taskevent.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (teq *TaskEventQuery) FirstX(ctx context.Context) *TaskEvent {
+	node, err := teq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first TaskEvent ID from the query.
+// Returns a *NotFoundError when no TaskEvent ID was found.
+func (teq *TaskEventQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = teq.Limit(1).IDs(setContextOp(ctx, teq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{taskevent.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (teq *TaskEventQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := teq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single TaskEvent entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one TaskEvent entity is found.
+// Returns a *NotFoundError when no TaskEvent entities are found.
+func (teq *TaskEventQuery) Only(ctx context.Context) (*TaskEvent, error) {
+	nodes, err := teq.Limit(2).All(setContextOp(ctx, teq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{taskevent.Label}
+	default:
+		return nil, &NotSingularError{taskevent.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (teq *TaskEventQuery) OnlyX(ctx context.Context) *TaskEvent {
+	node, err := teq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only TaskEvent ID in the query.
+// Returns a *NotSingularError when more than one TaskEvent ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (teq *TaskEventQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = teq.Limit(2).IDs(setContextOp(ctx, teq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{taskevent.Label}
+	default:
+		err = &NotSingularError{taskevent.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (teq *TaskEventQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := teq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of TaskEvents.
+func (teq *TaskEventQuery) All(ctx context.Context) ([]*TaskEvent, error) {
+	ctx = setContextOp(ctx, teq.ctx, ent.OpQueryAll)
+	if err := teq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*TaskEvent, *TaskEventQuery]()
+	return withInterceptors[[]*TaskEvent](ctx, teq, qr, teq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (teq *TaskEventQuery) AllX(ctx context.Context) []*TaskEvent {
+	nodes, err := teq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of TaskEvent IDs.
+func (teq *TaskEventQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if teq.ctx.Unique == nil && teq.path != nil {
+		teq.Unique(true)
+	}
+	ctx = setContextOp(ctx, teq.ctx, ent.OpQueryIDs)
+	if err = teq.Select(taskevent.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (teq *TaskEventQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := teq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (teq *TaskEventQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, teq.ctx, ent.OpQueryCount)
+	if err := teq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, teq, querierCount[*TaskEventQuery](), teq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (teq *TaskEventQuery) CountX(ctx context.Context) int {
+	count, err := teq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (teq *TaskEventQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, teq.ctx, ent.OpQueryExist)
+	switch _, err := teq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (teq *TaskEventQuery) ExistX(ctx context.Context) bool {
+	exist, err := teq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the TaskEventQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (teq *TaskEventQuery) Clone() *TaskEventQuery {
+	if teq == nil {
+		return nil
+	}
+	return &TaskEventQuery{
+		config:     teq.config,
+		ctx:        teq.ctx.Clone(),
+		order:      append([]taskevent.OrderOption{}, teq.order...),
+		inters:     append([]Interceptor{}, teq.inters...),
+		predicates: append([]predicate.TaskEvent{}, teq.predicates...),
+		withActor:  teq.withActor.Clone(),
+		// clone intermediate query.
+		sql:  teq.sql.Clone(),
+		path: teq.path,
+	}
+}
+
+// WithActor tells the query-builder to eager-load the nodes that are connected to
+// the "actor" edge. The optional arguments are used to configure the query builder of the edge.
+func (teq *TaskEventQuery) WithActor(opts ...func(*UserQuery)) *TaskEventQuery {
+	query := (&UserClient{config: teq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	teq.withActor = query
+	return teq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		TaskID uuid.UUID `json:"task_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.TaskEvent.Query().
+//		GroupBy(taskevent.FieldTaskID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (teq *TaskEventQuery) GroupBy(field string, fields ...string) *TaskEventGroupBy {
+	teq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &TaskEventGroupBy{build: teq}
+	grbuild.flds = &teq.ctx.Fields
+	grbuild.label = taskevent.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		TaskID uuid.UUID `json:"task_id,omitempty"`
+//	}
+//
+//	client.TaskEvent.Query().
+//		Select(taskevent.FieldTaskID).
+//		Scan(ctx, &v)
+func (teq *TaskEventQuery) Select(fields ...string) *TaskEventSelect {
+	teq.ctx.Fields = append(teq.ctx.Fields, fields...)
+	sbuild := &TaskEventSelect{TaskEventQuery: teq}
+	sbuild.label = taskevent.Label
+	sbuild.flds, sbuild.scan = &teq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a TaskEventSelect configured with the given aggregations.
+func (teq *TaskEventQuery) Aggregate(fns ...AggregateFunc) *TaskEventSelect {
+	return teq.Select().Aggregate(fns...)
+}
+
+func (teq *TaskEventQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range teq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, teq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range teq.ctx.Fields {
+		if !taskevent.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if teq.path != nil {
+		prev, err := teq.path(ctx)
+		if err != nil {
+			return err
+		}
+		teq.sql = prev
+	}
+	return nil
+}
+
+func (teq *TaskEventQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*TaskEvent, error) {
+	var (
+		nodes       = []*TaskEvent{}
+		_spec       = teq.querySpec()
+		loadedTypes = [1]bool{
+			teq.withActor != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*TaskEvent).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &TaskEvent{config: teq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(teq.modifiers) > 0 {
+		_spec.Modifiers = teq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, teq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := teq.withActor; query != nil {
+		if err := teq.loadActor(ctx, query, nodes, nil,
+			func(n *TaskEvent, e *User) { n.Edges.Actor = e }); err != nil {
+			return nil, err
+		}
+	}
+	for i := range teq.loadTotal {
+		if err := teq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (teq *TaskEventQuery) loadActor(ctx context.Context, query *UserQuery, nodes []*TaskEvent, init func(*TaskEvent), assign func(*TaskEvent, *User)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*TaskEvent)
+	for i := range nodes {
+		if nodes[i].ActorID == nil {
+			continue
+		}
+		fk := *nodes[i].ActorID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "actor_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (teq *TaskEventQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := teq.querySpec()
+	if len(teq.modifiers) > 0 {
+		_spec.Modifiers = teq.modifiers
+	}
+	_spec.Node.Columns = teq.ctx.Fields
+	if len(teq.ctx.Fields) > 0 {
+		_spec.Unique = teq.ctx.Unique != nil && *teq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, teq.driver, _spec)
+}
+
+func (teq *TaskEventQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(taskevent.Table, taskevent.Columns, sqlgraph.NewFieldSpec(taskevent.FieldID, field.TypeUUID))
+	_spec.From = teq.sql
+	if unique := teq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if teq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := teq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, taskevent.FieldID)
+		for i := range fields {
+			if fields[i] != taskevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if teq.withActor != nil {
+			_spec.Node.AddColumnOnce(taskevent.FieldActorID)
+		}
+	}
+	if ps := teq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := teq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := teq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := teq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (teq *TaskEventQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(teq.driver.Dialect())
+	t1 := builder.Table(taskevent.Table)
+	columns := teq.ctx.Fields
+	if len(columns) == 0 {
+		columns = taskevent.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if teq.sql != nil {
+		selector = teq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if teq.ctx.Unique != nil && *teq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range teq.modifiers {
+		m(selector)
+	}
+	for _, p := range teq.predicates {
+		p(selector)
+	}
+	for _, p := range teq.order {
+		p(selector)
+	}
+	if offset := teq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := teq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (teq *TaskEventQuery) Modify(modifiers ...func(s *sql.Selector)) *TaskEventSelect {
+	teq.modifiers = append(teq.modifiers, modifiers...)
+	return teq.Select()
+}
+
+// TaskEventGroupBy is the group-by builder for TaskEvent entities.
+type TaskEventGroupBy struct {
+	selector
+	build *TaskEventQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (tegb *TaskEventGroupBy) Aggregate(fns ...AggregateFunc) *TaskEventGroupBy {
+	tegb.fns = append(tegb.fns, fns...)
+	return tegb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tegb *TaskEventGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tegb.build.ctx, ent.OpQueryGroupBy)
+	if err := tegb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TaskEventQuery, *TaskEventGroupBy](ctx, tegb.build, tegb, tegb.build.inters, v)
+}
+
+func (tegb *TaskEventGroupBy) sqlScan(ctx context.Context, root *TaskEventQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(tegb.fns))
+	for _, fn := range tegb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*tegb.flds)+len(tegb.fns))
+		for _, f := range *tegb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*tegb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tegb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// TaskEventSelect is the builder for selecting fields of TaskEvent entities.
+type TaskEventSelect struct {
+	*TaskEventQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (tes *TaskEventSelect) Aggregate(fns ...AggregateFunc) *TaskEventSelect {
+	tes.fns = append(tes.fns, fns...)
+	return tes
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tes *TaskEventSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tes.ctx, ent.OpQuerySelect)
+	if err := tes.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TaskEventQuery, *TaskEventSelect](ctx, tes.TaskEventQuery, tes, tes.inters, v)
+}
+
+func (tes *TaskEventSelect) sqlScan(ctx context.Context, root *TaskEventQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(tes.fns))
+	for _, fn := range tes.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*tes.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tes.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (tes *TaskEventSelect) Modify(modifiers ...func(s *sql.Selector)) *TaskEventSelect {
+	tes.modifiers = append(tes.modifiers, modifiers...)
+	return tes
+}
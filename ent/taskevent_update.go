@@ -0,0 +1,203 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/localopsco/go-sample/ent/predicate"
+	"github.com/localopsco/go-sample/ent/taskevent"
+)
+
+// TaskEventUpdate is the builder for updating TaskEvent entities.
+type TaskEventUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *TaskEventMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the TaskEventUpdate builder.
+func (teu *TaskEventUpdate) Where(ps ...predicate.TaskEvent) *TaskEventUpdate {
+	teu.mutation.Where(ps...)
+	return teu
+}
+
+// Mutation returns the TaskEventMutation object of the builder.
+func (teu *TaskEventUpdate) Mutation() *TaskEventMutation {
+	return teu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (teu *TaskEventUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, teu.sqlSave, teu.mutation, teu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (teu *TaskEventUpdate) SaveX(ctx context.Context) int {
+	affected, err := teu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (teu *TaskEventUpdate) Exec(ctx context.Context) error {
+	_, err := teu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (teu *TaskEventUpdate) ExecX(ctx context.Context) {
+	if err := teu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (teu *TaskEventUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TaskEventUpdate {
+	teu.modifiers = append(teu.modifiers, modifiers...)
+	return teu
+}
+
+func (teu *TaskEventUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(taskevent.Table, taskevent.Columns, sqlgraph.NewFieldSpec(taskevent.FieldID, field.TypeUUID))
+	if ps := teu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if teu.mutation.ProjectIDCleared() {
+		_spec.ClearField(taskevent.FieldProjectID, field.TypeUUID)
+	}
+	if teu.mutation.RequestIDCleared() {
+		_spec.ClearField(taskevent.FieldRequestID, field.TypeString)
+	}
+	_spec.AddModifiers(teu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, teu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{taskevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	teu.mutation.done = true
+	return n, nil
+}
+
+// TaskEventUpdateOne is the builder for updating a single TaskEvent entity.
+type TaskEventUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *TaskEventMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Mutation returns the TaskEventMutation object of the builder.
+func (teuo *TaskEventUpdateOne) Mutation() *TaskEventMutation {
+	return teuo.mutation
+}
+
+// Where appends a list predicates to the TaskEventUpdate builder.
+func (teuo *TaskEventUpdateOne) Where(ps ...predicate.TaskEvent) *TaskEventUpdateOne {
+	teuo.mutation.Where(ps...)
+	return teuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (teuo *TaskEventUpdateOne) Select(field string, fields ...string) *TaskEventUpdateOne {
+	teuo.fields = append([]string{field}, fields...)
+	return teuo
+}
+
+// Save executes the query and returns the updated TaskEvent entity.
+func (teuo *TaskEventUpdateOne) Save(ctx context.Context) (*TaskEvent, error) {
+	return withHooks(ctx, teuo.sqlSave, teuo.mutation, teuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (teuo *TaskEventUpdateOne) SaveX(ctx context.Context) *TaskEvent {
+	node, err := teuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (teuo *TaskEventUpdateOne) Exec(ctx context.Context) error {
+	_, err := teuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (teuo *TaskEventUpdateOne) ExecX(ctx context.Context) {
+	if err := teuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (teuo *TaskEventUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TaskEventUpdateOne {
+	teuo.modifiers = append(teuo.modifiers, modifiers...)
+	return teuo
+}
+
+func (teuo *TaskEventUpdateOne) sqlSave(ctx context.Context) (_node *TaskEvent, err error) {
+	_spec := sqlgraph.NewUpdateSpec(taskevent.Table, taskevent.Columns, sqlgraph.NewFieldSpec(taskevent.FieldID, field.TypeUUID))
+	id, ok := teuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TaskEvent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := teuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, taskevent.FieldID)
+		for _, f := range fields {
+			if !taskevent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != taskevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := teuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if teuo.mutation.ProjectIDCleared() {
+		_spec.ClearField(taskevent.FieldProjectID, field.TypeUUID)
+	}
+	if teuo.mutation.RequestIDCleared() {
+		_spec.ClearField(taskevent.FieldRequestID, field.TypeString)
+	}
+	_spec.AddModifiers(teuo.modifiers...)
+	_node = &TaskEvent{config: teuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, teuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{taskevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	teuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,129 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/ent/tasktombstone"
+)
+
+// TaskTombstone is the model entity for the TaskTombstone schema.
+type TaskTombstone struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// TaskID holds the value of the "task_id" field.
+	TaskID uuid.UUID `json:"task_id,omitempty"`
+	// ChangeSeq holds the value of the "change_seq" field.
+	ChangeSeq int64 `json:"change_seq,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt    time.Time `json:"deleted_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TaskTombstone) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case tasktombstone.FieldID, tasktombstone.FieldChangeSeq:
+			values[i] = new(sql.NullInt64)
+		case tasktombstone.FieldDeletedAt:
+			values[i] = new(sql.NullTime)
+		case tasktombstone.FieldTaskID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TaskTombstone fields.
+func (tt *TaskTombstone) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case tasktombstone.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			tt.ID = int(value.Int64)
+		case tasktombstone.FieldTaskID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field task_id", values[i])
+			} else if value != nil {
+				tt.TaskID = *value
+			}
+		case tasktombstone.FieldChangeSeq:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field change_seq", values[i])
+			} else if value.Valid {
+				tt.ChangeSeq = value.Int64
+			}
+		case tasktombstone.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				tt.DeletedAt = value.Time
+			}
+		default:
+			tt.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TaskTombstone.
+// This includes values selected through modifiers, order, etc.
+func (tt *TaskTombstone) Value(name string) (ent.Value, error) {
+	return tt.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this TaskTombstone.
+// Note that you need to call TaskTombstone.Unwrap() before calling this method if this TaskTombstone
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (tt *TaskTombstone) Update() *TaskTombstoneUpdateOne {
+	return NewTaskTombstoneClient(tt.config).UpdateOne(tt)
+}
+
+// Unwrap unwraps the TaskTombstone entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (tt *TaskTombstone) Unwrap() *TaskTombstone {
+	_tx, ok := tt.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TaskTombstone is not a transactional entity")
+	}
+	tt.config.driver = _tx.drv
+	return tt
+}
+
+// String implements the fmt.Stringer.
+func (tt *TaskTombstone) String() string {
+	var builder strings.Builder
+	builder.WriteString("TaskTombstone(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", tt.ID))
+	builder.WriteString("task_id=")
+	builder.WriteString(fmt.Sprintf("%v", tt.TaskID))
+	builder.WriteString(", ")
+	builder.WriteString("change_seq=")
+	builder.WriteString(fmt.Sprintf("%v", tt.ChangeSeq))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_at=")
+	builder.WriteString(tt.DeletedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TaskTombstones is a parsable slice of TaskTombstone.
+type TaskTombstones []*TaskTombstone
@@ -0,0 +1,229 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/ent/tasktombstone"
+)
+
+// TaskTombstoneCreate is the builder for creating a TaskTombstone entity.
+type TaskTombstoneCreate struct {
+	config
+	mutation *TaskTombstoneMutation
+	hooks    []Hook
+}
+
+// SetTaskID sets the "task_id" field.
+func (ttc *TaskTombstoneCreate) SetTaskID(u uuid.UUID) *TaskTombstoneCreate {
+	ttc.mutation.SetTaskID(u)
+	return ttc
+}
+
+// SetChangeSeq sets the "change_seq" field.
+func (ttc *TaskTombstoneCreate) SetChangeSeq(i int64) *TaskTombstoneCreate {
+	ttc.mutation.SetChangeSeq(i)
+	return ttc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (ttc *TaskTombstoneCreate) SetDeletedAt(t time.Time) *TaskTombstoneCreate {
+	ttc.mutation.SetDeletedAt(t)
+	return ttc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (ttc *TaskTombstoneCreate) SetNillableDeletedAt(t *time.Time) *TaskTombstoneCreate {
+	if t != nil {
+		ttc.SetDeletedAt(*t)
+	}
+	return ttc
+}
+
+// Mutation returns the TaskTombstoneMutation object of the builder.
+func (ttc *TaskTombstoneCreate) Mutation() *TaskTombstoneMutation {
+	return ttc.mutation
+}
+
+// Save creates the TaskTombstone in the database.
+func (ttc *TaskTombstoneCreate) Save(ctx context.Context) (*TaskTombstone, error) {
+	ttc.defaults()
+	return withHooks(ctx, ttc.sqlSave, ttc.mutation, ttc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ttc *TaskTombstoneCreate) SaveX(ctx context.Context) *TaskTombstone {
+	v, err := ttc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ttc *TaskTombstoneCreate) Exec(ctx context.Context) error {
+	_, err := ttc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ttc *TaskTombstoneCreate) ExecX(ctx context.Context) {
+	if err := ttc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ttc *TaskTombstoneCreate) defaults() {
+	if _, ok := ttc.mutation.DeletedAt(); !ok {
+		v := tasktombstone.DefaultDeletedAt()
+		ttc.mutation.SetDeletedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ttc *TaskTombstoneCreate) check() error {
+	if _, ok := ttc.mutation.TaskID(); !ok {
+		return &ValidationError{Name: "task_id", err: errors.New(`ent: missing required field "TaskTombstone.task_id"`)}
+	}
+	if _, ok := ttc.mutation.ChangeSeq(); !ok {
+		return &ValidationError{Name: "change_seq", err: errors.New(`ent: missing required field "TaskTombstone.change_seq"`)}
+	}
+	if _, ok := ttc.mutation.DeletedAt(); !ok {
+		return &ValidationError{Name: "deleted_at", err: errors.New(`ent: missing required field "TaskTombstone.deleted_at"`)}
+	}
+	return nil
+}
+
+func (ttc *TaskTombstoneCreate) sqlSave(ctx context.Context) (*TaskTombstone, error) {
+	if err := ttc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ttc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ttc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	ttc.mutation.id = &_node.ID
+	ttc.mutation.done = true
+	return _node, nil
+}
+
+func (ttc *TaskTombstoneCreate) createSpec() (*TaskTombstone, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TaskTombstone{config: ttc.config}
+		_spec = sqlgraph.NewCreateSpec(tasktombstone.Table, sqlgraph.NewFieldSpec(tasktombstone.FieldID, field.TypeInt))
+	)
+	if value, ok := ttc.mutation.TaskID(); ok {
+		_spec.SetField(tasktombstone.FieldTaskID, field.TypeUUID, value)
+		_node.TaskID = value
+	}
+	if value, ok := ttc.mutation.ChangeSeq(); ok {
+		_spec.SetField(tasktombstone.FieldChangeSeq, field.TypeInt64, value)
+		_node.ChangeSeq = value
+	}
+	if value, ok := ttc.mutation.DeletedAt(); ok {
+		_spec.SetField(tasktombstone.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = value
+	}
+	return _node, _spec
+}
+
+// TaskTombstoneCreateBulk is the builder for creating many TaskTombstone entities in bulk.
+type TaskTombstoneCreateBulk struct {
+	config
+	err      error
+	builders []*TaskTombstoneCreate
+}
+
+// Save creates the TaskTombstone entities in the database.
+func (ttcb *TaskTombstoneCreateBulk) Save(ctx context.Context) ([]*TaskTombstone, error) {
+	if ttcb.err != nil {
+		return nil, ttcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ttcb.builders))
+	nodes := make([]*TaskTombstone, len(ttcb.builders))
+	mutators := make([]Mutator, len(ttcb.builders))
+	for i := range ttcb.builders {
+		func(i int, root context.Context) {
+			builder := ttcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TaskTombstoneMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ttcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ttcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ttcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ttcb *TaskTombstoneCreateBulk) SaveX(ctx context.Context) []*TaskTombstone {
+	v, err := ttcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ttcb *TaskTombstoneCreateBulk) Exec(ctx context.Context) error {
+	_, err := ttcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ttcb *TaskTombstoneCreateBulk) ExecX(ctx context.Context) {
+	if err := ttcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
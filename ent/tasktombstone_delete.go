@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/localopsco/go-sample/ent/predicate"
+	"github.com/localopsco/go-sample/ent/tasktombstone"
+)
+
+// TaskTombstoneDelete is the builder for deleting a TaskTombstone entity.
+type TaskTombstoneDelete struct {
+	config
+	hooks    []Hook
+	mutation *TaskTombstoneMutation
+}
+
+// Where appends a list predicates to the TaskTombstoneDelete builder.
+func (ttd *TaskTombstoneDelete) Where(ps ...predicate.TaskTombstone) *TaskTombstoneDelete {
+	ttd.mutation.Where(ps...)
+	return ttd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ttd *TaskTombstoneDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, ttd.sqlExec, ttd.mutation, ttd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ttd *TaskTombstoneDelete) ExecX(ctx context.Context) int {
+	n, err := ttd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ttd *TaskTombstoneDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(tasktombstone.Table, sqlgraph.NewFieldSpec(tasktombstone.FieldID, field.TypeInt))
+	if ps := ttd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ttd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	ttd.mutation.done = true
+	return affected, err
+}
+
+// TaskTombstoneDeleteOne is the builder for deleting a single TaskTombstone entity.
+type TaskTombstoneDeleteOne struct {
+	ttd *TaskTombstoneDelete
+}
+
+// Where appends a list predicates to the TaskTombstoneDelete builder.
+func (ttdo *TaskTombstoneDeleteOne) Where(ps ...predicate.TaskTombstone) *TaskTombstoneDeleteOne {
+	ttdo.ttd.mutation.Where(ps...)
+	return ttdo
+}
+
+// Exec executes the deletion query.
+func (ttdo *TaskTombstoneDeleteOne) Exec(ctx context.Context) error {
+	n, err := ttdo.ttd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{tasktombstone.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ttdo *TaskTombstoneDeleteOne) ExecX(ctx context.Context) {
+	if err := ttdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
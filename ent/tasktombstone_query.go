@@ -0,0 +1,555 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/localopsco/go-sample/ent/predicate"
+	"github.com/localopsco/go-sample/ent/tasktombstone"
+)
+
+// TaskTombstoneQuery is the builder for querying TaskTombstone entities.
+type TaskTombstoneQuery struct {
+	config
+	ctx        *QueryContext
+	order      []tasktombstone.OrderOption
+	inters     []Interceptor
+	predicates []predicate.TaskTombstone
+	loadTotal  []func(context.Context, []*TaskTombstone) error
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the TaskTombstoneQuery builder.
+func (ttq *TaskTombstoneQuery) Where(ps ...predicate.TaskTombstone) *TaskTombstoneQuery {
+	ttq.predicates = append(ttq.predicates, ps...)
+	return ttq
+}
+
+// Limit the number of records to be returned by this query.
+func (ttq *TaskTombstoneQuery) Limit(limit int) *TaskTombstoneQuery {
+	ttq.ctx.Limit = &limit
+	return ttq
+}
+
+// Offset to start from.
+func (ttq *TaskTombstoneQuery) Offset(offset int) *TaskTombstoneQuery {
+	ttq.ctx.Offset = &offset
+	return ttq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (ttq *TaskTombstoneQuery) Unique(unique bool) *TaskTombstoneQuery {
+	ttq.ctx.Unique = &unique
+	return ttq
+}
+
+// Order specifies how the records should be ordered.
+func (ttq *TaskTombstoneQuery) Order(o ...tasktombstone.OrderOption) *TaskTombstoneQuery {
+	ttq.order = append(ttq.order, o...)
+	return ttq
+}
+
+// First returns the first TaskTombstone entity from the query.
+// Returns a *NotFoundError when no TaskTombstone was found.
+func (ttq *TaskTombstoneQuery) First(ctx context.Context) (*TaskTombstone, error) {
+	nodes, err := ttq.Limit(1).All(setContextOp(ctx, ttq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{tasktombstone.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ttq *TaskTombstoneQuery) FirstX(ctx context.Context) *TaskTombstone {
+	node, err := ttq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first TaskTombstone ID from the query.
+// Returns a *NotFoundError when no TaskTombstone ID was found.
+func (ttq *TaskTombstoneQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ttq.Limit(1).IDs(setContextOp(ctx, ttq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{tasktombstone.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (ttq *TaskTombstoneQuery) FirstIDX(ctx context.Context) int {
+	id, err := ttq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single TaskTombstone entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one TaskTombstone entity is found.
+// Returns a *NotFoundError when no TaskTombstone entities are found.
+func (ttq *TaskTombstoneQuery) Only(ctx context.Context) (*TaskTombstone, error) {
+	nodes, err := ttq.Limit(2).All(setContextOp(ctx, ttq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{tasktombstone.Label}
+	default:
+		return nil, &NotSingularError{tasktombstone.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ttq *TaskTombstoneQuery) OnlyX(ctx context.Context) *TaskTombstone {
+	node, err := ttq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only TaskTombstone ID in the query.
+// Returns a *NotSingularError when more than one TaskTombstone ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (ttq *TaskTombstoneQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ttq.Limit(2).IDs(setContextOp(ctx, ttq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{tasktombstone.Label}
+	default:
+		err = &NotSingularError{tasktombstone.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (ttq *TaskTombstoneQuery) OnlyIDX(ctx context.Context) int {
+	id, err := ttq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of TaskTombstones.
+func (ttq *TaskTombstoneQuery) All(ctx context.Context) ([]*TaskTombstone, error) {
+	ctx = setContextOp(ctx, ttq.ctx, ent.OpQueryAll)
+	if err := ttq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*TaskTombstone, *TaskTombstoneQuery]()
+	return withInterceptors[[]*TaskTombstone](ctx, ttq, qr, ttq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ttq *TaskTombstoneQuery) AllX(ctx context.Context) []*TaskTombstone {
+	nodes, err := ttq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of TaskTombstone IDs.
+func (ttq *TaskTombstoneQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if ttq.ctx.Unique == nil && ttq.path != nil {
+		ttq.Unique(true)
+	}
+	ctx = setContextOp(ctx, ttq.ctx, ent.OpQueryIDs)
+	if err = ttq.Select(tasktombstone.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ttq *TaskTombstoneQuery) IDsX(ctx context.Context) []int {
+	ids, err := ttq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ttq *TaskTombstoneQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, ttq.ctx, ent.OpQueryCount)
+	if err := ttq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, ttq, querierCount[*TaskTombstoneQuery](), ttq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ttq *TaskTombstoneQuery) CountX(ctx context.Context) int {
+	count, err := ttq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ttq *TaskTombstoneQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, ttq.ctx, ent.OpQueryExist)
+	switch _, err := ttq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ttq *TaskTombstoneQuery) ExistX(ctx context.Context) bool {
+	exist, err := ttq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the TaskTombstoneQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ttq *TaskTombstoneQuery) Clone() *TaskTombstoneQuery {
+	if ttq == nil {
+		return nil
+	}
+	return &TaskTombstoneQuery{
+		config:     ttq.config,
+		ctx:        ttq.ctx.Clone(),
+		order:      append([]tasktombstone.OrderOption{}, ttq.order...),
+		inters:     append([]Interceptor{}, ttq.inters...),
+		predicates: append([]predicate.TaskTombstone{}, ttq.predicates...),
+		// clone intermediate query.
+		sql:  ttq.sql.Clone(),
+		path: ttq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		TaskID uuid.UUID `json:"task_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.TaskTombstone.Query().
+//		GroupBy(tasktombstone.FieldTaskID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (ttq *TaskTombstoneQuery) GroupBy(field string, fields ...string) *TaskTombstoneGroupBy {
+	ttq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &TaskTombstoneGroupBy{build: ttq}
+	grbuild.flds = &ttq.ctx.Fields
+	grbuild.label = tasktombstone.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		TaskID uuid.UUID `json:"task_id,omitempty"`
+//	}
+//
+//	client.TaskTombstone.Query().
+//		Select(tasktombstone.FieldTaskID).
+//		Scan(ctx, &v)
+func (ttq *TaskTombstoneQuery) Select(fields ...string) *TaskTombstoneSelect {
+	ttq.ctx.Fields = append(ttq.ctx.Fields, fields...)
+	sbuild := &TaskTombstoneSelect{TaskTombstoneQuery: ttq}
+	sbuild.label = tasktombstone.Label
+	sbuild.flds, sbuild.scan = &ttq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a TaskTombstoneSelect configured with the given aggregations.
+func (ttq *TaskTombstoneQuery) Aggregate(fns ...AggregateFunc) *TaskTombstoneSelect {
+	return ttq.Select().Aggregate(fns...)
+}
+
+func (ttq *TaskTombstoneQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range ttq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, ttq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range ttq.ctx.Fields {
+		if !tasktombstone.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if ttq.path != nil {
+		prev, err := ttq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ttq.sql = prev
+	}
+	return nil
+}
+
+func (ttq *TaskTombstoneQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*TaskTombstone, error) {
+	var (
+		nodes = []*TaskTombstone{}
+		_spec = ttq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*TaskTombstone).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &TaskTombstone{config: ttq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(ttq.modifiers) > 0 {
+		_spec.Modifiers = ttq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ttq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	for i := range ttq.loadTotal {
+		if err := ttq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (ttq *TaskTombstoneQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ttq.querySpec()
+	if len(ttq.modifiers) > 0 {
+		_spec.Modifiers = ttq.modifiers
+	}
+	_spec.Node.Columns = ttq.ctx.Fields
+	if len(ttq.ctx.Fields) > 0 {
+		_spec.Unique = ttq.ctx.Unique != nil && *ttq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, ttq.driver, _spec)
+}
+
+func (ttq *TaskTombstoneQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(tasktombstone.Table, tasktombstone.Columns, sqlgraph.NewFieldSpec(tasktombstone.FieldID, field.TypeInt))
+	_spec.From = ttq.sql
+	if unique := ttq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if ttq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := ttq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, tasktombstone.FieldID)
+		for i := range fields {
+			if fields[i] != tasktombstone.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := ttq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ttq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ttq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ttq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ttq *TaskTombstoneQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ttq.driver.Dialect())
+	t1 := builder.Table(tasktombstone.Table)
+	columns := ttq.ctx.Fields
+	if len(columns) == 0 {
+		columns = tasktombstone.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if ttq.sql != nil {
+		selector = ttq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if ttq.ctx.Unique != nil && *ttq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range ttq.modifiers {
+		m(selector)
+	}
+	for _, p := range ttq.predicates {
+		p(selector)
+	}
+	for _, p := range ttq.order {
+		p(selector)
+	}
+	if offset := ttq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ttq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (ttq *TaskTombstoneQuery) Modify(modifiers ...func(s *sql.Selector)) *TaskTombstoneSelect {
+	ttq.modifiers = append(ttq.modifiers, modifiers...)
+	return ttq.Select()
+}
+
+// TaskTombstoneGroupBy is the group-by builder for TaskTombstone entities.
+type TaskTombstoneGroupBy struct {
+	selector
+	build *TaskTombstoneQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ttgb *TaskTombstoneGroupBy) Aggregate(fns ...AggregateFunc) *TaskTombstoneGroupBy {
+	ttgb.fns = append(ttgb.fns, fns...)
+	return ttgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ttgb *TaskTombstoneGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ttgb.build.ctx, ent.OpQueryGroupBy)
+	if err := ttgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TaskTombstoneQuery, *TaskTombstoneGroupBy](ctx, ttgb.build, ttgb, ttgb.build.inters, v)
+}
+
+func (ttgb *TaskTombstoneGroupBy) sqlScan(ctx context.Context, root *TaskTombstoneQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(ttgb.fns))
+	for _, fn := range ttgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*ttgb.flds)+len(ttgb.fns))
+		for _, f := range *ttgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*ttgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ttgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// TaskTombstoneSelect is the builder for selecting fields of TaskTombstone entities.
+type TaskTombstoneSelect struct {
+	*TaskTombstoneQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (tts *TaskTombstoneSelect) Aggregate(fns ...AggregateFunc) *TaskTombstoneSelect {
+	tts.fns = append(tts.fns, fns...)
+	return tts
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tts *TaskTombstoneSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tts.ctx, ent.OpQuerySelect)
+	if err := tts.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TaskTombstoneQuery, *TaskTombstoneSelect](ctx, tts.TaskTombstoneQuery, tts, tts.inters, v)
+}
+
+func (tts *TaskTombstoneSelect) sqlScan(ctx context.Context, root *TaskTombstoneQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(tts.fns))
+	for _, fn := range tts.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*tts.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tts.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (tts *TaskTombstoneSelect) Modify(modifiers ...func(s *sql.Selector)) *TaskTombstoneSelect {
+	tts.modifiers = append(tts.modifiers, modifiers...)
+	return tts
+}
@@ -0,0 +1,191 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/localopsco/go-sample/ent/predicate"
+	"github.com/localopsco/go-sample/ent/tasktombstone"
+)
+
+// TaskTombstoneUpdate is the builder for updating TaskTombstone entities.
+type TaskTombstoneUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *TaskTombstoneMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the TaskTombstoneUpdate builder.
+func (ttu *TaskTombstoneUpdate) Where(ps ...predicate.TaskTombstone) *TaskTombstoneUpdate {
+	ttu.mutation.Where(ps...)
+	return ttu
+}
+
+// Mutation returns the TaskTombstoneMutation object of the builder.
+func (ttu *TaskTombstoneUpdate) Mutation() *TaskTombstoneMutation {
+	return ttu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ttu *TaskTombstoneUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, ttu.sqlSave, ttu.mutation, ttu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ttu *TaskTombstoneUpdate) SaveX(ctx context.Context) int {
+	affected, err := ttu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ttu *TaskTombstoneUpdate) Exec(ctx context.Context) error {
+	_, err := ttu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ttu *TaskTombstoneUpdate) ExecX(ctx context.Context) {
+	if err := ttu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ttu *TaskTombstoneUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TaskTombstoneUpdate {
+	ttu.modifiers = append(ttu.modifiers, modifiers...)
+	return ttu
+}
+
+func (ttu *TaskTombstoneUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(tasktombstone.Table, tasktombstone.Columns, sqlgraph.NewFieldSpec(tasktombstone.FieldID, field.TypeInt))
+	if ps := ttu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_spec.AddModifiers(ttu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, ttu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tasktombstone.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ttu.mutation.done = true
+	return n, nil
+}
+
+// TaskTombstoneUpdateOne is the builder for updating a single TaskTombstone entity.
+type TaskTombstoneUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *TaskTombstoneMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Mutation returns the TaskTombstoneMutation object of the builder.
+func (ttuo *TaskTombstoneUpdateOne) Mutation() *TaskTombstoneMutation {
+	return ttuo.mutation
+}
+
+// Where appends a list predicates to the TaskTombstoneUpdate builder.
+func (ttuo *TaskTombstoneUpdateOne) Where(ps ...predicate.TaskTombstone) *TaskTombstoneUpdateOne {
+	ttuo.mutation.Where(ps...)
+	return ttuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ttuo *TaskTombstoneUpdateOne) Select(field string, fields ...string) *TaskTombstoneUpdateOne {
+	ttuo.fields = append([]string{field}, fields...)
+	return ttuo
+}
+
+// Save executes the query and returns the updated TaskTombstone entity.
+func (ttuo *TaskTombstoneUpdateOne) Save(ctx context.Context) (*TaskTombstone, error) {
+	return withHooks(ctx, ttuo.sqlSave, ttuo.mutation, ttuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ttuo *TaskTombstoneUpdateOne) SaveX(ctx context.Context) *TaskTombstone {
+	node, err := ttuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ttuo *TaskTombstoneUpdateOne) Exec(ctx context.Context) error {
+	_, err := ttuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ttuo *TaskTombstoneUpdateOne) ExecX(ctx context.Context) {
+	if err := ttuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ttuo *TaskTombstoneUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TaskTombstoneUpdateOne {
+	ttuo.modifiers = append(ttuo.modifiers, modifiers...)
+	return ttuo
+}
+
+func (ttuo *TaskTombstoneUpdateOne) sqlSave(ctx context.Context) (_node *TaskTombstone, err error) {
+	_spec := sqlgraph.NewUpdateSpec(tasktombstone.Table, tasktombstone.Columns, sqlgraph.NewFieldSpec(tasktombstone.FieldID, field.TypeInt))
+	id, ok := ttuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TaskTombstone.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ttuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, tasktombstone.FieldID)
+		for _, f := range fields {
+			if !tasktombstone.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != tasktombstone.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ttuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_spec.AddModifiers(ttuo.modifiers...)
+	_node = &TaskTombstone{config: ttuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ttuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tasktombstone.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ttuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,448 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/ent/user"
+)
+
+// User is the model entity for the User schema.
+type User struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Username holds the value of the "username" field.
+	Username string `json:"username,omitempty"`
+	// DisplayName holds the value of the "display_name" field.
+	DisplayName string `json:"display_name,omitempty"`
+	// APITokenHash holds the value of the "api_token_hash" field.
+	APITokenHash string `json:"-"`
+	// CalendarTokenHash holds the value of the "calendar_token_hash" field.
+	CalendarTokenHash *string `json:"-"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the UserQuery when eager-loading is set.
+	Edges        UserEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// UserEdges holds the relations/edges for other nodes in the graph.
+type UserEdges struct {
+	// Comments holds the value of the comments edge.
+	Comments []*Comment `json:"comments,omitempty"`
+	// MentionedIn holds the value of the mentioned_in edge.
+	MentionedIn []*Comment `json:"mentioned_in,omitempty"`
+	// AssignedTasks holds the value of the assigned_tasks edge.
+	AssignedTasks []*Task `json:"assigned_tasks,omitempty"`
+	// WatchedTasks holds the value of the watched_tasks edge.
+	WatchedTasks []*Task `json:"watched_tasks,omitempty"`
+	// TaskEvents holds the value of the task_events edge.
+	TaskEvents []*TaskEvent `json:"task_events,omitempty"`
+	// SavedViews holds the value of the saved_views edge.
+	SavedViews []*SavedView `json:"saved_views,omitempty"`
+	// Webhooks holds the value of the webhooks edge.
+	Webhooks []*Webhook `json:"webhooks,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [7]bool
+
+	namedComments      map[string][]*Comment
+	namedMentionedIn   map[string][]*Comment
+	namedAssignedTasks map[string][]*Task
+	namedWatchedTasks  map[string][]*Task
+	namedTaskEvents    map[string][]*TaskEvent
+	namedSavedViews    map[string][]*SavedView
+	namedWebhooks      map[string][]*Webhook
+}
+
+// CommentsOrErr returns the Comments value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) CommentsOrErr() ([]*Comment, error) {
+	if e.loadedTypes[0] {
+		return e.Comments, nil
+	}
+	return nil, &NotLoadedError{edge: "comments"}
+}
+
+// MentionedInOrErr returns the MentionedIn value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) MentionedInOrErr() ([]*Comment, error) {
+	if e.loadedTypes[1] {
+		return e.MentionedIn, nil
+	}
+	return nil, &NotLoadedError{edge: "mentioned_in"}
+}
+
+// AssignedTasksOrErr returns the AssignedTasks value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) AssignedTasksOrErr() ([]*Task, error) {
+	if e.loadedTypes[2] {
+		return e.AssignedTasks, nil
+	}
+	return nil, &NotLoadedError{edge: "assigned_tasks"}
+}
+
+// WatchedTasksOrErr returns the WatchedTasks value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) WatchedTasksOrErr() ([]*Task, error) {
+	if e.loadedTypes[3] {
+		return e.WatchedTasks, nil
+	}
+	return nil, &NotLoadedError{edge: "watched_tasks"}
+}
+
+// TaskEventsOrErr returns the TaskEvents value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) TaskEventsOrErr() ([]*TaskEvent, error) {
+	if e.loadedTypes[4] {
+		return e.TaskEvents, nil
+	}
+	return nil, &NotLoadedError{edge: "task_events"}
+}
+
+// SavedViewsOrErr returns the SavedViews value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) SavedViewsOrErr() ([]*SavedView, error) {
+	if e.loadedTypes[5] {
+		return e.SavedViews, nil
+	}
+	return nil, &NotLoadedError{edge: "saved_views"}
+}
+
+// WebhooksOrErr returns the Webhooks value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) WebhooksOrErr() ([]*Webhook, error) {
+	if e.loadedTypes[6] {
+		return e.Webhooks, nil
+	}
+	return nil, &NotLoadedError{edge: "webhooks"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*User) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case user.FieldUsername, user.FieldDisplayName, user.FieldAPITokenHash, user.FieldCalendarTokenHash:
+			values[i] = new(sql.NullString)
+		case user.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case user.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the User fields.
+func (u *User) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case user.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				u.ID = *value
+			}
+		case user.FieldUsername:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field username", values[i])
+			} else if value.Valid {
+				u.Username = value.String
+			}
+		case user.FieldDisplayName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field display_name", values[i])
+			} else if value.Valid {
+				u.DisplayName = value.String
+			}
+		case user.FieldAPITokenHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field api_token_hash", values[i])
+			} else if value.Valid {
+				u.APITokenHash = value.String
+			}
+		case user.FieldCalendarTokenHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field calendar_token_hash", values[i])
+			} else if value.Valid {
+				u.CalendarTokenHash = new(string)
+				*u.CalendarTokenHash = value.String
+			}
+		case user.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				u.CreatedAt = value.Time
+			}
+		default:
+			u.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the User.
+// This includes values selected through modifiers, order, etc.
+func (u *User) Value(name string) (ent.Value, error) {
+	return u.selectValues.Get(name)
+}
+
+// QueryComments queries the "comments" edge of the User entity.
+func (u *User) QueryComments() *CommentQuery {
+	return NewUserClient(u.config).QueryComments(u)
+}
+
+// QueryMentionedIn queries the "mentioned_in" edge of the User entity.
+func (u *User) QueryMentionedIn() *CommentQuery {
+	return NewUserClient(u.config).QueryMentionedIn(u)
+}
+
+// QueryAssignedTasks queries the "assigned_tasks" edge of the User entity.
+func (u *User) QueryAssignedTasks() *TaskQuery {
+	return NewUserClient(u.config).QueryAssignedTasks(u)
+}
+
+// QueryWatchedTasks queries the "watched_tasks" edge of the User entity.
+func (u *User) QueryWatchedTasks() *TaskQuery {
+	return NewUserClient(u.config).QueryWatchedTasks(u)
+}
+
+// QueryTaskEvents queries the "task_events" edge of the User entity.
+func (u *User) QueryTaskEvents() *TaskEventQuery {
+	return NewUserClient(u.config).QueryTaskEvents(u)
+}
+
+// QuerySavedViews queries the "saved_views" edge of the User entity.
+func (u *User) QuerySavedViews() *SavedViewQuery {
+	return NewUserClient(u.config).QuerySavedViews(u)
+}
+
+// QueryWebhooks queries the "webhooks" edge of the User entity.
+func (u *User) QueryWebhooks() *WebhookQuery {
+	return NewUserClient(u.config).QueryWebhooks(u)
+}
+
+// Update returns a builder for updating this User.
+// Note that you need to call User.Unwrap() before calling this method if this User
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (u *User) Update() *UserUpdateOne {
+	return NewUserClient(u.config).UpdateOne(u)
+}
+
+// Unwrap unwraps the User entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (u *User) Unwrap() *User {
+	_tx, ok := u.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: User is not a transactional entity")
+	}
+	u.config.driver = _tx.drv
+	return u
+}
+
+// String implements the fmt.Stringer.
+func (u *User) String() string {
+	var builder strings.Builder
+	builder.WriteString("User(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", u.ID))
+	builder.WriteString("username=")
+	builder.WriteString(u.Username)
+	builder.WriteString(", ")
+	builder.WriteString("display_name=")
+	builder.WriteString(u.DisplayName)
+	builder.WriteString(", ")
+	builder.WriteString("api_token_hash=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("calendar_token_hash=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(u.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// NamedComments returns the Comments named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (u *User) NamedComments(name string) ([]*Comment, error) {
+	if u.Edges.namedComments == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := u.Edges.namedComments[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (u *User) appendNamedComments(name string, edges ...*Comment) {
+	if u.Edges.namedComments == nil {
+		u.Edges.namedComments = make(map[string][]*Comment)
+	}
+	if len(edges) == 0 {
+		u.Edges.namedComments[name] = []*Comment{}
+	} else {
+		u.Edges.namedComments[name] = append(u.Edges.namedComments[name], edges...)
+	}
+}
+
+// NamedMentionedIn returns the MentionedIn named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (u *User) NamedMentionedIn(name string) ([]*Comment, error) {
+	if u.Edges.namedMentionedIn == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := u.Edges.namedMentionedIn[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (u *User) appendNamedMentionedIn(name string, edges ...*Comment) {
+	if u.Edges.namedMentionedIn == nil {
+		u.Edges.namedMentionedIn = make(map[string][]*Comment)
+	}
+	if len(edges) == 0 {
+		u.Edges.namedMentionedIn[name] = []*Comment{}
+	} else {
+		u.Edges.namedMentionedIn[name] = append(u.Edges.namedMentionedIn[name], edges...)
+	}
+}
+
+// NamedAssignedTasks returns the AssignedTasks named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (u *User) NamedAssignedTasks(name string) ([]*Task, error) {
+	if u.Edges.namedAssignedTasks == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := u.Edges.namedAssignedTasks[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (u *User) appendNamedAssignedTasks(name string, edges ...*Task) {
+	if u.Edges.namedAssignedTasks == nil {
+		u.Edges.namedAssignedTasks = make(map[string][]*Task)
+	}
+	if len(edges) == 0 {
+		u.Edges.namedAssignedTasks[name] = []*Task{}
+	} else {
+		u.Edges.namedAssignedTasks[name] = append(u.Edges.namedAssignedTasks[name], edges...)
+	}
+}
+
+// NamedWatchedTasks returns the WatchedTasks named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (u *User) NamedWatchedTasks(name string) ([]*Task, error) {
+	if u.Edges.namedWatchedTasks == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := u.Edges.namedWatchedTasks[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (u *User) appendNamedWatchedTasks(name string, edges ...*Task) {
+	if u.Edges.namedWatchedTasks == nil {
+		u.Edges.namedWatchedTasks = make(map[string][]*Task)
+	}
+	if len(edges) == 0 {
+		u.Edges.namedWatchedTasks[name] = []*Task{}
+	} else {
+		u.Edges.namedWatchedTasks[name] = append(u.Edges.namedWatchedTasks[name], edges...)
+	}
+}
+
+// NamedTaskEvents returns the TaskEvents named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (u *User) NamedTaskEvents(name string) ([]*TaskEvent, error) {
+	if u.Edges.namedTaskEvents == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := u.Edges.namedTaskEvents[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (u *User) appendNamedTaskEvents(name string, edges ...*TaskEvent) {
+	if u.Edges.namedTaskEvents == nil {
+		u.Edges.namedTaskEvents = make(map[string][]*TaskEvent)
+	}
+	if len(edges) == 0 {
+		u.Edges.namedTaskEvents[name] = []*TaskEvent{}
+	} else {
+		u.Edges.namedTaskEvents[name] = append(u.Edges.namedTaskEvents[name], edges...)
+	}
+}
+
+// NamedSavedViews returns the SavedViews named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (u *User) NamedSavedViews(name string) ([]*SavedView, error) {
+	if u.Edges.namedSavedViews == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := u.Edges.namedSavedViews[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (u *User) appendNamedSavedViews(name string, edges ...*SavedView) {
+	if u.Edges.namedSavedViews == nil {
+		u.Edges.namedSavedViews = make(map[string][]*SavedView)
+	}
+	if len(edges) == 0 {
+		u.Edges.namedSavedViews[name] = []*SavedView{}
+	} else {
+		u.Edges.namedSavedViews[name] = append(u.Edges.namedSavedViews[name], edges...)
+	}
+}
+
+// NamedWebhooks returns the Webhooks named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (u *User) NamedWebhooks(name string) ([]*Webhook, error) {
+	if u.Edges.namedWebhooks == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := u.Edges.namedWebhooks[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (u *User) appendNamedWebhooks(name string, edges ...*Webhook) {
+	if u.Edges.namedWebhooks == nil {
+		u.Edges.namedWebhooks = make(map[string][]*Webhook)
+	}
+	if len(edges) == 0 {
+		u.Edges.namedWebhooks[name] = []*Webhook{}
+	} else {
+		u.Edges.namedWebhooks[name] = append(u.Edges.namedWebhooks[name], edges...)
+	}
+}
+
+// Users is a parsable slice of User.
+type Users []*User
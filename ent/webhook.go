@@ -0,0 +1,257 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/ent/user"
+	"github.com/localopsco/go-sample/ent/webhook"
+)
+
+// Webhook is the model entity for the Webhook schema.
+type Webhook struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// URL holds the value of the "url" field.
+	URL string `json:"url,omitempty"`
+	// EventTypes holds the value of the "event_types" field.
+	EventTypes []string `json:"event_types,omitempty"`
+	// Secret holds the value of the "secret" field.
+	Secret string `json:"-"`
+	// Active holds the value of the "active" field.
+	Active bool `json:"active,omitempty"`
+	// OwnerID holds the value of the "owner_id" field.
+	OwnerID *uuid.UUID `json:"owner_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the WebhookQuery when eager-loading is set.
+	Edges        WebhookEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// WebhookEdges holds the relations/edges for other nodes in the graph.
+type WebhookEdges struct {
+	// Deliveries holds the value of the deliveries edge.
+	Deliveries []*WebhookDelivery `json:"deliveries,omitempty"`
+	// Owner holds the value of the owner edge.
+	Owner *User `json:"owner,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+	// totalCount holds the count of the edges above.
+	totalCount [1]map[string]int
+
+	namedDeliveries map[string][]*WebhookDelivery
+}
+
+// DeliveriesOrErr returns the Deliveries value or an error if the edge
+// was not loaded in eager-loading.
+func (e WebhookEdges) DeliveriesOrErr() ([]*WebhookDelivery, error) {
+	if e.loadedTypes[0] {
+		return e.Deliveries, nil
+	}
+	return nil, &NotLoadedError{edge: "deliveries"}
+}
+
+// OwnerOrErr returns the Owner value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e WebhookEdges) OwnerOrErr() (*User, error) {
+	if e.Owner != nil {
+		return e.Owner, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "owner"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Webhook) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case webhook.FieldOwnerID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case webhook.FieldEventTypes:
+			values[i] = new([]byte)
+		case webhook.FieldActive:
+			values[i] = new(sql.NullBool)
+		case webhook.FieldURL, webhook.FieldSecret:
+			values[i] = new(sql.NullString)
+		case webhook.FieldCreatedAt, webhook.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case webhook.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Webhook fields.
+func (w *Webhook) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case webhook.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				w.ID = *value
+			}
+		case webhook.FieldURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field url", values[i])
+			} else if value.Valid {
+				w.URL = value.String
+			}
+		case webhook.FieldEventTypes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field event_types", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &w.EventTypes); err != nil {
+					return fmt.Errorf("unmarshal field event_types: %w", err)
+				}
+			}
+		case webhook.FieldSecret:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field secret", values[i])
+			} else if value.Valid {
+				w.Secret = value.String
+			}
+		case webhook.FieldActive:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field active", values[i])
+			} else if value.Valid {
+				w.Active = value.Bool
+			}
+		case webhook.FieldOwnerID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field owner_id", values[i])
+			} else if value.Valid {
+				w.OwnerID = new(uuid.UUID)
+				*w.OwnerID = *value.S.(*uuid.UUID)
+			}
+		case webhook.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				w.CreatedAt = value.Time
+			}
+		case webhook.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				w.UpdatedAt = value.Time
+			}
+		default:
+			w.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Webhook.
+// This includes values selected through modifiers, order, etc.
+func (w *Webhook) Value(name string) (ent.Value, error) {
+	return w.selectValues.Get(name)
+}
+
+// QueryDeliveries queries the "deliveries" edge of the Webhook entity.
+func (w *Webhook) QueryDeliveries() *WebhookDeliveryQuery {
+	return NewWebhookClient(w.config).QueryDeliveries(w)
+}
+
+// QueryOwner queries the "owner" edge of the Webhook entity.
+func (w *Webhook) QueryOwner() *UserQuery {
+	return NewWebhookClient(w.config).QueryOwner(w)
+}
+
+// Update returns a builder for updating this Webhook.
+// Note that you need to call Webhook.Unwrap() before calling this method if this Webhook
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (w *Webhook) Update() *WebhookUpdateOne {
+	return NewWebhookClient(w.config).UpdateOne(w)
+}
+
+// Unwrap unwraps the Webhook entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (w *Webhook) Unwrap() *Webhook {
+	_tx, ok := w.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Webhook is not a transactional entity")
+	}
+	w.config.driver = _tx.drv
+	return w
+}
+
+// String implements the fmt.Stringer.
+func (w *Webhook) String() string {
+	var builder strings.Builder
+	builder.WriteString("Webhook(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", w.ID))
+	builder.WriteString("url=")
+	builder.WriteString(w.URL)
+	builder.WriteString(", ")
+	builder.WriteString("event_types=")
+	builder.WriteString(fmt.Sprintf("%v", w.EventTypes))
+	builder.WriteString(", ")
+	builder.WriteString("secret=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("active=")
+	builder.WriteString(fmt.Sprintf("%v", w.Active))
+	builder.WriteString(", ")
+	if v := w.OwnerID; v != nil {
+		builder.WriteString("owner_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(w.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(w.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// NamedDeliveries returns the Deliveries named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (w *Webhook) NamedDeliveries(name string) ([]*WebhookDelivery, error) {
+	if w.Edges.namedDeliveries == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := w.Edges.namedDeliveries[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (w *Webhook) appendNamedDeliveries(name string, edges ...*WebhookDelivery) {
+	if w.Edges.namedDeliveries == nil {
+		w.Edges.namedDeliveries = make(map[string][]*WebhookDelivery)
+	}
+	if len(edges) == 0 {
+		w.Edges.namedDeliveries[name] = []*WebhookDelivery{}
+	} else {
+		w.Edges.namedDeliveries[name] = append(w.Edges.namedDeliveries[name], edges...)
+	}
+}
+
+// Webhooks is a parsable slice of Webhook.
+type Webhooks []*Webhook
@@ -0,0 +1,394 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/ent/user"
+	"github.com/localopsco/go-sample/ent/webhook"
+	"github.com/localopsco/go-sample/ent/webhookdelivery"
+)
+
+// WebhookCreate is the builder for creating a Webhook entity.
+type WebhookCreate struct {
+	config
+	mutation *WebhookMutation
+	hooks    []Hook
+}
+
+// SetURL sets the "url" field.
+func (wc *WebhookCreate) SetURL(s string) *WebhookCreate {
+	wc.mutation.SetURL(s)
+	return wc
+}
+
+// SetEventTypes sets the "event_types" field.
+func (wc *WebhookCreate) SetEventTypes(s []string) *WebhookCreate {
+	wc.mutation.SetEventTypes(s)
+	return wc
+}
+
+// SetSecret sets the "secret" field.
+func (wc *WebhookCreate) SetSecret(s string) *WebhookCreate {
+	wc.mutation.SetSecret(s)
+	return wc
+}
+
+// SetActive sets the "active" field.
+func (wc *WebhookCreate) SetActive(b bool) *WebhookCreate {
+	wc.mutation.SetActive(b)
+	return wc
+}
+
+// SetNillableActive sets the "active" field if the given value is not nil.
+func (wc *WebhookCreate) SetNillableActive(b *bool) *WebhookCreate {
+	if b != nil {
+		wc.SetActive(*b)
+	}
+	return wc
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (wc *WebhookCreate) SetOwnerID(u uuid.UUID) *WebhookCreate {
+	wc.mutation.SetOwnerID(u)
+	return wc
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (wc *WebhookCreate) SetNillableOwnerID(u *uuid.UUID) *WebhookCreate {
+	if u != nil {
+		wc.SetOwnerID(*u)
+	}
+	return wc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (wc *WebhookCreate) SetCreatedAt(t time.Time) *WebhookCreate {
+	wc.mutation.SetCreatedAt(t)
+	return wc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (wc *WebhookCreate) SetNillableCreatedAt(t *time.Time) *WebhookCreate {
+	if t != nil {
+		wc.SetCreatedAt(*t)
+	}
+	return wc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (wc *WebhookCreate) SetUpdatedAt(t time.Time) *WebhookCreate {
+	wc.mutation.SetUpdatedAt(t)
+	return wc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (wc *WebhookCreate) SetNillableUpdatedAt(t *time.Time) *WebhookCreate {
+	if t != nil {
+		wc.SetUpdatedAt(*t)
+	}
+	return wc
+}
+
+// SetID sets the "id" field.
+func (wc *WebhookCreate) SetID(u uuid.UUID) *WebhookCreate {
+	wc.mutation.SetID(u)
+	return wc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (wc *WebhookCreate) SetNillableID(u *uuid.UUID) *WebhookCreate {
+	if u != nil {
+		wc.SetID(*u)
+	}
+	return wc
+}
+
+// AddDeliveryIDs adds the "deliveries" edge to the WebhookDelivery entity by IDs.
+func (wc *WebhookCreate) AddDeliveryIDs(ids ...uuid.UUID) *WebhookCreate {
+	wc.mutation.AddDeliveryIDs(ids...)
+	return wc
+}
+
+// AddDeliveries adds the "deliveries" edges to the WebhookDelivery entity.
+func (wc *WebhookCreate) AddDeliveries(w ...*WebhookDelivery) *WebhookCreate {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return wc.AddDeliveryIDs(ids...)
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (wc *WebhookCreate) SetOwner(u *User) *WebhookCreate {
+	return wc.SetOwnerID(u.ID)
+}
+
+// Mutation returns the WebhookMutation object of the builder.
+func (wc *WebhookCreate) Mutation() *WebhookMutation {
+	return wc.mutation
+}
+
+// Save creates the Webhook in the database.
+func (wc *WebhookCreate) Save(ctx context.Context) (*Webhook, error) {
+	wc.defaults()
+	return withHooks(ctx, wc.sqlSave, wc.mutation, wc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (wc *WebhookCreate) SaveX(ctx context.Context) *Webhook {
+	v, err := wc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wc *WebhookCreate) Exec(ctx context.Context) error {
+	_, err := wc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wc *WebhookCreate) ExecX(ctx context.Context) {
+	if err := wc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wc *WebhookCreate) defaults() {
+	if _, ok := wc.mutation.Active(); !ok {
+		v := webhook.DefaultActive
+		wc.mutation.SetActive(v)
+	}
+	if _, ok := wc.mutation.CreatedAt(); !ok {
+		v := webhook.DefaultCreatedAt()
+		wc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := wc.mutation.UpdatedAt(); !ok {
+		v := webhook.DefaultUpdatedAt()
+		wc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := wc.mutation.ID(); !ok {
+		v := webhook.DefaultID()
+		wc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wc *WebhookCreate) check() error {
+	if _, ok := wc.mutation.URL(); !ok {
+		return &ValidationError{Name: "url", err: errors.New(`ent: missing required field "Webhook.url"`)}
+	}
+	if v, ok := wc.mutation.URL(); ok {
+		if err := webhook.URLValidator(v); err != nil {
+			return &ValidationError{Name: "url", err: fmt.Errorf(`ent: validator failed for field "Webhook.url": %w`, err)}
+		}
+	}
+	if _, ok := wc.mutation.EventTypes(); !ok {
+		return &ValidationError{Name: "event_types", err: errors.New(`ent: missing required field "Webhook.event_types"`)}
+	}
+	if _, ok := wc.mutation.Secret(); !ok {
+		return &ValidationError{Name: "secret", err: errors.New(`ent: missing required field "Webhook.secret"`)}
+	}
+	if v, ok := wc.mutation.Secret(); ok {
+		if err := webhook.SecretValidator(v); err != nil {
+			return &ValidationError{Name: "secret", err: fmt.Errorf(`ent: validator failed for field "Webhook.secret": %w`, err)}
+		}
+	}
+	if _, ok := wc.mutation.Active(); !ok {
+		return &ValidationError{Name: "active", err: errors.New(`ent: missing required field "Webhook.active"`)}
+	}
+	if _, ok := wc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Webhook.created_at"`)}
+	}
+	if _, ok := wc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Webhook.updated_at"`)}
+	}
+	return nil
+}
+
+func (wc *WebhookCreate) sqlSave(ctx context.Context) (*Webhook, error) {
+	if err := wc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := wc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, wc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	wc.mutation.id = &_node.ID
+	wc.mutation.done = true
+	return _node, nil
+}
+
+func (wc *WebhookCreate) createSpec() (*Webhook, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Webhook{config: wc.config}
+		_spec = sqlgraph.NewCreateSpec(webhook.Table, sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeUUID))
+	)
+	if id, ok := wc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := wc.mutation.URL(); ok {
+		_spec.SetField(webhook.FieldURL, field.TypeString, value)
+		_node.URL = value
+	}
+	if value, ok := wc.mutation.EventTypes(); ok {
+		_spec.SetField(webhook.FieldEventTypes, field.TypeJSON, value)
+		_node.EventTypes = value
+	}
+	if value, ok := wc.mutation.Secret(); ok {
+		_spec.SetField(webhook.FieldSecret, field.TypeString, value)
+		_node.Secret = value
+	}
+	if value, ok := wc.mutation.Active(); ok {
+		_spec.SetField(webhook.FieldActive, field.TypeBool, value)
+		_node.Active = value
+	}
+	if value, ok := wc.mutation.CreatedAt(); ok {
+		_spec.SetField(webhook.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := wc.mutation.UpdatedAt(); ok {
+		_spec.SetField(webhook.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := wc.mutation.DeliveriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   webhook.DeliveriesTable,
+			Columns: []string{webhook.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := wc.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webhook.OwnerTable,
+			Columns: []string{webhook.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.OwnerID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// WebhookCreateBulk is the builder for creating many Webhook entities in bulk.
+type WebhookCreateBulk struct {
+	config
+	err      error
+	builders []*WebhookCreate
+}
+
+// Save creates the Webhook entities in the database.
+func (wcb *WebhookCreateBulk) Save(ctx context.Context) ([]*Webhook, error) {
+	if wcb.err != nil {
+		return nil, wcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(wcb.builders))
+	nodes := make([]*Webhook, len(wcb.builders))
+	mutators := make([]Mutator, len(wcb.builders))
+	for i := range wcb.builders {
+		func(i int, root context.Context) {
+			builder := wcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WebhookMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, wcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, wcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, wcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wcb *WebhookCreateBulk) SaveX(ctx context.Context) []*Webhook {
+	v, err := wcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wcb *WebhookCreateBulk) Exec(ctx context.Context) error {
+	_, err := wcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wcb *WebhookCreateBulk) ExecX(ctx context.Context) {
+	if err := wcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/localopsco/go-sample/ent/predicate"
+	"github.com/localopsco/go-sample/ent/webhook"
+)
+
+// WebhookDelete is the builder for deleting a Webhook entity.
+type WebhookDelete struct {
+	config
+	hooks    []Hook
+	mutation *WebhookMutation
+}
+
+// Where appends a list predicates to the WebhookDelete builder.
+func (wd *WebhookDelete) Where(ps ...predicate.Webhook) *WebhookDelete {
+	wd.mutation.Where(ps...)
+	return wd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (wd *WebhookDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, wd.sqlExec, wd.mutation, wd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wd *WebhookDelete) ExecX(ctx context.Context) int {
+	n, err := wd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (wd *WebhookDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(webhook.Table, sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeUUID))
+	if ps := wd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, wd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	wd.mutation.done = true
+	return affected, err
+}
+
+// WebhookDeleteOne is the builder for deleting a single Webhook entity.
+type WebhookDeleteOne struct {
+	wd *WebhookDelete
+}
+
+// Where appends a list predicates to the WebhookDelete builder.
+func (wdo *WebhookDeleteOne) Where(ps ...predicate.Webhook) *WebhookDeleteOne {
+	wdo.wd.mutation.Where(ps...)
+	return wdo
+}
+
+// Exec executes the deletion query.
+func (wdo *WebhookDeleteOne) Exec(ctx context.Context) error {
+	n, err := wdo.wd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{webhook.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wdo *WebhookDeleteOne) ExecX(ctx context.Context) {
+	if err := wdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
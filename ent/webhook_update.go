@@ -0,0 +1,605 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/ent/predicate"
+	"github.com/localopsco/go-sample/ent/webhook"
+	"github.com/localopsco/go-sample/ent/webhookdelivery"
+)
+
+// WebhookUpdate is the builder for updating Webhook entities.
+type WebhookUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *WebhookMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the WebhookUpdate builder.
+func (wu *WebhookUpdate) Where(ps ...predicate.Webhook) *WebhookUpdate {
+	wu.mutation.Where(ps...)
+	return wu
+}
+
+// SetURL sets the "url" field.
+func (wu *WebhookUpdate) SetURL(s string) *WebhookUpdate {
+	wu.mutation.SetURL(s)
+	return wu
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (wu *WebhookUpdate) SetNillableURL(s *string) *WebhookUpdate {
+	if s != nil {
+		wu.SetURL(*s)
+	}
+	return wu
+}
+
+// SetEventTypes sets the "event_types" field.
+func (wu *WebhookUpdate) SetEventTypes(s []string) *WebhookUpdate {
+	wu.mutation.SetEventTypes(s)
+	return wu
+}
+
+// AppendEventTypes appends s to the "event_types" field.
+func (wu *WebhookUpdate) AppendEventTypes(s []string) *WebhookUpdate {
+	wu.mutation.AppendEventTypes(s)
+	return wu
+}
+
+// SetSecret sets the "secret" field.
+func (wu *WebhookUpdate) SetSecret(s string) *WebhookUpdate {
+	wu.mutation.SetSecret(s)
+	return wu
+}
+
+// SetNillableSecret sets the "secret" field if the given value is not nil.
+func (wu *WebhookUpdate) SetNillableSecret(s *string) *WebhookUpdate {
+	if s != nil {
+		wu.SetSecret(*s)
+	}
+	return wu
+}
+
+// SetActive sets the "active" field.
+func (wu *WebhookUpdate) SetActive(b bool) *WebhookUpdate {
+	wu.mutation.SetActive(b)
+	return wu
+}
+
+// SetNillableActive sets the "active" field if the given value is not nil.
+func (wu *WebhookUpdate) SetNillableActive(b *bool) *WebhookUpdate {
+	if b != nil {
+		wu.SetActive(*b)
+	}
+	return wu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (wu *WebhookUpdate) SetCreatedAt(t time.Time) *WebhookUpdate {
+	wu.mutation.SetCreatedAt(t)
+	return wu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (wu *WebhookUpdate) SetNillableCreatedAt(t *time.Time) *WebhookUpdate {
+	if t != nil {
+		wu.SetCreatedAt(*t)
+	}
+	return wu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (wu *WebhookUpdate) SetUpdatedAt(t time.Time) *WebhookUpdate {
+	wu.mutation.SetUpdatedAt(t)
+	return wu
+}
+
+// AddDeliveryIDs adds the "deliveries" edge to the WebhookDelivery entity by IDs.
+func (wu *WebhookUpdate) AddDeliveryIDs(ids ...uuid.UUID) *WebhookUpdate {
+	wu.mutation.AddDeliveryIDs(ids...)
+	return wu
+}
+
+// AddDeliveries adds the "deliveries" edges to the WebhookDelivery entity.
+func (wu *WebhookUpdate) AddDeliveries(w ...*WebhookDelivery) *WebhookUpdate {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return wu.AddDeliveryIDs(ids...)
+}
+
+// Mutation returns the WebhookMutation object of the builder.
+func (wu *WebhookUpdate) Mutation() *WebhookMutation {
+	return wu.mutation
+}
+
+// ClearDeliveries clears all "deliveries" edges to the WebhookDelivery entity.
+func (wu *WebhookUpdate) ClearDeliveries() *WebhookUpdate {
+	wu.mutation.ClearDeliveries()
+	return wu
+}
+
+// RemoveDeliveryIDs removes the "deliveries" edge to WebhookDelivery entities by IDs.
+func (wu *WebhookUpdate) RemoveDeliveryIDs(ids ...uuid.UUID) *WebhookUpdate {
+	wu.mutation.RemoveDeliveryIDs(ids...)
+	return wu
+}
+
+// RemoveDeliveries removes "deliveries" edges to WebhookDelivery entities.
+func (wu *WebhookUpdate) RemoveDeliveries(w ...*WebhookDelivery) *WebhookUpdate {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return wu.RemoveDeliveryIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (wu *WebhookUpdate) Save(ctx context.Context) (int, error) {
+	wu.defaults()
+	return withHooks(ctx, wu.sqlSave, wu.mutation, wu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wu *WebhookUpdate) SaveX(ctx context.Context) int {
+	affected, err := wu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (wu *WebhookUpdate) Exec(ctx context.Context) error {
+	_, err := wu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wu *WebhookUpdate) ExecX(ctx context.Context) {
+	if err := wu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wu *WebhookUpdate) defaults() {
+	if _, ok := wu.mutation.UpdatedAt(); !ok {
+		v := webhook.UpdateDefaultUpdatedAt()
+		wu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wu *WebhookUpdate) check() error {
+	if v, ok := wu.mutation.URL(); ok {
+		if err := webhook.URLValidator(v); err != nil {
+			return &ValidationError{Name: "url", err: fmt.Errorf(`ent: validator failed for field "Webhook.url": %w`, err)}
+		}
+	}
+	if v, ok := wu.mutation.Secret(); ok {
+		if err := webhook.SecretValidator(v); err != nil {
+			return &ValidationError{Name: "secret", err: fmt.Errorf(`ent: validator failed for field "Webhook.secret": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (wu *WebhookUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *WebhookUpdate {
+	wu.modifiers = append(wu.modifiers, modifiers...)
+	return wu
+}
+
+func (wu *WebhookUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := wu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(webhook.Table, webhook.Columns, sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeUUID))
+	if ps := wu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wu.mutation.URL(); ok {
+		_spec.SetField(webhook.FieldURL, field.TypeString, value)
+	}
+	if value, ok := wu.mutation.EventTypes(); ok {
+		_spec.SetField(webhook.FieldEventTypes, field.TypeJSON, value)
+	}
+	if value, ok := wu.mutation.AppendedEventTypes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, webhook.FieldEventTypes, value)
+		})
+	}
+	if value, ok := wu.mutation.Secret(); ok {
+		_spec.SetField(webhook.FieldSecret, field.TypeString, value)
+	}
+	if value, ok := wu.mutation.Active(); ok {
+		_spec.SetField(webhook.FieldActive, field.TypeBool, value)
+	}
+	if value, ok := wu.mutation.CreatedAt(); ok {
+		_spec.SetField(webhook.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := wu.mutation.UpdatedAt(); ok {
+		_spec.SetField(webhook.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if wu.mutation.DeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   webhook.DeliveriesTable,
+			Columns: []string{webhook.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wu.mutation.RemovedDeliveriesIDs(); len(nodes) > 0 && !wu.mutation.DeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   webhook.DeliveriesTable,
+			Columns: []string{webhook.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wu.mutation.DeliveriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   webhook.DeliveriesTable,
+			Columns: []string{webhook.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(wu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, wu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webhook.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	wu.mutation.done = true
+	return n, nil
+}
+
+// WebhookUpdateOne is the builder for updating a single Webhook entity.
+type WebhookUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *WebhookMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetURL sets the "url" field.
+func (wuo *WebhookUpdateOne) SetURL(s string) *WebhookUpdateOne {
+	wuo.mutation.SetURL(s)
+	return wuo
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (wuo *WebhookUpdateOne) SetNillableURL(s *string) *WebhookUpdateOne {
+	if s != nil {
+		wuo.SetURL(*s)
+	}
+	return wuo
+}
+
+// SetEventTypes sets the "event_types" field.
+func (wuo *WebhookUpdateOne) SetEventTypes(s []string) *WebhookUpdateOne {
+	wuo.mutation.SetEventTypes(s)
+	return wuo
+}
+
+// AppendEventTypes appends s to the "event_types" field.
+func (wuo *WebhookUpdateOne) AppendEventTypes(s []string) *WebhookUpdateOne {
+	wuo.mutation.AppendEventTypes(s)
+	return wuo
+}
+
+// SetSecret sets the "secret" field.
+func (wuo *WebhookUpdateOne) SetSecret(s string) *WebhookUpdateOne {
+	wuo.mutation.SetSecret(s)
+	return wuo
+}
+
+// SetNillableSecret sets the "secret" field if the given value is not nil.
+func (wuo *WebhookUpdateOne) SetNillableSecret(s *string) *WebhookUpdateOne {
+	if s != nil {
+		wuo.SetSecret(*s)
+	}
+	return wuo
+}
+
+// SetActive sets the "active" field.
+func (wuo *WebhookUpdateOne) SetActive(b bool) *WebhookUpdateOne {
+	wuo.mutation.SetActive(b)
+	return wuo
+}
+
+// SetNillableActive sets the "active" field if the given value is not nil.
+func (wuo *WebhookUpdateOne) SetNillableActive(b *bool) *WebhookUpdateOne {
+	if b != nil {
+		wuo.SetActive(*b)
+	}
+	return wuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (wuo *WebhookUpdateOne) SetCreatedAt(t time.Time) *WebhookUpdateOne {
+	wuo.mutation.SetCreatedAt(t)
+	return wuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (wuo *WebhookUpdateOne) SetNillableCreatedAt(t *time.Time) *WebhookUpdateOne {
+	if t != nil {
+		wuo.SetCreatedAt(*t)
+	}
+	return wuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (wuo *WebhookUpdateOne) SetUpdatedAt(t time.Time) *WebhookUpdateOne {
+	wuo.mutation.SetUpdatedAt(t)
+	return wuo
+}
+
+// AddDeliveryIDs adds the "deliveries" edge to the WebhookDelivery entity by IDs.
+func (wuo *WebhookUpdateOne) AddDeliveryIDs(ids ...uuid.UUID) *WebhookUpdateOne {
+	wuo.mutation.AddDeliveryIDs(ids...)
+	return wuo
+}
+
+// AddDeliveries adds the "deliveries" edges to the WebhookDelivery entity.
+func (wuo *WebhookUpdateOne) AddDeliveries(w ...*WebhookDelivery) *WebhookUpdateOne {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return wuo.AddDeliveryIDs(ids...)
+}
+
+// Mutation returns the WebhookMutation object of the builder.
+func (wuo *WebhookUpdateOne) Mutation() *WebhookMutation {
+	return wuo.mutation
+}
+
+// ClearDeliveries clears all "deliveries" edges to the WebhookDelivery entity.
+func (wuo *WebhookUpdateOne) ClearDeliveries() *WebhookUpdateOne {
+	wuo.mutation.ClearDeliveries()
+	return wuo
+}
+
+// RemoveDeliveryIDs removes the "deliveries" edge to WebhookDelivery entities by IDs.
+func (wuo *WebhookUpdateOne) RemoveDeliveryIDs(ids ...uuid.UUID) *WebhookUpdateOne {
+	wuo.mutation.RemoveDeliveryIDs(ids...)
+	return wuo
+}
+
+// RemoveDeliveries removes "deliveries" edges to WebhookDelivery entities.
+func (wuo *WebhookUpdateOne) RemoveDeliveries(w ...*WebhookDelivery) *WebhookUpdateOne {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return wuo.RemoveDeliveryIDs(ids...)
+}
+
+// Where appends a list predicates to the WebhookUpdate builder.
+func (wuo *WebhookUpdateOne) Where(ps ...predicate.Webhook) *WebhookUpdateOne {
+	wuo.mutation.Where(ps...)
+	return wuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (wuo *WebhookUpdateOne) Select(field string, fields ...string) *WebhookUpdateOne {
+	wuo.fields = append([]string{field}, fields...)
+	return wuo
+}
+
+// Save executes the query and returns the updated Webhook entity.
+func (wuo *WebhookUpdateOne) Save(ctx context.Context) (*Webhook, error) {
+	wuo.defaults()
+	return withHooks(ctx, wuo.sqlSave, wuo.mutation, wuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wuo *WebhookUpdateOne) SaveX(ctx context.Context) *Webhook {
+	node, err := wuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (wuo *WebhookUpdateOne) Exec(ctx context.Context) error {
+	_, err := wuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wuo *WebhookUpdateOne) ExecX(ctx context.Context) {
+	if err := wuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wuo *WebhookUpdateOne) defaults() {
+	if _, ok := wuo.mutation.UpdatedAt(); !ok {
+		v := webhook.UpdateDefaultUpdatedAt()
+		wuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wuo *WebhookUpdateOne) check() error {
+	if v, ok := wuo.mutation.URL(); ok {
+		if err := webhook.URLValidator(v); err != nil {
+			return &ValidationError{Name: "url", err: fmt.Errorf(`ent: validator failed for field "Webhook.url": %w`, err)}
+		}
+	}
+	if v, ok := wuo.mutation.Secret(); ok {
+		if err := webhook.SecretValidator(v); err != nil {
+			return &ValidationError{Name: "secret", err: fmt.Errorf(`ent: validator failed for field "Webhook.secret": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (wuo *WebhookUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *WebhookUpdateOne {
+	wuo.modifiers = append(wuo.modifiers, modifiers...)
+	return wuo
+}
+
+func (wuo *WebhookUpdateOne) sqlSave(ctx context.Context) (_node *Webhook, err error) {
+	if err := wuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(webhook.Table, webhook.Columns, sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeUUID))
+	id, ok := wuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Webhook.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := wuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, webhook.FieldID)
+		for _, f := range fields {
+			if !webhook.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != webhook.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := wuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wuo.mutation.URL(); ok {
+		_spec.SetField(webhook.FieldURL, field.TypeString, value)
+	}
+	if value, ok := wuo.mutation.EventTypes(); ok {
+		_spec.SetField(webhook.FieldEventTypes, field.TypeJSON, value)
+	}
+	if value, ok := wuo.mutation.AppendedEventTypes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, webhook.FieldEventTypes, value)
+		})
+	}
+	if value, ok := wuo.mutation.Secret(); ok {
+		_spec.SetField(webhook.FieldSecret, field.TypeString, value)
+	}
+	if value, ok := wuo.mutation.Active(); ok {
+		_spec.SetField(webhook.FieldActive, field.TypeBool, value)
+	}
+	if value, ok := wuo.mutation.CreatedAt(); ok {
+		_spec.SetField(webhook.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := wuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(webhook.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if wuo.mutation.DeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   webhook.DeliveriesTable,
+			Columns: []string{webhook.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wuo.mutation.RemovedDeliveriesIDs(); len(nodes) > 0 && !wuo.mutation.DeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   webhook.DeliveriesTable,
+			Columns: []string{webhook.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wuo.mutation.DeliveriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   webhook.DeliveriesTable,
+			Columns: []string{webhook.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(wuo.modifiers...)
+	_node = &Webhook{config: wuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, wuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webhook.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	wuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,260 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/ent/webhook"
+	"github.com/localopsco/go-sample/ent/webhookdelivery"
+	"github.com/localopsco/go-sample/models"
+)
+
+// WebhookDelivery is the model entity for the WebhookDelivery schema.
+type WebhookDelivery struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// WebhookID holds the value of the "webhook_id" field.
+	WebhookID uuid.UUID `json:"webhook_id,omitempty"`
+	// EventID holds the value of the "event_id" field.
+	EventID uuid.UUID `json:"event_id,omitempty"`
+	// EventType holds the value of the "event_type" field.
+	EventType string `json:"event_type,omitempty"`
+	// Payload holds the value of the "payload" field.
+	Payload []byte `json:"payload,omitempty"`
+	// Status holds the value of the "status" field.
+	Status webhookdelivery.Status `json:"status,omitempty"`
+	// AttemptCount holds the value of the "attempt_count" field.
+	AttemptCount int `json:"attempt_count,omitempty"`
+	// NextAttemptAt holds the value of the "next_attempt_at" field.
+	NextAttemptAt *time.Time `json:"next_attempt_at,omitempty"`
+	// LastStatusCode holds the value of the "last_status_code" field.
+	LastStatusCode *int `json:"last_status_code,omitempty"`
+	// Attempts holds the value of the "attempts" field.
+	Attempts []models.DeliveryAttempt `json:"attempts,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the WebhookDeliveryQuery when eager-loading is set.
+	Edges        WebhookDeliveryEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// WebhookDeliveryEdges holds the relations/edges for other nodes in the graph.
+type WebhookDeliveryEdges struct {
+	// Webhook holds the value of the webhook edge.
+	Webhook *Webhook `json:"webhook,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// WebhookOrErr returns the Webhook value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e WebhookDeliveryEdges) WebhookOrErr() (*Webhook, error) {
+	if e.Webhook != nil {
+		return e.Webhook, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: webhook.Label}
+	}
+	return nil, &NotLoadedError{edge: "webhook"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*WebhookDelivery) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case webhookdelivery.FieldPayload, webhookdelivery.FieldAttempts:
+			values[i] = new([]byte)
+		case webhookdelivery.FieldAttemptCount, webhookdelivery.FieldLastStatusCode:
+			values[i] = new(sql.NullInt64)
+		case webhookdelivery.FieldEventType, webhookdelivery.FieldStatus:
+			values[i] = new(sql.NullString)
+		case webhookdelivery.FieldNextAttemptAt, webhookdelivery.FieldCreatedAt, webhookdelivery.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case webhookdelivery.FieldID, webhookdelivery.FieldWebhookID, webhookdelivery.FieldEventID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the WebhookDelivery fields.
+func (wd *WebhookDelivery) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case webhookdelivery.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				wd.ID = *value
+			}
+		case webhookdelivery.FieldWebhookID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field webhook_id", values[i])
+			} else if value != nil {
+				wd.WebhookID = *value
+			}
+		case webhookdelivery.FieldEventID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field event_id", values[i])
+			} else if value != nil {
+				wd.EventID = *value
+			}
+		case webhookdelivery.FieldEventType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field event_type", values[i])
+			} else if value.Valid {
+				wd.EventType = value.String
+			}
+		case webhookdelivery.FieldPayload:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field payload", values[i])
+			} else if value != nil {
+				wd.Payload = *value
+			}
+		case webhookdelivery.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				wd.Status = webhookdelivery.Status(value.String)
+			}
+		case webhookdelivery.FieldAttemptCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempt_count", values[i])
+			} else if value.Valid {
+				wd.AttemptCount = int(value.Int64)
+			}
+		case webhookdelivery.FieldNextAttemptAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field next_attempt_at", values[i])
+			} else if value.Valid {
+				wd.NextAttemptAt = new(time.Time)
+				*wd.NextAttemptAt = value.Time
+			}
+		case webhookdelivery.FieldLastStatusCode:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field last_status_code", values[i])
+			} else if value.Valid {
+				wd.LastStatusCode = new(int)
+				*wd.LastStatusCode = int(value.Int64)
+			}
+		case webhookdelivery.FieldAttempts:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field attempts", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &wd.Attempts); err != nil {
+					return fmt.Errorf("unmarshal field attempts: %w", err)
+				}
+			}
+		case webhookdelivery.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				wd.CreatedAt = value.Time
+			}
+		case webhookdelivery.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				wd.UpdatedAt = value.Time
+			}
+		default:
+			wd.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the WebhookDelivery.
+// This includes values selected through modifiers, order, etc.
+func (wd *WebhookDelivery) Value(name string) (ent.Value, error) {
+	return wd.selectValues.Get(name)
+}
+
+// QueryWebhook queries the "webhook" edge of the WebhookDelivery entity.
+func (wd *WebhookDelivery) QueryWebhook() *WebhookQuery {
+	return NewWebhookDeliveryClient(wd.config).QueryWebhook(wd)
+}
+
+// Update returns a builder for updating this WebhookDelivery.
+// Note that you need to call WebhookDelivery.Unwrap() before calling this method if this WebhookDelivery
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (wd *WebhookDelivery) Update() *WebhookDeliveryUpdateOne {
+	return NewWebhookDeliveryClient(wd.config).UpdateOne(wd)
+}
+
+// Unwrap unwraps the WebhookDelivery entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (wd *WebhookDelivery) Unwrap() *WebhookDelivery {
+	_tx, ok := wd.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: WebhookDelivery is not a transactional entity")
+	}
+	wd.config.driver = _tx.drv
+	return wd
+}
+
+// String implements the fmt.Stringer.
+func (wd *WebhookDelivery) String() string {
+	var builder strings.Builder
+	builder.WriteString("WebhookDelivery(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", wd.ID))
+	builder.WriteString("webhook_id=")
+	builder.WriteString(fmt.Sprintf("%v", wd.WebhookID))
+	builder.WriteString(", ")
+	builder.WriteString("event_id=")
+	builder.WriteString(fmt.Sprintf("%v", wd.EventID))
+	builder.WriteString(", ")
+	builder.WriteString("event_type=")
+	builder.WriteString(wd.EventType)
+	builder.WriteString(", ")
+	builder.WriteString("payload=")
+	builder.WriteString(fmt.Sprintf("%v", wd.Payload))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", wd.Status))
+	builder.WriteString(", ")
+	builder.WriteString("attempt_count=")
+	builder.WriteString(fmt.Sprintf("%v", wd.AttemptCount))
+	builder.WriteString(", ")
+	if v := wd.NextAttemptAt; v != nil {
+		builder.WriteString("next_attempt_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := wd.LastStatusCode; v != nil {
+		builder.WriteString("last_status_code=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("attempts=")
+	builder.WriteString(fmt.Sprintf("%v", wd.Attempts))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(wd.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(wd.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// WebhookDeliveries is a parsable slice of WebhookDelivery.
+type WebhookDeliveries []*WebhookDelivery
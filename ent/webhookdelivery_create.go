@@ -0,0 +1,427 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/ent/webhook"
+	"github.com/localopsco/go-sample/ent/webhookdelivery"
+	"github.com/localopsco/go-sample/models"
+)
+
+// WebhookDeliveryCreate is the builder for creating a WebhookDelivery entity.
+type WebhookDeliveryCreate struct {
+	config
+	mutation *WebhookDeliveryMutation
+	hooks    []Hook
+}
+
+// SetWebhookID sets the "webhook_id" field.
+func (wdc *WebhookDeliveryCreate) SetWebhookID(u uuid.UUID) *WebhookDeliveryCreate {
+	wdc.mutation.SetWebhookID(u)
+	return wdc
+}
+
+// SetEventID sets the "event_id" field.
+func (wdc *WebhookDeliveryCreate) SetEventID(u uuid.UUID) *WebhookDeliveryCreate {
+	wdc.mutation.SetEventID(u)
+	return wdc
+}
+
+// SetEventType sets the "event_type" field.
+func (wdc *WebhookDeliveryCreate) SetEventType(s string) *WebhookDeliveryCreate {
+	wdc.mutation.SetEventType(s)
+	return wdc
+}
+
+// SetPayload sets the "payload" field.
+func (wdc *WebhookDeliveryCreate) SetPayload(b []byte) *WebhookDeliveryCreate {
+	wdc.mutation.SetPayload(b)
+	return wdc
+}
+
+// SetStatus sets the "status" field.
+func (wdc *WebhookDeliveryCreate) SetStatus(w webhookdelivery.Status) *WebhookDeliveryCreate {
+	wdc.mutation.SetStatus(w)
+	return wdc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (wdc *WebhookDeliveryCreate) SetNillableStatus(w *webhookdelivery.Status) *WebhookDeliveryCreate {
+	if w != nil {
+		wdc.SetStatus(*w)
+	}
+	return wdc
+}
+
+// SetAttemptCount sets the "attempt_count" field.
+func (wdc *WebhookDeliveryCreate) SetAttemptCount(i int) *WebhookDeliveryCreate {
+	wdc.mutation.SetAttemptCount(i)
+	return wdc
+}
+
+// SetNillableAttemptCount sets the "attempt_count" field if the given value is not nil.
+func (wdc *WebhookDeliveryCreate) SetNillableAttemptCount(i *int) *WebhookDeliveryCreate {
+	if i != nil {
+		wdc.SetAttemptCount(*i)
+	}
+	return wdc
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (wdc *WebhookDeliveryCreate) SetNextAttemptAt(t time.Time) *WebhookDeliveryCreate {
+	wdc.mutation.SetNextAttemptAt(t)
+	return wdc
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (wdc *WebhookDeliveryCreate) SetNillableNextAttemptAt(t *time.Time) *WebhookDeliveryCreate {
+	if t != nil {
+		wdc.SetNextAttemptAt(*t)
+	}
+	return wdc
+}
+
+// SetLastStatusCode sets the "last_status_code" field.
+func (wdc *WebhookDeliveryCreate) SetLastStatusCode(i int) *WebhookDeliveryCreate {
+	wdc.mutation.SetLastStatusCode(i)
+	return wdc
+}
+
+// SetNillableLastStatusCode sets the "last_status_code" field if the given value is not nil.
+func (wdc *WebhookDeliveryCreate) SetNillableLastStatusCode(i *int) *WebhookDeliveryCreate {
+	if i != nil {
+		wdc.SetLastStatusCode(*i)
+	}
+	return wdc
+}
+
+// SetAttempts sets the "attempts" field.
+func (wdc *WebhookDeliveryCreate) SetAttempts(ma []models.DeliveryAttempt) *WebhookDeliveryCreate {
+	wdc.mutation.SetAttempts(ma)
+	return wdc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (wdc *WebhookDeliveryCreate) SetCreatedAt(t time.Time) *WebhookDeliveryCreate {
+	wdc.mutation.SetCreatedAt(t)
+	return wdc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (wdc *WebhookDeliveryCreate) SetNillableCreatedAt(t *time.Time) *WebhookDeliveryCreate {
+	if t != nil {
+		wdc.SetCreatedAt(*t)
+	}
+	return wdc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (wdc *WebhookDeliveryCreate) SetUpdatedAt(t time.Time) *WebhookDeliveryCreate {
+	wdc.mutation.SetUpdatedAt(t)
+	return wdc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (wdc *WebhookDeliveryCreate) SetNillableUpdatedAt(t *time.Time) *WebhookDeliveryCreate {
+	if t != nil {
+		wdc.SetUpdatedAt(*t)
+	}
+	return wdc
+}
+
+// SetID sets the "id" field.
+func (wdc *WebhookDeliveryCreate) SetID(u uuid.UUID) *WebhookDeliveryCreate {
+	wdc.mutation.SetID(u)
+	return wdc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (wdc *WebhookDeliveryCreate) SetNillableID(u *uuid.UUID) *WebhookDeliveryCreate {
+	if u != nil {
+		wdc.SetID(*u)
+	}
+	return wdc
+}
+
+// SetWebhook sets the "webhook" edge to the Webhook entity.
+func (wdc *WebhookDeliveryCreate) SetWebhook(w *Webhook) *WebhookDeliveryCreate {
+	return wdc.SetWebhookID(w.ID)
+}
+
+// Mutation returns the WebhookDeliveryMutation object of the builder.
+func (wdc *WebhookDeliveryCreate) Mutation() *WebhookDeliveryMutation {
+	return wdc.mutation
+}
+
+// Save creates the WebhookDelivery in the database.
+func (wdc *WebhookDeliveryCreate) Save(ctx context.Context) (*WebhookDelivery, error) {
+	wdc.defaults()
+	return withHooks(ctx, wdc.sqlSave, wdc.mutation, wdc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (wdc *WebhookDeliveryCreate) SaveX(ctx context.Context) *WebhookDelivery {
+	v, err := wdc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wdc *WebhookDeliveryCreate) Exec(ctx context.Context) error {
+	_, err := wdc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wdc *WebhookDeliveryCreate) ExecX(ctx context.Context) {
+	if err := wdc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wdc *WebhookDeliveryCreate) defaults() {
+	if _, ok := wdc.mutation.Status(); !ok {
+		v := webhookdelivery.DefaultStatus
+		wdc.mutation.SetStatus(v)
+	}
+	if _, ok := wdc.mutation.AttemptCount(); !ok {
+		v := webhookdelivery.DefaultAttemptCount
+		wdc.mutation.SetAttemptCount(v)
+	}
+	if _, ok := wdc.mutation.CreatedAt(); !ok {
+		v := webhookdelivery.DefaultCreatedAt()
+		wdc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := wdc.mutation.UpdatedAt(); !ok {
+		v := webhookdelivery.DefaultUpdatedAt()
+		wdc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := wdc.mutation.ID(); !ok {
+		v := webhookdelivery.DefaultID()
+		wdc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wdc *WebhookDeliveryCreate) check() error {
+	if _, ok := wdc.mutation.WebhookID(); !ok {
+		return &ValidationError{Name: "webhook_id", err: errors.New(`ent: missing required field "WebhookDelivery.webhook_id"`)}
+	}
+	if _, ok := wdc.mutation.EventID(); !ok {
+		return &ValidationError{Name: "event_id", err: errors.New(`ent: missing required field "WebhookDelivery.event_id"`)}
+	}
+	if _, ok := wdc.mutation.EventType(); !ok {
+		return &ValidationError{Name: "event_type", err: errors.New(`ent: missing required field "WebhookDelivery.event_type"`)}
+	}
+	if _, ok := wdc.mutation.Payload(); !ok {
+		return &ValidationError{Name: "payload", err: errors.New(`ent: missing required field "WebhookDelivery.payload"`)}
+	}
+	if _, ok := wdc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "WebhookDelivery.status"`)}
+	}
+	if v, ok := wdc.mutation.Status(); ok {
+		if err := webhookdelivery.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "WebhookDelivery.status": %w`, err)}
+		}
+	}
+	if _, ok := wdc.mutation.AttemptCount(); !ok {
+		return &ValidationError{Name: "attempt_count", err: errors.New(`ent: missing required field "WebhookDelivery.attempt_count"`)}
+	}
+	if _, ok := wdc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "WebhookDelivery.created_at"`)}
+	}
+	if _, ok := wdc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "WebhookDelivery.updated_at"`)}
+	}
+	if len(wdc.mutation.WebhookIDs()) == 0 {
+		return &ValidationError{Name: "webhook", err: errors.New(`ent: missing required edge "WebhookDelivery.webhook"`)}
+	}
+	return nil
+}
+
+func (wdc *WebhookDeliveryCreate) sqlSave(ctx context.Context) (*WebhookDelivery, error) {
+	if err := wdc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := wdc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, wdc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	wdc.mutation.id = &_node.ID
+	wdc.mutation.done = true
+	return _node, nil
+}
+
+func (wdc *WebhookDeliveryCreate) createSpec() (*WebhookDelivery, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WebhookDelivery{config: wdc.config}
+		_spec = sqlgraph.NewCreateSpec(webhookdelivery.Table, sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeUUID))
+	)
+	if id, ok := wdc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := wdc.mutation.EventID(); ok {
+		_spec.SetField(webhookdelivery.FieldEventID, field.TypeUUID, value)
+		_node.EventID = value
+	}
+	if value, ok := wdc.mutation.EventType(); ok {
+		_spec.SetField(webhookdelivery.FieldEventType, field.TypeString, value)
+		_node.EventType = value
+	}
+	if value, ok := wdc.mutation.Payload(); ok {
+		_spec.SetField(webhookdelivery.FieldPayload, field.TypeBytes, value)
+		_node.Payload = value
+	}
+	if value, ok := wdc.mutation.Status(); ok {
+		_spec.SetField(webhookdelivery.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := wdc.mutation.AttemptCount(); ok {
+		_spec.SetField(webhookdelivery.FieldAttemptCount, field.TypeInt, value)
+		_node.AttemptCount = value
+	}
+	if value, ok := wdc.mutation.NextAttemptAt(); ok {
+		_spec.SetField(webhookdelivery.FieldNextAttemptAt, field.TypeTime, value)
+		_node.NextAttemptAt = &value
+	}
+	if value, ok := wdc.mutation.LastStatusCode(); ok {
+		_spec.SetField(webhookdelivery.FieldLastStatusCode, field.TypeInt, value)
+		_node.LastStatusCode = &value
+	}
+	if value, ok := wdc.mutation.Attempts(); ok {
+		_spec.SetField(webhookdelivery.FieldAttempts, field.TypeJSON, value)
+		_node.Attempts = value
+	}
+	if value, ok := wdc.mutation.CreatedAt(); ok {
+		_spec.SetField(webhookdelivery.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := wdc.mutation.UpdatedAt(); ok {
+		_spec.SetField(webhookdelivery.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := wdc.mutation.WebhookIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webhookdelivery.WebhookTable,
+			Columns: []string{webhookdelivery.WebhookColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.WebhookID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// WebhookDeliveryCreateBulk is the builder for creating many WebhookDelivery entities in bulk.
+type WebhookDeliveryCreateBulk struct {
+	config
+	err      error
+	builders []*WebhookDeliveryCreate
+}
+
+// Save creates the WebhookDelivery entities in the database.
+func (wdcb *WebhookDeliveryCreateBulk) Save(ctx context.Context) ([]*WebhookDelivery, error) {
+	if wdcb.err != nil {
+		return nil, wdcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(wdcb.builders))
+	nodes := make([]*WebhookDelivery, len(wdcb.builders))
+	mutators := make([]Mutator, len(wdcb.builders))
+	for i := range wdcb.builders {
+		func(i int, root context.Context) {
+			builder := wdcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WebhookDeliveryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, wdcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, wdcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, wdcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wdcb *WebhookDeliveryCreateBulk) SaveX(ctx context.Context) []*WebhookDelivery {
+	v, err := wdcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wdcb *WebhookDeliveryCreateBulk) Exec(ctx context.Context) error {
+	_, err := wdcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wdcb *WebhookDeliveryCreateBulk) ExecX(ctx context.Context) {
+	if err := wdcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,762 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/ent/predicate"
+	"github.com/localopsco/go-sample/ent/webhook"
+	"github.com/localopsco/go-sample/ent/webhookdelivery"
+	"github.com/localopsco/go-sample/models"
+)
+
+// WebhookDeliveryUpdate is the builder for updating WebhookDelivery entities.
+type WebhookDeliveryUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *WebhookDeliveryMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the WebhookDeliveryUpdate builder.
+func (wdu *WebhookDeliveryUpdate) Where(ps ...predicate.WebhookDelivery) *WebhookDeliveryUpdate {
+	wdu.mutation.Where(ps...)
+	return wdu
+}
+
+// SetWebhookID sets the "webhook_id" field.
+func (wdu *WebhookDeliveryUpdate) SetWebhookID(u uuid.UUID) *WebhookDeliveryUpdate {
+	wdu.mutation.SetWebhookID(u)
+	return wdu
+}
+
+// SetNillableWebhookID sets the "webhook_id" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableWebhookID(u *uuid.UUID) *WebhookDeliveryUpdate {
+	if u != nil {
+		wdu.SetWebhookID(*u)
+	}
+	return wdu
+}
+
+// SetEventID sets the "event_id" field.
+func (wdu *WebhookDeliveryUpdate) SetEventID(u uuid.UUID) *WebhookDeliveryUpdate {
+	wdu.mutation.SetEventID(u)
+	return wdu
+}
+
+// SetNillableEventID sets the "event_id" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableEventID(u *uuid.UUID) *WebhookDeliveryUpdate {
+	if u != nil {
+		wdu.SetEventID(*u)
+	}
+	return wdu
+}
+
+// SetEventType sets the "event_type" field.
+func (wdu *WebhookDeliveryUpdate) SetEventType(s string) *WebhookDeliveryUpdate {
+	wdu.mutation.SetEventType(s)
+	return wdu
+}
+
+// SetNillableEventType sets the "event_type" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableEventType(s *string) *WebhookDeliveryUpdate {
+	if s != nil {
+		wdu.SetEventType(*s)
+	}
+	return wdu
+}
+
+// SetPayload sets the "payload" field.
+func (wdu *WebhookDeliveryUpdate) SetPayload(b []byte) *WebhookDeliveryUpdate {
+	wdu.mutation.SetPayload(b)
+	return wdu
+}
+
+// SetStatus sets the "status" field.
+func (wdu *WebhookDeliveryUpdate) SetStatus(w webhookdelivery.Status) *WebhookDeliveryUpdate {
+	wdu.mutation.SetStatus(w)
+	return wdu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableStatus(w *webhookdelivery.Status) *WebhookDeliveryUpdate {
+	if w != nil {
+		wdu.SetStatus(*w)
+	}
+	return wdu
+}
+
+// SetAttemptCount sets the "attempt_count" field.
+func (wdu *WebhookDeliveryUpdate) SetAttemptCount(i int) *WebhookDeliveryUpdate {
+	wdu.mutation.ResetAttemptCount()
+	wdu.mutation.SetAttemptCount(i)
+	return wdu
+}
+
+// SetNillableAttemptCount sets the "attempt_count" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableAttemptCount(i *int) *WebhookDeliveryUpdate {
+	if i != nil {
+		wdu.SetAttemptCount(*i)
+	}
+	return wdu
+}
+
+// AddAttemptCount adds i to the "attempt_count" field.
+func (wdu *WebhookDeliveryUpdate) AddAttemptCount(i int) *WebhookDeliveryUpdate {
+	wdu.mutation.AddAttemptCount(i)
+	return wdu
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (wdu *WebhookDeliveryUpdate) SetNextAttemptAt(t time.Time) *WebhookDeliveryUpdate {
+	wdu.mutation.SetNextAttemptAt(t)
+	return wdu
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableNextAttemptAt(t *time.Time) *WebhookDeliveryUpdate {
+	if t != nil {
+		wdu.SetNextAttemptAt(*t)
+	}
+	return wdu
+}
+
+// ClearNextAttemptAt clears the value of the "next_attempt_at" field.
+func (wdu *WebhookDeliveryUpdate) ClearNextAttemptAt() *WebhookDeliveryUpdate {
+	wdu.mutation.ClearNextAttemptAt()
+	return wdu
+}
+
+// SetLastStatusCode sets the "last_status_code" field.
+func (wdu *WebhookDeliveryUpdate) SetLastStatusCode(i int) *WebhookDeliveryUpdate {
+	wdu.mutation.ResetLastStatusCode()
+	wdu.mutation.SetLastStatusCode(i)
+	return wdu
+}
+
+// SetNillableLastStatusCode sets the "last_status_code" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableLastStatusCode(i *int) *WebhookDeliveryUpdate {
+	if i != nil {
+		wdu.SetLastStatusCode(*i)
+	}
+	return wdu
+}
+
+// AddLastStatusCode adds i to the "last_status_code" field.
+func (wdu *WebhookDeliveryUpdate) AddLastStatusCode(i int) *WebhookDeliveryUpdate {
+	wdu.mutation.AddLastStatusCode(i)
+	return wdu
+}
+
+// ClearLastStatusCode clears the value of the "last_status_code" field.
+func (wdu *WebhookDeliveryUpdate) ClearLastStatusCode() *WebhookDeliveryUpdate {
+	wdu.mutation.ClearLastStatusCode()
+	return wdu
+}
+
+// SetAttempts sets the "attempts" field.
+func (wdu *WebhookDeliveryUpdate) SetAttempts(ma []models.DeliveryAttempt) *WebhookDeliveryUpdate {
+	wdu.mutation.SetAttempts(ma)
+	return wdu
+}
+
+// AppendAttempts appends ma to the "attempts" field.
+func (wdu *WebhookDeliveryUpdate) AppendAttempts(ma []models.DeliveryAttempt) *WebhookDeliveryUpdate {
+	wdu.mutation.AppendAttempts(ma)
+	return wdu
+}
+
+// ClearAttempts clears the value of the "attempts" field.
+func (wdu *WebhookDeliveryUpdate) ClearAttempts() *WebhookDeliveryUpdate {
+	wdu.mutation.ClearAttempts()
+	return wdu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (wdu *WebhookDeliveryUpdate) SetCreatedAt(t time.Time) *WebhookDeliveryUpdate {
+	wdu.mutation.SetCreatedAt(t)
+	return wdu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableCreatedAt(t *time.Time) *WebhookDeliveryUpdate {
+	if t != nil {
+		wdu.SetCreatedAt(*t)
+	}
+	return wdu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (wdu *WebhookDeliveryUpdate) SetUpdatedAt(t time.Time) *WebhookDeliveryUpdate {
+	wdu.mutation.SetUpdatedAt(t)
+	return wdu
+}
+
+// SetWebhook sets the "webhook" edge to the Webhook entity.
+func (wdu *WebhookDeliveryUpdate) SetWebhook(w *Webhook) *WebhookDeliveryUpdate {
+	return wdu.SetWebhookID(w.ID)
+}
+
+// Mutation returns the WebhookDeliveryMutation object of the builder.
+func (wdu *WebhookDeliveryUpdate) Mutation() *WebhookDeliveryMutation {
+	return wdu.mutation
+}
+
+// ClearWebhook clears the "webhook" edge to the Webhook entity.
+func (wdu *WebhookDeliveryUpdate) ClearWebhook() *WebhookDeliveryUpdate {
+	wdu.mutation.ClearWebhook()
+	return wdu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (wdu *WebhookDeliveryUpdate) Save(ctx context.Context) (int, error) {
+	wdu.defaults()
+	return withHooks(ctx, wdu.sqlSave, wdu.mutation, wdu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wdu *WebhookDeliveryUpdate) SaveX(ctx context.Context) int {
+	affected, err := wdu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (wdu *WebhookDeliveryUpdate) Exec(ctx context.Context) error {
+	_, err := wdu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wdu *WebhookDeliveryUpdate) ExecX(ctx context.Context) {
+	if err := wdu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wdu *WebhookDeliveryUpdate) defaults() {
+	if _, ok := wdu.mutation.UpdatedAt(); !ok {
+		v := webhookdelivery.UpdateDefaultUpdatedAt()
+		wdu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wdu *WebhookDeliveryUpdate) check() error {
+	if v, ok := wdu.mutation.Status(); ok {
+		if err := webhookdelivery.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "WebhookDelivery.status": %w`, err)}
+		}
+	}
+	if wdu.mutation.WebhookCleared() && len(wdu.mutation.WebhookIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "WebhookDelivery.webhook"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (wdu *WebhookDeliveryUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *WebhookDeliveryUpdate {
+	wdu.modifiers = append(wdu.modifiers, modifiers...)
+	return wdu
+}
+
+func (wdu *WebhookDeliveryUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := wdu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(webhookdelivery.Table, webhookdelivery.Columns, sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeUUID))
+	if ps := wdu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wdu.mutation.EventID(); ok {
+		_spec.SetField(webhookdelivery.FieldEventID, field.TypeUUID, value)
+	}
+	if value, ok := wdu.mutation.EventType(); ok {
+		_spec.SetField(webhookdelivery.FieldEventType, field.TypeString, value)
+	}
+	if value, ok := wdu.mutation.Payload(); ok {
+		_spec.SetField(webhookdelivery.FieldPayload, field.TypeBytes, value)
+	}
+	if value, ok := wdu.mutation.Status(); ok {
+		_spec.SetField(webhookdelivery.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := wdu.mutation.AttemptCount(); ok {
+		_spec.SetField(webhookdelivery.FieldAttemptCount, field.TypeInt, value)
+	}
+	if value, ok := wdu.mutation.AddedAttemptCount(); ok {
+		_spec.AddField(webhookdelivery.FieldAttemptCount, field.TypeInt, value)
+	}
+	if value, ok := wdu.mutation.NextAttemptAt(); ok {
+		_spec.SetField(webhookdelivery.FieldNextAttemptAt, field.TypeTime, value)
+	}
+	if wdu.mutation.NextAttemptAtCleared() {
+		_spec.ClearField(webhookdelivery.FieldNextAttemptAt, field.TypeTime)
+	}
+	if value, ok := wdu.mutation.LastStatusCode(); ok {
+		_spec.SetField(webhookdelivery.FieldLastStatusCode, field.TypeInt, value)
+	}
+	if value, ok := wdu.mutation.AddedLastStatusCode(); ok {
+		_spec.AddField(webhookdelivery.FieldLastStatusCode, field.TypeInt, value)
+	}
+	if wdu.mutation.LastStatusCodeCleared() {
+		_spec.ClearField(webhookdelivery.FieldLastStatusCode, field.TypeInt)
+	}
+	if value, ok := wdu.mutation.Attempts(); ok {
+		_spec.SetField(webhookdelivery.FieldAttempts, field.TypeJSON, value)
+	}
+	if value, ok := wdu.mutation.AppendedAttempts(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, webhookdelivery.FieldAttempts, value)
+		})
+	}
+	if wdu.mutation.AttemptsCleared() {
+		_spec.ClearField(webhookdelivery.FieldAttempts, field.TypeJSON)
+	}
+	if value, ok := wdu.mutation.CreatedAt(); ok {
+		_spec.SetField(webhookdelivery.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := wdu.mutation.UpdatedAt(); ok {
+		_spec.SetField(webhookdelivery.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if wdu.mutation.WebhookCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webhookdelivery.WebhookTable,
+			Columns: []string{webhookdelivery.WebhookColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wdu.mutation.WebhookIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webhookdelivery.WebhookTable,
+			Columns: []string{webhookdelivery.WebhookColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(wdu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, wdu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webhookdelivery.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	wdu.mutation.done = true
+	return n, nil
+}
+
+// WebhookDeliveryUpdateOne is the builder for updating a single WebhookDelivery entity.
+type WebhookDeliveryUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *WebhookDeliveryMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetWebhookID sets the "webhook_id" field.
+func (wduo *WebhookDeliveryUpdateOne) SetWebhookID(u uuid.UUID) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetWebhookID(u)
+	return wduo
+}
+
+// SetNillableWebhookID sets the "webhook_id" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableWebhookID(u *uuid.UUID) *WebhookDeliveryUpdateOne {
+	if u != nil {
+		wduo.SetWebhookID(*u)
+	}
+	return wduo
+}
+
+// SetEventID sets the "event_id" field.
+func (wduo *WebhookDeliveryUpdateOne) SetEventID(u uuid.UUID) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetEventID(u)
+	return wduo
+}
+
+// SetNillableEventID sets the "event_id" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableEventID(u *uuid.UUID) *WebhookDeliveryUpdateOne {
+	if u != nil {
+		wduo.SetEventID(*u)
+	}
+	return wduo
+}
+
+// SetEventType sets the "event_type" field.
+func (wduo *WebhookDeliveryUpdateOne) SetEventType(s string) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetEventType(s)
+	return wduo
+}
+
+// SetNillableEventType sets the "event_type" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableEventType(s *string) *WebhookDeliveryUpdateOne {
+	if s != nil {
+		wduo.SetEventType(*s)
+	}
+	return wduo
+}
+
+// SetPayload sets the "payload" field.
+func (wduo *WebhookDeliveryUpdateOne) SetPayload(b []byte) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetPayload(b)
+	return wduo
+}
+
+// SetStatus sets the "status" field.
+func (wduo *WebhookDeliveryUpdateOne) SetStatus(w webhookdelivery.Status) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetStatus(w)
+	return wduo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableStatus(w *webhookdelivery.Status) *WebhookDeliveryUpdateOne {
+	if w != nil {
+		wduo.SetStatus(*w)
+	}
+	return wduo
+}
+
+// SetAttemptCount sets the "attempt_count" field.
+func (wduo *WebhookDeliveryUpdateOne) SetAttemptCount(i int) *WebhookDeliveryUpdateOne {
+	wduo.mutation.ResetAttemptCount()
+	wduo.mutation.SetAttemptCount(i)
+	return wduo
+}
+
+// SetNillableAttemptCount sets the "attempt_count" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableAttemptCount(i *int) *WebhookDeliveryUpdateOne {
+	if i != nil {
+		wduo.SetAttemptCount(*i)
+	}
+	return wduo
+}
+
+// AddAttemptCount adds i to the "attempt_count" field.
+func (wduo *WebhookDeliveryUpdateOne) AddAttemptCount(i int) *WebhookDeliveryUpdateOne {
+	wduo.mutation.AddAttemptCount(i)
+	return wduo
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (wduo *WebhookDeliveryUpdateOne) SetNextAttemptAt(t time.Time) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetNextAttemptAt(t)
+	return wduo
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableNextAttemptAt(t *time.Time) *WebhookDeliveryUpdateOne {
+	if t != nil {
+		wduo.SetNextAttemptAt(*t)
+	}
+	return wduo
+}
+
+// ClearNextAttemptAt clears the value of the "next_attempt_at" field.
+func (wduo *WebhookDeliveryUpdateOne) ClearNextAttemptAt() *WebhookDeliveryUpdateOne {
+	wduo.mutation.ClearNextAttemptAt()
+	return wduo
+}
+
+// SetLastStatusCode sets the "last_status_code" field.
+func (wduo *WebhookDeliveryUpdateOne) SetLastStatusCode(i int) *WebhookDeliveryUpdateOne {
+	wduo.mutation.ResetLastStatusCode()
+	wduo.mutation.SetLastStatusCode(i)
+	return wduo
+}
+
+// SetNillableLastStatusCode sets the "last_status_code" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableLastStatusCode(i *int) *WebhookDeliveryUpdateOne {
+	if i != nil {
+		wduo.SetLastStatusCode(*i)
+	}
+	return wduo
+}
+
+// AddLastStatusCode adds i to the "last_status_code" field.
+func (wduo *WebhookDeliveryUpdateOne) AddLastStatusCode(i int) *WebhookDeliveryUpdateOne {
+	wduo.mutation.AddLastStatusCode(i)
+	return wduo
+}
+
+// ClearLastStatusCode clears the value of the "last_status_code" field.
+func (wduo *WebhookDeliveryUpdateOne) ClearLastStatusCode() *WebhookDeliveryUpdateOne {
+	wduo.mutation.ClearLastStatusCode()
+	return wduo
+}
+
+// SetAttempts sets the "attempts" field.
+func (wduo *WebhookDeliveryUpdateOne) SetAttempts(ma []models.DeliveryAttempt) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetAttempts(ma)
+	return wduo
+}
+
+// AppendAttempts appends ma to the "attempts" field.
+func (wduo *WebhookDeliveryUpdateOne) AppendAttempts(ma []models.DeliveryAttempt) *WebhookDeliveryUpdateOne {
+	wduo.mutation.AppendAttempts(ma)
+	return wduo
+}
+
+// ClearAttempts clears the value of the "attempts" field.
+func (wduo *WebhookDeliveryUpdateOne) ClearAttempts() *WebhookDeliveryUpdateOne {
+	wduo.mutation.ClearAttempts()
+	return wduo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (wduo *WebhookDeliveryUpdateOne) SetCreatedAt(t time.Time) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetCreatedAt(t)
+	return wduo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableCreatedAt(t *time.Time) *WebhookDeliveryUpdateOne {
+	if t != nil {
+		wduo.SetCreatedAt(*t)
+	}
+	return wduo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (wduo *WebhookDeliveryUpdateOne) SetUpdatedAt(t time.Time) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetUpdatedAt(t)
+	return wduo
+}
+
+// SetWebhook sets the "webhook" edge to the Webhook entity.
+func (wduo *WebhookDeliveryUpdateOne) SetWebhook(w *Webhook) *WebhookDeliveryUpdateOne {
+	return wduo.SetWebhookID(w.ID)
+}
+
+// Mutation returns the WebhookDeliveryMutation object of the builder.
+func (wduo *WebhookDeliveryUpdateOne) Mutation() *WebhookDeliveryMutation {
+	return wduo.mutation
+}
+
+// ClearWebhook clears the "webhook" edge to the Webhook entity.
+func (wduo *WebhookDeliveryUpdateOne) ClearWebhook() *WebhookDeliveryUpdateOne {
+	wduo.mutation.ClearWebhook()
+	return wduo
+}
+
+// Where appends a list predicates to the WebhookDeliveryUpdate builder.
+func (wduo *WebhookDeliveryUpdateOne) Where(ps ...predicate.WebhookDelivery) *WebhookDeliveryUpdateOne {
+	wduo.mutation.Where(ps...)
+	return wduo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (wduo *WebhookDeliveryUpdateOne) Select(field string, fields ...string) *WebhookDeliveryUpdateOne {
+	wduo.fields = append([]string{field}, fields...)
+	return wduo
+}
+
+// Save executes the query and returns the updated WebhookDelivery entity.
+func (wduo *WebhookDeliveryUpdateOne) Save(ctx context.Context) (*WebhookDelivery, error) {
+	wduo.defaults()
+	return withHooks(ctx, wduo.sqlSave, wduo.mutation, wduo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wduo *WebhookDeliveryUpdateOne) SaveX(ctx context.Context) *WebhookDelivery {
+	node, err := wduo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (wduo *WebhookDeliveryUpdateOne) Exec(ctx context.Context) error {
+	_, err := wduo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wduo *WebhookDeliveryUpdateOne) ExecX(ctx context.Context) {
+	if err := wduo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wduo *WebhookDeliveryUpdateOne) defaults() {
+	if _, ok := wduo.mutation.UpdatedAt(); !ok {
+		v := webhookdelivery.UpdateDefaultUpdatedAt()
+		wduo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wduo *WebhookDeliveryUpdateOne) check() error {
+	if v, ok := wduo.mutation.Status(); ok {
+		if err := webhookdelivery.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "WebhookDelivery.status": %w`, err)}
+		}
+	}
+	if wduo.mutation.WebhookCleared() && len(wduo.mutation.WebhookIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "WebhookDelivery.webhook"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (wduo *WebhookDeliveryUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *WebhookDeliveryUpdateOne {
+	wduo.modifiers = append(wduo.modifiers, modifiers...)
+	return wduo
+}
+
+func (wduo *WebhookDeliveryUpdateOne) sqlSave(ctx context.Context) (_node *WebhookDelivery, err error) {
+	if err := wduo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(webhookdelivery.Table, webhookdelivery.Columns, sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeUUID))
+	id, ok := wduo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "WebhookDelivery.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := wduo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, webhookdelivery.FieldID)
+		for _, f := range fields {
+			if !webhookdelivery.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != webhookdelivery.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := wduo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wduo.mutation.EventID(); ok {
+		_spec.SetField(webhookdelivery.FieldEventID, field.TypeUUID, value)
+	}
+	if value, ok := wduo.mutation.EventType(); ok {
+		_spec.SetField(webhookdelivery.FieldEventType, field.TypeString, value)
+	}
+	if value, ok := wduo.mutation.Payload(); ok {
+		_spec.SetField(webhookdelivery.FieldPayload, field.TypeBytes, value)
+	}
+	if value, ok := wduo.mutation.Status(); ok {
+		_spec.SetField(webhookdelivery.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := wduo.mutation.AttemptCount(); ok {
+		_spec.SetField(webhookdelivery.FieldAttemptCount, field.TypeInt, value)
+	}
+	if value, ok := wduo.mutation.AddedAttemptCount(); ok {
+		_spec.AddField(webhookdelivery.FieldAttemptCount, field.TypeInt, value)
+	}
+	if value, ok := wduo.mutation.NextAttemptAt(); ok {
+		_spec.SetField(webhookdelivery.FieldNextAttemptAt, field.TypeTime, value)
+	}
+	if wduo.mutation.NextAttemptAtCleared() {
+		_spec.ClearField(webhookdelivery.FieldNextAttemptAt, field.TypeTime)
+	}
+	if value, ok := wduo.mutation.LastStatusCode(); ok {
+		_spec.SetField(webhookdelivery.FieldLastStatusCode, field.TypeInt, value)
+	}
+	if value, ok := wduo.mutation.AddedLastStatusCode(); ok {
+		_spec.AddField(webhookdelivery.FieldLastStatusCode, field.TypeInt, value)
+	}
+	if wduo.mutation.LastStatusCodeCleared() {
+		_spec.ClearField(webhookdelivery.FieldLastStatusCode, field.TypeInt)
+	}
+	if value, ok := wduo.mutation.Attempts(); ok {
+		_spec.SetField(webhookdelivery.FieldAttempts, field.TypeJSON, value)
+	}
+	if value, ok := wduo.mutation.AppendedAttempts(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, webhookdelivery.FieldAttempts, value)
+		})
+	}
+	if wduo.mutation.AttemptsCleared() {
+		_spec.ClearField(webhookdelivery.FieldAttempts, field.TypeJSON)
+	}
+	if value, ok := wduo.mutation.CreatedAt(); ok {
+		_spec.SetField(webhookdelivery.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := wduo.mutation.UpdatedAt(); ok {
+		_spec.SetField(webhookdelivery.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if wduo.mutation.WebhookCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webhookdelivery.WebhookTable,
+			Columns: []string{webhookdelivery.WebhookColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wduo.mutation.WebhookIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webhookdelivery.WebhookTable,
+			Columns: []string{webhookdelivery.WebhookColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(wduo.modifiers...)
+	_node = &WebhookDelivery{config: wduo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, wduo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webhookdelivery.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	wduo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,226 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/ent/workflow"
+)
+
+// Workflow is the model entity for the Workflow schema.
+type Workflow struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// IsDefault holds the value of the "is_default" field.
+	IsDefault bool `json:"is_default,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the WorkflowQuery when eager-loading is set.
+	Edges        WorkflowEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// WorkflowEdges holds the relations/edges for other nodes in the graph.
+type WorkflowEdges struct {
+	// Statuses holds the value of the statuses edge.
+	Statuses []*WorkflowStatus `json:"statuses,omitempty"`
+	// Projects holds the value of the projects edge.
+	Projects []*Project `json:"projects,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+	// totalCount holds the count of the edges above.
+	totalCount [2]map[string]int
+
+	namedStatuses map[string][]*WorkflowStatus
+	namedProjects map[string][]*Project
+}
+
+// StatusesOrErr returns the Statuses value or an error if the edge
+// was not loaded in eager-loading.
+func (e WorkflowEdges) StatusesOrErr() ([]*WorkflowStatus, error) {
+	if e.loadedTypes[0] {
+		return e.Statuses, nil
+	}
+	return nil, &NotLoadedError{edge: "statuses"}
+}
+
+// ProjectsOrErr returns the Projects value or an error if the edge
+// was not loaded in eager-loading.
+func (e WorkflowEdges) ProjectsOrErr() ([]*Project, error) {
+	if e.loadedTypes[1] {
+		return e.Projects, nil
+	}
+	return nil, &NotLoadedError{edge: "projects"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Workflow) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case workflow.FieldIsDefault:
+			values[i] = new(sql.NullBool)
+		case workflow.FieldName:
+			values[i] = new(sql.NullString)
+		case workflow.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case workflow.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Workflow fields.
+func (w *Workflow) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case workflow.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				w.ID = *value
+			}
+		case workflow.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				w.Name = value.String
+			}
+		case workflow.FieldIsDefault:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_default", values[i])
+			} else if value.Valid {
+				w.IsDefault = value.Bool
+			}
+		case workflow.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				w.CreatedAt = value.Time
+			}
+		default:
+			w.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Workflow.
+// This includes values selected through modifiers, order, etc.
+func (w *Workflow) Value(name string) (ent.Value, error) {
+	return w.selectValues.Get(name)
+}
+
+// QueryStatuses queries the "statuses" edge of the Workflow entity.
+func (w *Workflow) QueryStatuses() *WorkflowStatusQuery {
+	return NewWorkflowClient(w.config).QueryStatuses(w)
+}
+
+// QueryProjects queries the "projects" edge of the Workflow entity.
+func (w *Workflow) QueryProjects() *ProjectQuery {
+	return NewWorkflowClient(w.config).QueryProjects(w)
+}
+
+// Update returns a builder for updating this Workflow.
+// Note that you need to call Workflow.Unwrap() before calling this method if this Workflow
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (w *Workflow) Update() *WorkflowUpdateOne {
+	return NewWorkflowClient(w.config).UpdateOne(w)
+}
+
+// Unwrap unwraps the Workflow entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (w *Workflow) Unwrap() *Workflow {
+	_tx, ok := w.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Workflow is not a transactional entity")
+	}
+	w.config.driver = _tx.drv
+	return w
+}
+
+// String implements the fmt.Stringer.
+func (w *Workflow) String() string {
+	var builder strings.Builder
+	builder.WriteString("Workflow(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", w.ID))
+	builder.WriteString("name=")
+	builder.WriteString(w.Name)
+	builder.WriteString(", ")
+	builder.WriteString("is_default=")
+	builder.WriteString(fmt.Sprintf("%v", w.IsDefault))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(w.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// NamedStatuses returns the Statuses named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (w *Workflow) NamedStatuses(name string) ([]*WorkflowStatus, error) {
+	if w.Edges.namedStatuses == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := w.Edges.namedStatuses[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (w *Workflow) appendNamedStatuses(name string, edges ...*WorkflowStatus) {
+	if w.Edges.namedStatuses == nil {
+		w.Edges.namedStatuses = make(map[string][]*WorkflowStatus)
+	}
+	if len(edges) == 0 {
+		w.Edges.namedStatuses[name] = []*WorkflowStatus{}
+	} else {
+		w.Edges.namedStatuses[name] = append(w.Edges.namedStatuses[name], edges...)
+	}
+}
+
+// NamedProjects returns the Projects named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (w *Workflow) NamedProjects(name string) ([]*Project, error) {
+	if w.Edges.namedProjects == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := w.Edges.namedProjects[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (w *Workflow) appendNamedProjects(name string, edges ...*Project) {
+	if w.Edges.namedProjects == nil {
+		w.Edges.namedProjects = make(map[string][]*Project)
+	}
+	if len(edges) == 0 {
+		w.Edges.namedProjects[name] = []*Project{}
+	} else {
+		w.Edges.namedProjects[name] = append(w.Edges.namedProjects[name], edges...)
+	}
+}
+
+// Workflows is a parsable slice of Workflow.
+type Workflows []*Workflow
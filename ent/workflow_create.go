@@ -0,0 +1,333 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/ent/project"
+	"github.com/localopsco/go-sample/ent/workflow"
+	"github.com/localopsco/go-sample/ent/workflowstatus"
+)
+
+// WorkflowCreate is the builder for creating a Workflow entity.
+type WorkflowCreate struct {
+	config
+	mutation *WorkflowMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (wc *WorkflowCreate) SetName(s string) *WorkflowCreate {
+	wc.mutation.SetName(s)
+	return wc
+}
+
+// SetIsDefault sets the "is_default" field.
+func (wc *WorkflowCreate) SetIsDefault(b bool) *WorkflowCreate {
+	wc.mutation.SetIsDefault(b)
+	return wc
+}
+
+// SetNillableIsDefault sets the "is_default" field if the given value is not nil.
+func (wc *WorkflowCreate) SetNillableIsDefault(b *bool) *WorkflowCreate {
+	if b != nil {
+		wc.SetIsDefault(*b)
+	}
+	return wc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (wc *WorkflowCreate) SetCreatedAt(t time.Time) *WorkflowCreate {
+	wc.mutation.SetCreatedAt(t)
+	return wc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (wc *WorkflowCreate) SetNillableCreatedAt(t *time.Time) *WorkflowCreate {
+	if t != nil {
+		wc.SetCreatedAt(*t)
+	}
+	return wc
+}
+
+// SetID sets the "id" field.
+func (wc *WorkflowCreate) SetID(u uuid.UUID) *WorkflowCreate {
+	wc.mutation.SetID(u)
+	return wc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (wc *WorkflowCreate) SetNillableID(u *uuid.UUID) *WorkflowCreate {
+	if u != nil {
+		wc.SetID(*u)
+	}
+	return wc
+}
+
+// AddStatusIDs adds the "statuses" edge to the WorkflowStatus entity by IDs.
+func (wc *WorkflowCreate) AddStatusIDs(ids ...uuid.UUID) *WorkflowCreate {
+	wc.mutation.AddStatusIDs(ids...)
+	return wc
+}
+
+// AddStatuses adds the "statuses" edges to the WorkflowStatus entity.
+func (wc *WorkflowCreate) AddStatuses(w ...*WorkflowStatus) *WorkflowCreate {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return wc.AddStatusIDs(ids...)
+}
+
+// AddProjectIDs adds the "projects" edge to the Project entity by IDs.
+func (wc *WorkflowCreate) AddProjectIDs(ids ...uuid.UUID) *WorkflowCreate {
+	wc.mutation.AddProjectIDs(ids...)
+	return wc
+}
+
+// AddProjects adds the "projects" edges to the Project entity.
+func (wc *WorkflowCreate) AddProjects(p ...*Project) *WorkflowCreate {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return wc.AddProjectIDs(ids...)
+}
+
+// Mutation returns the WorkflowMutation object of the builder.
+func (wc *WorkflowCreate) Mutation() *WorkflowMutation {
+	return wc.mutation
+}
+
+// Save creates the Workflow in the database.
+func (wc *WorkflowCreate) Save(ctx context.Context) (*Workflow, error) {
+	wc.defaults()
+	return withHooks(ctx, wc.sqlSave, wc.mutation, wc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (wc *WorkflowCreate) SaveX(ctx context.Context) *Workflow {
+	v, err := wc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wc *WorkflowCreate) Exec(ctx context.Context) error {
+	_, err := wc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wc *WorkflowCreate) ExecX(ctx context.Context) {
+	if err := wc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wc *WorkflowCreate) defaults() {
+	if _, ok := wc.mutation.IsDefault(); !ok {
+		v := workflow.DefaultIsDefault
+		wc.mutation.SetIsDefault(v)
+	}
+	if _, ok := wc.mutation.CreatedAt(); !ok {
+		v := workflow.DefaultCreatedAt()
+		wc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := wc.mutation.ID(); !ok {
+		v := workflow.DefaultID()
+		wc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wc *WorkflowCreate) check() error {
+	if _, ok := wc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Workflow.name"`)}
+	}
+	if v, ok := wc.mutation.Name(); ok {
+		if err := workflow.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Workflow.name": %w`, err)}
+		}
+	}
+	if _, ok := wc.mutation.IsDefault(); !ok {
+		return &ValidationError{Name: "is_default", err: errors.New(`ent: missing required field "Workflow.is_default"`)}
+	}
+	if _, ok := wc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Workflow.created_at"`)}
+	}
+	return nil
+}
+
+func (wc *WorkflowCreate) sqlSave(ctx context.Context) (*Workflow, error) {
+	if err := wc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := wc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, wc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	wc.mutation.id = &_node.ID
+	wc.mutation.done = true
+	return _node, nil
+}
+
+func (wc *WorkflowCreate) createSpec() (*Workflow, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Workflow{config: wc.config}
+		_spec = sqlgraph.NewCreateSpec(workflow.Table, sqlgraph.NewFieldSpec(workflow.FieldID, field.TypeUUID))
+	)
+	if id, ok := wc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := wc.mutation.Name(); ok {
+		_spec.SetField(workflow.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := wc.mutation.IsDefault(); ok {
+		_spec.SetField(workflow.FieldIsDefault, field.TypeBool, value)
+		_node.IsDefault = value
+	}
+	if value, ok := wc.mutation.CreatedAt(); ok {
+		_spec.SetField(workflow.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := wc.mutation.StatusesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workflow.StatusesTable,
+			Columns: []string{workflow.StatusesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workflowstatus.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := wc.mutation.ProjectsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workflow.ProjectsTable,
+			Columns: []string{workflow.ProjectsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// WorkflowCreateBulk is the builder for creating many Workflow entities in bulk.
+type WorkflowCreateBulk struct {
+	config
+	err      error
+	builders []*WorkflowCreate
+}
+
+// Save creates the Workflow entities in the database.
+func (wcb *WorkflowCreateBulk) Save(ctx context.Context) ([]*Workflow, error) {
+	if wcb.err != nil {
+		return nil, wcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(wcb.builders))
+	nodes := make([]*Workflow, len(wcb.builders))
+	mutators := make([]Mutator, len(wcb.builders))
+	for i := range wcb.builders {
+		func(i int, root context.Context) {
+			builder := wcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WorkflowMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, wcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, wcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, wcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wcb *WorkflowCreateBulk) SaveX(ctx context.Context) []*Workflow {
+	v, err := wcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wcb *WorkflowCreateBulk) Exec(ctx context.Context) error {
+	_, err := wcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wcb *WorkflowCreateBulk) ExecX(ctx context.Context) {
+	if err := wcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
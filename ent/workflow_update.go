@@ -0,0 +1,647 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/ent/predicate"
+	"github.com/localopsco/go-sample/ent/project"
+	"github.com/localopsco/go-sample/ent/workflow"
+	"github.com/localopsco/go-sample/ent/workflowstatus"
+)
+
+// WorkflowUpdate is the builder for updating Workflow entities.
+type WorkflowUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *WorkflowMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the WorkflowUpdate builder.
+func (wu *WorkflowUpdate) Where(ps ...predicate.Workflow) *WorkflowUpdate {
+	wu.mutation.Where(ps...)
+	return wu
+}
+
+// SetName sets the "name" field.
+func (wu *WorkflowUpdate) SetName(s string) *WorkflowUpdate {
+	wu.mutation.SetName(s)
+	return wu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (wu *WorkflowUpdate) SetNillableName(s *string) *WorkflowUpdate {
+	if s != nil {
+		wu.SetName(*s)
+	}
+	return wu
+}
+
+// SetIsDefault sets the "is_default" field.
+func (wu *WorkflowUpdate) SetIsDefault(b bool) *WorkflowUpdate {
+	wu.mutation.SetIsDefault(b)
+	return wu
+}
+
+// SetNillableIsDefault sets the "is_default" field if the given value is not nil.
+func (wu *WorkflowUpdate) SetNillableIsDefault(b *bool) *WorkflowUpdate {
+	if b != nil {
+		wu.SetIsDefault(*b)
+	}
+	return wu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (wu *WorkflowUpdate) SetCreatedAt(t time.Time) *WorkflowUpdate {
+	wu.mutation.SetCreatedAt(t)
+	return wu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (wu *WorkflowUpdate) SetNillableCreatedAt(t *time.Time) *WorkflowUpdate {
+	if t != nil {
+		wu.SetCreatedAt(*t)
+	}
+	return wu
+}
+
+// AddStatusIDs adds the "statuses" edge to the WorkflowStatus entity by IDs.
+func (wu *WorkflowUpdate) AddStatusIDs(ids ...uuid.UUID) *WorkflowUpdate {
+	wu.mutation.AddStatusIDs(ids...)
+	return wu
+}
+
+// AddStatuses adds the "statuses" edges to the WorkflowStatus entity.
+func (wu *WorkflowUpdate) AddStatuses(w ...*WorkflowStatus) *WorkflowUpdate {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return wu.AddStatusIDs(ids...)
+}
+
+// AddProjectIDs adds the "projects" edge to the Project entity by IDs.
+func (wu *WorkflowUpdate) AddProjectIDs(ids ...uuid.UUID) *WorkflowUpdate {
+	wu.mutation.AddProjectIDs(ids...)
+	return wu
+}
+
+// AddProjects adds the "projects" edges to the Project entity.
+func (wu *WorkflowUpdate) AddProjects(p ...*Project) *WorkflowUpdate {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return wu.AddProjectIDs(ids...)
+}
+
+// Mutation returns the WorkflowMutation object of the builder.
+func (wu *WorkflowUpdate) Mutation() *WorkflowMutation {
+	return wu.mutation
+}
+
+// ClearStatuses clears all "statuses" edges to the WorkflowStatus entity.
+func (wu *WorkflowUpdate) ClearStatuses() *WorkflowUpdate {
+	wu.mutation.ClearStatuses()
+	return wu
+}
+
+// RemoveStatusIDs removes the "statuses" edge to WorkflowStatus entities by IDs.
+func (wu *WorkflowUpdate) RemoveStatusIDs(ids ...uuid.UUID) *WorkflowUpdate {
+	wu.mutation.RemoveStatusIDs(ids...)
+	return wu
+}
+
+// RemoveStatuses removes "statuses" edges to WorkflowStatus entities.
+func (wu *WorkflowUpdate) RemoveStatuses(w ...*WorkflowStatus) *WorkflowUpdate {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return wu.RemoveStatusIDs(ids...)
+}
+
+// ClearProjects clears all "projects" edges to the Project entity.
+func (wu *WorkflowUpdate) ClearProjects() *WorkflowUpdate {
+	wu.mutation.ClearProjects()
+	return wu
+}
+
+// RemoveProjectIDs removes the "projects" edge to Project entities by IDs.
+func (wu *WorkflowUpdate) RemoveProjectIDs(ids ...uuid.UUID) *WorkflowUpdate {
+	wu.mutation.RemoveProjectIDs(ids...)
+	return wu
+}
+
+// RemoveProjects removes "projects" edges to Project entities.
+func (wu *WorkflowUpdate) RemoveProjects(p ...*Project) *WorkflowUpdate {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return wu.RemoveProjectIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (wu *WorkflowUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, wu.sqlSave, wu.mutation, wu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wu *WorkflowUpdate) SaveX(ctx context.Context) int {
+	affected, err := wu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (wu *WorkflowUpdate) Exec(ctx context.Context) error {
+	_, err := wu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wu *WorkflowUpdate) ExecX(ctx context.Context) {
+	if err := wu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wu *WorkflowUpdate) check() error {
+	if v, ok := wu.mutation.Name(); ok {
+		if err := workflow.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Workflow.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (wu *WorkflowUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *WorkflowUpdate {
+	wu.modifiers = append(wu.modifiers, modifiers...)
+	return wu
+}
+
+func (wu *WorkflowUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := wu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(workflow.Table, workflow.Columns, sqlgraph.NewFieldSpec(workflow.FieldID, field.TypeUUID))
+	if ps := wu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wu.mutation.Name(); ok {
+		_spec.SetField(workflow.FieldName, field.TypeString, value)
+	}
+	if value, ok := wu.mutation.IsDefault(); ok {
+		_spec.SetField(workflow.FieldIsDefault, field.TypeBool, value)
+	}
+	if value, ok := wu.mutation.CreatedAt(); ok {
+		_spec.SetField(workflow.FieldCreatedAt, field.TypeTime, value)
+	}
+	if wu.mutation.StatusesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workflow.StatusesTable,
+			Columns: []string{workflow.StatusesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workflowstatus.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wu.mutation.RemovedStatusesIDs(); len(nodes) > 0 && !wu.mutation.StatusesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workflow.StatusesTable,
+			Columns: []string{workflow.StatusesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workflowstatus.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wu.mutation.StatusesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workflow.StatusesTable,
+			Columns: []string{workflow.StatusesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workflowstatus.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if wu.mutation.ProjectsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workflow.ProjectsTable,
+			Columns: []string{workflow.ProjectsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wu.mutation.RemovedProjectsIDs(); len(nodes) > 0 && !wu.mutation.ProjectsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workflow.ProjectsTable,
+			Columns: []string{workflow.ProjectsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wu.mutation.ProjectsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workflow.ProjectsTable,
+			Columns: []string{workflow.ProjectsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(wu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, wu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{workflow.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	wu.mutation.done = true
+	return n, nil
+}
+
+// WorkflowUpdateOne is the builder for updating a single Workflow entity.
+type WorkflowUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *WorkflowMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetName sets the "name" field.
+func (wuo *WorkflowUpdateOne) SetName(s string) *WorkflowUpdateOne {
+	wuo.mutation.SetName(s)
+	return wuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (wuo *WorkflowUpdateOne) SetNillableName(s *string) *WorkflowUpdateOne {
+	if s != nil {
+		wuo.SetName(*s)
+	}
+	return wuo
+}
+
+// SetIsDefault sets the "is_default" field.
+func (wuo *WorkflowUpdateOne) SetIsDefault(b bool) *WorkflowUpdateOne {
+	wuo.mutation.SetIsDefault(b)
+	return wuo
+}
+
+// SetNillableIsDefault sets the "is_default" field if the given value is not nil.
+func (wuo *WorkflowUpdateOne) SetNillableIsDefault(b *bool) *WorkflowUpdateOne {
+	if b != nil {
+		wuo.SetIsDefault(*b)
+	}
+	return wuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (wuo *WorkflowUpdateOne) SetCreatedAt(t time.Time) *WorkflowUpdateOne {
+	wuo.mutation.SetCreatedAt(t)
+	return wuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (wuo *WorkflowUpdateOne) SetNillableCreatedAt(t *time.Time) *WorkflowUpdateOne {
+	if t != nil {
+		wuo.SetCreatedAt(*t)
+	}
+	return wuo
+}
+
+// AddStatusIDs adds the "statuses" edge to the WorkflowStatus entity by IDs.
+func (wuo *WorkflowUpdateOne) AddStatusIDs(ids ...uuid.UUID) *WorkflowUpdateOne {
+	wuo.mutation.AddStatusIDs(ids...)
+	return wuo
+}
+
+// AddStatuses adds the "statuses" edges to the WorkflowStatus entity.
+func (wuo *WorkflowUpdateOne) AddStatuses(w ...*WorkflowStatus) *WorkflowUpdateOne {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return wuo.AddStatusIDs(ids...)
+}
+
+// AddProjectIDs adds the "projects" edge to the Project entity by IDs.
+func (wuo *WorkflowUpdateOne) AddProjectIDs(ids ...uuid.UUID) *WorkflowUpdateOne {
+	wuo.mutation.AddProjectIDs(ids...)
+	return wuo
+}
+
+// AddProjects adds the "projects" edges to the Project entity.
+func (wuo *WorkflowUpdateOne) AddProjects(p ...*Project) *WorkflowUpdateOne {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return wuo.AddProjectIDs(ids...)
+}
+
+// Mutation returns the WorkflowMutation object of the builder.
+func (wuo *WorkflowUpdateOne) Mutation() *WorkflowMutation {
+	return wuo.mutation
+}
+
+// ClearStatuses clears all "statuses" edges to the WorkflowStatus entity.
+func (wuo *WorkflowUpdateOne) ClearStatuses() *WorkflowUpdateOne {
+	wuo.mutation.ClearStatuses()
+	return wuo
+}
+
+// RemoveStatusIDs removes the "statuses" edge to WorkflowStatus entities by IDs.
+func (wuo *WorkflowUpdateOne) RemoveStatusIDs(ids ...uuid.UUID) *WorkflowUpdateOne {
+	wuo.mutation.RemoveStatusIDs(ids...)
+	return wuo
+}
+
+// RemoveStatuses removes "statuses" edges to WorkflowStatus entities.
+func (wuo *WorkflowUpdateOne) RemoveStatuses(w ...*WorkflowStatus) *WorkflowUpdateOne {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return wuo.RemoveStatusIDs(ids...)
+}
+
+// ClearProjects clears all "projects" edges to the Project entity.
+func (wuo *WorkflowUpdateOne) ClearProjects() *WorkflowUpdateOne {
+	wuo.mutation.ClearProjects()
+	return wuo
+}
+
+// RemoveProjectIDs removes the "projects" edge to Project entities by IDs.
+func (wuo *WorkflowUpdateOne) RemoveProjectIDs(ids ...uuid.UUID) *WorkflowUpdateOne {
+	wuo.mutation.RemoveProjectIDs(ids...)
+	return wuo
+}
+
+// RemoveProjects removes "projects" edges to Project entities.
+func (wuo *WorkflowUpdateOne) RemoveProjects(p ...*Project) *WorkflowUpdateOne {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return wuo.RemoveProjectIDs(ids...)
+}
+
+// Where appends a list predicates to the WorkflowUpdate builder.
+func (wuo *WorkflowUpdateOne) Where(ps ...predicate.Workflow) *WorkflowUpdateOne {
+	wuo.mutation.Where(ps...)
+	return wuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (wuo *WorkflowUpdateOne) Select(field string, fields ...string) *WorkflowUpdateOne {
+	wuo.fields = append([]string{field}, fields...)
+	return wuo
+}
+
+// Save executes the query and returns the updated Workflow entity.
+func (wuo *WorkflowUpdateOne) Save(ctx context.Context) (*Workflow, error) {
+	return withHooks(ctx, wuo.sqlSave, wuo.mutation, wuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wuo *WorkflowUpdateOne) SaveX(ctx context.Context) *Workflow {
+	node, err := wuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (wuo *WorkflowUpdateOne) Exec(ctx context.Context) error {
+	_, err := wuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wuo *WorkflowUpdateOne) ExecX(ctx context.Context) {
+	if err := wuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wuo *WorkflowUpdateOne) check() error {
+	if v, ok := wuo.mutation.Name(); ok {
+		if err := workflow.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Workflow.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (wuo *WorkflowUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *WorkflowUpdateOne {
+	wuo.modifiers = append(wuo.modifiers, modifiers...)
+	return wuo
+}
+
+func (wuo *WorkflowUpdateOne) sqlSave(ctx context.Context) (_node *Workflow, err error) {
+	if err := wuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(workflow.Table, workflow.Columns, sqlgraph.NewFieldSpec(workflow.FieldID, field.TypeUUID))
+	id, ok := wuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Workflow.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := wuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, workflow.FieldID)
+		for _, f := range fields {
+			if !workflow.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != workflow.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := wuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wuo.mutation.Name(); ok {
+		_spec.SetField(workflow.FieldName, field.TypeString, value)
+	}
+	if value, ok := wuo.mutation.IsDefault(); ok {
+		_spec.SetField(workflow.FieldIsDefault, field.TypeBool, value)
+	}
+	if value, ok := wuo.mutation.CreatedAt(); ok {
+		_spec.SetField(workflow.FieldCreatedAt, field.TypeTime, value)
+	}
+	if wuo.mutation.StatusesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workflow.StatusesTable,
+			Columns: []string{workflow.StatusesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workflowstatus.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wuo.mutation.RemovedStatusesIDs(); len(nodes) > 0 && !wuo.mutation.StatusesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workflow.StatusesTable,
+			Columns: []string{workflow.StatusesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workflowstatus.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wuo.mutation.StatusesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workflow.StatusesTable,
+			Columns: []string{workflow.StatusesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workflowstatus.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if wuo.mutation.ProjectsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workflow.ProjectsTable,
+			Columns: []string{workflow.ProjectsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wuo.mutation.RemovedProjectsIDs(); len(nodes) > 0 && !wuo.mutation.ProjectsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workflow.ProjectsTable,
+			Columns: []string{workflow.ProjectsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wuo.mutation.ProjectsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workflow.ProjectsTable,
+			Columns: []string{workflow.ProjectsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(wuo.modifiers...)
+	_node = &Workflow{config: wuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, wuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{workflow.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	wuo.mutation.done = true
+	return _node, nil
+}
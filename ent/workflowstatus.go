@@ -0,0 +1,262 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/ent/workflow"
+	"github.com/localopsco/go-sample/ent/workflowstatus"
+)
+
+// WorkflowStatus is the model entity for the WorkflowStatus schema.
+type WorkflowStatus struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// WorkflowID holds the value of the "workflow_id" field.
+	WorkflowID uuid.UUID `json:"workflow_id,omitempty"`
+	// Key holds the value of the "key" field.
+	Key string `json:"key,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Category holds the value of the "category" field.
+	Category workflowstatus.Category `json:"category,omitempty"`
+	// Position holds the value of the "position" field.
+	Position int `json:"position,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the WorkflowStatusQuery when eager-loading is set.
+	Edges        WorkflowStatusEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// WorkflowStatusEdges holds the relations/edges for other nodes in the graph.
+type WorkflowStatusEdges struct {
+	// Workflow holds the value of the workflow edge.
+	Workflow *Workflow `json:"workflow,omitempty"`
+	// Transitions holds the value of the transitions edge.
+	Transitions []*WorkflowStatus `json:"transitions,omitempty"`
+	// Tasks holds the value of the tasks edge.
+	Tasks []*Task `json:"tasks,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+
+	namedTransitions map[string][]*WorkflowStatus
+	namedTasks       map[string][]*Task
+}
+
+// WorkflowOrErr returns the Workflow value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e WorkflowStatusEdges) WorkflowOrErr() (*Workflow, error) {
+	if e.Workflow != nil {
+		return e.Workflow, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: workflow.Label}
+	}
+	return nil, &NotLoadedError{edge: "workflow"}
+}
+
+// TransitionsOrErr returns the Transitions value or an error if the edge
+// was not loaded in eager-loading.
+func (e WorkflowStatusEdges) TransitionsOrErr() ([]*WorkflowStatus, error) {
+	if e.loadedTypes[1] {
+		return e.Transitions, nil
+	}
+	return nil, &NotLoadedError{edge: "transitions"}
+}
+
+// TasksOrErr returns the Tasks value or an error if the edge
+// was not loaded in eager-loading.
+func (e WorkflowStatusEdges) TasksOrErr() ([]*Task, error) {
+	if e.loadedTypes[2] {
+		return e.Tasks, nil
+	}
+	return nil, &NotLoadedError{edge: "tasks"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*WorkflowStatus) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case workflowstatus.FieldPosition:
+			values[i] = new(sql.NullInt64)
+		case workflowstatus.FieldKey, workflowstatus.FieldName, workflowstatus.FieldCategory:
+			values[i] = new(sql.NullString)
+		case workflowstatus.FieldID, workflowstatus.FieldWorkflowID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the WorkflowStatus fields.
+func (ws *WorkflowStatus) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case workflowstatus.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				ws.ID = *value
+			}
+		case workflowstatus.FieldWorkflowID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field workflow_id", values[i])
+			} else if value != nil {
+				ws.WorkflowID = *value
+			}
+		case workflowstatus.FieldKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field key", values[i])
+			} else if value.Valid {
+				ws.Key = value.String
+			}
+		case workflowstatus.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				ws.Name = value.String
+			}
+		case workflowstatus.FieldCategory:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field category", values[i])
+			} else if value.Valid {
+				ws.Category = workflowstatus.Category(value.String)
+			}
+		case workflowstatus.FieldPosition:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field position", values[i])
+			} else if value.Valid {
+				ws.Position = int(value.Int64)
+			}
+		default:
+			ws.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the WorkflowStatus.
+// This includes values selected through modifiers, order, etc.
+func (ws *WorkflowStatus) Value(name string) (ent.Value, error) {
+	return ws.selectValues.Get(name)
+}
+
+// QueryWorkflow queries the "workflow" edge of the WorkflowStatus entity.
+func (ws *WorkflowStatus) QueryWorkflow() *WorkflowQuery {
+	return NewWorkflowStatusClient(ws.config).QueryWorkflow(ws)
+}
+
+// QueryTransitions queries the "transitions" edge of the WorkflowStatus entity.
+func (ws *WorkflowStatus) QueryTransitions() *WorkflowStatusQuery {
+	return NewWorkflowStatusClient(ws.config).QueryTransitions(ws)
+}
+
+// QueryTasks queries the "tasks" edge of the WorkflowStatus entity.
+func (ws *WorkflowStatus) QueryTasks() *TaskQuery {
+	return NewWorkflowStatusClient(ws.config).QueryTasks(ws)
+}
+
+// Update returns a builder for updating this WorkflowStatus.
+// Note that you need to call WorkflowStatus.Unwrap() before calling this method if this WorkflowStatus
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ws *WorkflowStatus) Update() *WorkflowStatusUpdateOne {
+	return NewWorkflowStatusClient(ws.config).UpdateOne(ws)
+}
+
+// Unwrap unwraps the WorkflowStatus entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ws *WorkflowStatus) Unwrap() *WorkflowStatus {
+	_tx, ok := ws.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: WorkflowStatus is not a transactional entity")
+	}
+	ws.config.driver = _tx.drv
+	return ws
+}
+
+// String implements the fmt.Stringer.
+func (ws *WorkflowStatus) String() string {
+	var builder strings.Builder
+	builder.WriteString("WorkflowStatus(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ws.ID))
+	builder.WriteString("workflow_id=")
+	builder.WriteString(fmt.Sprintf("%v", ws.WorkflowID))
+	builder.WriteString(", ")
+	builder.WriteString("key=")
+	builder.WriteString(ws.Key)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(ws.Name)
+	builder.WriteString(", ")
+	builder.WriteString("category=")
+	builder.WriteString(fmt.Sprintf("%v", ws.Category))
+	builder.WriteString(", ")
+	builder.WriteString("position=")
+	builder.WriteString(fmt.Sprintf("%v", ws.Position))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// NamedTransitions returns the Transitions named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (ws *WorkflowStatus) NamedTransitions(name string) ([]*WorkflowStatus, error) {
+	if ws.Edges.namedTransitions == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := ws.Edges.namedTransitions[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (ws *WorkflowStatus) appendNamedTransitions(name string, edges ...*WorkflowStatus) {
+	if ws.Edges.namedTransitions == nil {
+		ws.Edges.namedTransitions = make(map[string][]*WorkflowStatus)
+	}
+	if len(edges) == 0 {
+		ws.Edges.namedTransitions[name] = []*WorkflowStatus{}
+	} else {
+		ws.Edges.namedTransitions[name] = append(ws.Edges.namedTransitions[name], edges...)
+	}
+}
+
+// NamedTasks returns the Tasks named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (ws *WorkflowStatus) NamedTasks(name string) ([]*Task, error) {
+	if ws.Edges.namedTasks == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := ws.Edges.namedTasks[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (ws *WorkflowStatus) appendNamedTasks(name string, edges ...*Task) {
+	if ws.Edges.namedTasks == nil {
+		ws.Edges.namedTasks = make(map[string][]*Task)
+	}
+	if len(edges) == 0 {
+		ws.Edges.namedTasks[name] = []*Task{}
+	} else {
+		ws.Edges.namedTasks[name] = append(ws.Edges.namedTasks[name], edges...)
+	}
+}
+
+// WorkflowStatusSlice is a parsable slice of WorkflowStatus.
+type WorkflowStatusSlice []*WorkflowStatus
@@ -0,0 +1,377 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/ent/task"
+	"github.com/localopsco/go-sample/ent/workflow"
+	"github.com/localopsco/go-sample/ent/workflowstatus"
+)
+
+// WorkflowStatusCreate is the builder for creating a WorkflowStatus entity.
+type WorkflowStatusCreate struct {
+	config
+	mutation *WorkflowStatusMutation
+	hooks    []Hook
+}
+
+// SetWorkflowID sets the "workflow_id" field.
+func (wsc *WorkflowStatusCreate) SetWorkflowID(u uuid.UUID) *WorkflowStatusCreate {
+	wsc.mutation.SetWorkflowID(u)
+	return wsc
+}
+
+// SetKey sets the "key" field.
+func (wsc *WorkflowStatusCreate) SetKey(s string) *WorkflowStatusCreate {
+	wsc.mutation.SetKey(s)
+	return wsc
+}
+
+// SetName sets the "name" field.
+func (wsc *WorkflowStatusCreate) SetName(s string) *WorkflowStatusCreate {
+	wsc.mutation.SetName(s)
+	return wsc
+}
+
+// SetCategory sets the "category" field.
+func (wsc *WorkflowStatusCreate) SetCategory(w workflowstatus.Category) *WorkflowStatusCreate {
+	wsc.mutation.SetCategory(w)
+	return wsc
+}
+
+// SetPosition sets the "position" field.
+func (wsc *WorkflowStatusCreate) SetPosition(i int) *WorkflowStatusCreate {
+	wsc.mutation.SetPosition(i)
+	return wsc
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (wsc *WorkflowStatusCreate) SetNillablePosition(i *int) *WorkflowStatusCreate {
+	if i != nil {
+		wsc.SetPosition(*i)
+	}
+	return wsc
+}
+
+// SetID sets the "id" field.
+func (wsc *WorkflowStatusCreate) SetID(u uuid.UUID) *WorkflowStatusCreate {
+	wsc.mutation.SetID(u)
+	return wsc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (wsc *WorkflowStatusCreate) SetNillableID(u *uuid.UUID) *WorkflowStatusCreate {
+	if u != nil {
+		wsc.SetID(*u)
+	}
+	return wsc
+}
+
+// SetWorkflow sets the "workflow" edge to the Workflow entity.
+func (wsc *WorkflowStatusCreate) SetWorkflow(w *Workflow) *WorkflowStatusCreate {
+	return wsc.SetWorkflowID(w.ID)
+}
+
+// AddTransitionIDs adds the "transitions" edge to the WorkflowStatus entity by IDs.
+func (wsc *WorkflowStatusCreate) AddTransitionIDs(ids ...uuid.UUID) *WorkflowStatusCreate {
+	wsc.mutation.AddTransitionIDs(ids...)
+	return wsc
+}
+
+// AddTransitions adds the "transitions" edges to the WorkflowStatus entity.
+func (wsc *WorkflowStatusCreate) AddTransitions(w ...*WorkflowStatus) *WorkflowStatusCreate {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return wsc.AddTransitionIDs(ids...)
+}
+
+// AddTaskIDs adds the "tasks" edge to the Task entity by IDs.
+func (wsc *WorkflowStatusCreate) AddTaskIDs(ids ...uuid.UUID) *WorkflowStatusCreate {
+	wsc.mutation.AddTaskIDs(ids...)
+	return wsc
+}
+
+// AddTasks adds the "tasks" edges to the Task entity.
+func (wsc *WorkflowStatusCreate) AddTasks(t ...*Task) *WorkflowStatusCreate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return wsc.AddTaskIDs(ids...)
+}
+
+// Mutation returns the WorkflowStatusMutation object of the builder.
+func (wsc *WorkflowStatusCreate) Mutation() *WorkflowStatusMutation {
+	return wsc.mutation
+}
+
+// Save creates the WorkflowStatus in the database.
+func (wsc *WorkflowStatusCreate) Save(ctx context.Context) (*WorkflowStatus, error) {
+	wsc.defaults()
+	return withHooks(ctx, wsc.sqlSave, wsc.mutation, wsc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (wsc *WorkflowStatusCreate) SaveX(ctx context.Context) *WorkflowStatus {
+	v, err := wsc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wsc *WorkflowStatusCreate) Exec(ctx context.Context) error {
+	_, err := wsc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wsc *WorkflowStatusCreate) ExecX(ctx context.Context) {
+	if err := wsc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wsc *WorkflowStatusCreate) defaults() {
+	if _, ok := wsc.mutation.Position(); !ok {
+		v := workflowstatus.DefaultPosition
+		wsc.mutation.SetPosition(v)
+	}
+	if _, ok := wsc.mutation.ID(); !ok {
+		v := workflowstatus.DefaultID()
+		wsc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wsc *WorkflowStatusCreate) check() error {
+	if _, ok := wsc.mutation.WorkflowID(); !ok {
+		return &ValidationError{Name: "workflow_id", err: errors.New(`ent: missing required field "WorkflowStatus.workflow_id"`)}
+	}
+	if _, ok := wsc.mutation.Key(); !ok {
+		return &ValidationError{Name: "key", err: errors.New(`ent: missing required field "WorkflowStatus.key"`)}
+	}
+	if v, ok := wsc.mutation.Key(); ok {
+		if err := workflowstatus.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "WorkflowStatus.key": %w`, err)}
+		}
+	}
+	if _, ok := wsc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "WorkflowStatus.name"`)}
+	}
+	if v, ok := wsc.mutation.Name(); ok {
+		if err := workflowstatus.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "WorkflowStatus.name": %w`, err)}
+		}
+	}
+	if _, ok := wsc.mutation.Category(); !ok {
+		return &ValidationError{Name: "category", err: errors.New(`ent: missing required field "WorkflowStatus.category"`)}
+	}
+	if v, ok := wsc.mutation.Category(); ok {
+		if err := workflowstatus.CategoryValidator(v); err != nil {
+			return &ValidationError{Name: "category", err: fmt.Errorf(`ent: validator failed for field "WorkflowStatus.category": %w`, err)}
+		}
+	}
+	if _, ok := wsc.mutation.Position(); !ok {
+		return &ValidationError{Name: "position", err: errors.New(`ent: missing required field "WorkflowStatus.position"`)}
+	}
+	if len(wsc.mutation.WorkflowIDs()) == 0 {
+		return &ValidationError{Name: "workflow", err: errors.New(`ent: missing required edge "WorkflowStatus.workflow"`)}
+	}
+	return nil
+}
+
+func (wsc *WorkflowStatusCreate) sqlSave(ctx context.Context) (*WorkflowStatus, error) {
+	if err := wsc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := wsc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, wsc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	wsc.mutation.id = &_node.ID
+	wsc.mutation.done = true
+	return _node, nil
+}
+
+func (wsc *WorkflowStatusCreate) createSpec() (*WorkflowStatus, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WorkflowStatus{config: wsc.config}
+		_spec = sqlgraph.NewCreateSpec(workflowstatus.Table, sqlgraph.NewFieldSpec(workflowstatus.FieldID, field.TypeUUID))
+	)
+	if id, ok := wsc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := wsc.mutation.Key(); ok {
+		_spec.SetField(workflowstatus.FieldKey, field.TypeString, value)
+		_node.Key = value
+	}
+	if value, ok := wsc.mutation.Name(); ok {
+		_spec.SetField(workflowstatus.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := wsc.mutation.Category(); ok {
+		_spec.SetField(workflowstatus.FieldCategory, field.TypeEnum, value)
+		_node.Category = value
+	}
+	if value, ok := wsc.mutation.Position(); ok {
+		_spec.SetField(workflowstatus.FieldPosition, field.TypeInt, value)
+		_node.Position = value
+	}
+	if nodes := wsc.mutation.WorkflowIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   workflowstatus.WorkflowTable,
+			Columns: []string{workflowstatus.WorkflowColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workflow.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.WorkflowID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := wsc.mutation.TransitionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   workflowstatus.TransitionsTable,
+			Columns: workflowstatus.TransitionsPrimaryKey,
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workflowstatus.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := wsc.mutation.TasksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workflowstatus.TasksTable,
+			Columns: []string{workflowstatus.TasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// WorkflowStatusCreateBulk is the builder for creating many WorkflowStatus entities in bulk.
+type WorkflowStatusCreateBulk struct {
+	config
+	err      error
+	builders []*WorkflowStatusCreate
+}
+
+// Save creates the WorkflowStatus entities in the database.
+func (wscb *WorkflowStatusCreateBulk) Save(ctx context.Context) ([]*WorkflowStatus, error) {
+	if wscb.err != nil {
+		return nil, wscb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(wscb.builders))
+	nodes := make([]*WorkflowStatus, len(wscb.builders))
+	mutators := make([]Mutator, len(wscb.builders))
+	for i := range wscb.builders {
+		func(i int, root context.Context) {
+			builder := wscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WorkflowStatusMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, wscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, wscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, wscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wscb *WorkflowStatusCreateBulk) SaveX(ctx context.Context) []*WorkflowStatus {
+	v, err := wscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wscb *WorkflowStatusCreateBulk) Exec(ctx context.Context) error {
+	_, err := wscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wscb *WorkflowStatusCreateBulk) ExecX(ctx context.Context) {
+	if err := wscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/localopsco/go-sample/ent/predicate"
+	"github.com/localopsco/go-sample/ent/workflowstatus"
+)
+
+// WorkflowStatusDelete is the builder for deleting a WorkflowStatus entity.
+type WorkflowStatusDelete struct {
+	config
+	hooks    []Hook
+	mutation *WorkflowStatusMutation
+}
+
+// Where appends a list predicates to the WorkflowStatusDelete builder.
+func (wsd *WorkflowStatusDelete) Where(ps ...predicate.WorkflowStatus) *WorkflowStatusDelete {
+	wsd.mutation.Where(ps...)
+	return wsd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (wsd *WorkflowStatusDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, wsd.sqlExec, wsd.mutation, wsd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wsd *WorkflowStatusDelete) ExecX(ctx context.Context) int {
+	n, err := wsd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (wsd *WorkflowStatusDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(workflowstatus.Table, sqlgraph.NewFieldSpec(workflowstatus.FieldID, field.TypeUUID))
+	if ps := wsd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, wsd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	wsd.mutation.done = true
+	return affected, err
+}
+
+// WorkflowStatusDeleteOne is the builder for deleting a single WorkflowStatus entity.
+type WorkflowStatusDeleteOne struct {
+	wsd *WorkflowStatusDelete
+}
+
+// Where appends a list predicates to the WorkflowStatusDelete builder.
+func (wsdo *WorkflowStatusDeleteOne) Where(ps ...predicate.WorkflowStatus) *WorkflowStatusDeleteOne {
+	wsdo.wsd.mutation.Where(ps...)
+	return wsdo
+}
+
+// Exec executes the deletion query.
+func (wsdo *WorkflowStatusDeleteOne) Exec(ctx context.Context) error {
+	n, err := wsdo.wsd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{workflowstatus.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wsdo *WorkflowStatusDeleteOne) ExecX(ctx context.Context) {
+	if err := wsdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
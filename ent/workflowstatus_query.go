@@ -0,0 +1,858 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/ent/predicate"
+	"github.com/localopsco/go-sample/ent/task"
+	"github.com/localopsco/go-sample/ent/workflow"
+	"github.com/localopsco/go-sample/ent/workflowstatus"
+)
+
+// WorkflowStatusQuery is the builder for querying WorkflowStatus entities.
+type WorkflowStatusQuery struct {
+	config
+	ctx                  *QueryContext
+	order                []workflowstatus.OrderOption
+	inters               []Interceptor
+	predicates           []predicate.WorkflowStatus
+	withWorkflow         *WorkflowQuery
+	withTransitions      *WorkflowStatusQuery
+	withTasks            *TaskQuery
+	loadTotal            []func(context.Context, []*WorkflowStatus) error
+	modifiers            []func(*sql.Selector)
+	withNamedTransitions map[string]*WorkflowStatusQuery
+	withNamedTasks       map[string]*TaskQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the WorkflowStatusQuery builder.
+func (wsq *WorkflowStatusQuery) Where(ps ...predicate.WorkflowStatus) *WorkflowStatusQuery {
+	wsq.predicates = append(wsq.predicates, ps...)
+	return wsq
+}
+
+// Limit the number of records to be returned by this query.
+func (wsq *WorkflowStatusQuery) Limit(limit int) *WorkflowStatusQuery {
+	wsq.ctx.Limit = &limit
+	return wsq
+}
+
+// Offset to start from.
+func (wsq *WorkflowStatusQuery) Offset(offset int) *WorkflowStatusQuery {
+	wsq.ctx.Offset = &offset
+	return wsq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (wsq *WorkflowStatusQuery) Unique(unique bool) *WorkflowStatusQuery {
+	wsq.ctx.Unique = &unique
+	return wsq
+}
+
+// Order specifies how the records should be ordered.
+func (wsq *WorkflowStatusQuery) Order(o ...workflowstatus.OrderOption) *WorkflowStatusQuery {
+	wsq.order = append(wsq.order, o...)
+	return wsq
+}
+
+// QueryWorkflow chains the current query on the "workflow" edge.
+func (wsq *WorkflowStatusQuery) QueryWorkflow() *WorkflowQuery {
+	query := (&WorkflowClient{config: wsq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := wsq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := wsq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(workflowstatus.Table, workflowstatus.FieldID, selector),
+			sqlgraph.To(workflow.Table, workflow.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, workflowstatus.WorkflowTable, workflowstatus.WorkflowColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(wsq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTransitions chains the current query on the "transitions" edge.
+func (wsq *WorkflowStatusQuery) QueryTransitions() *WorkflowStatusQuery {
+	query := (&WorkflowStatusClient{config: wsq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := wsq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := wsq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(workflowstatus.Table, workflowstatus.FieldID, selector),
+			sqlgraph.To(workflowstatus.Table, workflowstatus.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, workflowstatus.TransitionsTable, workflowstatus.TransitionsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(wsq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTasks chains the current query on the "tasks" edge.
+func (wsq *WorkflowStatusQuery) QueryTasks() *TaskQuery {
+	query := (&TaskClient{config: wsq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := wsq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := wsq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(workflowstatus.Table, workflowstatus.FieldID, selector),
+			sqlgraph.To(task.Table, task.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, workflowstatus.TasksTable, workflowstatus.TasksColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(wsq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first WorkflowStatus entity from the query.
+// Returns a *NotFoundError when no WorkflowStatus was found.
+func (wsq *WorkflowStatusQuery) First(ctx context.Context) (*WorkflowStatus, error) {
+	nodes, err := wsq.Limit(1).All(setContextOp(ctx, wsq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{workflowstatus.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (wsq *WorkflowStatusQuery) FirstX(ctx context.Context) *WorkflowStatus {
+	node, err := wsq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first WorkflowStatus ID from the query.
+// Returns a *NotFoundError when no WorkflowStatus ID was found.
+func (wsq *WorkflowStatusQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = wsq.Limit(1).IDs(setContextOp(ctx, wsq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{workflowstatus.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (wsq *WorkflowStatusQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := wsq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single WorkflowStatus entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one WorkflowStatus entity is found.
+// Returns a *NotFoundError when no WorkflowStatus entities are found.
+func (wsq *WorkflowStatusQuery) Only(ctx context.Context) (*WorkflowStatus, error) {
+	nodes, err := wsq.Limit(2).All(setContextOp(ctx, wsq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{workflowstatus.Label}
+	default:
+		return nil, &NotSingularError{workflowstatus.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (wsq *WorkflowStatusQuery) OnlyX(ctx context.Context) *WorkflowStatus {
+	node, err := wsq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only WorkflowStatus ID in the query.
+// Returns a *NotSingularError when more than one WorkflowStatus ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (wsq *WorkflowStatusQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = wsq.Limit(2).IDs(setContextOp(ctx, wsq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{workflowstatus.Label}
+	default:
+		err = &NotSingularError{workflowstatus.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (wsq *WorkflowStatusQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := wsq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of WorkflowStatusSlice.
+func (wsq *WorkflowStatusQuery) All(ctx context.Context) ([]*WorkflowStatus, error) {
+	ctx = setContextOp(ctx, wsq.ctx, ent.OpQueryAll)
+	if err := wsq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*WorkflowStatus, *WorkflowStatusQuery]()
+	return withInterceptors[[]*WorkflowStatus](ctx, wsq, qr, wsq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (wsq *WorkflowStatusQuery) AllX(ctx context.Context) []*WorkflowStatus {
+	nodes, err := wsq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of WorkflowStatus IDs.
+func (wsq *WorkflowStatusQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if wsq.ctx.Unique == nil && wsq.path != nil {
+		wsq.Unique(true)
+	}
+	ctx = setContextOp(ctx, wsq.ctx, ent.OpQueryIDs)
+	if err = wsq.Select(workflowstatus.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (wsq *WorkflowStatusQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := wsq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (wsq *WorkflowStatusQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, wsq.ctx, ent.OpQueryCount)
+	if err := wsq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, wsq, querierCount[*WorkflowStatusQuery](), wsq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (wsq *WorkflowStatusQuery) CountX(ctx context.Context) int {
+	count, err := wsq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (wsq *WorkflowStatusQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, wsq.ctx, ent.OpQueryExist)
+	switch _, err := wsq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (wsq *WorkflowStatusQuery) ExistX(ctx context.Context) bool {
+	exist, err := wsq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the WorkflowStatusQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (wsq *WorkflowStatusQuery) Clone() *WorkflowStatusQuery {
+	if wsq == nil {
+		return nil
+	}
+	return &WorkflowStatusQuery{
+		config:          wsq.config,
+		ctx:             wsq.ctx.Clone(),
+		order:           append([]workflowstatus.OrderOption{}, wsq.order...),
+		inters:          append([]Interceptor{}, wsq.inters...),
+		predicates:      append([]predicate.WorkflowStatus{}, wsq.predicates...),
+		withWorkflow:    wsq.withWorkflow.Clone(),
+		withTransitions: wsq.withTransitions.Clone(),
+		withTasks:       wsq.withTasks.Clone(),
+		// clone intermediate query.
+		sql:  wsq.sql.Clone(),
+		path: wsq.path,
+	}
+}
+
+// WithWorkflow tells the query-builder to eager-load the nodes that are connected to
+// the "workflow" edge. The optional arguments are used to configure the query builder of the edge.
+func (wsq *WorkflowStatusQuery) WithWorkflow(opts ...func(*WorkflowQuery)) *WorkflowStatusQuery {
+	query := (&WorkflowClient{config: wsq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	wsq.withWorkflow = query
+	return wsq
+}
+
+// WithTransitions tells the query-builder to eager-load the nodes that are connected to
+// the "transitions" edge. The optional arguments are used to configure the query builder of the edge.
+func (wsq *WorkflowStatusQuery) WithTransitions(opts ...func(*WorkflowStatusQuery)) *WorkflowStatusQuery {
+	query := (&WorkflowStatusClient{config: wsq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	wsq.withTransitions = query
+	return wsq
+}
+
+// WithTasks tells the query-builder to eager-load the nodes that are connected to
+// the "tasks" edge. The optional arguments are used to configure the query builder of the edge.
+func (wsq *WorkflowStatusQuery) WithTasks(opts ...func(*TaskQuery)) *WorkflowStatusQuery {
+	query := (&TaskClient{config: wsq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	wsq.withTasks = query
+	return wsq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		WorkflowID uuid.UUID `json:"workflow_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.WorkflowStatus.Query().
+//		GroupBy(workflowstatus.FieldWorkflowID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (wsq *WorkflowStatusQuery) GroupBy(field string, fields ...string) *WorkflowStatusGroupBy {
+	wsq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &WorkflowStatusGroupBy{build: wsq}
+	grbuild.flds = &wsq.ctx.Fields
+	grbuild.label = workflowstatus.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		WorkflowID uuid.UUID `json:"workflow_id,omitempty"`
+//	}
+//
+//	client.WorkflowStatus.Query().
+//		Select(workflowstatus.FieldWorkflowID).
+//		Scan(ctx, &v)
+func (wsq *WorkflowStatusQuery) Select(fields ...string) *WorkflowStatusSelect {
+	wsq.ctx.Fields = append(wsq.ctx.Fields, fields...)
+	sbuild := &WorkflowStatusSelect{WorkflowStatusQuery: wsq}
+	sbuild.label = workflowstatus.Label
+	sbuild.flds, sbuild.scan = &wsq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a WorkflowStatusSelect configured with the given aggregations.
+func (wsq *WorkflowStatusQuery) Aggregate(fns ...AggregateFunc) *WorkflowStatusSelect {
+	return wsq.Select().Aggregate(fns...)
+}
+
+func (wsq *WorkflowStatusQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range wsq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, wsq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range wsq.ctx.Fields {
+		if !workflowstatus.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if wsq.path != nil {
+		prev, err := wsq.path(ctx)
+		if err != nil {
+			return err
+		}
+		wsq.sql = prev
+	}
+	return nil
+}
+
+func (wsq *WorkflowStatusQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*WorkflowStatus, error) {
+	var (
+		nodes       = []*WorkflowStatus{}
+		_spec       = wsq.querySpec()
+		loadedTypes = [3]bool{
+			wsq.withWorkflow != nil,
+			wsq.withTransitions != nil,
+			wsq.withTasks != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*WorkflowStatus).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &WorkflowStatus{config: wsq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(wsq.modifiers) > 0 {
+		_spec.Modifiers = wsq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, wsq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := wsq.withWorkflow; query != nil {
+		if err := wsq.loadWorkflow(ctx, query, nodes, nil,
+			func(n *WorkflowStatus, e *Workflow) { n.Edges.Workflow = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := wsq.withTransitions; query != nil {
+		if err := wsq.loadTransitions(ctx, query, nodes,
+			func(n *WorkflowStatus) { n.Edges.Transitions = []*WorkflowStatus{} },
+			func(n *WorkflowStatus, e *WorkflowStatus) { n.Edges.Transitions = append(n.Edges.Transitions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := wsq.withTasks; query != nil {
+		if err := wsq.loadTasks(ctx, query, nodes,
+			func(n *WorkflowStatus) { n.Edges.Tasks = []*Task{} },
+			func(n *WorkflowStatus, e *Task) { n.Edges.Tasks = append(n.Edges.Tasks, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range wsq.withNamedTransitions {
+		if err := wsq.loadTransitions(ctx, query, nodes,
+			func(n *WorkflowStatus) { n.appendNamedTransitions(name) },
+			func(n *WorkflowStatus, e *WorkflowStatus) { n.appendNamedTransitions(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range wsq.withNamedTasks {
+		if err := wsq.loadTasks(ctx, query, nodes,
+			func(n *WorkflowStatus) { n.appendNamedTasks(name) },
+			func(n *WorkflowStatus, e *Task) { n.appendNamedTasks(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for i := range wsq.loadTotal {
+		if err := wsq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (wsq *WorkflowStatusQuery) loadWorkflow(ctx context.Context, query *WorkflowQuery, nodes []*WorkflowStatus, init func(*WorkflowStatus), assign func(*WorkflowStatus, *Workflow)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*WorkflowStatus)
+	for i := range nodes {
+		fk := nodes[i].WorkflowID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(workflow.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "workflow_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (wsq *WorkflowStatusQuery) loadTransitions(ctx context.Context, query *WorkflowStatusQuery, nodes []*WorkflowStatus, init func(*WorkflowStatus), assign func(*WorkflowStatus, *WorkflowStatus)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[uuid.UUID]*WorkflowStatus)
+	nids := make(map[uuid.UUID]map[*WorkflowStatus]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(workflowstatus.TransitionsTable)
+		s.Join(joinT).On(s.C(workflowstatus.FieldID), joinT.C(workflowstatus.TransitionsPrimaryKey[1]))
+		s.Where(sql.InValues(joinT.C(workflowstatus.TransitionsPrimaryKey[0]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(workflowstatus.TransitionsPrimaryKey[0]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(uuid.UUID)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := *values[0].(*uuid.UUID)
+				inValue := *values[1].(*uuid.UUID)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*WorkflowStatus]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*WorkflowStatus](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "transitions" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (wsq *WorkflowStatusQuery) loadTasks(ctx context.Context, query *TaskQuery, nodes []*WorkflowStatus, init func(*WorkflowStatus), assign func(*WorkflowStatus, *Task)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*WorkflowStatus)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(task.FieldStatusID)
+	}
+	query.Where(predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(workflowstatus.TasksColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.StatusID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "status_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (wsq *WorkflowStatusQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := wsq.querySpec()
+	if len(wsq.modifiers) > 0 {
+		_spec.Modifiers = wsq.modifiers
+	}
+	_spec.Node.Columns = wsq.ctx.Fields
+	if len(wsq.ctx.Fields) > 0 {
+		_spec.Unique = wsq.ctx.Unique != nil && *wsq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, wsq.driver, _spec)
+}
+
+func (wsq *WorkflowStatusQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(workflowstatus.Table, workflowstatus.Columns, sqlgraph.NewFieldSpec(workflowstatus.FieldID, field.TypeUUID))
+	_spec.From = wsq.sql
+	if unique := wsq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if wsq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := wsq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, workflowstatus.FieldID)
+		for i := range fields {
+			if fields[i] != workflowstatus.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if wsq.withWorkflow != nil {
+			_spec.Node.AddColumnOnce(workflowstatus.FieldWorkflowID)
+		}
+	}
+	if ps := wsq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := wsq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := wsq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := wsq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (wsq *WorkflowStatusQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(wsq.driver.Dialect())
+	t1 := builder.Table(workflowstatus.Table)
+	columns := wsq.ctx.Fields
+	if len(columns) == 0 {
+		columns = workflowstatus.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if wsq.sql != nil {
+		selector = wsq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if wsq.ctx.Unique != nil && *wsq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range wsq.modifiers {
+		m(selector)
+	}
+	for _, p := range wsq.predicates {
+		p(selector)
+	}
+	for _, p := range wsq.order {
+		p(selector)
+	}
+	if offset := wsq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := wsq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (wsq *WorkflowStatusQuery) Modify(modifiers ...func(s *sql.Selector)) *WorkflowStatusSelect {
+	wsq.modifiers = append(wsq.modifiers, modifiers...)
+	return wsq.Select()
+}
+
+// WithNamedTransitions tells the query-builder to eager-load the nodes that are connected to the "transitions"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (wsq *WorkflowStatusQuery) WithNamedTransitions(name string, opts ...func(*WorkflowStatusQuery)) *WorkflowStatusQuery {
+	query := (&WorkflowStatusClient{config: wsq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if wsq.withNamedTransitions == nil {
+		wsq.withNamedTransitions = make(map[string]*WorkflowStatusQuery)
+	}
+	wsq.withNamedTransitions[name] = query
+	return wsq
+}
+
+// WithNamedTasks tells the query-builder to eager-load the nodes that are connected to the "tasks"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (wsq *WorkflowStatusQuery) WithNamedTasks(name string, opts ...func(*TaskQuery)) *WorkflowStatusQuery {
+	query := (&TaskClient{config: wsq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if wsq.withNamedTasks == nil {
+		wsq.withNamedTasks = make(map[string]*TaskQuery)
+	}
+	wsq.withNamedTasks[name] = query
+	return wsq
+}
+
+// WorkflowStatusGroupBy is the group-by builder for WorkflowStatus entities.
+type WorkflowStatusGroupBy struct {
+	selector
+	build *WorkflowStatusQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (wsgb *WorkflowStatusGroupBy) Aggregate(fns ...AggregateFunc) *WorkflowStatusGroupBy {
+	wsgb.fns = append(wsgb.fns, fns...)
+	return wsgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (wsgb *WorkflowStatusGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, wsgb.build.ctx, ent.OpQueryGroupBy)
+	if err := wsgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*WorkflowStatusQuery, *WorkflowStatusGroupBy](ctx, wsgb.build, wsgb, wsgb.build.inters, v)
+}
+
+func (wsgb *WorkflowStatusGroupBy) sqlScan(ctx context.Context, root *WorkflowStatusQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(wsgb.fns))
+	for _, fn := range wsgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*wsgb.flds)+len(wsgb.fns))
+		for _, f := range *wsgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*wsgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := wsgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// WorkflowStatusSelect is the builder for selecting fields of WorkflowStatus entities.
+type WorkflowStatusSelect struct {
+	*WorkflowStatusQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (wss *WorkflowStatusSelect) Aggregate(fns ...AggregateFunc) *WorkflowStatusSelect {
+	wss.fns = append(wss.fns, fns...)
+	return wss
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (wss *WorkflowStatusSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, wss.ctx, ent.OpQuerySelect)
+	if err := wss.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*WorkflowStatusQuery, *WorkflowStatusSelect](ctx, wss.WorkflowStatusQuery, wss, wss.inters, v)
+}
+
+func (wss *WorkflowStatusSelect) sqlScan(ctx context.Context, root *WorkflowStatusQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(wss.fns))
+	for _, fn := range wss.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*wss.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := wss.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (wss *WorkflowStatusSelect) Modify(modifiers ...func(s *sql.Selector)) *WorkflowStatusSelect {
+	wss.modifiers = append(wss.modifiers, modifiers...)
+	return wss
+}
@@ -0,0 +1,834 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/ent/predicate"
+	"github.com/localopsco/go-sample/ent/task"
+	"github.com/localopsco/go-sample/ent/workflow"
+	"github.com/localopsco/go-sample/ent/workflowstatus"
+)
+
+// WorkflowStatusUpdate is the builder for updating WorkflowStatus entities.
+type WorkflowStatusUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *WorkflowStatusMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the WorkflowStatusUpdate builder.
+func (wsu *WorkflowStatusUpdate) Where(ps ...predicate.WorkflowStatus) *WorkflowStatusUpdate {
+	wsu.mutation.Where(ps...)
+	return wsu
+}
+
+// SetWorkflowID sets the "workflow_id" field.
+func (wsu *WorkflowStatusUpdate) SetWorkflowID(u uuid.UUID) *WorkflowStatusUpdate {
+	wsu.mutation.SetWorkflowID(u)
+	return wsu
+}
+
+// SetNillableWorkflowID sets the "workflow_id" field if the given value is not nil.
+func (wsu *WorkflowStatusUpdate) SetNillableWorkflowID(u *uuid.UUID) *WorkflowStatusUpdate {
+	if u != nil {
+		wsu.SetWorkflowID(*u)
+	}
+	return wsu
+}
+
+// SetKey sets the "key" field.
+func (wsu *WorkflowStatusUpdate) SetKey(s string) *WorkflowStatusUpdate {
+	wsu.mutation.SetKey(s)
+	return wsu
+}
+
+// SetNillableKey sets the "key" field if the given value is not nil.
+func (wsu *WorkflowStatusUpdate) SetNillableKey(s *string) *WorkflowStatusUpdate {
+	if s != nil {
+		wsu.SetKey(*s)
+	}
+	return wsu
+}
+
+// SetName sets the "name" field.
+func (wsu *WorkflowStatusUpdate) SetName(s string) *WorkflowStatusUpdate {
+	wsu.mutation.SetName(s)
+	return wsu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (wsu *WorkflowStatusUpdate) SetNillableName(s *string) *WorkflowStatusUpdate {
+	if s != nil {
+		wsu.SetName(*s)
+	}
+	return wsu
+}
+
+// SetCategory sets the "category" field.
+func (wsu *WorkflowStatusUpdate) SetCategory(w workflowstatus.Category) *WorkflowStatusUpdate {
+	wsu.mutation.SetCategory(w)
+	return wsu
+}
+
+// SetNillableCategory sets the "category" field if the given value is not nil.
+func (wsu *WorkflowStatusUpdate) SetNillableCategory(w *workflowstatus.Category) *WorkflowStatusUpdate {
+	if w != nil {
+		wsu.SetCategory(*w)
+	}
+	return wsu
+}
+
+// SetPosition sets the "position" field.
+func (wsu *WorkflowStatusUpdate) SetPosition(i int) *WorkflowStatusUpdate {
+	wsu.mutation.ResetPosition()
+	wsu.mutation.SetPosition(i)
+	return wsu
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (wsu *WorkflowStatusUpdate) SetNillablePosition(i *int) *WorkflowStatusUpdate {
+	if i != nil {
+		wsu.SetPosition(*i)
+	}
+	return wsu
+}
+
+// AddPosition adds i to the "position" field.
+func (wsu *WorkflowStatusUpdate) AddPosition(i int) *WorkflowStatusUpdate {
+	wsu.mutation.AddPosition(i)
+	return wsu
+}
+
+// SetWorkflow sets the "workflow" edge to the Workflow entity.
+func (wsu *WorkflowStatusUpdate) SetWorkflow(w *Workflow) *WorkflowStatusUpdate {
+	return wsu.SetWorkflowID(w.ID)
+}
+
+// AddTransitionIDs adds the "transitions" edge to the WorkflowStatus entity by IDs.
+func (wsu *WorkflowStatusUpdate) AddTransitionIDs(ids ...uuid.UUID) *WorkflowStatusUpdate {
+	wsu.mutation.AddTransitionIDs(ids...)
+	return wsu
+}
+
+// AddTransitions adds the "transitions" edges to the WorkflowStatus entity.
+func (wsu *WorkflowStatusUpdate) AddTransitions(w ...*WorkflowStatus) *WorkflowStatusUpdate {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return wsu.AddTransitionIDs(ids...)
+}
+
+// AddTaskIDs adds the "tasks" edge to the Task entity by IDs.
+func (wsu *WorkflowStatusUpdate) AddTaskIDs(ids ...uuid.UUID) *WorkflowStatusUpdate {
+	wsu.mutation.AddTaskIDs(ids...)
+	return wsu
+}
+
+// AddTasks adds the "tasks" edges to the Task entity.
+func (wsu *WorkflowStatusUpdate) AddTasks(t ...*Task) *WorkflowStatusUpdate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return wsu.AddTaskIDs(ids...)
+}
+
+// Mutation returns the WorkflowStatusMutation object of the builder.
+func (wsu *WorkflowStatusUpdate) Mutation() *WorkflowStatusMutation {
+	return wsu.mutation
+}
+
+// ClearWorkflow clears the "workflow" edge to the Workflow entity.
+func (wsu *WorkflowStatusUpdate) ClearWorkflow() *WorkflowStatusUpdate {
+	wsu.mutation.ClearWorkflow()
+	return wsu
+}
+
+// ClearTransitions clears all "transitions" edges to the WorkflowStatus entity.
+func (wsu *WorkflowStatusUpdate) ClearTransitions() *WorkflowStatusUpdate {
+	wsu.mutation.ClearTransitions()
+	return wsu
+}
+
+// RemoveTransitionIDs removes the "transitions" edge to WorkflowStatus entities by IDs.
+func (wsu *WorkflowStatusUpdate) RemoveTransitionIDs(ids ...uuid.UUID) *WorkflowStatusUpdate {
+	wsu.mutation.RemoveTransitionIDs(ids...)
+	return wsu
+}
+
+// RemoveTransitions removes "transitions" edges to WorkflowStatus entities.
+func (wsu *WorkflowStatusUpdate) RemoveTransitions(w ...*WorkflowStatus) *WorkflowStatusUpdate {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return wsu.RemoveTransitionIDs(ids...)
+}
+
+// ClearTasks clears all "tasks" edges to the Task entity.
+func (wsu *WorkflowStatusUpdate) ClearTasks() *WorkflowStatusUpdate {
+	wsu.mutation.ClearTasks()
+	return wsu
+}
+
+// RemoveTaskIDs removes the "tasks" edge to Task entities by IDs.
+func (wsu *WorkflowStatusUpdate) RemoveTaskIDs(ids ...uuid.UUID) *WorkflowStatusUpdate {
+	wsu.mutation.RemoveTaskIDs(ids...)
+	return wsu
+}
+
+// RemoveTasks removes "tasks" edges to Task entities.
+func (wsu *WorkflowStatusUpdate) RemoveTasks(t ...*Task) *WorkflowStatusUpdate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return wsu.RemoveTaskIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (wsu *WorkflowStatusUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, wsu.sqlSave, wsu.mutation, wsu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wsu *WorkflowStatusUpdate) SaveX(ctx context.Context) int {
+	affected, err := wsu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (wsu *WorkflowStatusUpdate) Exec(ctx context.Context) error {
+	_, err := wsu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wsu *WorkflowStatusUpdate) ExecX(ctx context.Context) {
+	if err := wsu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wsu *WorkflowStatusUpdate) check() error {
+	if v, ok := wsu.mutation.Key(); ok {
+		if err := workflowstatus.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "WorkflowStatus.key": %w`, err)}
+		}
+	}
+	if v, ok := wsu.mutation.Name(); ok {
+		if err := workflowstatus.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "WorkflowStatus.name": %w`, err)}
+		}
+	}
+	if v, ok := wsu.mutation.Category(); ok {
+		if err := workflowstatus.CategoryValidator(v); err != nil {
+			return &ValidationError{Name: "category", err: fmt.Errorf(`ent: validator failed for field "WorkflowStatus.category": %w`, err)}
+		}
+	}
+	if wsu.mutation.WorkflowCleared() && len(wsu.mutation.WorkflowIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "WorkflowStatus.workflow"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (wsu *WorkflowStatusUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *WorkflowStatusUpdate {
+	wsu.modifiers = append(wsu.modifiers, modifiers...)
+	return wsu
+}
+
+func (wsu *WorkflowStatusUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := wsu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(workflowstatus.Table, workflowstatus.Columns, sqlgraph.NewFieldSpec(workflowstatus.FieldID, field.TypeUUID))
+	if ps := wsu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wsu.mutation.Key(); ok {
+		_spec.SetField(workflowstatus.FieldKey, field.TypeString, value)
+	}
+	if value, ok := wsu.mutation.Name(); ok {
+		_spec.SetField(workflowstatus.FieldName, field.TypeString, value)
+	}
+	if value, ok := wsu.mutation.Category(); ok {
+		_spec.SetField(workflowstatus.FieldCategory, field.TypeEnum, value)
+	}
+	if value, ok := wsu.mutation.Position(); ok {
+		_spec.SetField(workflowstatus.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := wsu.mutation.AddedPosition(); ok {
+		_spec.AddField(workflowstatus.FieldPosition, field.TypeInt, value)
+	}
+	if wsu.mutation.WorkflowCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   workflowstatus.WorkflowTable,
+			Columns: []string{workflowstatus.WorkflowColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workflow.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wsu.mutation.WorkflowIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   workflowstatus.WorkflowTable,
+			Columns: []string{workflowstatus.WorkflowColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workflow.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if wsu.mutation.TransitionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   workflowstatus.TransitionsTable,
+			Columns: workflowstatus.TransitionsPrimaryKey,
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workflowstatus.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wsu.mutation.RemovedTransitionsIDs(); len(nodes) > 0 && !wsu.mutation.TransitionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   workflowstatus.TransitionsTable,
+			Columns: workflowstatus.TransitionsPrimaryKey,
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workflowstatus.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wsu.mutation.TransitionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   workflowstatus.TransitionsTable,
+			Columns: workflowstatus.TransitionsPrimaryKey,
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workflowstatus.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if wsu.mutation.TasksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workflowstatus.TasksTable,
+			Columns: []string{workflowstatus.TasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wsu.mutation.RemovedTasksIDs(); len(nodes) > 0 && !wsu.mutation.TasksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workflowstatus.TasksTable,
+			Columns: []string{workflowstatus.TasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wsu.mutation.TasksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workflowstatus.TasksTable,
+			Columns: []string{workflowstatus.TasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(wsu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, wsu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{workflowstatus.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	wsu.mutation.done = true
+	return n, nil
+}
+
+// WorkflowStatusUpdateOne is the builder for updating a single WorkflowStatus entity.
+type WorkflowStatusUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *WorkflowStatusMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetWorkflowID sets the "workflow_id" field.
+func (wsuo *WorkflowStatusUpdateOne) SetWorkflowID(u uuid.UUID) *WorkflowStatusUpdateOne {
+	wsuo.mutation.SetWorkflowID(u)
+	return wsuo
+}
+
+// SetNillableWorkflowID sets the "workflow_id" field if the given value is not nil.
+func (wsuo *WorkflowStatusUpdateOne) SetNillableWorkflowID(u *uuid.UUID) *WorkflowStatusUpdateOne {
+	if u != nil {
+		wsuo.SetWorkflowID(*u)
+	}
+	return wsuo
+}
+
+// SetKey sets the "key" field.
+func (wsuo *WorkflowStatusUpdateOne) SetKey(s string) *WorkflowStatusUpdateOne {
+	wsuo.mutation.SetKey(s)
+	return wsuo
+}
+
+// SetNillableKey sets the "key" field if the given value is not nil.
+func (wsuo *WorkflowStatusUpdateOne) SetNillableKey(s *string) *WorkflowStatusUpdateOne {
+	if s != nil {
+		wsuo.SetKey(*s)
+	}
+	return wsuo
+}
+
+// SetName sets the "name" field.
+func (wsuo *WorkflowStatusUpdateOne) SetName(s string) *WorkflowStatusUpdateOne {
+	wsuo.mutation.SetName(s)
+	return wsuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (wsuo *WorkflowStatusUpdateOne) SetNillableName(s *string) *WorkflowStatusUpdateOne {
+	if s != nil {
+		wsuo.SetName(*s)
+	}
+	return wsuo
+}
+
+// SetCategory sets the "category" field.
+func (wsuo *WorkflowStatusUpdateOne) SetCategory(w workflowstatus.Category) *WorkflowStatusUpdateOne {
+	wsuo.mutation.SetCategory(w)
+	return wsuo
+}
+
+// SetNillableCategory sets the "category" field if the given value is not nil.
+func (wsuo *WorkflowStatusUpdateOne) SetNillableCategory(w *workflowstatus.Category) *WorkflowStatusUpdateOne {
+	if w != nil {
+		wsuo.SetCategory(*w)
+	}
+	return wsuo
+}
+
+// SetPosition sets the "position" field.
+func (wsuo *WorkflowStatusUpdateOne) SetPosition(i int) *WorkflowStatusUpdateOne {
+	wsuo.mutation.ResetPosition()
+	wsuo.mutation.SetPosition(i)
+	return wsuo
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (wsuo *WorkflowStatusUpdateOne) SetNillablePosition(i *int) *WorkflowStatusUpdateOne {
+	if i != nil {
+		wsuo.SetPosition(*i)
+	}
+	return wsuo
+}
+
+// AddPosition adds i to the "position" field.
+func (wsuo *WorkflowStatusUpdateOne) AddPosition(i int) *WorkflowStatusUpdateOne {
+	wsuo.mutation.AddPosition(i)
+	return wsuo
+}
+
+// SetWorkflow sets the "workflow" edge to the Workflow entity.
+func (wsuo *WorkflowStatusUpdateOne) SetWorkflow(w *Workflow) *WorkflowStatusUpdateOne {
+	return wsuo.SetWorkflowID(w.ID)
+}
+
+// AddTransitionIDs adds the "transitions" edge to the WorkflowStatus entity by IDs.
+func (wsuo *WorkflowStatusUpdateOne) AddTransitionIDs(ids ...uuid.UUID) *WorkflowStatusUpdateOne {
+	wsuo.mutation.AddTransitionIDs(ids...)
+	return wsuo
+}
+
+// AddTransitions adds the "transitions" edges to the WorkflowStatus entity.
+func (wsuo *WorkflowStatusUpdateOne) AddTransitions(w ...*WorkflowStatus) *WorkflowStatusUpdateOne {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return wsuo.AddTransitionIDs(ids...)
+}
+
+// AddTaskIDs adds the "tasks" edge to the Task entity by IDs.
+func (wsuo *WorkflowStatusUpdateOne) AddTaskIDs(ids ...uuid.UUID) *WorkflowStatusUpdateOne {
+	wsuo.mutation.AddTaskIDs(ids...)
+	return wsuo
+}
+
+// AddTasks adds the "tasks" edges to the Task entity.
+func (wsuo *WorkflowStatusUpdateOne) AddTasks(t ...*Task) *WorkflowStatusUpdateOne {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return wsuo.AddTaskIDs(ids...)
+}
+
+// Mutation returns the WorkflowStatusMutation object of the builder.
+func (wsuo *WorkflowStatusUpdateOne) Mutation() *WorkflowStatusMutation {
+	return wsuo.mutation
+}
+
+// ClearWorkflow clears the "workflow" edge to the Workflow entity.
+func (wsuo *WorkflowStatusUpdateOne) ClearWorkflow() *WorkflowStatusUpdateOne {
+	wsuo.mutation.ClearWorkflow()
+	return wsuo
+}
+
+// ClearTransitions clears all "transitions" edges to the WorkflowStatus entity.
+func (wsuo *WorkflowStatusUpdateOne) ClearTransitions() *WorkflowStatusUpdateOne {
+	wsuo.mutation.ClearTransitions()
+	return wsuo
+}
+
+// RemoveTransitionIDs removes the "transitions" edge to WorkflowStatus entities by IDs.
+func (wsuo *WorkflowStatusUpdateOne) RemoveTransitionIDs(ids ...uuid.UUID) *WorkflowStatusUpdateOne {
+	wsuo.mutation.RemoveTransitionIDs(ids...)
+	return wsuo
+}
+
+// RemoveTransitions removes "transitions" edges to WorkflowStatus entities.
+func (wsuo *WorkflowStatusUpdateOne) RemoveTransitions(w ...*WorkflowStatus) *WorkflowStatusUpdateOne {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return wsuo.RemoveTransitionIDs(ids...)
+}
+
+// ClearTasks clears all "tasks" edges to the Task entity.
+func (wsuo *WorkflowStatusUpdateOne) ClearTasks() *WorkflowStatusUpdateOne {
+	wsuo.mutation.ClearTasks()
+	return wsuo
+}
+
+// RemoveTaskIDs removes the "tasks" edge to Task entities by IDs.
+func (wsuo *WorkflowStatusUpdateOne) RemoveTaskIDs(ids ...uuid.UUID) *WorkflowStatusUpdateOne {
+	wsuo.mutation.RemoveTaskIDs(ids...)
+	return wsuo
+}
+
+// RemoveTasks removes "tasks" edges to Task entities.
+func (wsuo *WorkflowStatusUpdateOne) RemoveTasks(t ...*Task) *WorkflowStatusUpdateOne {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return wsuo.RemoveTaskIDs(ids...)
+}
+
+// Where appends a list predicates to the WorkflowStatusUpdate builder.
+func (wsuo *WorkflowStatusUpdateOne) Where(ps ...predicate.WorkflowStatus) *WorkflowStatusUpdateOne {
+	wsuo.mutation.Where(ps...)
+	return wsuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (wsuo *WorkflowStatusUpdateOne) Select(field string, fields ...string) *WorkflowStatusUpdateOne {
+	wsuo.fields = append([]string{field}, fields...)
+	return wsuo
+}
+
+// Save executes the query and returns the updated WorkflowStatus entity.
+func (wsuo *WorkflowStatusUpdateOne) Save(ctx context.Context) (*WorkflowStatus, error) {
+	return withHooks(ctx, wsuo.sqlSave, wsuo.mutation, wsuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wsuo *WorkflowStatusUpdateOne) SaveX(ctx context.Context) *WorkflowStatus {
+	node, err := wsuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (wsuo *WorkflowStatusUpdateOne) Exec(ctx context.Context) error {
+	_, err := wsuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wsuo *WorkflowStatusUpdateOne) ExecX(ctx context.Context) {
+	if err := wsuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wsuo *WorkflowStatusUpdateOne) check() error {
+	if v, ok := wsuo.mutation.Key(); ok {
+		if err := workflowstatus.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "WorkflowStatus.key": %w`, err)}
+		}
+	}
+	if v, ok := wsuo.mutation.Name(); ok {
+		if err := workflowstatus.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "WorkflowStatus.name": %w`, err)}
+		}
+	}
+	if v, ok := wsuo.mutation.Category(); ok {
+		if err := workflowstatus.CategoryValidator(v); err != nil {
+			return &ValidationError{Name: "category", err: fmt.Errorf(`ent: validator failed for field "WorkflowStatus.category": %w`, err)}
+		}
+	}
+	if wsuo.mutation.WorkflowCleared() && len(wsuo.mutation.WorkflowIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "WorkflowStatus.workflow"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (wsuo *WorkflowStatusUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *WorkflowStatusUpdateOne {
+	wsuo.modifiers = append(wsuo.modifiers, modifiers...)
+	return wsuo
+}
+
+func (wsuo *WorkflowStatusUpdateOne) sqlSave(ctx context.Context) (_node *WorkflowStatus, err error) {
+	if err := wsuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(workflowstatus.Table, workflowstatus.Columns, sqlgraph.NewFieldSpec(workflowstatus.FieldID, field.TypeUUID))
+	id, ok := wsuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "WorkflowStatus.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := wsuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, workflowstatus.FieldID)
+		for _, f := range fields {
+			if !workflowstatus.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != workflowstatus.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := wsuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wsuo.mutation.Key(); ok {
+		_spec.SetField(workflowstatus.FieldKey, field.TypeString, value)
+	}
+	if value, ok := wsuo.mutation.Name(); ok {
+		_spec.SetField(workflowstatus.FieldName, field.TypeString, value)
+	}
+	if value, ok := wsuo.mutation.Category(); ok {
+		_spec.SetField(workflowstatus.FieldCategory, field.TypeEnum, value)
+	}
+	if value, ok := wsuo.mutation.Position(); ok {
+		_spec.SetField(workflowstatus.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := wsuo.mutation.AddedPosition(); ok {
+		_spec.AddField(workflowstatus.FieldPosition, field.TypeInt, value)
+	}
+	if wsuo.mutation.WorkflowCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   workflowstatus.WorkflowTable,
+			Columns: []string{workflowstatus.WorkflowColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workflow.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wsuo.mutation.WorkflowIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   workflowstatus.WorkflowTable,
+			Columns: []string{workflowstatus.WorkflowColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workflow.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if wsuo.mutation.TransitionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   workflowstatus.TransitionsTable,
+			Columns: workflowstatus.TransitionsPrimaryKey,
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workflowstatus.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wsuo.mutation.RemovedTransitionsIDs(); len(nodes) > 0 && !wsuo.mutation.TransitionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   workflowstatus.TransitionsTable,
+			Columns: workflowstatus.TransitionsPrimaryKey,
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workflowstatus.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wsuo.mutation.TransitionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   workflowstatus.TransitionsTable,
+			Columns: workflowstatus.TransitionsPrimaryKey,
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workflowstatus.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if wsuo.mutation.TasksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workflowstatus.TasksTable,
+			Columns: []string{workflowstatus.TasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wsuo.mutation.RemovedTasksIDs(); len(nodes) > 0 && !wsuo.mutation.TasksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workflowstatus.TasksTable,
+			Columns: []string{workflowstatus.TasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wsuo.mutation.TasksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workflowstatus.TasksTable,
+			Columns: []string{workflowstatus.TasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(wsuo.modifiers...)
+	_node = &WorkflowStatus{config: wsuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, wsuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{workflowstatus.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	wsuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,142 @@
+package handler
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/models"
+	"github.com/localopsco/go-sample/service"
+)
+
+func (h *Handler) AssignUser(c *gin.Context) {
+	var reqBody struct {
+		UserID   *uuid.UUID `json:"user_id"`
+		Username string     `json:"username"`
+	}
+	err := c.ShouldBindWith(&reqBody, binding.JSON)
+	if err != nil || (reqBody.UserID == nil && reqBody.Username == "") {
+		c.JSON(http.StatusUnprocessableEntity, gin.H{
+			"message": "Invalid data",
+		})
+		return
+	}
+
+	taskIDStr := strings.TrimSpace(c.Param("task_id"))
+	taskID, err := uuid.Parse(taskIDStr)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"message": "Invalid task_id",
+		})
+		return
+	}
+
+	userID := reqBody.UserID
+	if userID == nil {
+		user, err := h.userSvc.GetUserByUsername(c.Request.Context(), reqBody.Username)
+		if err != nil {
+			h.assigneeError(c, "assigning user", err)
+			return
+		}
+		userID = &user.ID
+	}
+	task, err := h.svc.AssignUser(c.Request.Context(), taskID, *userID)
+	if err != nil {
+		h.assigneeError(c, "assigning user", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, task)
+}
+
+func (h *Handler) UnassignUser(c *gin.Context) {
+	taskIDStr := strings.TrimSpace(c.Param("task_id"))
+	taskID, err := uuid.Parse(taskIDStr)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"message": "Invalid task_id",
+		})
+		return
+	}
+	userIDStr := strings.TrimSpace(c.Param("user_id"))
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"message": "Invalid user_id",
+		})
+		return
+	}
+
+	task, err := h.svc.UnassignUser(c.Request.Context(), taskID, userID)
+	if err != nil {
+		h.assigneeError(c, "unassigning user", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, task)
+}
+
+func (h *Handler) WatchTask(c *gin.Context) {
+	h.changeWatch(c, "watching task", h.svc.Watch)
+}
+
+func (h *Handler) UnwatchTask(c *gin.Context) {
+	h.changeWatch(c, "unwatching task", h.svc.Unwatch)
+}
+
+func (h *Handler) changeWatch(c *gin.Context, action string, change func(ctx context.Context, taskID, userID uuid.UUID) (*models.Task, error)) {
+	user, ok := currentUser(c)
+	if !ok {
+		return
+	}
+	taskIDStr := strings.TrimSpace(c.Param("task_id"))
+	taskID, err := uuid.Parse(taskIDStr)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"message": "Invalid task_id",
+		})
+		return
+	}
+
+	task, err := change(c.Request.Context(), taskID, user.ID)
+	if err != nil {
+		h.assigneeError(c, action, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, task)
+}
+
+func (h *Handler) ListMyTasks(c *gin.Context) {
+	user, ok := currentUser(c)
+	if !ok {
+		return
+	}
+	groups, err := h.svc.MyTasks(c.Request.Context(), user.ID)
+	if err != nil {
+		log.Printf("error listing my tasks: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"message": "Unknown error. Something went wrong.",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, groups)
+}
+
+func (h *Handler) assigneeError(c *gin.Context, action string, err error) {
+	if err.Error() == service.TaskNotFoundError || err.Error() == service.UserNotFoundError {
+		c.JSON(http.StatusNotFound, gin.H{
+			"message": err.Error(),
+		})
+		return
+	}
+	log.Printf("error %s: %v", action, err)
+	c.JSON(http.StatusInternalServerError, gin.H{
+		"message": "Unknown error. Something went wrong.",
+	})
+}
@@ -0,0 +1,113 @@
+package handler
+
+import (
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/models"
+	"github.com/localopsco/go-sample/service"
+)
+
+func (h *Handler) GetTaskHistory(c *gin.Context) {
+	taskIDStr := strings.TrimSpace(c.Param("task_id"))
+	taskID, err := uuid.Parse(taskIDStr)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"message": "Invalid task_id",
+		})
+		return
+	}
+	limit, offset, err := parsePagination(c)
+	if err != nil {
+		c.JSON(http.StatusUnprocessableEntity, gin.H{
+			"message": err.Error(),
+		})
+		return
+	}
+	page, err := h.auditSvc.TaskHistory(c.Request.Context(), taskID, limit, offset)
+	if err != nil {
+		if err.Error() == service.TaskNotFoundError {
+			c.JSON(http.StatusNotFound, gin.H{
+				"message": err.Error(),
+			})
+			return
+		}
+		log.Printf("error getting task history: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"message": "Unknown error. Something went wrong.",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, page)
+}
+
+func (h *Handler) ListAuditEvents(c *gin.Context) {
+	filter := models.TaskEventFilter{Action: c.Query("action")}
+	var err error
+	for param, target := range map[string]**uuid.UUID{
+		"task_id":    &filter.TaskID,
+		"project_id": &filter.ProjectID,
+	} {
+		if *target, err = parseOptionalUUID(c.Query(param)); err != nil {
+			c.JSON(http.StatusUnprocessableEntity, gin.H{
+				"message": "Invalid " + param,
+			})
+			return
+		}
+	}
+	if actor := c.Query("actor_id"); actor == "me" {
+		user, ok := currentUser(c)
+		if !ok {
+			return
+		}
+		filter.ActorID = &user.ID
+	} else if filter.ActorID, err = parseOptionalUUID(actor); err != nil {
+		c.JSON(http.StatusUnprocessableEntity, gin.H{
+			"message": "Invalid actor_id",
+		})
+		return
+	}
+	for param, target := range map[string]**time.Time{
+		"since": &filter.Since,
+		"until": &filter.Until,
+	} {
+		if s := c.Query(param); s != "" {
+			t, err := time.Parse(time.RFC3339, s)
+			if err != nil {
+				c.JSON(http.StatusUnprocessableEntity, gin.H{
+					"message": "Invalid " + param,
+				})
+				return
+			}
+			*target = &t
+		}
+	}
+	limit, offset, err := parsePagination(c)
+	if err != nil {
+		c.JSON(http.StatusUnprocessableEntity, gin.H{
+			"message": err.Error(),
+		})
+		return
+	}
+	page, err := h.auditSvc.ListEvents(c.Request.Context(), filter, limit, offset)
+	if err != nil {
+		if err.Error() == service.InvalidAuditFilterError {
+			c.JSON(http.StatusUnprocessableEntity, gin.H{
+				"message": err.Error(),
+			})
+			return
+		}
+		log.Printf("error listing audit events: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"message": "Unknown error. Something went wrong.",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, page)
+}
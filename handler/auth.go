@@ -0,0 +1,69 @@
+package handler
+
+import (
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/localopsco/go-sample/models"
+	"github.com/localopsco/go-sample/service"
+)
+
+const userContextKey = "user"
+
+// Authenticate resolves the bearer token of a request to its user. Requests
+// without a token pass through anonymously; endpoints that need a user call
+// currentUser.
+func (h *Handler) Authenticate(c *gin.Context) {
+	header := c.GetHeader("Authorization")
+	if header == "" {
+		c.Next()
+		return
+	}
+	token, ok := strings.CutPrefix(header, "Bearer ")
+	if !ok || strings.TrimSpace(token) == "" {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
+			"message": service.AuthenticationRequiredError,
+		})
+		return
+	}
+	user, err := h.userSvc.Authenticate(c.Request.Context(), strings.TrimSpace(token))
+	if err != nil {
+		if err.Error() == service.AuthenticationRequiredError {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
+				"message": err.Error(),
+			})
+			return
+		}
+		log.Printf("error authenticating request: %v", err)
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"message": "Unknown error. Something went wrong.",
+		})
+		return
+	}
+	c.Set(userContextKey, user)
+	c.Request = c.Request.WithContext(models.WithActor(c.Request.Context(), user.ID))
+	c.Next()
+}
+
+// currentUser returns the authenticated user, responding with 401 if the
+// request is anonymous.
+func currentUser(c *gin.Context) (*models.User, bool) {
+	if value, ok := c.Get(userContextKey); ok {
+		return value.(*models.User), true
+	}
+	c.JSON(http.StatusUnauthorized, gin.H{
+		"message": service.AuthenticationRequiredError,
+	})
+	return nil, false
+}
+
+// optionalUser returns the authenticated user, or nil if the request is
+// anonymous.
+func optionalUser(c *gin.Context) *models.User {
+	if value, ok := c.Get(userContextKey); ok {
+		return value.(*models.User)
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package handler
+
+import (
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/service"
+)
+
+func (h *Handler) GetBoard(c *gin.Context) {
+	projectIDStr := strings.TrimSpace(c.Param("project_id"))
+	projectID, err := uuid.Parse(projectIDStr)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"message": "Invalid project_id",
+		})
+		return
+	}
+	limit, offset, err := parsePagination(c)
+	if err != nil {
+		c.JSON(http.StatusUnprocessableEntity, gin.H{
+			"message": err.Error(),
+		})
+		return
+	}
+
+	board, err := h.svc.GetBoard(c.Request.Context(), projectID, c.Query("group_by"), limit, offset)
+	if err != nil {
+		switch err.Error() {
+		case service.InvalidBoardGroupError:
+			c.JSON(http.StatusUnprocessableEntity, gin.H{
+				"message": err.Error(),
+			})
+			return
+		case service.ProjectNotFoundError:
+			c.JSON(http.StatusNotFound, gin.H{
+				"message": err.Error(),
+			})
+			return
+		}
+		log.Printf("error getting board: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"message": "Unknown error. Something went wrong.",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, board)
+}
@@ -0,0 +1,160 @@
+package handler
+
+import (
+	"errors"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/models"
+	"github.com/localopsco/go-sample/service"
+)
+
+// bulkTask is a task to create in a bulk request, with the fields accepted
+// by CreateTask.
+type bulkTask struct {
+	Title           string             `json:"title"`
+	Description     string             `json:"description"`
+	Status          string             `json:"status"`
+	IsCompleted     bool               `json:"is_completed"`
+	ProjectID       *uuid.UUID         `json:"project_id"`
+	ParentID        *uuid.UUID         `json:"parent_id"`
+	StartAt         *time.Time         `json:"start_at"`
+	DueAt           *time.Time         `json:"due_at"`
+	Priority        string             `json:"priority"`
+	EstimateMinutes *int               `json:"estimate_minutes"`
+	Recurrence      *models.Recurrence `json:"recurrence"`
+	Labels          []string           `json:"labels"`
+}
+
+func (h *Handler) BulkTasks(c *gin.Context) {
+	var reqBody struct {
+		Operation string      `json:"operation"`
+		Mode      string      `json:"mode"`
+		Tasks     []bulkTask  `json:"tasks"`
+		IDs       []uuid.UUID `json:"ids"`
+		Query     string      `json:"query"`
+		Update    struct {
+			Title           *string                    `json:"title"`
+			Description     *string                    `json:"description"`
+			Status          *string                    `json:"status"`
+			StartAt         models.Optional[time.Time] `json:"start_at"`
+			DueAt           models.Optional[time.Time] `json:"due_at"`
+			Priority        *string                    `json:"priority"`
+			EstimateMinutes models.Optional[int]       `json:"estimate_minutes"`
+			Labels          *[]string                  `json:"labels"`
+		} `json:"update"`
+		GroupBy      string   `json:"group_by"`
+		Column       string   `json:"column"`
+		AddLabels    []string `json:"add_labels"`
+		RemoveLabels []string `json:"remove_labels"`
+	}
+	err := c.ShouldBindWith(&reqBody, binding.JSON)
+	if err != nil {
+		c.JSON(http.StatusUnprocessableEntity, gin.H{
+			"message": "Invalid data",
+		})
+		return
+	}
+	loc, err := time.LoadLocation(c.DefaultQuery("tz", "UTC"))
+	if err != nil {
+		c.JSON(http.StatusUnprocessableEntity, gin.H{
+			"message": "Invalid tz",
+		})
+		return
+	}
+
+	tasks := make([]models.Task, 0, len(reqBody.Tasks))
+	for _, t := range reqBody.Tasks {
+		tasks = append(tasks, models.Task{
+			Title:           t.Title,
+			Description:     t.Description,
+			Status:          t.Status,
+			IsCompleted:     t.IsCompleted,
+			ProjectID:       t.ProjectID,
+			ParentID:        t.ParentID,
+			StartAt:         t.StartAt,
+			DueAt:           t.DueAt,
+			Priority:        t.Priority,
+			EstimateMinutes: t.EstimateMinutes,
+			Recurrence:      t.Recurrence,
+			Labels:          t.Labels,
+		})
+	}
+	force, _ := strconv.ParseBool(c.Query("force"))
+	u := reqBody.Update
+	result, err := h.svc.Bulk(c.Request.Context(), models.BulkRequest{
+		Operation: reqBody.Operation,
+		Mode:      reqBody.Mode,
+		Force:     force,
+		Tasks:     tasks,
+		IDs:       reqBody.IDs,
+		Query:     reqBody.Query,
+		Location:  loc,
+		Update: models.BulkTaskUpdate{
+			Title:           u.Title,
+			Description:     u.Description,
+			Status:          u.Status,
+			StartAt:         u.StartAt,
+			DueAt:           u.DueAt,
+			Priority:        u.Priority,
+			EstimateMinutes: u.EstimateMinutes,
+			Labels:          u.Labels,
+		},
+		GroupBy:      reqBody.GroupBy,
+		Column:       reqBody.Column,
+		AddLabels:    reqBody.AddLabels,
+		RemoveLabels: reqBody.RemoveLabels,
+	})
+	if err != nil {
+		if queryError(c, err) {
+			return
+		}
+		switch err.Error() {
+		case service.InvalidBulkOperationError, service.InvalidBulkModeError, service.InvalidBulkTargetError,
+			service.BulkTooLargeError, service.EmptyBulkLabelsError, service.InvalidBoardGroupError,
+			service.InvalidLabelError, service.TooManyLabelsError:
+			c.JSON(http.StatusUnprocessableEntity, gin.H{
+				"message": err.Error(),
+			})
+			return
+		}
+		log.Printf("error running bulk %s: %v", reqBody.Operation, err)
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"message": "Unknown error. Something went wrong.",
+		})
+		return
+	}
+
+	for _, item := range result.Items {
+		if item.Err != nil {
+			item.Error = bulkItemError(item.Err)
+		}
+	}
+	status := http.StatusOK
+	if !result.Committed {
+		status = http.StatusUnprocessableEntity
+	}
+	c.JSON(status, result)
+}
+
+// bulkItemError returns the message reported for an item of a bulk
+// operation that failed.
+func bulkItemError(err error) string {
+	var transitionErr *service.TransitionError
+	if errors.As(err, &transitionErr) || isInvalidTaskFieldsError(err) {
+		return err.Error()
+	}
+	switch err.Error() {
+	case service.TaskNotFoundError, service.ParentTaskNotFoundError, service.ProjectNotFoundError,
+		service.MaxDepthExceededError, service.OpenSubtasksError, service.TaskBlockedError,
+		service.InvalidColumnError, service.TaskNotInProjectError, service.WIPLimitExceededError:
+		return err.Error()
+	}
+	log.Printf("error in bulk item: %v", err)
+	return "Unknown error. Something went wrong."
+}
@@ -0,0 +1,640 @@
+package handler
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/models"
+	"github.com/localopsco/go-sample/service"
+)
+
+// DAVPrefix is the path the CalDAV handler is mounted at.
+const DAVPrefix = "/dav/"
+
+// inboxCollection names the collection of the tasks outside of any project.
+const inboxCollection = "inbox"
+
+// davSyncTokenPrefix turns sync tokens into the URIs WebDAV expects.
+const davSyncTokenPrefix = "https://github.com/localopsco/go-sample/ns/sync/"
+
+// maxCalendarObjectBytes caps the size of a calendar object a client PUTs.
+const maxCalendarObjectBytes = 1 << 20
+
+// Kinds of DAV resources.
+const (
+	davRoot = iota
+	davPrincipal
+	davHome
+	davCollection
+	davObject
+)
+
+// CalDAVHandler serves tasks to CalDAV clients, with a VTODO collection
+// per project and an inbox collection for the tasks outside of any
+// project. It runs next to the gin router rather than in it, since WebDAV
+// methods and paths do not fit gin's routing.
+//
+// Clients sign in with HTTP basic authentication, giving their username
+// and API token, and find their collections from /dav/ or
+// /.well-known/caldav. A user's resources live under
+// /dav/principals/<username>/ and /dav/calendars/<username>/, with tasks at
+// /dav/calendars/<username>/<collection>/<name>.ics. ETags are task
+// versions, and sync tokens are those of the delta sync API.
+type CalDAVHandler struct {
+	svc        *service.TaskService
+	projectSvc *service.ProjectService
+	userSvc    *service.UserService
+}
+
+func NewCalDAVHandler(taskSvc *service.TaskService, projectSvc *service.ProjectService, userSvc *service.UserService) *CalDAVHandler {
+	return &CalDAVHandler{
+		taskSvc,
+		projectSvc,
+		userSvc,
+	}
+}
+
+// davResource is the resource a request addresses. Task is nil for an
+// object that does not exist yet.
+type davResource struct {
+	kind       int
+	user       *models.User
+	collection string
+	project    *models.Project
+	name       string
+	task       *models.Task
+}
+
+func (res *davResource) projectID() *uuid.UUID {
+	if res.project == nil {
+		return nil
+	}
+	return &res.project.ID
+}
+
+func (res *davResource) href() string {
+	switch res.kind {
+	case davPrincipal:
+		return principalHref(res.user)
+	case davHome:
+		return homeHref(res.user)
+	case davCollection:
+		return collectionHref(res.user, res.collection)
+	case davObject:
+		return objectHref(res.user, res.collection, res.name)
+	}
+	return DAVPrefix
+}
+
+func principalHref(user *models.User) string {
+	return DAVPrefix + "principals/" + url.PathEscape(user.Username) + "/"
+}
+
+func homeHref(user *models.User) string {
+	return DAVPrefix + "calendars/" + url.PathEscape(user.Username) + "/"
+}
+
+func collectionHref(user *models.User, collection string) string {
+	return homeHref(user) + url.PathEscape(collection) + "/"
+}
+
+func objectHref(user *models.User, collection, name string) string {
+	return collectionHref(user, collection) + url.PathEscape(name) + ".ics"
+}
+
+func (h *CalDAVHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == "/.well-known/caldav" {
+		http.Redirect(w, r, DAVPrefix, http.StatusMovedPermanently)
+		return
+	}
+	w.Header().Set("DAV", "1, 3, calendar-access")
+	if r.Method == http.MethodOptions {
+		w.Header().Set("Allow", "OPTIONS, GET, HEAD, PUT, DELETE, PROPFIND, REPORT")
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	user, err := h.authenticate(r)
+	if err != nil {
+		if err.Error() != service.AuthenticationRequiredError {
+			h.davError(w, err)
+			return
+		}
+		w.Header().Set("WWW-Authenticate", `Basic realm="tasks", charset="UTF-8"`)
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+	ctx := models.WithActor(r.Context(), user.ID)
+	res, err := h.resolve(ctx, user, r.URL.EscapedPath())
+	if err != nil {
+		h.davError(w, err)
+		return
+	}
+
+	switch r.Method {
+	case "PROPFIND":
+		h.propfind(ctx, w, r, res)
+	case "REPORT":
+		h.report(ctx, w, r, res)
+	case http.MethodGet, http.MethodHead:
+		h.get(w, r, res)
+	case http.MethodPut:
+		h.put(ctx, w, r, res)
+	case http.MethodDelete:
+		h.delete(ctx, w, r, res)
+	default:
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// authenticate returns the user signing in with their username and API
+// token, through basic authentication, or with a bearer token.
+func (h *CalDAVHandler) authenticate(r *http.Request) (*models.User, error) {
+	if username, token, ok := r.BasicAuth(); ok {
+		user, err := h.userSvc.Authenticate(r.Context(), token)
+		if err != nil {
+			return nil, err
+		}
+		if user.Username != username {
+			return nil, errors.New(service.AuthenticationRequiredError)
+		}
+		return user, nil
+	}
+	if token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer "); ok {
+		return h.userSvc.Authenticate(r.Context(), strings.TrimSpace(token))
+	}
+	return nil, errors.New(service.AuthenticationRequiredError)
+}
+
+// resolve finds the resource at a path. Users can only reach their own
+// principal and collections. Objects are resolved without their task if it
+// does not exist, so that it can be created.
+func (h *CalDAVHandler) resolve(ctx context.Context, user *models.User, path string) (*davResource, error) {
+	notFound := errors.New(service.TaskNotFoundError)
+	rest, ok := strings.CutPrefix(path, DAVPrefix)
+	if !ok {
+		if path+"/" == DAVPrefix {
+			return &davResource{kind: davRoot, user: user}, nil
+		}
+		return nil, notFound
+	}
+	var segments []string
+	for _, segment := range strings.Split(strings.TrimSuffix(rest, "/"), "/") {
+		segment, err := url.PathUnescape(segment)
+		if err != nil {
+			return nil, notFound
+		}
+		segments = append(segments, segment)
+	}
+	if len(segments) == 1 && segments[0] == "" {
+		return &davResource{kind: davRoot, user: user}, nil
+	}
+	if len(segments) < 2 || segments[1] != user.Username {
+		return nil, notFound
+	}
+	switch {
+	case segments[0] == "principals" && len(segments) == 2:
+		return &davResource{kind: davPrincipal, user: user}, nil
+	case segments[0] != "calendars" || len(segments) > 4:
+		return nil, notFound
+	case len(segments) == 2:
+		return &davResource{kind: davHome, user: user}, nil
+	}
+
+	res := &davResource{kind: davCollection, user: user, collection: segments[2]}
+	if res.collection != inboxCollection {
+		projectID, err := uuid.Parse(res.collection)
+		if err != nil {
+			return nil, notFound
+		}
+		if res.project, err = h.projectSvc.GetProject(ctx, projectID); err != nil {
+			return nil, err
+		}
+		res.collection = res.project.ID.String()
+	}
+	if len(segments) == 3 {
+		return res, nil
+	}
+	name, ok := strings.CutSuffix(segments[3], ".ics")
+	if !ok || name == "" {
+		return nil, notFound
+	}
+	res.kind, res.name = davObject, name
+	task, err := h.svc.GetCalendarObject(ctx, res.projectID(), name)
+	if err != nil && err.Error() != service.TaskNotFoundError {
+		return nil, err
+	}
+	res.task = task
+	return res, nil
+}
+
+// children lists the members of a collection resource.
+func (h *CalDAVHandler) children(ctx context.Context, res *davResource) ([]*davResource, error) {
+	switch res.kind {
+	case davHome:
+		children := []*davResource{{kind: davCollection, user: res.user, collection: inboxCollection}}
+		projects, err := h.projectSvc.ListProjects(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, project := range projects {
+			children = append(children, &davResource{kind: davCollection, user: res.user, collection: project.ID.String(), project: project})
+		}
+		return children, nil
+	case davCollection:
+		tasks, err := h.svc.CalendarTasks(ctx, res.projectID())
+		if err != nil {
+			return nil, err
+		}
+		children := make([]*davResource, len(tasks))
+		for i, task := range tasks {
+			children[i] = res.object(task)
+		}
+		return children, nil
+	}
+	return nil, nil
+}
+
+// object returns the object resource of a task in a collection.
+func (res *davResource) object(task *models.Task) *davResource {
+	return &davResource{kind: davObject, user: res.user, collection: res.collection, project: res.project, name: service.CalendarObjectName(task), task: task}
+}
+
+func (h *CalDAVHandler) propfind(ctx context.Context, w http.ResponseWriter, r *http.Request, res *davResource) {
+	if res.kind == davObject && res.task == nil {
+		h.davError(w, errors.New(service.TaskNotFoundError))
+		return
+	}
+	var body davPropfind
+	if err := decodeDAVBody(r, &body); err != nil {
+		http.Error(w, "Invalid XML body", http.StatusBadRequest)
+		return
+	}
+	resources := []*davResource{res}
+	// Depth infinity is served as depth 1, which reaches every resource
+	// below collections anyway.
+	if r.Header.Get("Depth") != "0" {
+		children, err := h.children(ctx, res)
+		if err != nil {
+			h.davError(w, err)
+			return
+		}
+		resources = append(resources, children...)
+	}
+	props := newDAVProps(h.svc)
+	responses := make([]davResponse, 0, len(resources))
+	for _, child := range resources {
+		response, err := props.response(ctx, child, body.Prop, body.PropName != nil)
+		if err != nil {
+			h.davError(w, err)
+			return
+		}
+		responses = append(responses, response)
+	}
+	writeMultistatus(w, responses, "")
+}
+
+func (h *CalDAVHandler) report(ctx context.Context, w http.ResponseWriter, r *http.Request, res *davResource) {
+	var body davReport
+	if err := decodeDAVBody(r, &body); err != nil {
+		http.Error(w, "Invalid XML body", http.StatusBadRequest)
+		return
+	}
+	if res.kind != davCollection {
+		writeDAVError(w, http.StatusForbidden, xml.Name{Space: davNS, Local: "supported-report"})
+		return
+	}
+	props := newDAVProps(h.svc)
+	var responses []davResponse
+	add := func(object *davResource) error {
+		response, err := props.response(ctx, object, body.Prop, false)
+		if err != nil {
+			return err
+		}
+		responses = append(responses, response)
+		return nil
+	}
+	var syncToken string
+	var err error
+	switch body.XMLName {
+	case xml.Name{Space: caldavNS, Local: "calendar-query"}:
+		var children []*davResource
+		children, err = h.children(ctx, res)
+		for i := 0; err == nil && i < len(children); i++ {
+			if matchCalendarQuery(children[i].task, body.Filter) {
+				err = add(children[i])
+			}
+		}
+	case xml.Name{Space: caldavNS, Local: "calendar-multiget"}:
+		for i := 0; err == nil && i < len(body.Hrefs); i++ {
+			href := strings.TrimSpace(body.Hrefs[i])
+			object, resolveErr := h.resolveHref(ctx, res, href)
+			if resolveErr != nil {
+				err = resolveErr
+			} else if object == nil {
+				responses = append(responses, davResponse{Href: href, Status: http.StatusNotFound})
+			} else {
+				err = add(object)
+			}
+		}
+	case xml.Name{Space: davNS, Local: "sync-collection"}:
+		token := ""
+		if body.SyncToken != nil && strings.TrimSpace(*body.SyncToken) != "" {
+			var ok bool
+			if token, ok = strings.CutPrefix(strings.TrimSpace(*body.SyncToken), davSyncTokenPrefix); !ok {
+				writeDAVError(w, http.StatusForbidden, xml.Name{Space: davNS, Local: "valid-sync-token"})
+				return
+			}
+		}
+		var changes *models.CalendarChanges
+		if changes, err = h.svc.CalendarChanges(ctx, res.projectID(), token); err == nil {
+			for i := 0; err == nil && i < len(changes.Tasks); i++ {
+				err = add(res.object(changes.Tasks[i]))
+			}
+			for _, name := range changes.Removed {
+				responses = append(responses, davResponse{Href: objectHref(res.user, res.collection, name), Status: http.StatusNotFound})
+			}
+			syncToken = davSyncTokenPrefix + changes.Token
+		}
+	default:
+		writeDAVError(w, http.StatusForbidden, xml.Name{Space: davNS, Local: "supported-report"})
+		return
+	}
+	if err != nil {
+		h.davError(w, err)
+		return
+	}
+	writeMultistatus(w, responses, syncToken)
+}
+
+// resolveHref returns the object an href of a multiget names, or nil if
+// it does not name an existing object in the collection.
+func (h *CalDAVHandler) resolveHref(ctx context.Context, collection *davResource, href string) (*davResource, error) {
+	u, err := url.Parse(href)
+	if err != nil {
+		return nil, nil
+	}
+	res, err := h.resolve(ctx, collection.user, u.EscapedPath())
+	if err != nil {
+		if err.Error() == service.TaskNotFoundError || err.Error() == service.ProjectNotFoundError {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if res.kind != davObject || res.task == nil || res.collection != collection.collection {
+		return nil, nil
+	}
+	return res, nil
+}
+
+func (h *CalDAVHandler) get(w http.ResponseWriter, r *http.Request, res *davResource) {
+	if res.kind != davObject {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if res.task == nil {
+		h.davError(w, errors.New(service.TaskNotFoundError))
+		return
+	}
+	etag := taskETag(res.task)
+	w.Header().Set("ETag", etag)
+	if r.Header.Get("If-None-Match") == etag {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	data := service.TaskICalendar(res.task)
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8; component=VTODO")
+	w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+	w.WriteHeader(http.StatusOK)
+	if r.Method != http.MethodHead {
+		w.Write(data)
+	}
+}
+
+// put stores a calendar object. Since the stored object differs from the
+// one sent, the response carries no ETag and clients read it back.
+func (h *CalDAVHandler) put(ctx context.Context, w http.ResponseWriter, r *http.Request, res *davResource) {
+	if res.kind != davObject {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	data, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxCalendarObjectBytes))
+	if err != nil {
+		writeDAVError(w, http.StatusForbidden, xml.Name{Space: caldavNS, Local: "max-resource-size"})
+		return
+	}
+	_, created, err := h.svc.PutCalendarObject(ctx, models.CalendarObjectPut{
+		ProjectID:   res.projectID(),
+		Name:        res.name,
+		Data:        data,
+		IfMatch:     parseIfMatch(r),
+		IfNoneMatch: r.Header.Get("If-None-Match") == "*",
+	})
+	if err != nil {
+		h.davError(w, err)
+		return
+	}
+	if created {
+		w.WriteHeader(http.StatusCreated)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *CalDAVHandler) delete(ctx context.Context, w http.ResponseWriter, r *http.Request, res *davResource) {
+	if res.kind != davObject {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if err := h.svc.DeleteCalendarObject(ctx, res.projectID(), res.name, parseIfMatch(r)); err != nil {
+		h.davError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func taskETag(task *models.Task) string {
+	return fmt.Sprintf(`"%d"`, task.Version)
+}
+
+// parseIfMatch returns the version an If-Match header expects, or nil if
+// there is none. ETags that are not task versions match no version.
+func parseIfMatch(r *http.Request) *int {
+	value := strings.TrimSpace(r.Header.Get("If-Match"))
+	if value == "" || value == "*" {
+		return nil
+	}
+	version, err := strconv.Atoi(strings.Trim(strings.TrimPrefix(value, "W/"), `"`))
+	if err != nil {
+		version = -1
+	}
+	return &version
+}
+
+// decodeDAVBody decodes an XML request body into v, leaving v alone if the
+// body is empty.
+func decodeDAVBody(r *http.Request, v any) error {
+	err := xml.NewDecoder(io.LimitReader(r.Body, maxCalendarObjectBytes)).Decode(v)
+	if err == io.EOF {
+		return nil
+	}
+	return err
+}
+
+func (h *CalDAVHandler) davError(w http.ResponseWriter, err error) {
+	var transitionErr *service.TransitionError
+	if errors.As(err, &transitionErr) {
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	}
+	switch err.Error() {
+	case service.TaskNotFoundError, service.ProjectNotFoundError:
+		http.Error(w, "Not found", http.StatusNotFound)
+		return
+	case service.AuthenticationRequiredError:
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	case service.CalendarObjectChangedError, service.CalendarObjectExistsError:
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	case service.InvalidCalendarObjectError:
+		writeDAVError(w, http.StatusForbidden, xml.Name{Space: caldavNS, Local: "valid-calendar-data"})
+		return
+	case service.CalendarUIDTakenError, service.CalendarUIDMismatchError:
+		writeDAVError(w, http.StatusForbidden, xml.Name{Space: caldavNS, Local: "no-uid-conflict"})
+		return
+	case service.InvalidSyncTokenError:
+		writeDAVError(w, http.StatusForbidden, xml.Name{Space: davNS, Local: "valid-sync-token"})
+		return
+	case service.CalendarObjectNameError, service.TaskBlockedError, service.OpenSubtasksError:
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+	if isInvalidTaskFieldsError(err) {
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+	log.Printf("error serving CalDAV request: %v", err)
+	http.Error(w, "Unknown error. Something went wrong.", http.StatusInternalServerError)
+}
+
+// davProps computes the properties of resources, reading the sync token
+// shared by collections at most once per request.
+type davProps struct {
+	svc       *service.TaskService
+	syncToken string
+}
+
+func newDAVProps(svc *service.TaskService) *davProps {
+	return &davProps{svc: svc}
+}
+
+// response lists the requested properties of a resource, or all of them if
+// none are named. calendar-data is only listed when asked for.
+func (p *davProps) response(ctx context.Context, res *davResource, requested *davPropNames, namesOnly bool) (davResponse, error) {
+	props, err := p.all(ctx, res)
+	if err != nil {
+		return davResponse{}, err
+	}
+	response := davResponse{Href: res.href()}
+	if requested == nil {
+		for _, prop := range props {
+			if prop.Name.Local == "calendar-data" {
+				continue
+			}
+			if namesOnly {
+				prop.Value = ""
+			}
+			response.Found = append(response.Found, prop)
+		}
+		return response, nil
+	}
+	for _, name := range requested.names() {
+		found := false
+		for _, prop := range props {
+			if prop.Name == name {
+				response.Found, found = append(response.Found, prop), true
+				break
+			}
+		}
+		if !found {
+			response.Missing = append(response.Missing, name)
+		}
+	}
+	return response, nil
+}
+
+func (p *davProps) all(ctx context.Context, res *davResource) ([]davProp, error) {
+	d := func(local, value string) davProp {
+		return davProp{Name: xml.Name{Space: davNS, Local: local}, Value: value}
+	}
+	c := func(local, value string) davProp {
+		return davProp{Name: xml.Name{Space: caldavNS, Local: local}, Value: value}
+	}
+	principal := d("current-user-principal", davHrefs(principalHref(res.user)))
+	switch res.kind {
+	case davRoot:
+		return []davProp{d("resourcetype", "<d:collection/>"), principal}, nil
+	case davPrincipal:
+		displayName := res.user.DisplayName
+		if displayName == "" {
+			displayName = res.user.Username
+		}
+		return []davProp{
+			d("resourcetype", "<d:principal/>"),
+			d("displayname", davEscape(displayName)),
+			principal,
+			d("principal-URL", davHrefs(principalHref(res.user))),
+			c("calendar-home-set", davHrefs(homeHref(res.user))),
+		}, nil
+	case davHome:
+		return []davProp{
+			d("resourcetype", "<d:collection/>"),
+			principal,
+			d("owner", davHrefs(principalHref(res.user))),
+		}, nil
+	case davCollection:
+		if p.syncToken == "" {
+			token, err := p.svc.CalendarSyncToken(ctx)
+			if err != nil {
+				return nil, err
+			}
+			p.syncToken = davSyncTokenPrefix + token
+		}
+		displayName, description := "Inbox", "Tasks outside of any project"
+		if res.project != nil {
+			displayName, description = res.project.Name, res.project.Description
+		}
+		return []davProp{
+			d("resourcetype", "<d:collection/><c:calendar/>"),
+			d("displayname", davEscape(displayName)),
+			c("calendar-description", davEscape(description)),
+			c("supported-calendar-component-set", `<c:comp name="VTODO"/>`),
+			d("supported-report-set", "<d:supported-report><d:report><c:calendar-query/></d:report></d:supported-report>"+
+				"<d:supported-report><d:report><c:calendar-multiget/></d:report></d:supported-report>"+
+				"<d:supported-report><d:report><d:sync-collection/></d:report></d:supported-report>"),
+			d("current-user-privilege-set", "<d:privilege><d:read/></d:privilege><d:privilege><d:write/></d:privilege>"+
+				"<d:privilege><d:write-content/></d:privilege><d:privilege><d:bind/></d:privilege><d:privilege><d:unbind/></d:privilege>"),
+			d("sync-token", davEscape(p.syncToken)),
+			{Name: xml.Name{Space: calendarNS, Local: "getctag"}, Value: davEscape(p.syncToken)},
+			principal,
+			d("owner", davHrefs(principalHref(res.user))),
+		}, nil
+	}
+	data := service.TaskICalendar(res.task)
+	return []davProp{
+		d("resourcetype", ""),
+		d("getetag", davEscape(taskETag(res.task))),
+		d("getcontenttype", "text/calendar; charset=utf-8; component=VTODO"),
+		d("getcontentlength", strconv.Itoa(len(data))),
+		c("calendar-data", davEscape(string(data))),
+	}, nil
+}
@@ -0,0 +1,222 @@
+package handler
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/base64"
+	"flag"
+	"fmt"
+	"html"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/localopsco/go-sample/datastore"
+	"github.com/localopsco/go-sample/datastore/datastoretest"
+	"github.com/localopsco/go-sample/models"
+	"github.com/localopsco/go-sample/service"
+)
+
+var update = flag.Bool("update", false, "update the golden files of the CalDAV tests")
+
+// davUsername is the user the recorded requests sign in as.
+const davUsername = "ann"
+
+var (
+	syncTokenPattern = regexp.MustCompile(regexp.QuoteMeta(davSyncTokenPrefix) + `[A-Za-z0-9_-]+`)
+	// icalTimePattern matches the properties of calendar objects that hold
+	// the time tasks were created, changed or completed.
+	icalTimePattern = regexp.MustCompile(`(DTSTAMP|CREATED|LAST-MODIFIED|COMPLETED):\d{8}T\d{6}Z`)
+	hrefPattern     = regexp.MustCompile(`<d:href>([^<]*)</d:href>`)
+	getETagPattern  = regexp.MustCompile(`<d:getetag>([^<]*)</d:getetag>`)
+)
+
+// TestCalDAVClients replays requests as Apple Reminders, Thunderbird and
+// DAVx⁵ send them, with their headers and bodies, while they discover and
+// sync a collection and create, change and delete tasks in it. Each client
+// has a directory in testdata/caldav holding its requests,
+// in the order they are sent, as NN-name.http, with the response expected
+// for each in NN-name.golden. Run the test with -update to rewrite the
+// golden files.
+//
+// In requests, {{auth}} stands for the credentials of the user and
+// {{sync-token}} for the last sync token a response returned. In golden
+// files, sync tokens are numbered in the order they appear and the times
+// tasks were created, changed or completed are left out.
+func TestCalDAVClients(t *testing.T) {
+	clients, err := os.ReadDir(filepath.Join("testdata", "caldav"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, client := range clients {
+		t.Run(client.Name(), func(t *testing.T) {
+			replayCalDAVSession(t, filepath.Join("testdata", "caldav", client.Name()))
+		})
+	}
+}
+
+func replayCalDAVSession(t *testing.T, dir string) {
+	client := datastoretest.NewClient(t)
+	taskSvc := service.NewTaskService(
+		datastore.NewTaskStore(client),
+		datastore.NewWorkflowStore(client),
+		datastore.NewProjectStore(client),
+		datastore.NewUserStore(client),
+		nil,
+		datastore.NewOutboxStore(client),
+	)
+	userSvc := service.NewUserService(datastore.NewUserStore(client))
+	user, token, err := userSvc.CreateUser(context.Background(), davUsername, "Ann")
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := NewCalDAVHandler(taskSvc, service.NewProjectService(datastore.NewProjectStore(client), datastore.NewWorkflowStore(client)), userSvc)
+
+	requests, err := filepath.Glob(filepath.Join(dir, "*.http"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(requests) == 0 {
+		t.Fatalf("no requests in %s", dir)
+	}
+	sort.Strings(requests)
+	session := &davSession{
+		auth:   base64.StdEncoding.EncodeToString([]byte(davUsername + ":" + token)),
+		tokens: map[string]string{},
+	}
+	for _, file := range requests {
+		step := strings.TrimSuffix(filepath.Base(file), ".http")
+		r := session.readRequest(t, file)
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+
+		checkCalDAVETags(t, step, taskSvc, models.WithActor(context.Background(), user.ID), r, w)
+		if isCalendarObject(w.Header()) && strings.Count(w.Body.String(), "\n") != strings.Count(w.Body.String(), "\r\n") {
+			t.Errorf("%s: calendar object has lines that do not end in CRLF", step)
+		}
+		if tokens := syncTokenPattern.FindAllString(w.Body.String(), -1); len(tokens) > 0 {
+			session.syncToken = tokens[len(tokens)-1]
+		}
+		got := session.dump(w)
+		golden := strings.TrimSuffix(file, ".http") + ".golden"
+		if *update {
+			if err := os.WriteFile(golden, got, 0o644); err != nil {
+				t.Fatal(err)
+			}
+			continue
+		}
+		want, err := os.ReadFile(golden)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(got, want) {
+			t.Errorf("%s: got response\n%s\nwant\n%s", step, got, want)
+		}
+	}
+}
+
+// davSession holds the state a client carries from one request to the
+// next.
+type davSession struct {
+	auth      string
+	syncToken string
+	// tokens numbers the sync tokens seen, so that golden files show
+	// whether a token changed.
+	tokens map[string]string
+}
+
+// readRequest reads a recorded request, filling in its placeholders. The
+// body is everything after the header, and its length is set to match.
+func (s *davSession) readRequest(t *testing.T, file string) *http.Request {
+	t.Helper()
+	data, err := os.ReadFile(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	text := strings.NewReplacer("{{auth}}", s.auth, "{{sync-token}}", s.syncToken).Replace(string(data))
+	head, body, _ := strings.Cut(text, "\n\n")
+	r, err := http.ReadRequest(bufio.NewReader(strings.NewReader(head + "\n\n")))
+	if err != nil {
+		t.Fatalf("reading %s: %v", file, err)
+	}
+	// Calendar objects are sent with CRLF line endings.
+	if isCalendarObject(r.Header) {
+		body = strings.ReplaceAll(body, "\n", "\r\n")
+	}
+	r.Body = io.NopCloser(strings.NewReader(body))
+	r.ContentLength = int64(len(body))
+	r.Header.Set("Content-Length", strconv.Itoa(len(body)))
+	return r
+}
+
+func isCalendarObject(header http.Header) bool {
+	return strings.HasPrefix(header.Get("Content-Type"), "text/calendar")
+}
+
+// dump writes out a response the way golden files hold it: its status,
+// the headers clients rely on and its body, with an element per line and
+// calendar objects in LF line endings.
+func (s *davSession) dump(w *httptest.ResponseRecorder) []byte {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "%d %s\n", w.Code, http.StatusText(w.Code))
+	for _, name := range []string{"Content-Type", "DAV", "Allow", "ETag", "Location", "WWW-Authenticate"} {
+		if value := w.Header().Get(name); value != "" {
+			fmt.Fprintf(&b, "%s: %s\n", name, value)
+		}
+	}
+	body := w.Body.String()
+	body = syncTokenPattern.ReplaceAllStringFunc(body, func(token string) string {
+		if _, ok := s.tokens[token]; !ok {
+			s.tokens[token] = fmt.Sprintf("token-%d", len(s.tokens)+1)
+		}
+		return davSyncTokenPrefix + s.tokens[token]
+	})
+	body = icalTimePattern.ReplaceAllString(body, "$1:<time>")
+	if strings.HasPrefix(w.Header().Get("Content-Type"), "application/xml") {
+		body = strings.ReplaceAll(body, "><", ">\n<")
+	}
+	if isCalendarObject(w.Header()) {
+		body = strings.ReplaceAll(body, "\r\n", "\n")
+	}
+	if body != "" {
+		b.WriteString("\n")
+		b.WriteString(strings.TrimSuffix(body, "\n"))
+		b.WriteString("\n")
+	}
+	return b.Bytes()
+}
+
+// checkCalDAVETags fails unless the ETags of a response, in its header or
+// in the getetag properties of a multistatus, are those of the tasks they
+// belong to.
+func checkCalDAVETags(t *testing.T, step string, svc *service.TaskService, ctx context.Context, r *http.Request, w *httptest.ResponseRecorder) {
+	t.Helper()
+	check := func(href, etag string) {
+		name := strings.TrimSuffix(path.Base(href), ".ics")
+		task, err := svc.GetCalendarObject(ctx, nil, name)
+		if err != nil {
+			t.Fatalf("%s: responded with ETag %s for %s: %v", step, etag, name, err)
+		}
+		if want := taskETag(task); etag != want {
+			t.Errorf("%s: ETag of %s = %s, want %s", step, name, etag, want)
+		}
+	}
+	if etag := w.Header().Get("ETag"); etag != "" {
+		check(r.URL.Path, etag)
+	}
+	for _, response := range strings.Split(w.Body.String(), "<d:response>")[1:] {
+		href, etag := hrefPattern.FindStringSubmatch(response), getETagPattern.FindStringSubmatch(response)
+		if href != nil && etag != nil {
+			check(html.UnescapeString(href[1]), html.UnescapeString(etag[1]))
+		}
+	}
+}
@@ -0,0 +1,299 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/localopsco/go-sample/models"
+	"github.com/localopsco/go-sample/service"
+)
+
+// XML namespaces of WebDAV, CalDAV and the CalendarServer extensions.
+const (
+	davNS      = "DAV:"
+	caldavNS   = "urn:ietf:params:xml:ns:caldav"
+	calendarNS = "http://calendarserver.org/ns/"
+)
+
+var davPrefixes = map[string]string{
+	davNS:      "d",
+	caldavNS:   "c",
+	calendarNS: "cs",
+}
+
+// davProp is a property of a resource. Value is its content as XML.
+type davProp struct {
+	Name  xml.Name
+	Value string
+}
+
+// davResponse is the response element of a multistatus for one resource.
+// Properties are listed as found or missing; Status is set instead for
+// resources that no longer exist.
+type davResponse struct {
+	Href    string
+	Found   []davProp
+	Missing []xml.Name
+	Status  int
+}
+
+// davPropfind is the body of a PROPFIND request.
+type davPropfind struct {
+	XMLName  xml.Name      `xml:"DAV: propfind"`
+	AllProp  *struct{}     `xml:"DAV: allprop"`
+	PropName *struct{}     `xml:"DAV: propname"`
+	Prop     *davPropNames `xml:"DAV: prop"`
+}
+
+type davPropNames struct {
+	Names []struct {
+		XMLName xml.Name
+	} `xml:",any"`
+}
+
+func (p *davPropNames) names() []xml.Name {
+	names := make([]xml.Name, len(p.Names))
+	for i, name := range p.Names {
+		names[i] = name.XMLName
+	}
+	return names
+}
+
+// davReport is the body of a REPORT request: a calendar-query,
+// calendar-multiget or sync-collection.
+type davReport struct {
+	XMLName   xml.Name
+	AllProp   *struct{}      `xml:"DAV: allprop"`
+	Prop      *davPropNames  `xml:"DAV: prop"`
+	Hrefs     []string       `xml:"DAV: href"`
+	SyncToken *string        `xml:"DAV: sync-token"`
+	Filter    *davCompFilter `xml:"urn:ietf:params:xml:ns:caldav filter>comp-filter"`
+}
+
+type davCompFilter struct {
+	Name         string          `xml:"name,attr"`
+	IsNotDefined *struct{}       `xml:"urn:ietf:params:xml:ns:caldav is-not-defined"`
+	TimeRange    *davTimeRange   `xml:"urn:ietf:params:xml:ns:caldav time-range"`
+	PropFilters  []davPropFilter `xml:"urn:ietf:params:xml:ns:caldav prop-filter"`
+	CompFilters  []davCompFilter `xml:"urn:ietf:params:xml:ns:caldav comp-filter"`
+}
+
+type davPropFilter struct {
+	Name         string        `xml:"name,attr"`
+	IsNotDefined *struct{}     `xml:"urn:ietf:params:xml:ns:caldav is-not-defined"`
+	TimeRange    *davTimeRange `xml:"urn:ietf:params:xml:ns:caldav time-range"`
+	TextMatch    *struct {
+		Value  string `xml:",chardata"`
+		Negate string `xml:"negate-condition,attr"`
+	} `xml:"urn:ietf:params:xml:ns:caldav text-match"`
+}
+
+type davTimeRange struct {
+	Start string `xml:"start,attr"`
+	End   string `xml:"end,attr"`
+}
+
+// contains reports whether t falls in the range. Missing bounds are open.
+func (r *davTimeRange) contains(t time.Time) bool {
+	start, end := r.bounds()
+	return !t.Before(start) && t.Before(end)
+}
+
+// overlaps reports whether the period from a to b overlaps the range.
+func (r *davTimeRange) overlaps(a, b time.Time) bool {
+	start, end := r.bounds()
+	return a.Before(end) && (b.After(start) || b.Equal(start))
+}
+
+func (r *davTimeRange) bounds() (time.Time, time.Time) {
+	start, end := time.Unix(0, 0), time.Date(9999, 1, 1, 0, 0, 0, 0, time.UTC)
+	if t, err := time.Parse("20060102T150405Z", r.Start); err == nil {
+		start = t
+	}
+	if t, err := time.Parse("20060102T150405Z", r.End); err == nil {
+		end = t
+	}
+	return start, end
+}
+
+// matchCalendarQuery reports whether a task's calendar object matches the
+// filter of a calendar-query. Filters on properties that tasks never have
+// only match with is-not-defined.
+func matchCalendarQuery(task *models.Task, filter *davCompFilter) bool {
+	if filter == nil {
+		return true
+	}
+	if filter.Name != "VCALENDAR" {
+		return filter.IsNotDefined != nil
+	}
+	for _, comp := range filter.CompFilters {
+		if comp.Name != "VTODO" {
+			if comp.IsNotDefined == nil {
+				return false
+			}
+			continue
+		}
+		if !matchTodoFilter(task, comp) {
+			return false
+		}
+	}
+	return true
+}
+
+func matchTodoFilter(task *models.Task, filter davCompFilter) bool {
+	if filter.IsNotDefined != nil {
+		return false
+	}
+	if r := filter.TimeRange; r != nil {
+		a, b := task.StartAt, task.DueAt
+		if a == nil {
+			a = b
+		}
+		if b == nil {
+			b = a
+		}
+		// Tasks without dates match any range.
+		if a != nil && !r.overlaps(*a, *b) {
+			return false
+		}
+	}
+	for _, prop := range filter.PropFilters {
+		value, at, defined := todoProperty(task, prop.Name)
+		switch {
+		case prop.IsNotDefined != nil:
+			if defined {
+				return false
+			}
+		case !defined:
+			return false
+		case prop.TimeRange != nil:
+			if at == nil || !prop.TimeRange.contains(*at) {
+				return false
+			}
+		case prop.TextMatch != nil:
+			match := strings.Contains(strings.ToLower(value), strings.ToLower(prop.TextMatch.Value))
+			if match == (prop.TextMatch.Negate == "yes") {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// todoProperty returns the value of a property of a task's VTODO, as text
+// or as a time, and whether the VTODO has it.
+func todoProperty(task *models.Task, name string) (string, *time.Time, bool) {
+	switch strings.ToUpper(name) {
+	case "UID":
+		return service.CalendarUID(task), nil, true
+	case "SUMMARY":
+		return task.Title, nil, true
+	case "DESCRIPTION":
+		return task.Description, nil, task.Description != ""
+	case "STATUS":
+		return service.CalendarTodoStatus(task), nil, true
+	case "CATEGORIES":
+		return strings.Join(task.Labels, ","), nil, len(task.Labels) > 0
+	case "CREATED", "DTSTAMP":
+		return "", &task.CreatedAt, true
+	case "DTSTART":
+		return "", task.StartAt, task.StartAt != nil
+	case "DUE":
+		return "", task.DueAt, task.DueAt != nil
+	case "COMPLETED":
+		completed := task.StatusCategory == models.StatusCategoryDone && task.CompletedAt != nil
+		return "", task.CompletedAt, completed
+	}
+	return "", nil, false
+}
+
+// writeMultistatus responds with a multistatus listing the given resources,
+// followed by a sync token if one is given.
+func writeMultistatus(w http.ResponseWriter, responses []davResponse, syncToken string) {
+	var b bytes.Buffer
+	b.WriteString(xml.Header)
+	fmt.Fprintf(&b, `<d:multistatus xmlns:d="%s" xmlns:c="%s" xmlns:cs="%s">`, davNS, caldavNS, calendarNS)
+	for _, response := range responses {
+		b.WriteString("<d:response><d:href>")
+		b.WriteString(davEscape(response.Href))
+		b.WriteString("</d:href>")
+		if response.Status != 0 {
+			fmt.Fprintf(&b, "<d:status>%s</d:status>", davStatus(response.Status))
+		}
+		if len(response.Found) > 0 {
+			b.WriteString("<d:propstat><d:prop>")
+			for _, prop := range response.Found {
+				writeDAVElement(&b, prop.Name, prop.Value)
+			}
+			fmt.Fprintf(&b, "</d:prop><d:status>%s</d:status></d:propstat>", davStatus(http.StatusOK))
+		}
+		if len(response.Missing) > 0 {
+			b.WriteString("<d:propstat><d:prop>")
+			for _, name := range response.Missing {
+				writeDAVElement(&b, name, "")
+			}
+			fmt.Fprintf(&b, "</d:prop><d:status>%s</d:status></d:propstat>", davStatus(http.StatusNotFound))
+		}
+		b.WriteString("</d:response>")
+	}
+	if syncToken != "" {
+		fmt.Fprintf(&b, "<d:sync-token>%s</d:sync-token>", davEscape(syncToken))
+	}
+	b.WriteString("</d:multistatus>")
+	w.Header().Set("Content-Type", "application/xml; charset=utf-8")
+	w.WriteHeader(http.StatusMultiStatus)
+	w.Write(b.Bytes())
+}
+
+// writeDAVError responds with a DAV error element naming the precondition
+// that failed.
+func writeDAVError(w http.ResponseWriter, status int, condition xml.Name) {
+	var b bytes.Buffer
+	b.WriteString(xml.Header)
+	fmt.Fprintf(&b, `<d:error xmlns:d="%s" xmlns:c="%s">`, davNS, caldavNS)
+	writeDAVElement(&b, condition, "")
+	b.WriteString("</d:error>")
+	w.Header().Set("Content-Type", "application/xml; charset=utf-8")
+	w.WriteHeader(status)
+	w.Write(b.Bytes())
+}
+
+// writeDAVElement writes an element with the given XML content, declaring
+// its namespace unless it has a prefix.
+func writeDAVElement(b *bytes.Buffer, name xml.Name, value string) {
+	tag := name.Local
+	if prefix, ok := davPrefixes[name.Space]; ok {
+		tag = prefix + ":" + name.Local
+		fmt.Fprintf(b, "<%s", tag)
+	} else {
+		fmt.Fprintf(b, `<%s xmlns="%s"`, tag, davEscape(name.Space))
+	}
+	if value == "" {
+		b.WriteString("/>")
+		return
+	}
+	fmt.Fprintf(b, ">%s</%s>", value, tag)
+}
+
+func davStatus(status int) string {
+	return fmt.Sprintf("HTTP/1.1 %d %s", status, http.StatusText(status))
+}
+
+func davEscape(s string) string {
+	var b strings.Builder
+	xml.EscapeText(&b, []byte(s))
+	return b.String()
+}
+
+// davHrefs renders hrefs as the content of a property.
+func davHrefs(hrefs ...string) string {
+	var b strings.Builder
+	for _, href := range hrefs {
+		fmt.Fprintf(&b, "<d:href>%s</d:href>", davEscape(href))
+	}
+	return b.String()
+}
@@ -0,0 +1,111 @@
+package handler
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/localopsco/go-sample/models"
+	"github.com/localopsco/go-sample/service"
+)
+
+// CalendarFeed serves the tasks with a due date as an iCalendar feed to the
+// holder of a calendar token, at /calendar/<token>.ics. Calendar apps cannot
+// send headers, so the token in the path is the only credential. The list
+// filters apply, such as ?project_id, ?label and ?assignee=me, and
+// ?components chooses between vevent, vtodo or both.
+func (h *Handler) CalendarFeed(c *gin.Context) {
+	token, ok := strings.CutSuffix(c.Param("feed"), ".ics")
+	if !ok || token == "" {
+		c.JSON(http.StatusNotFound, gin.H{
+			"message": service.CalendarFeedNotFoundError,
+		})
+		return
+	}
+	user, err := h.userSvc.AuthenticateCalendar(c.Request.Context(), token)
+	if err != nil {
+		h.calendarError(c, err)
+		return
+	}
+	// The feed acts for its owner, so that filters such as assignee=me
+	// resolve to them.
+	c.Set(userContextKey, user)
+	c.Request = c.Request.WithContext(models.WithActor(c.Request.Context(), user.ID))
+
+	filter, ok := parseTaskFilter(c)
+	if !ok {
+		return
+	}
+	opts := models.CalendarOptions{Filter: filter}
+	for _, component := range strings.Split(c.Query("components"), ",") {
+		if component = strings.ToLower(strings.TrimSpace(component)); component != "" {
+			opts.Components = append(opts.Components, component)
+		}
+	}
+	feed, err := h.exportSvc.Calendar(c.Request.Context(), opts)
+	if err != nil {
+		h.calendarError(c, err)
+		return
+	}
+	c.Header("Content-Disposition", `inline; filename="tasks.ics"`)
+	c.Data(http.StatusOK, "text/calendar; charset=utf-8", feed)
+}
+
+// CreateCalendarToken issues a calendar feed token for the current user
+// and returns the feed's URL. Any previous token stops working.
+func (h *Handler) CreateCalendarToken(c *gin.Context) {
+	user, ok := currentUser(c)
+	if !ok {
+		return
+	}
+	token, err := h.userSvc.CreateCalendarToken(c.Request.Context(), user.ID)
+	if err != nil {
+		h.calendarError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"calendar_token": token,
+		"url":            fmt.Sprintf("/api/v1/calendar/%s.ics", token),
+	})
+}
+
+// RevokeCalendarToken stops the current user's calendar feed.
+func (h *Handler) RevokeCalendarToken(c *gin.Context) {
+	user, ok := currentUser(c)
+	if !ok {
+		return
+	}
+	if err := h.userSvc.RevokeCalendarToken(c.Request.Context(), user.ID); err != nil {
+		h.calendarError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "success",
+	})
+}
+
+func (h *Handler) calendarError(c *gin.Context, err error) {
+	if queryError(c, err) {
+		return
+	}
+	switch err.Error() {
+	case service.CalendarFeedNotFoundError:
+		c.JSON(http.StatusNotFound, gin.H{
+			"message": err.Error(),
+		})
+		return
+	case service.InvalidCalendarComponentsError, service.InvalidFilterError:
+		c.JSON(http.StatusUnprocessableEntity, gin.H{
+			"message": err.Error(),
+		})
+		return
+	}
+	log.Printf("error serving calendar feed: %v", err)
+	c.JSON(http.StatusInternalServerError, gin.H{
+		"message": "Unknown error. Something went wrong.",
+	})
+}
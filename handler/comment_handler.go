@@ -0,0 +1,165 @@
+package handler
+
+import (
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/service"
+)
+
+func (h *Handler) CreateComment(c *gin.Context) {
+	var reqBody struct {
+		Body     string     `json:"body"`
+		ParentID *uuid.UUID `json:"parent_id"`
+	}
+	user, ok := currentUser(c)
+	if !ok {
+		return
+	}
+	taskIDStr := strings.TrimSpace(c.Param("task_id"))
+	taskID, err := uuid.Parse(taskIDStr)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"message": "Invalid task_id",
+		})
+		return
+	}
+	err = c.ShouldBindWith(&reqBody, binding.JSON)
+	if err != nil {
+		c.JSON(http.StatusUnprocessableEntity, gin.H{
+			"message": "Invalid data",
+		})
+		return
+	}
+	comment, err := h.commentSvc.CreateComment(c.Request.Context(), taskID, user, reqBody.Body, reqBody.ParentID)
+	if err != nil {
+		h.commentError(c, "creating", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, comment)
+}
+
+func (h *Handler) ListComments(c *gin.Context) {
+	taskIDStr := strings.TrimSpace(c.Param("task_id"))
+	taskID, err := uuid.Parse(taskIDStr)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"message": "Invalid task_id",
+		})
+		return
+	}
+	parentID, err := parseOptionalUUID(c.Query("parent_id"))
+	if err != nil {
+		c.JSON(http.StatusUnprocessableEntity, gin.H{
+			"message": "Invalid parent_id",
+		})
+		return
+	}
+	limit, offset, err := parsePagination(c)
+	if err != nil {
+		c.JSON(http.StatusUnprocessableEntity, gin.H{
+			"message": err.Error(),
+		})
+		return
+	}
+	page, err := h.commentSvc.ListComments(c.Request.Context(), taskID, parentID, limit, offset)
+	if err != nil {
+		h.commentError(c, "listing", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, page)
+}
+
+func (h *Handler) UpdateComment(c *gin.Context) {
+	var reqBody struct {
+		Body string `json:"body"`
+	}
+	user, ok := currentUser(c)
+	if !ok {
+		return
+	}
+	taskID, commentID, ok := commentParams(c)
+	if !ok {
+		return
+	}
+	err := c.ShouldBindWith(&reqBody, binding.JSON)
+	if err != nil {
+		c.JSON(http.StatusUnprocessableEntity, gin.H{
+			"message": "Invalid data",
+		})
+		return
+	}
+	comment, err := h.commentSvc.UpdateComment(c.Request.Context(), taskID, commentID, user, reqBody.Body)
+	if err != nil {
+		h.commentError(c, "updating", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, comment)
+}
+
+func (h *Handler) DeleteComment(c *gin.Context) {
+	user, ok := currentUser(c)
+	if !ok {
+		return
+	}
+	taskID, commentID, ok := commentParams(c)
+	if !ok {
+		return
+	}
+	err := h.commentSvc.DeleteComment(c.Request.Context(), taskID, commentID, user)
+	if err != nil {
+		h.commentError(c, "deleting", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Comment deleted",
+	})
+}
+
+func (h *Handler) commentError(c *gin.Context, action string, err error) {
+	switch err.Error() {
+	case service.TaskNotFoundError, service.CommentNotFoundError, service.ParentCommentNotFoundError:
+		c.JSON(http.StatusNotFound, gin.H{
+			"message": err.Error(),
+		})
+	case service.EmptyCommentError, service.CommentTooLongError:
+		c.JSON(http.StatusUnprocessableEntity, gin.H{
+			"message": err.Error(),
+		})
+	case service.NotCommentAuthorError:
+		c.JSON(http.StatusForbidden, gin.H{
+			"message": err.Error(),
+		})
+	default:
+		log.Printf("error %s comment: %v", action, err)
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"message": "Unknown error. Something went wrong.",
+		})
+	}
+}
+
+func commentParams(c *gin.Context) (uuid.UUID, uuid.UUID, bool) {
+	taskID, err := uuid.Parse(strings.TrimSpace(c.Param("task_id")))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"message": "Invalid task_id",
+		})
+		return uuid.Nil, uuid.Nil, false
+	}
+	commentID, err := uuid.Parse(strings.TrimSpace(c.Param("comment_id")))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"message": "Invalid comment_id",
+		})
+		return uuid.Nil, uuid.Nil, false
+	}
+	return taskID, commentID, true
+}
@@ -0,0 +1,141 @@
+package handler
+
+import (
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/service"
+)
+
+func (h *Handler) AddBlocker(c *gin.Context) {
+	var reqBody struct {
+		BlockerID uuid.UUID `json:"blocker_id"`
+	}
+	err := c.ShouldBindWith(&reqBody, binding.JSON)
+	if err != nil {
+		c.JSON(http.StatusUnprocessableEntity, gin.H{
+			"message": "Invalid data",
+		})
+		return
+	}
+
+	taskIDStr := strings.TrimSpace(c.Param("task_id"))
+	taskID, err := uuid.Parse(taskIDStr)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"message": "Invalid task_id",
+		})
+		return
+	}
+
+	task, err := h.svc.AddBlocker(c.Request.Context(), taskID, reqBody.BlockerID)
+	if err != nil {
+		if err.Error() == service.TaskNotFoundError || err.Error() == service.BlockerTaskNotFoundError {
+			c.JSON(http.StatusNotFound, gin.H{
+				"message": err.Error(),
+			})
+			return
+		}
+		if err.Error() == service.SelfDependencyError || err.Error() == service.DependencyCycleError {
+			c.JSON(http.StatusUnprocessableEntity, gin.H{
+				"message": err.Error(),
+			})
+			return
+		}
+		log.Printf("error adding blocker: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"message": "Unknown error. Something went wrong.",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, task)
+}
+
+func (h *Handler) RemoveBlocker(c *gin.Context) {
+	taskIDStr := strings.TrimSpace(c.Param("task_id"))
+	taskID, err := uuid.Parse(taskIDStr)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"message": "Invalid task_id",
+		})
+		return
+	}
+	blockerIDStr := strings.TrimSpace(c.Param("blocker_id"))
+	blockerID, err := uuid.Parse(blockerIDStr)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"message": "Invalid blocker_id",
+		})
+		return
+	}
+
+	task, err := h.svc.RemoveBlocker(c.Request.Context(), taskID, blockerID)
+	if err != nil {
+		if err.Error() == service.TaskNotFoundError {
+			c.JSON(http.StatusNotFound, gin.H{
+				"message": err.Error(),
+			})
+			return
+		}
+		log.Printf("error removing blocker: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"message": "Unknown error. Something went wrong.",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, task)
+}
+
+func (h *Handler) GetDependencyGraph(c *gin.Context) {
+	taskIDStr := strings.TrimSpace(c.Param("task_id"))
+	taskID, err := uuid.Parse(taskIDStr)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"message": "Invalid task_id",
+		})
+		return
+	}
+
+	graph, err := h.svc.GetDependencyGraph(c.Request.Context(), taskID)
+	if err != nil {
+		if err.Error() == service.TaskNotFoundError {
+			c.JSON(http.StatusNotFound, gin.H{
+				"message": err.Error(),
+			})
+			return
+		}
+		log.Printf("error getting dependency graph: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"message": "Unknown error. Something went wrong.",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, graph)
+}
+
+func (h *Handler) GetFullDependencyGraph(c *gin.Context) {
+	projectID, err := parseOptionalUUID(c.Query("project_id"))
+	if err != nil {
+		c.JSON(http.StatusUnprocessableEntity, gin.H{
+			"message": "Invalid project_id",
+		})
+		return
+	}
+	graph, err := h.svc.GetFullDependencyGraph(c.Request.Context(), projectID)
+	if err != nil {
+		log.Printf("error getting dependency graph: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"message": "Unknown error. Something went wrong.",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, graph)
+}
@@ -0,0 +1,154 @@
+package handler
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/models"
+	"github.com/localopsco/go-sample/service"
+)
+
+// ExportTasks streams the tasks matching the list filters as CSV, JSON or
+// NDJSON, in order of creation. Columns are chosen with ?columns, a
+// comma-separated list, and times are written in the ?tz timezone.
+func (h *Handler) ExportTasks(c *gin.Context) {
+	opts, ok := parseExportOptions(c)
+	if !ok {
+		return
+	}
+	opts, err := h.exportSvc.CheckExport(opts)
+	if err != nil {
+		h.exportError(c, "exporting tasks", err)
+		return
+	}
+	c.Header("Content-Type", service.ExportContentType(opts.Format))
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, service.ExportFilename(opts.Format, time.Now())))
+	c.Status(http.StatusOK)
+	// Once rows have been written the status can no longer change, so
+	// errors only cut the export short.
+	if _, err := h.exportSvc.Export(c.Request.Context(), c.Writer, opts); err != nil {
+		log.Printf("error exporting tasks: %v", err)
+	}
+}
+
+// CreateExportJob queues an export with the same parameters as
+// ExportTasks, to be downloaded once it has run.
+func (h *Handler) CreateExportJob(c *gin.Context) {
+	opts, ok := parseExportOptions(c)
+	if !ok {
+		return
+	}
+	job, err := h.exportSvc.CreateJob(c.Request.Context(), opts, exportUserID(c))
+	if err != nil {
+		h.exportError(c, "creating export job", err)
+		return
+	}
+	c.JSON(http.StatusAccepted, job)
+}
+
+func (h *Handler) GetExportJob(c *gin.Context) {
+	jobID, ok := exportJobParam(c)
+	if !ok {
+		return
+	}
+	job, err := h.exportSvc.GetJob(c.Request.Context(), jobID, exportUserID(c))
+	if err != nil {
+		h.exportError(c, "getting export job", err)
+		return
+	}
+	if job.Status == models.ExportJobSucceeded {
+		job.DownloadURL = fmt.Sprintf("/api/v1/tasks/export/jobs/%s/download/", job.ID)
+	}
+	c.JSON(http.StatusOK, job)
+}
+
+// DownloadExport redirects to a short-lived link to the result of an export
+// job that has succeeded.
+func (h *Handler) DownloadExport(c *gin.Context) {
+	jobID, ok := exportJobParam(c)
+	if !ok {
+		return
+	}
+	url, err := h.exportSvc.ResultURL(c.Request.Context(), jobID, exportUserID(c))
+	if err != nil {
+		h.exportError(c, "downloading export", err)
+		return
+	}
+	c.Redirect(http.StatusFound, url)
+}
+
+// parseExportOptions reads the format and columns of an export along with
+// the list filters. It responds with an error and returns false if they are
+// invalid.
+func parseExportOptions(c *gin.Context) (models.ExportOptions, bool) {
+	filter, ok := parseTaskFilter(c)
+	if !ok {
+		return models.ExportOptions{}, false
+	}
+	opts := models.ExportOptions{
+		Format: c.DefaultQuery("format", models.ExportCSV),
+		Filter: filter,
+	}
+	for _, column := range strings.Split(c.Query("columns"), ",") {
+		if column = strings.TrimSpace(column); column != "" {
+			opts.Columns = append(opts.Columns, column)
+		}
+	}
+	return opts, true
+}
+
+func exportUserID(c *gin.Context) *uuid.UUID {
+	if user := optionalUser(c); user != nil {
+		return &user.ID
+	}
+	return nil
+}
+
+func exportJobParam(c *gin.Context) (uuid.UUID, bool) {
+	jobIDStr := strings.TrimSpace(c.Param("job_id"))
+	jobID, err := uuid.Parse(jobIDStr)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"message": service.ExportJobNotFoundError,
+		})
+		return uuid.UUID{}, false
+	}
+	return jobID, true
+}
+
+func (h *Handler) exportError(c *gin.Context, action string, err error) {
+	if queryError(c, err) {
+		return
+	}
+	switch err.Error() {
+	case service.ExportJobNotFoundError:
+		c.JSON(http.StatusNotFound, gin.H{
+			"message": err.Error(),
+		})
+		return
+	case service.ExportNotReadyError:
+		c.JSON(http.StatusConflict, gin.H{
+			"message": err.Error(),
+		})
+		return
+	case service.ExportJobsNotEnabledError:
+		c.JSON(http.StatusForbidden, gin.H{
+			"message": err.Error(),
+		})
+		return
+	case service.InvalidExportFormatError, service.InvalidExportColumnsError, service.InvalidFilterError:
+		c.JSON(http.StatusUnprocessableEntity, gin.H{
+			"message": err.Error(),
+		})
+		return
+	}
+	log.Printf("error %s: %v", action, err)
+	c.JSON(http.StatusInternalServerError, gin.H{
+		"message": "Unknown error. Something went wrong.",
+	})
+}
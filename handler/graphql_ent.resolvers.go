@@ -0,0 +1,36 @@
+package handler
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.50
+
+import (
+	"context"
+
+	"entgo.io/contrib/entgql"
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/ent"
+)
+
+func (r *queryGqlResolver) Node(ctx context.Context, id uuid.UUID) (ent.Noder, error) {
+	return r.h.graphSvc.GetNode(ctx, id)
+}
+
+func (r *queryGqlResolver) Nodes(ctx context.Context, ids []uuid.UUID) ([]ent.Noder, error) {
+	return r.h.graphSvc.GetNodes(ctx, ids)
+}
+
+func (r *queryGqlResolver) Projects(ctx context.Context, after *entgql.Cursor[uuid.UUID], first *int, before *entgql.Cursor[uuid.UUID], last *int, orderBy *ent.ProjectOrder, where *ent.ProjectWhereInput) (*ent.ProjectConnection, error) {
+	return r.h.graphSvc.PaginateProjects(ctx, after, first, before, last, orderBy, where)
+}
+
+func (r *queryGqlResolver) Tasks(ctx context.Context, after *entgql.Cursor[uuid.UUID], first *int, before *entgql.Cursor[uuid.UUID], last *int, orderBy *ent.TaskOrder, where *ent.TaskWhereInput) (*ent.TaskConnection, error) {
+	return r.h.graphSvc.PaginateTasks(ctx, after, first, before, last, orderBy, where)
+}
+
+func (r *gqlResolver) Query() QueryResolver { return &queryGqlResolver{r} }
+
+func (r *gqlResolver) Task() TaskResolver { return &taskGqlResolver{r} }
+
+type queryGqlResolver struct{ *gqlResolver }
+type taskGqlResolver struct{ *gqlResolver }
@@ -0,0 +1,161 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"log"
+	"mime"
+	"net/http"
+
+	"github.com/99designs/gqlgen/graphql"
+	gqlhandler "github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/gin-gonic/gin"
+	"github.com/localopsco/go-sample/ent"
+	"github.com/localopsco/go-sample/service"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// maxGraphQLBodyBytes caps the size of a GraphQL request without uploads,
+// and maxGraphQLUploadBytes that of a multipart request with them.
+const (
+	maxGraphQLBodyBytes   = 1 << 20
+	maxGraphQLUploadBytes = 32 << 20
+)
+
+// The schema of the GraphQL API is generated from ent/schema by entgql,
+// along with the where-inputs that filter its connections, and the executor
+// by gqlgen; see gqlgen.yml. Run go generate ./ent after changing either
+// the ent schema or handler/graphql/schema.graphql.
+func newGraphQLServer(h *Handler) *gqlhandler.Server {
+	cfg := Config{Resolvers: &gqlResolver{h}}
+	setGraphQLComplexity(&cfg.Complexity)
+	srv := gqlhandler.New(NewExecutableSchema(cfg))
+	srv.AddTransport(transport.GET{})
+	srv.AddTransport(transport.POST{})
+	srv.AddTransport(transport.MultipartForm{
+		MaxUploadSize: maxGraphQLUploadBytes,
+		MaxMemory:     maxGraphQLBodyBytes,
+	})
+	srv.Use(graphQLLimits{})
+	srv.Use(extension.FixedComplexityLimit(maxGraphQLComplexity))
+	srv.SetErrorPresenter(presentGraphQLError)
+	return srv
+}
+
+// GraphQL serves the GraphQL API. Queries are accepted with GET and POST,
+// and mutations with POST only, as JSON or as multipart requests that carry
+// file uploads (https://github.com/jaydenseric/graphql-multipart-request-spec).
+// Operations that cannot be parsed or validated, or that are deeper or more
+// complex than maxGraphQLDepth and maxGraphQLComplexity, are rejected before
+// they run.
+func (h *Handler) GraphQL(c *gin.Context) {
+	if mediaType, _, _ := mime.ParseMediaType(c.GetHeader("Content-Type")); mediaType != "multipart/form-data" {
+		c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxGraphQLBodyBytes)
+	}
+	ctx := withGraphQLLoaders(c.Request.Context(), h)
+	h.graphql.ServeHTTP(c.Writer, c.Request.WithContext(ctx))
+}
+
+// gqlError is an error returned to GraphQL clients, with a code in its
+// extensions that tells the kind of error apart.
+type gqlError struct {
+	message string
+	code    string
+}
+
+func (e *gqlError) Error() string {
+	return e.message
+}
+
+func (e *gqlError) Extensions() map[string]interface{} {
+	return map[string]interface{}{
+		"code": e.code,
+	}
+}
+
+// Codes of GraphQL errors.
+const (
+	gqlBadUserInput  = "BAD_USER_INPUT"
+	gqlNotFound      = "NOT_FOUND"
+	gqlConflict      = "CONFLICT"
+	gqlForbidden     = "FORBIDDEN"
+	gqlInternalError = "INTERNAL_SERVER_ERROR"
+)
+
+// presentGraphQLError presents the errors of resolvers, which gqlgen wraps
+// with the path of their field, as graphQLError turns them. Errors of the
+// GraphQL layer itself, such as those of validation or of nodes that do
+// not exist, are presented as they are.
+func presentGraphQLError(ctx context.Context, err error) *gqlerror.Error {
+	presented := graphql.DefaultErrorPresenter(ctx, err)
+	var inner *gqlerror.Error
+	if presented.Err == nil || errors.As(presented.Err, &inner) {
+		return presented
+	}
+	clientErr := graphQLError(presented.Err)
+	presented.Err = clientErr
+	presented.Message = clientErr.Error()
+	presented.Extensions = clientErr.Extensions()
+	return presented
+}
+
+// graphQLError turns an error of a resolver into one for clients, logging
+// unexpected ones.
+func graphQLError(err error) *gqlError {
+	var gqlErr *gqlError
+	if errors.As(err, &gqlErr) {
+		return gqlErr
+	}
+	var transitionErr *service.TransitionError
+	if errors.As(err, &transitionErr) {
+		return &gqlError{err.Error(), gqlBadUserInput}
+	}
+	if isInvalidTaskFieldsError(err) {
+		return &gqlError{err.Error(), gqlBadUserInput}
+	}
+	for _, emptyWhere := range []error{ent.ErrEmptyTaskWhereInput, ent.ErrEmptyProjectWhereInput,
+		ent.ErrEmptyUserWhereInput, ent.ErrEmptyWorkflowStatusWhereInput} {
+		if errors.Is(err, emptyWhere) {
+			return &gqlError{err.Error(), gqlBadUserInput}
+		}
+	}
+	switch err.Error() {
+	case service.TaskNotFoundError, service.ProjectNotFoundError, service.ParentTaskNotFoundError,
+		service.UserNotFoundError, service.WorkflowNotFoundError:
+		return &gqlError{err.Error(), gqlNotFound}
+	case service.MaxDepthExceededError, service.InvalidProjectError:
+		return &gqlError{err.Error(), gqlBadUserInput}
+	case service.OpenSubtasksError, service.TaskBlockedError:
+		return &gqlError{err.Error(), gqlConflict}
+	case service.AttachmentsNotEnabledError:
+		return &gqlError{err.Error(), gqlForbidden}
+	}
+	log.Printf("error resolving GraphQL field: %v", err)
+	return &gqlError{"Unknown error. Something went wrong.", gqlInternalError}
+}
+
+// graphQLNullableError returns the error for a nullable field that could not
+// be resolved, or nil to resolve it to null when what it refers to does not
+// exist.
+func graphQLNullableError(err error, notFound string) error {
+	if err.Error() == notFound {
+		return nil
+	}
+	return err
+}
+
+// gqlContextKey keys the values the GraphQL handler puts in a request's
+// context.
+type gqlContextKey int
+
+const gqlLoadersKey gqlContextKey = iota
+
+func graphQLLoadersFrom(ctx context.Context) *gqlLoaders {
+	return ctx.Value(gqlLoadersKey).(*gqlLoaders)
+}
+
+func withGraphQLLoaders(ctx context.Context, h *Handler) context.Context {
+	return context.WithValue(ctx, gqlLoadersKey, newGQLLoaders(h))
+}
@@ -0,0 +1,181 @@
+package handler
+
+import (
+	"context"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/ent"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// maxGraphQLDepth is how deeply the fields of an operation may nest, and
+// maxGraphQLComplexity how many objects and fields it may ask for at most.
+const (
+	maxGraphQLDepth      = 10
+	maxGraphQLComplexity = 5000
+)
+
+// maxConnectionSize is the most edges a page of a connection can have, as
+// in service.ListTasksPage. The lists of a task's subtasks, blockers and
+// users are not paged, and are taken to hold gqlListSize items when the
+// complexity of an operation is counted.
+const (
+	maxConnectionSize = 100
+	gqlListSize       = 10
+)
+
+// Codes of the errors of operations that are too deep or too complex. The
+// latter is set by gqlgen's complexity extension. Both are returned with
+// status 422, like those of operations that are not valid.
+const (
+	gqlDepthLimitExceeded      = "DEPTH_LIMIT_EXCEEDED"
+	gqlComplexityLimitExceeded = "COMPLEXITY_LIMIT_EXCEEDED"
+)
+
+func init() {
+	errcode.RegisterErrorType(gqlDepthLimitExceeded, errcode.KindProtocol)
+	errcode.RegisterErrorType(gqlComplexityLimitExceeded, errcode.KindProtocol)
+}
+
+// graphQLLimits rejects operations that nest too deeply, or that page
+// through a connection without saying how many edges they want or asking
+// for more than maxConnectionSize. It runs once an operation is parsed and
+// validated, so that its complexity, which the number of edges bounds, is
+// checked next.
+type graphQLLimits struct{}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.OperationContextMutator
+} = graphQLLimits{}
+
+func (graphQLLimits) ExtensionName() string {
+	return "Limits"
+}
+
+func (graphQLLimits) Validate(graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (graphQLLimits) MutateOperationContext(_ context.Context, rc *graphql.OperationContext) *gqlerror.Error {
+	w := &gqlLimitWalker{doc: rc.Doc, variables: rc.Variables, visiting: map[string]bool{}}
+	w.selections(rc.Operation.SelectionSet, 1)
+	if w.err != nil {
+		return w.err
+	}
+	if w.depth > maxGraphQLDepth {
+		err := gqlerror.Errorf("operation has depth %d, which exceeds the limit of %d", w.depth, maxGraphQLDepth)
+		errcode.Set(err, gqlDepthLimitExceeded)
+		return err
+	}
+	return nil
+}
+
+// gqlLimitWalker walks the selections of an operation, measuring their
+// depth and checking the arguments of connections.
+type gqlLimitWalker struct {
+	doc       *ast.QueryDocument
+	variables map[string]interface{}
+	// visiting holds the fragments being expanded. Validation rejects
+	// cycles of fragments, but the walk does not rely on it.
+	visiting map[string]bool
+	depth    int
+	err      *gqlerror.Error
+}
+
+// selections walks a selection set whose fields are at depth. Introspection
+// is left out, since the size of the schema bounds it.
+func (w *gqlLimitWalker) selections(set ast.SelectionSet, depth int) {
+	for _, selection := range set {
+		if w.err != nil {
+			return
+		}
+		switch selection := selection.(type) {
+		case *ast.Field:
+			if strings.HasPrefix(selection.Name, "__") {
+				continue
+			}
+			w.depth = max(w.depth, depth)
+			w.checkConnection(selection)
+			w.selections(selection.SelectionSet, depth+1)
+		case *ast.InlineFragment:
+			w.selections(selection.SelectionSet, depth)
+		case *ast.FragmentSpread:
+			fragment := w.doc.Fragments.ForName(selection.Name)
+			if fragment == nil || w.visiting[selection.Name] {
+				continue
+			}
+			w.visiting[selection.Name] = true
+			w.selections(fragment.SelectionSet, depth)
+			w.visiting[selection.Name] = false
+		}
+	}
+}
+
+// checkConnection requires fields that return connections to be given
+// first or last, of at most maxConnectionSize.
+func (w *gqlLimitWalker) checkConnection(field *ast.Field) {
+	if field.Definition == nil || !strings.HasSuffix(field.Definition.Type.Name(), "Connection") {
+		return
+	}
+	args := field.ArgumentMap(w.variables)
+	given := false
+	for _, name := range []string{"first", "last"} {
+		if args[name] == nil {
+			continue
+		}
+		given = true
+		if n, err := graphql.UnmarshalInt(args[name]); err == nil && n > maxConnectionSize {
+			w.err = gqlerror.ErrorPosf(field.Position, "%s can have at most %d edges", field.Name, maxConnectionSize)
+		}
+	}
+	if !given {
+		w.err = gqlerror.ErrorPosf(field.Position, "%s needs first or last, of at most %d", field.Name, maxConnectionSize)
+	}
+	if w.err != nil {
+		errcode.Set(w.err, errcode.ValidationFailed)
+	}
+}
+
+// setGraphQLComplexity sets how the complexity of fields that return many
+// objects is counted. A field costs 1 plus the cost of its selections,
+// which count once for every edge a connection may have, every ID asked
+// for by nodes, and every item of the lists of a task's edges.
+func setGraphQLComplexity(c *ComplexityRoot) {
+	c.Query.Tasks = func(childComplexity int, _ *ent.Cursor, first *int, _ *ent.Cursor, last *int, _ *ent.TaskOrder, _ *ent.TaskWhereInput) int {
+		return 1 + connectionSize(first, last)*childComplexity
+	}
+	c.Query.Projects = func(childComplexity int, _ *ent.Cursor, first *int, _ *ent.Cursor, last *int, _ *ent.ProjectOrder, _ *ent.ProjectWhereInput) int {
+		return 1 + connectionSize(first, last)*childComplexity
+	}
+	c.Project.Tasks = func(childComplexity int, _ *ent.Cursor, first *int, _ *ent.Cursor, last *int, _ *ent.TaskOrder, _ *ent.TaskWhereInput) int {
+		return 1 + connectionSize(first, last)*childComplexity
+	}
+	c.Query.Nodes = func(childComplexity int, ids []uuid.UUID) int {
+		return 1 + len(ids)*childComplexity
+	}
+	list := func(childComplexity int) int {
+		return 1 + gqlListSize*childComplexity
+	}
+	c.Task.Children = list
+	c.Task.Blocks = list
+	c.Task.BlockedBy = list
+	c.Task.Assignees = list
+	c.Task.Watchers = list
+}
+
+// connectionSize returns how many edges a page of a connection may have.
+func connectionSize(first, last *int) int {
+	size := maxConnectionSize
+	if first != nil {
+		size = min(size, *first)
+	}
+	if last != nil {
+		size = min(size, *last)
+	}
+	return max(size, 1)
+}
@@ -0,0 +1,82 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/graph-gophers/dataloader"
+	"github.com/localopsco/go-sample/models"
+	"github.com/localopsco/go-sample/service"
+)
+
+// gqlLoaderWait is how long a loader waits for more keys before loading a
+// batch.
+const gqlLoaderWait = 2 * time.Millisecond
+
+// gqlLoaders batch the lookups that resolving a list of objects would
+// otherwise make once per object. Edges are loaded along with the entities
+// of a query by entgql, and the loaders serve the fields the task service
+// computes, such as whether each task of a connection is blocked. They cache
+// what they load for the request they serve.
+type gqlLoaders struct {
+	tasks *dataloader.Loader
+}
+
+func newGQLLoaders(h *Handler) *gqlLoaders {
+	return &gqlLoaders{
+		tasks: newGQLLoader(func(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]interface{}, error) {
+			tasks, err := h.svc.GetTasks(ctx, ids)
+			if err != nil {
+				return nil, err
+			}
+			byID := make(map[uuid.UUID]interface{}, len(tasks))
+			for _, task := range tasks {
+				byID[task.ID] = task
+			}
+			return byID, nil
+		}),
+	}
+}
+
+// newGQLLoader returns a loader of values by ID. Values that load returns
+// no entry for are nil.
+func newGQLLoader(load func(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]interface{}, error)) *dataloader.Loader {
+	return dataloader.NewBatchedLoader(func(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
+		ids := make([]uuid.UUID, len(keys))
+		for i, key := range keys {
+			ids[i] = key.Raw().(uuid.UUID)
+		}
+		results := make([]*dataloader.Result, len(keys))
+		values, err := load(ctx, ids)
+		for i, id := range ids {
+			results[i] = &dataloader.Result{Data: values[id], Error: err}
+		}
+		return results
+	}, dataloader.WithWait(gqlLoaderWait))
+}
+
+// gqlKey is a loader key for an ID.
+type gqlKey uuid.UUID
+
+func (k gqlKey) String() string   { return uuid.UUID(k).String() }
+func (k gqlKey) Raw() interface{} { return uuid.UUID(k) }
+
+// task returns the task service's view of a task.
+func (l *gqlLoaders) task(ctx context.Context, id uuid.UUID) (*models.Task, error) {
+	value, err := l.tasks.Load(ctx, gqlKey(id))()
+	if err != nil {
+		return nil, err
+	}
+	if value == nil {
+		return nil, errors.New(service.TaskNotFoundError)
+	}
+	return value.(*models.Task), nil
+}
+
+// prime puts the task service's view of a task that a mutation changed in
+// the cache, in place of any view loaded before the change.
+func (l *gqlLoaders) prime(ctx context.Context, task *models.Task) {
+	l.tasks.Clear(ctx, gqlKey(task.ID)).Prime(ctx, gqlKey(task.ID), task)
+}
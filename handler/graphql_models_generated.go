@@ -0,0 +1,53 @@
+// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.
+
+package handler
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/ent/task"
+)
+
+type CreateProjectInput struct {
+	Name        string     `json:"name"`
+	Description *string    `json:"description,omitempty"`
+	WorkflowID  *uuid.UUID `json:"workflowID,omitempty"`
+}
+
+type CreateTaskInput struct {
+	Title       string  `json:"title"`
+	Description *string `json:"description,omitempty"`
+	// The key of a status in the workflow of the task's project.
+	Status          *string        `json:"status,omitempty"`
+	ProjectID       *uuid.UUID     `json:"projectID,omitempty"`
+	ParentID        *uuid.UUID     `json:"parentID,omitempty"`
+	StartAt         *time.Time     `json:"startAt,omitempty"`
+	DueAt           *time.Time     `json:"dueAt,omitempty"`
+	Priority        *task.Priority `json:"priority,omitempty"`
+	EstimateMinutes *int           `json:"estimateMinutes,omitempty"`
+	Labels          []string       `json:"labels,omitempty"`
+}
+
+type UpdateProjectInput struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+// Changes a task. Fields left out are unchanged, and the clear fields unset
+// optional ones.
+type UpdateTaskInput struct {
+	Title                *string        `json:"title,omitempty"`
+	Description          *string        `json:"description,omitempty"`
+	Status               *string        `json:"status,omitempty"`
+	StartAt              *time.Time     `json:"startAt,omitempty"`
+	ClearStartAt         *bool          `json:"clearStartAt,omitempty"`
+	DueAt                *time.Time     `json:"dueAt,omitempty"`
+	ClearDueAt           *bool          `json:"clearDueAt,omitempty"`
+	Priority             *task.Priority `json:"priority,omitempty"`
+	EstimateMinutes      *int           `json:"estimateMinutes,omitempty"`
+	ClearEstimateMinutes *bool          `json:"clearEstimateMinutes,omitempty"`
+	Labels               []string       `json:"labels,omitempty"`
+	AppendLabels         []string       `json:"appendLabels,omitempty"`
+	ClearLabels          *bool          `json:"clearLabels,omitempty"`
+}
@@ -0,0 +1,30 @@
+package handler
+
+import "github.com/localopsco/go-sample/models"
+
+// gqlResolver resolves the fields of the GraphQL API that are not bound to
+// the fields and edges of ent entities.
+type gqlResolver struct {
+	h *Handler
+}
+
+// gqlOptional returns the change of an optional field an update input asks
+// for with a value and a flag that clears it.
+func gqlOptional[T any](value *T, clear *bool) models.Optional[T] {
+	if clear != nil && *clear {
+		return models.Optional[T]{Set: true}
+	}
+	return models.Optional[T]{Set: value != nil, Value: value}
+}
+
+func deref[T any](v *T) T {
+	var zero T
+	if v == nil {
+		return zero
+	}
+	return *v
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -0,0 +1,183 @@
+package handler
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.50
+
+import (
+	"context"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/ent"
+	"github.com/localopsco/go-sample/models"
+	"github.com/localopsco/go-sample/service"
+)
+
+func (r *mutationGqlResolver) CreateTask(ctx context.Context, input CreateTaskInput) (*ent.Task, error) {
+	task := models.Task{
+		Title:           input.Title,
+		Description:     deref(input.Description),
+		Status:          deref(input.Status),
+		ProjectID:       input.ProjectID,
+		ParentID:        input.ParentID,
+		StartAt:         input.StartAt,
+		DueAt:           input.DueAt,
+		EstimateMinutes: input.EstimateMinutes,
+		Labels:          input.Labels,
+	}
+	if input.Priority != nil {
+		task.Priority = input.Priority.String()
+	}
+	created, err := r.h.svc.CreateTask(ctx, task)
+	if err != nil {
+		return nil, err
+	}
+	graphQLLoadersFrom(ctx).prime(ctx, created)
+	return r.h.graphSvc.GetTask(ctx, created.ID)
+}
+
+func (r *mutationGqlResolver) UpdateTask(ctx context.Context, id uuid.UUID, input UpdateTaskInput, force *bool) (*ent.Task, error) {
+	update := models.TaskUpdate{
+		ID:              id,
+		Title:           deref(input.Title),
+		Description:     deref(input.Description),
+		Status:          input.Status,
+		StartAt:         gqlOptional(input.StartAt, input.ClearStartAt),
+		DueAt:           gqlOptional(input.DueAt, input.ClearDueAt),
+		EstimateMinutes: gqlOptional(input.EstimateMinutes, input.ClearEstimateMinutes),
+	}
+	if input.Priority != nil {
+		update.Priority = ptr(input.Priority.String())
+	}
+	switch {
+	case input.ClearLabels != nil && *input.ClearLabels:
+		update.Labels = &[]string{}
+	case input.Labels != nil:
+		update.Labels = &input.Labels
+	}
+	if input.AppendLabels != nil {
+		var labels []string
+		if update.Labels != nil {
+			labels = *update.Labels
+		} else {
+			existing, err := r.h.svc.GetTask(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			labels = existing.Labels
+		}
+		labels = append(append([]string{}, labels...), input.AppendLabels...)
+		update.Labels = &labels
+	}
+	updated, err := r.h.svc.UpdateTask(ctx, update, force != nil && *force)
+	if err != nil {
+		return nil, err
+	}
+	graphQLLoadersFrom(ctx).prime(ctx, updated)
+	return r.h.graphSvc.GetTask(ctx, id)
+}
+
+func (r *mutationGqlResolver) DeleteTask(ctx context.Context, id uuid.UUID) (uuid.UUID, error) {
+	if err := r.h.svc.DeleteTask(ctx, id); err != nil {
+		return uuid.Nil, err
+	}
+	return id, nil
+}
+
+func (r *mutationGqlResolver) AddAttachment(ctx context.Context, taskID uuid.UUID, file graphql.Upload) (*ent.Task, error) {
+	task, err := r.h.svc.AttachFile(ctx, taskID, file.File, file.ContentType)
+	if err != nil {
+		return nil, err
+	}
+	graphQLLoadersFrom(ctx).prime(ctx, task)
+	return r.h.graphSvc.GetTask(ctx, taskID)
+}
+
+func (r *mutationGqlResolver) DeleteAttachment(ctx context.Context, taskID uuid.UUID) (*ent.Task, error) {
+	task, err := r.h.svc.DeleteAttachment(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+	graphQLLoadersFrom(ctx).prime(ctx, task)
+	return r.h.graphSvc.GetTask(ctx, taskID)
+}
+
+func (r *mutationGqlResolver) CreateProject(ctx context.Context, input CreateProjectInput) (*ent.Project, error) {
+	project, err := r.h.projectSvc.CreateProject(ctx, input.Name, deref(input.Description), input.WorkflowID)
+	if err != nil {
+		return nil, err
+	}
+	return r.h.graphSvc.GetProject(ctx, project.ID)
+}
+
+func (r *mutationGqlResolver) UpdateProject(ctx context.Context, id uuid.UUID, input UpdateProjectInput) (*ent.Project, error) {
+	_, err := r.h.projectSvc.UpdateProject(ctx, models.ProjectUpdate{
+		ID:          id,
+		Name:        input.Name,
+		Description: input.Description,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return r.h.graphSvc.GetProject(ctx, id)
+}
+
+func (r *queryGqlResolver) Me(ctx context.Context) (*ent.User, error) {
+	actor := models.ActorFrom(ctx)
+	if actor == nil {
+		return nil, nil
+	}
+	return r.h.graphSvc.GetUser(ctx, *actor)
+}
+
+func (r *queryGqlResolver) Task(ctx context.Context, id uuid.UUID) (*ent.Task, error) {
+	task, err := r.h.graphSvc.GetTask(ctx, id)
+	if err != nil {
+		return nil, graphQLNullableError(err, service.TaskNotFoundError)
+	}
+	return task, nil
+}
+
+func (r *queryGqlResolver) Project(ctx context.Context, id uuid.UUID) (*ent.Project, error) {
+	project, err := r.h.graphSvc.GetProject(ctx, id)
+	if err != nil {
+		return nil, graphQLNullableError(err, service.ProjectNotFoundError)
+	}
+	return project, nil
+}
+
+func (r *taskGqlResolver) AttachmentURL(ctx context.Context, obj *ent.Task) (*string, error) {
+	if obj.AttachmentURL == "" {
+		return nil, nil
+	}
+	return &obj.AttachmentURL, nil
+}
+
+func (r *taskGqlResolver) IsCompleted(ctx context.Context, obj *ent.Task) (bool, error) {
+	task, err := graphQLLoadersFrom(ctx).task(ctx, obj.ID)
+	if err != nil {
+		return false, err
+	}
+	return task.IsCompleted, nil
+}
+
+func (r *taskGqlResolver) IsBlocked(ctx context.Context, obj *ent.Task) (bool, error) {
+	task, err := graphQLLoadersFrom(ctx).task(ctx, obj.ID)
+	if err != nil {
+		return false, err
+	}
+	return task.IsBlocked, nil
+}
+
+func (r *taskGqlResolver) Progress(ctx context.Context, obj *ent.Task) (*models.TaskProgress, error) {
+	task, err := graphQLLoadersFrom(ctx).task(ctx, obj.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &task.Progress, nil
+}
+
+func (r *gqlResolver) Mutation() MutationResolver { return &mutationGqlResolver{r} }
+
+type mutationGqlResolver struct{ *gqlResolver }
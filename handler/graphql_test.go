@@ -0,0 +1,285 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/localopsco/go-sample/datastore"
+	"github.com/localopsco/go-sample/datastore/datastoretest"
+	"github.com/localopsco/go-sample/models"
+	"github.com/localopsco/go-sample/service"
+)
+
+type graphQLResponse struct {
+	Data   map[string]json.RawMessage `json:"data"`
+	Errors []struct {
+		Message    string                 `json:"message"`
+		Extensions map[string]interface{} `json:"extensions"`
+	} `json:"errors"`
+}
+
+func (resp *graphQLResponse) code() string {
+	if len(resp.Errors) == 0 {
+		return ""
+	}
+	code, _ := resp.Errors[0].Extensions["code"].(string)
+	return code
+}
+
+// newGraphQLTestRouter returns a router serving the GraphQL API over a new
+// database, and the task service writing to it.
+func newGraphQLTestRouter(t *testing.T) (*gin.Engine, *service.TaskService) {
+	gin.SetMode(gin.TestMode)
+	client := datastoretest.NewClient(t)
+	taskSvc := service.NewTaskService(
+		datastore.NewTaskStore(client),
+		datastore.NewWorkflowStore(client),
+		datastore.NewProjectStore(client),
+		datastore.NewUserStore(client),
+		nil,
+		datastore.NewOutboxStore(client),
+	)
+	projectSvc := service.NewProjectService(datastore.NewProjectStore(client), datastore.NewWorkflowStore(client))
+	h := NewHandler(taskSvc, projectSvc, nil, service.NewUserService(datastore.NewUserStore(client)),
+		nil, nil, nil, nil, nil, nil, nil, nil, service.NewGraphService(datastore.NewGraphStore(client)))
+	router := gin.New()
+	router.GET("/api/graphql", h.GraphQL)
+	router.POST("/api/graphql", h.GraphQL)
+	return router, taskSvc
+}
+
+func postGraphQL(t *testing.T, router http.Handler, query string, variables map[string]interface{}) (int, *graphQLResponse) {
+	t.Helper()
+	body, err := json.Marshal(map[string]interface{}{"query": query, "variables": variables})
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := httptest.NewRequest(http.MethodPost, "/api/graphql", bytes.NewReader(body))
+	r.Header.Set("Content-Type", "application/json")
+	return serveGraphQL(t, router, r)
+}
+
+func serveGraphQL(t *testing.T, router http.Handler, r *http.Request) (int, *graphQLResponse) {
+	t.Helper()
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, r)
+	var resp graphQLResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("decoding %q: %v", w.Body.String(), err)
+	}
+	return w.Code, &resp
+}
+
+func createGraphQLTestTasks(t *testing.T, taskSvc *service.TaskService, tasks ...models.Task) {
+	t.Helper()
+	for _, task := range tasks {
+		if _, err := taskSvc.CreateTask(context.Background(), task); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestGraphQLRejectsOperationsBeforeTheyRun(t *testing.T) {
+	router, _ := newGraphQLTestRouter(t)
+	deep := "{ tasks(first: 1) { edges { node { parent { parent { parent { parent { parent { parent { parent { id } } } } } } } } } } }"
+	complex := "{ tasks(first: 100) { edges { node { children { children { children { id title } } } } } } }"
+	tests := []struct {
+		name   string
+		query  string
+		status int
+		code   string
+	}{
+		{"unparsable", "{ tasks(first: 1) { edges ", http.StatusUnprocessableEntity, "GRAPHQL_PARSE_FAILED"},
+		{"invalid", "{ tasks(first: 1) { nope } }", http.StatusUnprocessableEntity, "GRAPHQL_VALIDATION_FAILED"},
+		{"too deep", deep, http.StatusUnprocessableEntity, gqlDepthLimitExceeded},
+		{"too complex", complex, http.StatusUnprocessableEntity, gqlComplexityLimitExceeded},
+		{"unbounded connection", "{ tasks { totalCount } }", http.StatusUnprocessableEntity, "GRAPHQL_VALIDATION_FAILED"},
+		{"connection too large", "{ tasks(first: 101) { totalCount } }", http.StatusUnprocessableEntity, "GRAPHQL_VALIDATION_FAILED"},
+		{"nested connection without first", "{ projects(first: 1) { edges { node { tasks { totalCount } } } } }", http.StatusUnprocessableEntity, "GRAPHQL_VALIDATION_FAILED"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, resp := postGraphQL(t, router, tt.query, nil)
+			if status != tt.status || resp.code() != tt.code {
+				t.Errorf("got status %d and code %q, want %d and %q: %+v", status, resp.code(), tt.status, tt.code, resp.Errors)
+			}
+			if resp.Data != nil {
+				t.Errorf("got data %v for a rejected operation", resp.Data)
+			}
+		})
+	}
+}
+
+func TestGraphQLDepthCountsFragments(t *testing.T) {
+	router, _ := newGraphQLTestRouter(t)
+	query := `
+		query { tasks(first: 1) { edges { node { ...Ancestors } } } }
+		fragment Ancestors on Task { parent { parent { parent { parent { parent { parent { parent { id } } } } } } } }
+	`
+	status, resp := postGraphQL(t, router, query, nil)
+	if status != http.StatusUnprocessableEntity || resp.code() != gqlDepthLimitExceeded {
+		t.Errorf("got status %d and code %q, want %d and %q", status, resp.code(), http.StatusUnprocessableEntity, gqlDepthLimitExceeded)
+	}
+}
+
+func TestGraphQLRejectsMutationsOverGET(t *testing.T) {
+	router, _ := newGraphQLTestRouter(t)
+	query := url.Values{"query": {`mutation { createTask(input: {title: "a"}) { id } }`}}
+	r := httptest.NewRequest(http.MethodGet, "/api/graphql?"+query.Encode(), nil)
+	status, resp := serveGraphQL(t, router, r)
+	if status != http.StatusNotAcceptable || len(resp.Errors) == 0 {
+		t.Errorf("got status %d and errors %+v, want %d", status, resp.Errors, http.StatusNotAcceptable)
+	}
+}
+
+func TestGraphQLTaskWhereInput(t *testing.T) {
+	router, taskSvc := newGraphQLTestRouter(t)
+	createGraphQLTestTasks(t, taskSvc,
+		models.Task{Title: "Write report", Priority: "high"},
+		models.Task{Title: "Review report", Priority: "low"},
+		models.Task{Title: "Book flights", Priority: "high"},
+	)
+	query := `query($where: TaskWhereInput) {
+		tasks(first: 10, where: $where, orderBy: {field: TITLE, direction: ASC}) {
+			totalCount
+			edges { node { title priority } }
+		}
+	}`
+	tests := []struct {
+		name  string
+		where map[string]interface{}
+		want  []string
+	}{
+		{"priority", map[string]interface{}{"priority": "high"}, []string{"Book flights", "Write report"}},
+		{"title", map[string]interface{}{"titleContainsFold": "REPORT"}, []string{"Review report", "Write report"}},
+		{"and", map[string]interface{}{"and": []interface{}{
+			map[string]interface{}{"priority": "high"},
+			map[string]interface{}{"titleHasPrefix": "Write"},
+		}}, []string{"Write report"}},
+		{"not", map[string]interface{}{"not": map[string]interface{}{"priorityIn": []string{"high"}}}, []string{"Review report"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, resp := postGraphQL(t, router, query, map[string]interface{}{"where": tt.where})
+			if status != http.StatusOK || len(resp.Errors) > 0 {
+				t.Fatalf("got status %d and errors %+v", status, resp.Errors)
+			}
+			var data struct {
+				TotalCount int
+				Edges      []struct{ Node struct{ Title string } }
+			}
+			if err := json.Unmarshal(resp.Data["tasks"], &data); err != nil {
+				t.Fatal(err)
+			}
+			var titles []string
+			for _, edge := range data.Edges {
+				titles = append(titles, edge.Node.Title)
+			}
+			if fmt.Sprint(titles) != fmt.Sprint(tt.want) || data.TotalCount != len(tt.want) {
+				t.Errorf("got %v of %d, want %v", titles, data.TotalCount, tt.want)
+			}
+		})
+	}
+}
+
+func TestGraphQLTasksPagination(t *testing.T) {
+	router, taskSvc := newGraphQLTestRouter(t)
+	createGraphQLTestTasks(t, taskSvc,
+		models.Task{Title: "a"}, models.Task{Title: "b"}, models.Task{Title: "c"},
+	)
+	query := `query($after: Cursor) {
+		tasks(first: 2, after: $after, orderBy: {field: TITLE, direction: ASC}) {
+			pageInfo { hasNextPage endCursor }
+			edges { node { title } }
+		}
+	}`
+	var titles []string
+	var after interface{}
+	for page := 0; ; page++ {
+		if page > 2 {
+			t.Fatal("pagination did not end")
+		}
+		status, resp := postGraphQL(t, router, query, map[string]interface{}{"after": after})
+		if status != http.StatusOK || len(resp.Errors) > 0 {
+			t.Fatalf("got status %d and errors %+v", status, resp.Errors)
+		}
+		var data struct {
+			PageInfo struct {
+				HasNextPage bool
+				EndCursor   string
+			}
+			Edges []struct{ Node struct{ Title string } }
+		}
+		if err := json.Unmarshal(resp.Data["tasks"], &data); err != nil {
+			t.Fatal(err)
+		}
+		for _, edge := range data.Edges {
+			titles = append(titles, edge.Node.Title)
+		}
+		if !data.PageInfo.HasNextPage {
+			break
+		}
+		after = data.PageInfo.EndCursor
+	}
+	if got := strings.Join(titles, ","); got != "a,b,c" {
+		t.Errorf("got %s, want a,b,c", got)
+	}
+}
+
+func TestGraphQLCreateTask(t *testing.T) {
+	router, _ := newGraphQLTestRouter(t)
+	mutation := `mutation($input: CreateTaskInput!) {
+		createTask(input: $input) { id title priority labels isCompleted isBlocked progress { done total } status { key } }
+	}`
+	status, resp := postGraphQL(t, router, mutation, map[string]interface{}{
+		"input": map[string]interface{}{"title": "Write report", "priority": "urgent", "labels": []string{"work"}},
+	})
+	if status != http.StatusOK || len(resp.Errors) > 0 {
+		t.Fatalf("got status %d and errors %+v", status, resp.Errors)
+	}
+	var task struct {
+		ID          string
+		Title       string
+		Priority    string
+		Labels      []string
+		IsCompleted bool
+		Progress    struct{ Done, Total int }
+		Status      struct{ Key string }
+	}
+	if err := json.Unmarshal(resp.Data["createTask"], &task); err != nil {
+		t.Fatal(err)
+	}
+	if task.Title != "Write report" || task.Priority != "urgent" || fmt.Sprint(task.Labels) != "[work]" || task.IsCompleted || task.Status.Key == "" {
+		t.Errorf("got %+v", task)
+	}
+
+	status, resp = postGraphQL(t, router, `query($id: ID!) { node(id: $id) { id ... on Task { title } } }`, map[string]interface{}{"id": task.ID})
+	if status != http.StatusOK || len(resp.Errors) > 0 {
+		t.Fatalf("got status %d and errors %+v", status, resp.Errors)
+	}
+	if want := fmt.Sprintf(`{"id":%q,"title":"Write report"}`, task.ID); string(resp.Data["node"]) != want {
+		t.Errorf("got node %s, want %s", resp.Data["node"], want)
+	}
+}
+
+func TestGraphQLUpdateTaskErrors(t *testing.T) {
+	router, _ := newGraphQLTestRouter(t)
+	mutation := `mutation { updateTask(id: "6f1c7c55-2b4b-4e39-9f6c-9f5e0c9c0c3b", input: {title: "b"}) { id } }`
+	status, resp := postGraphQL(t, router, mutation, nil)
+	if status != http.StatusOK || resp.code() != gqlNotFound {
+		t.Errorf("got status %d and errors %+v, want code %s", status, resp.Errors, gqlNotFound)
+	}
+
+	status, resp = postGraphQL(t, router, `{ task(id: "6f1c7c55-2b4b-4e39-9f6c-9f5e0c9c0c3b") { id } }`, nil)
+	if status != http.StatusOK || len(resp.Errors) > 0 || string(resp.Data["task"]) != "null" {
+		t.Errorf("got status %d, data %s and errors %+v, want a null task", status, resp.Data["task"], resp.Errors)
+	}
+}
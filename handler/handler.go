@@ -1,24 +1,57 @@
 package handler
 
 import (
+	"errors"
 	"log"
 	"net/http"
+	"strconv"
 	"strings"
+	"time"
 
+	gqlhandler "github.com/99designs/gqlgen/graphql/handler"
 	"github.com/gin-gonic/gin"
 	"github.com/gin-gonic/gin/binding"
 	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/models"
 	"github.com/localopsco/go-sample/service"
+	"github.com/localopsco/go-sample/taskquery"
 )
 
 type Handler struct {
-	svc *service.TaskService
+	svc            *service.TaskService
+	projectSvc     *service.ProjectService
+	workflowSvc    *service.WorkflowService
+	userSvc        *service.UserService
+	commentSvc     *service.CommentService
+	auditSvc       *service.AuditService
+	searchSvc      *service.SearchService
+	savedViewSvc   *service.SavedViewService
+	idempotencySvc *service.IdempotencyService
+	webhookSvc     *service.WebhookService
+	streamSvc      *service.StreamService
+	exportSvc      *service.ExportService
+	graphSvc       *service.GraphService
+	graphql        *gqlhandler.Server
 }
 
-func NewHandler(taskSvc *service.TaskService) *Handler {
-	return &Handler{
-		taskSvc,
+func NewHandler(taskSvc *service.TaskService, projectSvc *service.ProjectService, workflowSvc *service.WorkflowService, userSvc *service.UserService, commentSvc *service.CommentService, auditSvc *service.AuditService, searchSvc *service.SearchService, savedViewSvc *service.SavedViewService, idempotencySvc *service.IdempotencyService, webhookSvc *service.WebhookService, streamSvc *service.StreamService, exportSvc *service.ExportService, graphSvc *service.GraphService) *Handler {
+	h := &Handler{
+		svc:            taskSvc,
+		projectSvc:     projectSvc,
+		workflowSvc:    workflowSvc,
+		userSvc:        userSvc,
+		commentSvc:     commentSvc,
+		auditSvc:       auditSvc,
+		searchSvc:      searchSvc,
+		savedViewSvc:   savedViewSvc,
+		idempotencySvc: idempotencySvc,
+		webhookSvc:     webhookSvc,
+		streamSvc:      streamSvc,
+		exportSvc:      exportSvc,
+		graphSvc:       graphSvc,
 	}
+	h.graphql = newGraphQLServer(h)
+	return h
 }
 
 func (h *Handler) Health(c *gin.Context) {
@@ -29,9 +62,18 @@ func (h *Handler) Health(c *gin.Context) {
 
 func (h *Handler) CreateTask(c *gin.Context) {
 	var reqBody struct {
-		Title       string `json:"title"`
-		Description string `json:"description"`
-		IsCompleted bool   `json:"is_completed"`
+		Title           string             `json:"title"`
+		Description     string             `json:"description"`
+		Status          string             `json:"status"`
+		IsCompleted     bool               `json:"is_completed"`
+		ProjectID       *uuid.UUID         `json:"project_id"`
+		ParentID        *uuid.UUID         `json:"parent_id"`
+		StartAt         *time.Time         `json:"start_at"`
+		DueAt           *time.Time         `json:"due_at"`
+		Priority        string             `json:"priority"`
+		EstimateMinutes *int               `json:"estimate_minutes"`
+		Recurrence      *models.Recurrence `json:"recurrence"`
+		Labels          []string           `json:"labels"`
 	}
 	err := c.ShouldBindWith(&reqBody, binding.JSON)
 	if err != nil {
@@ -40,8 +82,39 @@ func (h *Handler) CreateTask(c *gin.Context) {
 		})
 		return
 	}
-	task, err := h.svc.CreateTask(reqBody.Title, reqBody.Description, reqBody.IsCompleted)
+	task, err := h.svc.CreateTask(c.Request.Context(), models.Task{
+		Title:           reqBody.Title,
+		Description:     reqBody.Description,
+		Status:          reqBody.Status,
+		IsCompleted:     reqBody.IsCompleted,
+		ProjectID:       reqBody.ProjectID,
+		ParentID:        reqBody.ParentID,
+		StartAt:         reqBody.StartAt,
+		DueAt:           reqBody.DueAt,
+		Priority:        reqBody.Priority,
+		EstimateMinutes: reqBody.EstimateMinutes,
+		Recurrence:      reqBody.Recurrence,
+		Labels:          reqBody.Labels,
+	})
 	if err != nil {
+		if isInvalidTaskFieldsError(err) {
+			c.JSON(http.StatusUnprocessableEntity, gin.H{
+				"message": err.Error(),
+			})
+			return
+		}
+		if err.Error() == service.ParentTaskNotFoundError || err.Error() == service.ProjectNotFoundError {
+			c.JSON(http.StatusNotFound, gin.H{
+				"message": err.Error(),
+			})
+			return
+		}
+		if err.Error() == service.MaxDepthExceededError {
+			c.JSON(http.StatusUnprocessableEntity, gin.H{
+				"message": err.Error(),
+			})
+			return
+		}
 		log.Printf("error creating task: %v", err)
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"message": "Invalid data",
@@ -65,7 +138,7 @@ func (h *Handler) GetTask(c *gin.Context) {
 		})
 		return
 	}
-	task, err := h.svc.GetTask(taskID)
+	task, err := h.svc.GetTask(c.Request.Context(), taskID)
 	if err != nil {
 		if err.Error() == service.TaskNotFoundError {
 			c.JSON(http.StatusNotFound, gin.H{
@@ -86,9 +159,16 @@ func (h *Handler) GetTask(c *gin.Context) {
 func (h *Handler) UpdateTask(c *gin.Context) {
 
 	var reqBody struct {
-		Title       string `json:"title"`
-		Description string `json:"description"`
-		IsCompleted bool   `json:"is_completed"`
+		Title           string                             `json:"title"`
+		Description     string                             `json:"description"`
+		Status          *string                            `json:"status"`
+		IsCompleted     *bool                              `json:"is_completed"`
+		StartAt         models.Optional[time.Time]         `json:"start_at"`
+		DueAt           models.Optional[time.Time]         `json:"due_at"`
+		Priority        *string                            `json:"priority"`
+		EstimateMinutes models.Optional[int]               `json:"estimate_minutes"`
+		Recurrence      models.Optional[models.Recurrence] `json:"recurrence"`
+		Labels          *[]string                          `json:"labels"`
 	}
 	err := c.ShouldBindWith(&reqBody, binding.JSON)
 	if err != nil {
@@ -107,14 +187,47 @@ func (h *Handler) UpdateTask(c *gin.Context) {
 		return
 	}
 
-	task, err := h.svc.UpdateTask(taskID, reqBody.Title, reqBody.Description, reqBody.IsCompleted)
+	force, _ := strconv.ParseBool(c.Query("force"))
+	task, err := h.svc.UpdateTask(c.Request.Context(), models.TaskUpdate{
+		ID:              taskID,
+		Title:           reqBody.Title,
+		Description:     reqBody.Description,
+		Status:          reqBody.Status,
+		IsCompleted:     reqBody.IsCompleted,
+		StartAt:         reqBody.StartAt,
+		DueAt:           reqBody.DueAt,
+		Priority:        reqBody.Priority,
+		EstimateMinutes: reqBody.EstimateMinutes,
+		Recurrence:      reqBody.Recurrence,
+		Labels:          reqBody.Labels,
+	}, force)
 	if err != nil {
+		var transitionErr *service.TransitionError
+		if errors.As(err, &transitionErr) {
+			c.JSON(http.StatusUnprocessableEntity, gin.H{
+				"message":             err.Error(),
+				"allowed_transitions": transitionErr.Allowed,
+			})
+			return
+		}
+		if isInvalidTaskFieldsError(err) {
+			c.JSON(http.StatusUnprocessableEntity, gin.H{
+				"message": err.Error(),
+			})
+			return
+		}
 		if err.Error() == service.TaskNotFoundError {
 			c.JSON(http.StatusNotFound, gin.H{
 				"message": err.Error(),
 			})
 			return
 		}
+		if err.Error() == service.OpenSubtasksError || err.Error() == service.TaskBlockedError {
+			c.JSON(http.StatusConflict, gin.H{
+				"message": err.Error(),
+			})
+			return
+		}
 		log.Printf("error creating task: %v", err)
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"message": "Invalid data",
@@ -134,7 +247,7 @@ func (h *Handler) DeleteTask(c *gin.Context) {
 		})
 		return
 	}
-	err = h.svc.DeleteTask(taskID)
+	err = h.svc.DeleteTask(c.Request.Context(), taskID)
 	if err != nil {
 		if err.Error() == service.TaskNotFoundError {
 			c.JSON(http.StatusNotFound, gin.H{
@@ -155,8 +268,21 @@ func (h *Handler) DeleteTask(c *gin.Context) {
 }
 
 func (h *Handler) ListTasks(c *gin.Context) {
-	tasks, err := h.svc.ListTasks()
+	filter, ok := parseTaskFilter(c)
+	if !ok {
+		return
+	}
+	tasks, err := h.svc.ListTasks(c.Request.Context(), filter)
 	if err != nil {
+		if queryError(c, err) {
+			return
+		}
+		if err.Error() == service.InvalidFilterError {
+			c.JSON(http.StatusUnprocessableEntity, gin.H{
+				"message": err.Error(),
+			})
+			return
+		}
 		log.Printf("error getting task: %v", err)
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"message": "Unknown error. Something went wrong.",
@@ -167,6 +293,78 @@ func (h *Handler) ListTasks(c *gin.Context) {
 	c.JSON(http.StatusOK, tasks)
 }
 
+func (h *Handler) ListChildren(c *gin.Context) {
+	taskIDStr := strings.TrimSpace(c.Param("task_id"))
+	taskID, err := uuid.Parse(taskIDStr)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"message": "Invalid task_id",
+		})
+		return
+	}
+	tasks, err := h.svc.ListChildren(c.Request.Context(), taskID)
+	if err != nil {
+		if err.Error() == service.TaskNotFoundError {
+			c.JSON(http.StatusNotFound, gin.H{
+				"message": err.Error(),
+			})
+			return
+		}
+		log.Printf("error listing subtasks: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"message": "Unknown error. Something went wrong.",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, tasks)
+}
+
+func (h *Handler) ReorderChildren(c *gin.Context) {
+	var reqBody struct {
+		TaskIDs []uuid.UUID `json:"task_ids"`
+	}
+	err := c.ShouldBindWith(&reqBody, binding.JSON)
+	if err != nil {
+		c.JSON(http.StatusUnprocessableEntity, gin.H{
+			"message": "Invalid data",
+		})
+		return
+	}
+
+	taskIDStr := strings.TrimSpace(c.Param("task_id"))
+	taskID, err := uuid.Parse(taskIDStr)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"message": "Invalid task_id",
+		})
+		return
+	}
+
+	tasks, err := h.svc.ReorderChildren(c.Request.Context(), taskID, reqBody.TaskIDs)
+	if err != nil {
+		if err.Error() == service.TaskNotFoundError {
+			c.JSON(http.StatusNotFound, gin.H{
+				"message": err.Error(),
+			})
+			return
+		}
+		if err.Error() == service.InvalidSubtaskOrderError {
+			c.JSON(http.StatusUnprocessableEntity, gin.H{
+				"message": err.Error(),
+			})
+			return
+		}
+		log.Printf("error reordering subtasks: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"message": "Unknown error. Something went wrong.",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, tasks)
+}
+
 func (h *Handler) AddAttachment(c *gin.Context) {
 	taskIDStr := strings.TrimSpace(c.Param("task_id"))
 	taskID, err := uuid.Parse(taskIDStr)
@@ -185,7 +383,7 @@ func (h *Handler) AddAttachment(c *gin.Context) {
 		return
 	}
 
-	task, err := h.svc.AddAttachment(taskID, file)
+	task, err := h.svc.AddAttachment(c.Request.Context(), taskID, file)
 	if err != nil {
 		if err.Error() == service.AttachmentsNotEnabledError {
 			c.JSON(http.StatusForbidden, gin.H{
@@ -219,7 +417,7 @@ func (h *Handler) DeleteAttachment(c *gin.Context) {
 		return
 	}
 
-	task, err := h.svc.DeleteAttachment(taskID)
+	task, err := h.svc.DeleteAttachment(c.Request.Context(), taskID)
 	if err != nil {
 		if err.Error() == service.AttachmentsNotEnabledError {
 			c.JSON(http.StatusForbidden, gin.H{
@@ -242,3 +440,108 @@ func (h *Handler) DeleteAttachment(c *gin.Context) {
 
 	c.JSON(http.StatusOK, task)
 }
+
+func isInvalidTaskFieldsError(err error) bool {
+	switch err.Error() {
+	case service.InvalidPriorityError, service.InvalidScheduleError, service.InvalidEstimateError, service.UnknownStatusError,
+		service.InvalidRecurrenceError, service.RecurrenceNeedsDueDateError, service.InvalidLabelError, service.TooManyLabelsError:
+		return true
+	}
+	return false
+}
+
+// parseTaskFilter reads the list filters shared by the task listing and
+// search endpoints. It responds with an error and returns false if they are
+// invalid.
+func parseTaskFilter(c *gin.Context) (models.TaskFilter, bool) {
+	loc, err := time.LoadLocation(c.DefaultQuery("tz", "UTC"))
+	if err != nil {
+		c.JSON(http.StatusUnprocessableEntity, gin.H{
+			"message": "Invalid tz",
+		})
+		return models.TaskFilter{}, false
+	}
+	projectID, err := parseOptionalUUID(c.Query("project_id"))
+	if err != nil {
+		c.JSON(http.StatusUnprocessableEntity, gin.H{
+			"message": "Invalid project_id",
+		})
+		return models.TaskFilter{}, false
+	}
+	filter := models.TaskFilter{
+		Due:        c.Query("due"),
+		Priority:   c.Query("priority"),
+		Status:     c.Query("status"),
+		ProjectID:  projectID,
+		Unassigned: c.Query("unassigned") == "true",
+		Label:      strings.TrimSpace(c.Query("label")),
+		Query:      strings.TrimSpace(c.Query("q")),
+		Sort:       c.Query("sort"),
+		Location:   loc,
+	}
+	if assignee := c.Query("assignee"); assignee == "me" {
+		user, ok := currentUser(c)
+		if !ok {
+			return models.TaskFilter{}, false
+		}
+		filter.AssigneeID = &user.ID
+	} else if filter.AssigneeID, err = parseOptionalUUID(assignee); err != nil {
+		c.JSON(http.StatusUnprocessableEntity, gin.H{
+			"message": "Invalid assignee",
+		})
+		return models.TaskFilter{}, false
+	}
+	if c.Query("watching") == "true" {
+		user, ok := currentUser(c)
+		if !ok {
+			return models.TaskFilter{}, false
+		}
+		filter.WatcherID = &user.ID
+	}
+	return filter, true
+}
+
+// queryError responds to errors in a task query with the position of the
+// problem, and reports whether err was one.
+func queryError(c *gin.Context, err error) bool {
+	var parseErr *taskquery.ParseError
+	if !errors.As(err, &parseErr) {
+		return false
+	}
+	c.JSON(http.StatusUnprocessableEntity, gin.H{
+		"message":  "Invalid query: " + parseErr.Message,
+		"position": parseErr.Position,
+	})
+	return true
+}
+
+// parseOptionalUUID parses an optional UUID query parameter.
+func parseOptionalUUID(value string) (*uuid.UUID, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil, nil
+	}
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return nil, err
+	}
+	return &id, nil
+}
+
+// parsePagination reads the limit and offset query parameters. Missing
+// values are returned as zero and defaulted by the service.
+func parsePagination(c *gin.Context) (int, int, error) {
+	var limit, offset int
+	var err error
+	if s := c.Query("limit"); s != "" {
+		if limit, err = strconv.Atoi(s); err != nil || limit < 0 {
+			return 0, 0, errors.New("Invalid limit")
+		}
+	}
+	if s := c.Query("offset"); s != "" {
+		if offset, err = strconv.Atoi(s); err != nil || offset < 0 {
+			return 0, 0, errors.New("Invalid offset")
+		}
+	}
+	return limit, offset, nil
+}
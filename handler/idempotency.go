@@ -0,0 +1,199 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/localopsco/go-sample/models"
+	"github.com/localopsco/go-sample/service"
+)
+
+const idempotencyKeyHeader = "Idempotency-Key"
+const idempotentReplayedHeader = "Idempotent-Replayed"
+
+// maxIdempotentBodyBytes caps the size of a request with an idempotency key,
+// whose body is held in memory to be fingerprinted.
+const maxIdempotentBodyBytes = 32 << 20
+
+// unreplayedHeaders are left out of stored responses, along with the
+// request ID, since they describe the response as sent rather than its
+// content.
+var unreplayedHeaders = map[string]bool{
+	"Date":           true,
+	"Content-Length": true,
+	"Set-Cookie":     true,
+}
+
+// Idempotency makes a POST endpoint safe to retry. The first response to a
+// request with an Idempotency-Key header is stored, and repeating the
+// request with the same key replays it instead of running the handler
+// again. Server errors are not stored, so those requests can be retried.
+// Requests with a key and a body over maxIdempotentBodyBytes are rejected.
+func (h *Handler) Idempotency(c *gin.Context) {
+	key := c.GetHeader(idempotencyKeyHeader)
+	if key == "" {
+		c.Next()
+		return
+	}
+	body, err := io.ReadAll(http.MaxBytesReader(c.Writer, c.Request.Body, maxIdempotentBodyBytes))
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			c.AbortWithStatusJSON(http.StatusRequestEntityTooLarge, gin.H{
+				"message": "Request is too large",
+			})
+			return
+		}
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+			"message": "Invalid data",
+		})
+		return
+	}
+	c.Request.Body = io.NopCloser(bytes.NewReader(body))
+	idempotencyKey := models.IdempotencyKey{
+		Key:         key,
+		Caller:      idempotencyCaller(c),
+		Fingerprint: requestFingerprint(c.Request, body),
+	}
+
+	stored, err := h.idempotencySvc.Begin(c.Request.Context(), idempotencyKey)
+	if err != nil {
+		switch err.Error() {
+		case service.InvalidIdempotencyKeyError, service.IdempotencyKeyReusedError:
+			c.AbortWithStatusJSON(http.StatusUnprocessableEntity, gin.H{
+				"message": err.Error(),
+			})
+			return
+		case service.IdempotencyKeyInProgressError:
+			c.AbortWithStatusJSON(http.StatusConflict, gin.H{
+				"message": err.Error(),
+			})
+			return
+		}
+		log.Printf("error checking idempotency key: %v", err)
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"message": "Unknown error. Something went wrong.",
+		})
+		return
+	}
+	if stored != nil {
+		for name, values := range stored.Header {
+			c.Writer.Header()[name] = values
+		}
+		c.Header(idempotentReplayedHeader, "true")
+		c.Status(stored.Status)
+		c.Writer.Write(stored.Body)
+		c.Abort()
+		return
+	}
+
+	recorder := &responseRecorder{ResponseWriter: c.Writer}
+	c.Writer = recorder
+	c.Next()
+
+	// Store the response even if the client went away in the meantime.
+	ctx := context.WithoutCancel(c.Request.Context())
+	if recorder.Status() >= http.StatusInternalServerError {
+		if err := h.idempotencySvc.Release(ctx, idempotencyKey); err != nil {
+			log.Printf("error releasing idempotency key: %v", err)
+		}
+		return
+	}
+	header := make(map[string][]string)
+	for name, values := range recorder.Header() {
+		if !unreplayedHeaders[name] && name != http.CanonicalHeaderKey(requestIDHeader) {
+			header[name] = values
+		}
+	}
+	err = h.idempotencySvc.Complete(ctx, idempotencyKey, models.RecordedResponse{
+		Status: recorder.Status(),
+		Header: header,
+		Body:   recorder.body.Bytes(),
+	})
+	if err != nil {
+		log.Printf("error storing idempotent response: %v", err)
+	}
+}
+
+// responseRecorder keeps a copy of the body written to a response.
+type responseRecorder struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *responseRecorder) Write(data []byte) (int, error) {
+	w.body.Write(data)
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *responseRecorder) WriteString(s string) (int, error) {
+	w.body.WriteString(s)
+	return w.ResponseWriter.WriteString(s)
+}
+
+// idempotencyCaller identifies who sent a request: the user, or the client
+// address of anonymous requests.
+func idempotencyCaller(c *gin.Context) string {
+	if user := optionalUser(c); user != nil {
+		return "user:" + user.ID.String()
+	}
+	return "ip:" + c.ClientIP()
+}
+
+// requestFingerprint hashes the method, path and payload of a request. JSON
+// payloads are hashed in a canonical form and multipart payloads part by
+// part, so that retries encoding the same payload differently, such as with
+// a new multipart boundary, still match.
+func requestFingerprint(r *http.Request, body []byte) string {
+	hash := sha256.New()
+	fmt.Fprintf(hash, "%s %s\n", r.Method, r.URL.Path)
+	mediaType, params, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	switch {
+	case mediaType == "application/json":
+		var payload any
+		if err := json.Unmarshal(body, &payload); err == nil {
+			canonical, _ := json.Marshal(payload)
+			hash.Write(canonical)
+			break
+		}
+		hash.Write(body)
+	case strings.HasPrefix(mediaType, "multipart/"):
+		if err := hashMultipart(hash, body, params["boundary"]); err != nil {
+			fmt.Fprintf(hash, "invalid multipart\n")
+			hash.Write(body)
+		}
+	default:
+		hash.Write(body)
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+func hashMultipart(w io.Writer, body []byte, boundary string) error {
+	reader := multipart.NewReader(bytes.NewReader(body), boundary)
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		content := sha256.New()
+		if _, err := io.Copy(content, part); err != nil {
+			return err
+		}
+		fmt.Fprintf(w, "%q %q %x\n", part.FormName(), part.FileName(), content.Sum(nil))
+	}
+}
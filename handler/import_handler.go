@@ -0,0 +1,108 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/localopsco/go-sample/models"
+	"github.com/localopsco/go-sample/service"
+)
+
+// maxImportBytes caps the size of an uploaded import.
+const maxImportBytes = 10 << 20
+
+// ImportTasks creates tasks from a file in the ?format given: csv, json,
+// todotxt or taskwarrior. The file is the request body or the file field of
+// a multipart form. CSV columns are mapped to task fields with ?mapping, or
+// a mapping form field, holding a JSON object from field to column name.
+// With ?dry_run=true nothing is created and the result previews the tasks.
+func (h *Handler) ImportTasks(c *gin.Context) {
+	loc, err := time.LoadLocation(c.DefaultQuery("tz", "UTC"))
+	if err != nil {
+		c.JSON(http.StatusUnprocessableEntity, gin.H{
+			"message": "Invalid tz",
+		})
+		return
+	}
+	projectID, err := parseOptionalUUID(c.Query("project_id"))
+	if err != nil {
+		c.JSON(http.StatusUnprocessableEntity, gin.H{
+			"message": "Invalid project_id",
+		})
+		return
+	}
+	dryRun, _ := strconv.ParseBool(c.Query("dry_run"))
+	opts := models.ImportOptions{
+		Format:    c.DefaultQuery("format", models.ImportCSV),
+		DryRun:    dryRun,
+		ProjectID: projectID,
+		Location:  loc,
+	}
+
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxImportBytes)
+	var body io.Reader = c.Request.Body
+	mapping := c.Query("mapping")
+	if strings.HasPrefix(c.ContentType(), "multipart/") {
+		header, err := c.FormFile("file")
+		if err != nil {
+			h.importError(c, errors.New(service.InvalidImportFileError))
+			return
+		}
+		file, err := header.Open()
+		if err != nil {
+			h.importError(c, err)
+			return
+		}
+		defer file.Close()
+		body = file
+		if value := c.PostForm("mapping"); value != "" {
+			mapping = value
+		}
+	}
+	if mapping != "" {
+		if err := json.Unmarshal([]byte(mapping), &opts.Mapping); err != nil {
+			h.importError(c, errors.New(service.InvalidImportMappingError))
+			return
+		}
+	}
+
+	result, err := h.svc.Import(c.Request.Context(), body, opts)
+	if err != nil {
+		h.importError(c, err)
+		return
+	}
+	status := http.StatusOK
+	if result.Created > 0 {
+		status = http.StatusCreated
+	}
+	c.JSON(status, result)
+}
+
+func (h *Handler) importError(c *gin.Context, err error) {
+	var tooLarge *http.MaxBytesError
+	if errors.As(err, &tooLarge) {
+		c.JSON(http.StatusRequestEntityTooLarge, gin.H{
+			"message": "The file is too large",
+		})
+		return
+	}
+	switch err.Error() {
+	case service.InvalidImportFormatError, service.InvalidImportFileError, service.InvalidImportMappingError,
+		service.ImportColumnNotFoundError, service.ImportTooLargeError:
+		c.JSON(http.StatusUnprocessableEntity, gin.H{
+			"message": err.Error(),
+		})
+		return
+	}
+	log.Printf("error importing tasks: %v", err)
+	c.JSON(http.StatusInternalServerError, gin.H{
+		"message": "Unknown error. Something went wrong.",
+	})
+}
@@ -0,0 +1,87 @@
+package handler
+
+import (
+	"errors"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/models"
+	"github.com/localopsco/go-sample/service"
+)
+
+func (h *Handler) MoveTask(c *gin.Context) {
+	var reqBody struct {
+		Before  *uuid.UUID `json:"before"`
+		After   *uuid.UUID `json:"after"`
+		GroupBy string     `json:"group_by"`
+		Column  *string    `json:"column"`
+	}
+	err := c.ShouldBindWith(&reqBody, binding.JSON)
+	if err != nil {
+		c.JSON(http.StatusUnprocessableEntity, gin.H{
+			"message": "Invalid data",
+		})
+		return
+	}
+
+	taskIDStr := strings.TrimSpace(c.Param("task_id"))
+	taskID, err := uuid.Parse(taskIDStr)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"message": "Invalid task_id",
+		})
+		return
+	}
+
+	force, _ := strconv.ParseBool(c.Query("force"))
+	result, err := h.svc.MoveTask(c.Request.Context(), models.TaskMove{
+		ID:      taskID,
+		Before:  reqBody.Before,
+		After:   reqBody.After,
+		GroupBy: reqBody.GroupBy,
+		Column:  reqBody.Column,
+	}, force)
+	if err != nil {
+		h.moveError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+func (h *Handler) moveError(c *gin.Context, err error) {
+	var transitionErr *service.TransitionError
+	if errors.As(err, &transitionErr) {
+		c.JSON(http.StatusUnprocessableEntity, gin.H{
+			"message":             err.Error(),
+			"allowed_transitions": transitionErr.Allowed,
+		})
+		return
+	}
+	switch err.Error() {
+	case service.TaskNotFoundError, service.AnchorNotFoundError, service.ProjectNotFoundError:
+		c.JSON(http.StatusNotFound, gin.H{
+			"message": err.Error(),
+		})
+	case service.InvalidMoveError, service.AnchorColumnError, service.AnchorOrderError,
+		service.InvalidBoardGroupError, service.InvalidColumnError, service.TaskNotInProjectError,
+		service.InvalidLabelError, service.TooManyLabelsError:
+		c.JSON(http.StatusUnprocessableEntity, gin.H{
+			"message": err.Error(),
+		})
+	case service.WIPLimitExceededError, service.OpenSubtasksError, service.TaskBlockedError:
+		c.JSON(http.StatusConflict, gin.H{
+			"message": err.Error(),
+		})
+	default:
+		log.Printf("error moving task: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"message": "Unknown error. Something went wrong.",
+		})
+	}
+}
@@ -0,0 +1,144 @@
+package handler
+
+import (
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/models"
+	"github.com/localopsco/go-sample/service"
+)
+
+func (h *Handler) CreateProject(c *gin.Context) {
+	var reqBody struct {
+		Name        string     `json:"name"`
+		Description string     `json:"description"`
+		WorkflowID  *uuid.UUID `json:"workflow_id"`
+	}
+	err := c.ShouldBindWith(&reqBody, binding.JSON)
+	if err != nil {
+		c.JSON(http.StatusUnprocessableEntity, gin.H{
+			"message": "Invalid data",
+		})
+		return
+	}
+	project, err := h.projectSvc.CreateProject(c.Request.Context(), reqBody.Name, reqBody.Description, reqBody.WorkflowID)
+	if err != nil {
+		if err.Error() == service.InvalidProjectError {
+			c.JSON(http.StatusUnprocessableEntity, gin.H{
+				"message": err.Error(),
+			})
+			return
+		}
+		if err.Error() == service.WorkflowNotFoundError {
+			c.JSON(http.StatusNotFound, gin.H{
+				"message": err.Error(),
+			})
+			return
+		}
+		log.Printf("error creating project: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"message": "Unknown error. Something went wrong.",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, project)
+}
+
+func (h *Handler) GetProject(c *gin.Context) {
+	projectIDStr := strings.TrimSpace(c.Param("project_id"))
+	projectID, err := uuid.Parse(projectIDStr)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"message": "Invalid project_id",
+		})
+		return
+	}
+	project, err := h.projectSvc.GetProject(c.Request.Context(), projectID)
+	if err != nil {
+		if err.Error() == service.ProjectNotFoundError {
+			c.JSON(http.StatusNotFound, gin.H{
+				"message": err.Error(),
+			})
+			return
+		}
+		log.Printf("error getting project: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"message": "Unknown error. Something went wrong.",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, project)
+}
+
+func (h *Handler) ListProjects(c *gin.Context) {
+	projects, err := h.projectSvc.ListProjects(c.Request.Context())
+	if err != nil {
+		log.Printf("error listing projects: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"message": "Unknown error. Something went wrong.",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, projects)
+}
+
+func (h *Handler) UpdateProject(c *gin.Context) {
+	var reqBody struct {
+		Name        *string         `json:"name"`
+		Description *string         `json:"description"`
+		WIPLimits   *map[string]int `json:"wip_limits"`
+		WIPPolicy   *string         `json:"wip_policy"`
+	}
+	err := c.ShouldBindWith(&reqBody, binding.JSON)
+	if err != nil {
+		c.JSON(http.StatusUnprocessableEntity, gin.H{
+			"message": "Invalid data",
+		})
+		return
+	}
+
+	projectIDStr := strings.TrimSpace(c.Param("project_id"))
+	projectID, err := uuid.Parse(projectIDStr)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"message": "Invalid project_id",
+		})
+		return
+	}
+
+	project, err := h.projectSvc.UpdateProject(c.Request.Context(), models.ProjectUpdate{
+		ID:          projectID,
+		Name:        reqBody.Name,
+		Description: reqBody.Description,
+		WIPLimits:   reqBody.WIPLimits,
+		WIPPolicy:   reqBody.WIPPolicy,
+	})
+	if err != nil {
+		switch err.Error() {
+		case service.InvalidProjectError, service.InvalidWIPLimitsError, service.InvalidWIPPolicyError:
+			c.JSON(http.StatusUnprocessableEntity, gin.H{
+				"message": err.Error(),
+			})
+			return
+		case service.ProjectNotFoundError:
+			c.JSON(http.StatusNotFound, gin.H{
+				"message": err.Error(),
+			})
+			return
+		}
+		log.Printf("error updating project: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"message": "Unknown error. Something went wrong.",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, project)
+}
@@ -0,0 +1,21 @@
+package handler
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/models"
+)
+
+const requestIDHeader = "X-Request-ID"
+
+// RequestID tags each request with the ID from its X-Request-ID header, or
+// a new one, and echoes it back so clients can correlate audit events.
+func (h *Handler) RequestID(c *gin.Context) {
+	requestID := c.GetHeader(requestIDHeader)
+	if requestID == "" || len(requestID) > 128 {
+		requestID = uuid.NewString()
+	}
+	c.Header(requestIDHeader, requestID)
+	c.Request = c.Request.WithContext(models.WithRequestID(c.Request.Context(), requestID))
+	c.Next()
+}
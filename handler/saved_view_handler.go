@@ -0,0 +1,210 @@
+package handler
+
+import (
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/models"
+	"github.com/localopsco/go-sample/service"
+)
+
+func (h *Handler) CreateSavedView(c *gin.Context) {
+	var reqBody struct {
+		Name       string             `json:"name"`
+		Query      string             `json:"query"`
+		Filters    models.ViewFilters `json:"filters"`
+		Sort       string             `json:"sort"`
+		Visibility string             `json:"visibility"`
+	}
+	user, ok := currentUser(c)
+	if !ok {
+		return
+	}
+	err := c.ShouldBindWith(&reqBody, binding.JSON)
+	if err != nil {
+		c.JSON(http.StatusUnprocessableEntity, gin.H{
+			"message": "Invalid data",
+		})
+		return
+	}
+	view, err := h.savedViewSvc.CreateSavedView(c.Request.Context(), user, models.SavedView{
+		Name:       reqBody.Name,
+		Query:      reqBody.Query,
+		Filters:    reqBody.Filters,
+		Sort:       reqBody.Sort,
+		Visibility: reqBody.Visibility,
+	})
+	if err != nil {
+		h.savedViewError(c, "creating", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view)
+}
+
+func (h *Handler) ListSavedViews(c *gin.Context) {
+	user, ok := currentUser(c)
+	if !ok {
+		return
+	}
+	views, err := h.savedViewSvc.ListSavedViews(c.Request.Context(), user)
+	if err != nil {
+		h.savedViewError(c, "listing", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, views)
+}
+
+func (h *Handler) GetSavedView(c *gin.Context) {
+	user, ok := currentUser(c)
+	if !ok {
+		return
+	}
+	viewID, ok := viewParam(c)
+	if !ok {
+		return
+	}
+	view, err := h.savedViewSvc.GetSavedView(c.Request.Context(), user, viewID)
+	if err != nil {
+		h.savedViewError(c, "getting", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view)
+}
+
+func (h *Handler) UpdateSavedView(c *gin.Context) {
+	var reqBody struct {
+		Name       *string             `json:"name"`
+		Query      *string             `json:"query"`
+		Filters    *models.ViewFilters `json:"filters"`
+		Sort       *string             `json:"sort"`
+		Visibility *string             `json:"visibility"`
+	}
+	user, ok := currentUser(c)
+	if !ok {
+		return
+	}
+	viewID, ok := viewParam(c)
+	if !ok {
+		return
+	}
+	err := c.ShouldBindWith(&reqBody, binding.JSON)
+	if err != nil {
+		c.JSON(http.StatusUnprocessableEntity, gin.H{
+			"message": "Invalid data",
+		})
+		return
+	}
+	view, err := h.savedViewSvc.UpdateSavedView(c.Request.Context(), user, models.SavedViewUpdate{
+		ID:         viewID,
+		Name:       reqBody.Name,
+		Query:      reqBody.Query,
+		Filters:    reqBody.Filters,
+		Sort:       reqBody.Sort,
+		Visibility: reqBody.Visibility,
+	})
+	if err != nil {
+		h.savedViewError(c, "updating", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view)
+}
+
+func (h *Handler) DeleteSavedView(c *gin.Context) {
+	user, ok := currentUser(c)
+	if !ok {
+		return
+	}
+	viewID, ok := viewParam(c)
+	if !ok {
+		return
+	}
+	err := h.savedViewSvc.DeleteSavedView(c.Request.Context(), user, viewID)
+	if err != nil {
+		h.savedViewError(c, "deleting", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "View deleted",
+	})
+}
+
+// ListViewTasks runs a saved view, or a smart list when view_id is its key.
+func (h *Handler) ListViewTasks(c *gin.Context) {
+	loc, err := time.LoadLocation(c.DefaultQuery("tz", "UTC"))
+	if err != nil {
+		c.JSON(http.StatusUnprocessableEntity, gin.H{
+			"message": "Invalid tz",
+		})
+		return
+	}
+	limit, offset, err := parsePagination(c)
+	if err != nil {
+		c.JSON(http.StatusUnprocessableEntity, gin.H{
+			"message": err.Error(),
+		})
+		return
+	}
+	viewID := strings.TrimSpace(c.Param("view_id"))
+	page, err := h.savedViewSvc.ListViewTasks(c.Request.Context(), optionalUser(c), viewID, loc, limit, offset)
+	if err != nil {
+		h.savedViewError(c, "running", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, page)
+}
+
+func viewParam(c *gin.Context) (uuid.UUID, bool) {
+	viewIDStr := strings.TrimSpace(c.Param("view_id"))
+	viewID, err := uuid.Parse(viewIDStr)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"message": service.SavedViewNotFoundError,
+		})
+		return uuid.UUID{}, false
+	}
+	return viewID, true
+}
+
+func (h *Handler) savedViewError(c *gin.Context, action string, err error) {
+	if queryError(c, err) {
+		return
+	}
+	switch err.Error() {
+	case service.SavedViewNotFoundError:
+		c.JSON(http.StatusNotFound, gin.H{
+			"message": err.Error(),
+		})
+	case service.InvalidSavedViewError, service.InvalidVisibilityError, service.InvalidFilterError:
+		c.JSON(http.StatusUnprocessableEntity, gin.H{
+			"message": err.Error(),
+		})
+	case service.NotViewOwnerError:
+		c.JSON(http.StatusForbidden, gin.H{
+			"message": err.Error(),
+		})
+	case service.SavedViewNameTakenError:
+		c.JSON(http.StatusConflict, gin.H{
+			"message": err.Error(),
+		})
+	case service.AuthenticationRequiredError:
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"message": err.Error(),
+		})
+	default:
+		log.Printf("error %s view: %v", action, err)
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"message": "Unknown error. Something went wrong.",
+		})
+	}
+}
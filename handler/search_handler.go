@@ -0,0 +1,45 @@
+package handler
+
+import (
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/localopsco/go-sample/service"
+)
+
+func (h *Handler) Search(c *gin.Context) {
+	filter, ok := parseTaskFilter(c)
+	if !ok {
+		return
+	}
+	// q holds the search text here, so a filter expression goes in filter.
+	filter.Query = strings.TrimSpace(c.Query("filter"))
+	limit, offset, err := parsePagination(c)
+	if err != nil {
+		c.JSON(http.StatusUnprocessableEntity, gin.H{
+			"message": err.Error(),
+		})
+		return
+	}
+	page, err := h.searchSvc.Search(c.Request.Context(), c.Query("q"), filter, limit, offset)
+	if err != nil {
+		if queryError(c, err) {
+			return
+		}
+		if err.Error() == service.EmptySearchQueryError || err.Error() == service.InvalidFilterError {
+			c.JSON(http.StatusUnprocessableEntity, gin.H{
+				"message": err.Error(),
+			})
+			return
+		}
+		log.Printf("error searching tasks: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"message": "Unknown error. Something went wrong.",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, page)
+}
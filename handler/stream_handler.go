@@ -0,0 +1,247 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/gorilla/websocket"
+	"github.com/localopsco/go-sample/models"
+	"github.com/localopsco/go-sample/service"
+)
+
+// Streams send a heartbeat every streamHeartbeat, and WebSocket clients
+// that do not answer pings within streamPongWait are disconnected.
+const (
+	streamHeartbeat  = 15 * time.Second
+	streamPongWait   = 2 * streamHeartbeat
+	streamWriteWait  = 10 * time.Second
+	streamRetryDelay = 3000
+)
+
+// Names of stream events. Completions and attachment changes are sent as
+// updates; the type of the task event is in the data.
+const (
+	streamEventCreated = "task.created"
+	streamEventUpdated = "task.updated"
+	streamEventDeleted = "task.deleted"
+	streamEventReset   = "reset"
+)
+
+var upgrader = websocket.Upgrader{
+	ReadBufferSize:  1024,
+	WriteBufferSize: 1024,
+}
+
+// streamMessage is a stream event as sent over a WebSocket.
+type streamMessage struct {
+	ID    int           `json:"id,omitempty"`
+	Event string        `json:"event"`
+	Data  *models.Event `json:"data,omitempty"`
+}
+
+// StreamEvents streams task events as Server-Sent Events. Clients that
+// reconnect with a Last-Event-ID header first receive the events they
+// missed; a reset event tells them to reload instead when too many were
+// missed. Clients that fall behind are disconnected and resume the same way.
+func (h *Handler) StreamEvents(c *gin.Context) {
+	lastEventID := c.GetHeader("Last-Event-ID")
+	if lastEventID == "" {
+		lastEventID = c.Query("last_event_id")
+	}
+	sub, replayed, complete, ok := h.openStream(c, lastEventID)
+	if !ok {
+		return
+	}
+	defer h.streamSvc.Unsubscribe(sub)
+
+	w := c.Writer
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprintf(w, "retry: %d\n\n", streamRetryDelay)
+	w.Flush()
+
+	send := func(event string, data *models.Event) error {
+		payload := []byte("{}")
+		if data != nil {
+			var err error
+			if payload, err = json.Marshal(data); err != nil {
+				return err
+			}
+			fmt.Fprintf(w, "id: %d\n", data.Seq)
+		}
+		if _, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event, payload); err != nil {
+			return err
+		}
+		w.Flush()
+		return nil
+	}
+	heartbeat := func() error {
+		if _, err := fmt.Fprint(w, ": heartbeat\n\n"); err != nil {
+			return err
+		}
+		w.Flush()
+		return nil
+	}
+	pumpStream(c.Request.Context(), sub, replayed, complete, send, heartbeat)
+}
+
+// StreamEventsWS streams task events over a WebSocket, as JSON messages
+// with the id, event and data of the Server-Sent Events. Clients resume
+// with the last_event_id query parameter, and are closed with code 1013 if
+// they fall behind.
+func (h *Handler) StreamEventsWS(c *gin.Context) {
+	sub, replayed, complete, ok := h.openStream(c, c.Query("last_event_id"))
+	if !ok {
+		return
+	}
+	defer h.streamSvc.Unsubscribe(sub)
+
+	conn, err := upgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+	conn.SetReadLimit(512)
+	conn.SetReadDeadline(time.Now().Add(streamPongWait))
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(streamPongWait))
+	})
+	ctx, cancel := context.WithCancel(c.Request.Context())
+	defer cancel()
+	go func() {
+		defer cancel()
+		for {
+			if _, _, err := conn.NextReader(); err != nil {
+				return
+			}
+		}
+	}()
+
+	send := func(event string, data *models.Event) error {
+		message := streamMessage{Event: event, Data: data}
+		if data != nil {
+			message.ID = data.Seq
+		}
+		conn.SetWriteDeadline(time.Now().Add(streamWriteWait))
+		return conn.WriteJSON(message)
+	}
+	heartbeat := func() error {
+		return conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(streamWriteWait))
+	}
+	pumpStream(ctx, sub, replayed, complete, send, heartbeat)
+	if sub.Lagged() {
+		message := websocket.FormatCloseMessage(websocket.CloseTryAgainLater, "lagged")
+		conn.WriteControl(websocket.CloseMessage, message, time.Now().Add(streamWriteWait))
+	}
+}
+
+// openStream subscribes to the events the request asks for and loads those
+// that came after lastEventID, if given. It responds with an error and
+// returns false if the request is invalid.
+func (h *Handler) openStream(c *gin.Context, lastEventID string) (*service.Subscription, []models.Event, bool, bool) {
+	var filter service.StreamFilter
+	for _, s := range c.QueryArray("project_id") {
+		projectID, err := uuid.Parse(s)
+		if err != nil {
+			c.JSON(http.StatusUnprocessableEntity, gin.H{
+				"message": "Invalid project_id",
+			})
+			return nil, nil, false, false
+		}
+		filter.ProjectIDs = append(filter.ProjectIDs, projectID)
+	}
+	if mine, _ := strconv.ParseBool(c.Query("mine")); mine {
+		user, ok := currentUser(c)
+		if !ok {
+			return nil, nil, false, false
+		}
+		filter.UserID = &user.ID
+	}
+	seq := -1
+	if lastEventID != "" {
+		var err error
+		if seq, err = strconv.Atoi(lastEventID); err != nil || seq < 0 {
+			c.JSON(http.StatusUnprocessableEntity, gin.H{
+				"message": service.InvalidLastEventIDError,
+			})
+			return nil, nil, false, false
+		}
+	}
+
+	// Subscribe before replaying, so that no event falls in between.
+	sub := h.streamSvc.Subscribe(filter)
+	if seq < 0 {
+		return sub, nil, true, true
+	}
+	replayed, complete, err := h.streamSvc.Replay(c.Request.Context(), seq, filter)
+	if err != nil {
+		h.streamSvc.Unsubscribe(sub)
+		log.Printf("error replaying events: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"message": "Unknown error. Something went wrong.",
+		})
+		return nil, nil, false, false
+	}
+	return sub, replayed, complete, true
+}
+
+// pumpStream sends the replayed events, or a reset event if the replay was
+// incomplete, and then the events of sub with heartbeats in between, until
+// ctx is done, sending fails or sub is dropped.
+func pumpStream(ctx context.Context, sub *service.Subscription, replayed []models.Event, complete bool, send func(event string, data *models.Event) error, heartbeat func() error) {
+	sent := make(map[int]bool, len(replayed))
+	if !complete {
+		if err := send(streamEventReset, nil); err != nil {
+			return
+		}
+	} else {
+		for i := range replayed {
+			if err := send(streamEventName(replayed[i].Type), &replayed[i]); err != nil {
+				return
+			}
+			sent[replayed[i].Seq] = true
+		}
+	}
+	ticker := time.NewTicker(streamHeartbeat)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event, ok := <-sub.Events():
+			if !ok {
+				return
+			}
+			if sent[event.Seq] {
+				continue
+			}
+			if err := send(streamEventName(event.Type), &event); err != nil {
+				return
+			}
+		case <-ticker.C:
+			if err := heartbeat(); err != nil {
+				return
+			}
+		}
+	}
+}
+
+func streamEventName(eventType string) string {
+	switch eventType {
+	case models.EventTaskCreated:
+		return streamEventCreated
+	case models.EventTaskDeleted:
+		return streamEventDeleted
+	}
+	return streamEventUpdated
+}
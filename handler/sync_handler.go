@@ -0,0 +1,82 @@
+package handler
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/localopsco/go-sample/models"
+	"github.com/localopsco/go-sample/service"
+)
+
+// SyncTasks returns the tasks changed and deleted since the change token in
+// ?since, or every task if it is missing.
+func (h *Handler) SyncTasks(c *gin.Context) {
+	limit, _, err := parsePagination(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"message": err.Error(),
+		})
+		return
+	}
+	changes, err := h.svc.Sync(c.Request.Context(), c.Query("since"), limit)
+	if err != nil {
+		if err.Error() == service.InvalidSyncTokenError {
+			c.JSON(http.StatusUnprocessableEntity, gin.H{
+				"message": err.Error(),
+			})
+			return
+		}
+		log.Printf("error listing changes: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"message": "Unknown error. Something went wrong.",
+		})
+		return
+	}
+	c.JSON(http.StatusOK, changes)
+}
+
+// PushSync applies the task changes a client made offline. Each mutation
+// reports whether it was applied, conflicted or failed.
+func (h *Handler) PushSync(c *gin.Context) {
+	var push models.SyncPush
+	if err := c.ShouldBindWith(&push, binding.JSON); err != nil {
+		c.JSON(http.StatusUnprocessableEntity, gin.H{
+			"message": "Invalid data",
+		})
+		return
+	}
+	result, err := h.svc.Push(c.Request.Context(), push)
+	if err != nil {
+		switch err.Error() {
+		case service.InvalidSyncStrategyError, service.SyncTooLargeError:
+			c.JSON(http.StatusUnprocessableEntity, gin.H{
+				"message": err.Error(),
+			})
+			return
+		}
+		log.Printf("error pushing sync mutations: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"message": "Unknown error. Something went wrong.",
+		})
+		return
+	}
+	for _, item := range result.Results {
+		if item.Err != nil {
+			item.Error = syncMutationError(item.Err)
+		}
+	}
+	c.JSON(http.StatusOK, result)
+}
+
+// syncMutationError returns the message reported for a sync mutation that
+// failed.
+func syncMutationError(err error) string {
+	switch err.Error() {
+	case service.InvalidSyncOpError, service.MissingSyncIDError, service.MissingBaseVersionError,
+		service.UnknownSyncFieldError, service.InvalidSyncFieldsError:
+		return err.Error()
+	}
+	return bulkItemError(err)
+}
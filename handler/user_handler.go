@@ -0,0 +1,75 @@
+package handler
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/localopsco/go-sample/service"
+)
+
+func (h *Handler) CreateUser(c *gin.Context) {
+	var reqBody struct {
+		Username    string `json:"username"`
+		DisplayName string `json:"display_name"`
+	}
+	err := c.ShouldBindWith(&reqBody, binding.JSON)
+	if err != nil {
+		c.JSON(http.StatusUnprocessableEntity, gin.H{
+			"message": "Invalid data",
+		})
+		return
+	}
+	user, token, err := h.userSvc.CreateUser(c.Request.Context(), reqBody.Username, reqBody.DisplayName)
+	if err != nil {
+		if err.Error() == service.InvalidUsernameError {
+			c.JSON(http.StatusUnprocessableEntity, gin.H{
+				"message": err.Error(),
+			})
+			return
+		}
+		if err.Error() == service.UsernameTakenError {
+			c.JSON(http.StatusConflict, gin.H{
+				"message": err.Error(),
+			})
+			return
+		}
+		log.Printf("error creating user: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"message": "Unknown error. Something went wrong.",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"user":      user,
+		"api_token": token,
+	})
+}
+
+func (h *Handler) GetCurrentUser(c *gin.Context) {
+	user, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	c.JSON(http.StatusOK, user)
+}
+
+func (h *Handler) ListMentionedTasks(c *gin.Context) {
+	user, ok := currentUser(c)
+	if !ok {
+		return
+	}
+	tasks, err := h.commentSvc.MentionedTasks(c.Request.Context(), user)
+	if err != nil {
+		log.Printf("error listing mentioned tasks: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"message": "Unknown error. Something went wrong.",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, tasks)
+}
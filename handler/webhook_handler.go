@@ -0,0 +1,218 @@
+package handler
+
+import (
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/models"
+	"github.com/localopsco/go-sample/service"
+)
+
+func (h *Handler) CreateWebhook(c *gin.Context) {
+	var reqBody struct {
+		URL        string   `json:"url"`
+		EventTypes []string `json:"event_types"`
+		Secret     string   `json:"secret"`
+		Active     *bool    `json:"active"`
+	}
+	user, ok := currentUser(c)
+	if !ok {
+		return
+	}
+	err := c.ShouldBindWith(&reqBody, binding.JSON)
+	if err != nil {
+		c.JSON(http.StatusUnprocessableEntity, gin.H{
+			"message": "Invalid data",
+		})
+		return
+	}
+	active := reqBody.Active == nil || *reqBody.Active
+	webhook, err := h.webhookSvc.CreateWebhook(c.Request.Context(), user, models.Webhook{
+		URL:        reqBody.URL,
+		EventTypes: reqBody.EventTypes,
+		Secret:     reqBody.Secret,
+		Active:     active,
+	})
+	if err != nil {
+		h.webhookError(c, "creating", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, webhook)
+}
+
+func (h *Handler) ListWebhooks(c *gin.Context) {
+	user, ok := currentUser(c)
+	if !ok {
+		return
+	}
+	webhooks, err := h.webhookSvc.ListWebhooks(c.Request.Context(), user)
+	if err != nil {
+		h.webhookError(c, "listing", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, webhooks)
+}
+
+func (h *Handler) GetWebhook(c *gin.Context) {
+	user, ok := currentUser(c)
+	if !ok {
+		return
+	}
+	webhookID, ok := webhookParam(c)
+	if !ok {
+		return
+	}
+	webhook, err := h.webhookSvc.GetWebhook(c.Request.Context(), user, webhookID)
+	if err != nil {
+		h.webhookError(c, "getting", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, webhook)
+}
+
+func (h *Handler) UpdateWebhook(c *gin.Context) {
+	var reqBody struct {
+		URL        *string   `json:"url"`
+		EventTypes *[]string `json:"event_types"`
+		Secret     *string   `json:"secret"`
+		Active     *bool     `json:"active"`
+	}
+	user, ok := currentUser(c)
+	if !ok {
+		return
+	}
+	webhookID, ok := webhookParam(c)
+	if !ok {
+		return
+	}
+	err := c.ShouldBindWith(&reqBody, binding.JSON)
+	if err != nil {
+		c.JSON(http.StatusUnprocessableEntity, gin.H{
+			"message": "Invalid data",
+		})
+		return
+	}
+	webhook, err := h.webhookSvc.UpdateWebhook(c.Request.Context(), user, models.WebhookUpdate{
+		ID:         webhookID,
+		URL:        reqBody.URL,
+		EventTypes: reqBody.EventTypes,
+		Secret:     reqBody.Secret,
+		Active:     reqBody.Active,
+	})
+	if err != nil {
+		h.webhookError(c, "updating", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, webhook)
+}
+
+func (h *Handler) DeleteWebhook(c *gin.Context) {
+	user, ok := currentUser(c)
+	if !ok {
+		return
+	}
+	webhookID, ok := webhookParam(c)
+	if !ok {
+		return
+	}
+	err := h.webhookSvc.DeleteWebhook(c.Request.Context(), user, webhookID)
+	if err != nil {
+		h.webhookError(c, "deleting", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Webhook deleted",
+	})
+}
+
+// ListWebhookDeliveries returns the delivery log of a webhook, optionally
+// narrowed down to one status.
+func (h *Handler) ListWebhookDeliveries(c *gin.Context) {
+	user, ok := currentUser(c)
+	if !ok {
+		return
+	}
+	webhookID, ok := webhookParam(c)
+	if !ok {
+		return
+	}
+	limit, offset, err := parsePagination(c)
+	if err != nil {
+		c.JSON(http.StatusUnprocessableEntity, gin.H{
+			"message": err.Error(),
+		})
+		return
+	}
+	filter := models.WebhookDeliveryFilter{WebhookID: webhookID, Status: c.Query("status")}
+	page, err := h.webhookSvc.ListDeliveries(c.Request.Context(), user, filter, limit, offset)
+	if err != nil {
+		h.webhookError(c, "listing deliveries of", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, page)
+}
+
+func (h *Handler) RedeliverWebhook(c *gin.Context) {
+	user, ok := currentUser(c)
+	if !ok {
+		return
+	}
+	webhookID, ok := webhookParam(c)
+	if !ok {
+		return
+	}
+	deliveryID, err := uuid.Parse(strings.TrimSpace(c.Param("delivery_id")))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"message": service.DeliveryNotFoundError,
+		})
+		return
+	}
+	delivery, err := h.webhookSvc.Redeliver(c.Request.Context(), user, webhookID, deliveryID)
+	if err != nil {
+		h.webhookError(c, "redelivering", err)
+		return
+	}
+
+	c.JSON(http.StatusAccepted, delivery)
+}
+
+func webhookParam(c *gin.Context) (uuid.UUID, bool) {
+	webhookIDStr := strings.TrimSpace(c.Param("webhook_id"))
+	webhookID, err := uuid.Parse(webhookIDStr)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"message": service.WebhookNotFoundError,
+		})
+		return uuid.UUID{}, false
+	}
+	return webhookID, true
+}
+
+func (h *Handler) webhookError(c *gin.Context, action string, err error) {
+	switch err.Error() {
+	case service.WebhookNotFoundError, service.DeliveryNotFoundError:
+		c.JSON(http.StatusNotFound, gin.H{
+			"message": err.Error(),
+		})
+	case service.InvalidWebhookURLError, service.InvalidEventTypesError, service.InvalidWebhookSecretError, service.InvalidDeliveryStatusError:
+		c.JSON(http.StatusUnprocessableEntity, gin.H{
+			"message": err.Error(),
+		})
+	default:
+		log.Printf("error %s webhook: %v", action, err)
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"message": "Unknown error. Something went wrong.",
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package handler
+
+import (
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/models"
+	"github.com/localopsco/go-sample/service"
+)
+
+func (h *Handler) CreateWorkflow(c *gin.Context) {
+	var reqBody struct {
+		Name     string `json:"name"`
+		Statuses []struct {
+			Key         string   `json:"key"`
+			Name        string   `json:"name"`
+			Category    string   `json:"category"`
+			Transitions []string `json:"transitions"`
+		} `json:"statuses"`
+	}
+	err := c.ShouldBindWith(&reqBody, binding.JSON)
+	if err != nil {
+		c.JSON(http.StatusUnprocessableEntity, gin.H{
+			"message": "Invalid data",
+		})
+		return
+	}
+	w := models.Workflow{Name: reqBody.Name}
+	for _, status := range reqBody.Statuses {
+		w.Statuses = append(w.Statuses, &models.WorkflowStatus{
+			Key:         status.Key,
+			Name:        status.Name,
+			Category:    status.Category,
+			Transitions: status.Transitions,
+		})
+	}
+	workflow, err := h.workflowSvc.CreateWorkflow(c.Request.Context(), w)
+	if err != nil {
+		if err.Error() == service.InvalidWorkflowError {
+			c.JSON(http.StatusUnprocessableEntity, gin.H{
+				"message": err.Error(),
+			})
+			return
+		}
+		log.Printf("error creating workflow: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"message": "Unknown error. Something went wrong.",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, workflow)
+}
+
+func (h *Handler) GetWorkflow(c *gin.Context) {
+	workflowIDStr := strings.TrimSpace(c.Param("workflow_id"))
+	workflowID, err := uuid.Parse(workflowIDStr)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"message": "Invalid workflow_id",
+		})
+		return
+	}
+	workflow, err := h.workflowSvc.GetWorkflow(c.Request.Context(), workflowID)
+	if err != nil {
+		if err.Error() == service.WorkflowNotFoundError {
+			c.JSON(http.StatusNotFound, gin.H{
+				"message": err.Error(),
+			})
+			return
+		}
+		log.Printf("error getting workflow: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"message": "Unknown error. Something went wrong.",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, workflow)
+}
+
+func (h *Handler) ListWorkflows(c *gin.Context) {
+	workflows, err := h.workflowSvc.ListWorkflows(c.Request.Context())
+	if err != nil {
+		log.Printf("error listing workflows: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"message": "Unknown error. Something went wrong.",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, workflows)
+}
@@ -0,0 +1,52 @@
+package models
+
+import "github.com/google/uuid"
+
+// Board groups the tasks of a project into columns, one per status of the
+// project's workflow or one per label of a label group.
+type Board struct {
+	Project *Project `json:"project"`
+	// GroupBy is "status" or "label:" followed by the label group.
+	GroupBy string         `json:"group_by"`
+	Columns []*BoardColumn `json:"columns"`
+}
+
+// BoardColumn is a column of a board. Key is the status key or the label
+// of the column; the column of tasks without a label of the group has an
+// empty key. OpenCount counts the unfinished tasks, which are what the
+// column's WIP limit applies to.
+type BoardColumn struct {
+	Key       string       `json:"key"`
+	Name      string       `json:"name"`
+	Count     int          `json:"count"`
+	OpenCount int          `json:"open_count"`
+	WIPLimit  *int         `json:"wip_limit"`
+	OverLimit bool         `json:"over_limit"`
+	Tasks     *Page[*Task] `json:"tasks"`
+}
+
+// BoardEntry is the part of a task needed to place it on a board.
+type BoardEntry struct {
+	ID       uuid.UUID
+	StatusID uuid.UUID
+	Open     bool
+	Labels   []string
+}
+
+// ColumnTarget describes the board column a task is moved into: the status
+// or labels the task gets, and the WIP limit of the column. Label is the
+// label of the target column when moving between label columns.
+type ColumnTarget struct {
+	Status     *WorkflowStatus
+	Labels     *[]string
+	Label      string
+	WIPLimit   int
+	EnforceWIP bool
+}
+
+// TaskMoveResult is a moved task, along with warnings such as a column
+// going over its WIP limit.
+type TaskMoveResult struct {
+	*Task
+	Warnings []string `json:"warnings,omitempty"`
+}
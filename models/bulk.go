@@ -0,0 +1,90 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Bulk operations.
+const (
+	BulkCreate   = "create"
+	BulkUpdate   = "update"
+	BulkDelete   = "delete"
+	BulkComplete = "complete"
+	BulkMove     = "move"
+	BulkLabel    = "label"
+)
+
+// Bulk modes. An atomic bulk operation is rolled back entirely if any item
+// fails; a best-effort one keeps the items that succeeded.
+const (
+	BulkModeAtomic     = "atomic"
+	BulkModeBestEffort = "best_effort"
+)
+
+// Statuses of the items of a bulk operation. Items that succeeded are
+// rolled back when another item of an atomic operation fails.
+const (
+	BulkItemSucceeded  = "succeeded"
+	BulkItemFailed     = "failed"
+	BulkItemRolledBack = "rolled_back"
+)
+
+// BulkRequest applies one operation to many tasks. Create uses Tasks; the
+// other operations apply to the tasks listed in IDs or, if IDs is empty,
+// to those matching Query, a filter language expression.
+type BulkRequest struct {
+	Operation string
+	Mode      string
+	Force     bool
+	Tasks     []Task
+	IDs       []uuid.UUID
+	Query     string
+	Location  *time.Location
+	// Update holds the changes of an update.
+	Update BulkTaskUpdate
+	// GroupBy and Column are the board column of a move.
+	GroupBy string
+	Column  string
+	// AddLabels and RemoveLabels are the changes of a label operation.
+	AddLabels    []string
+	RemoveLabels []string
+}
+
+// BulkTaskUpdate holds the changes a bulk update applies to every task. Nil
+// and unset fields are left unchanged.
+type BulkTaskUpdate struct {
+	Title           *string
+	Description     *string
+	Status          *string
+	StartAt         Optional[time.Time]
+	DueAt           Optional[time.Time]
+	Priority        *string
+	EstimateMinutes Optional[int]
+	Labels          *[]string
+}
+
+// BulkResult reports the outcome of a bulk operation for each item, in the
+// order of the request. Committed is false if nothing was saved.
+type BulkResult struct {
+	Operation string            `json:"operation"`
+	Mode      string            `json:"mode"`
+	Committed bool              `json:"committed"`
+	Succeeded int               `json:"succeeded"`
+	Failed    int               `json:"failed"`
+	Items     []*BulkItemResult `json:"items"`
+}
+
+// BulkItemResult is the outcome of a bulk operation for one task. Index is
+// the position of the item in Tasks for creates, and in the selected tasks
+// otherwise. Err is turned into the user-facing Error by the handler.
+type BulkItemResult struct {
+	Index    int        `json:"index"`
+	ID       *uuid.UUID `json:"id"`
+	Status   string     `json:"status"`
+	Task     *Task      `json:"task,omitempty"`
+	Warnings []string   `json:"warnings,omitempty"`
+	Error    string     `json:"error,omitempty"`
+	Err      error      `json:"-"`
+}
@@ -0,0 +1,37 @@
+package models
+
+import "github.com/google/uuid"
+
+// Components of a calendar feed.
+const (
+	CalendarEvents = "vevent"
+	CalendarTodos  = "vtodo"
+)
+
+// CalendarOptions describes a calendar feed of the tasks matching Filter
+// that have a due date, written as the given components. Times are written
+// in the filter's location.
+type CalendarOptions struct {
+	Components []string
+	Filter     TaskFilter
+}
+
+// CalendarObjectPut stores an iCalendar object under a name in the CalDAV
+// collection of a project, or of the tasks outside of any project if
+// ProjectID is nil. IfMatch is the version the task is expected to be at,
+// and IfNoneMatch only lets the task be created.
+type CalendarObjectPut struct {
+	ProjectID   *uuid.UUID
+	Name        string
+	Data        []byte
+	IfMatch     *int
+	IfNoneMatch bool
+}
+
+// CalendarChanges lists the tasks changed in a CalDAV collection and the
+// names of those removed from it. Token is the collection's new sync token.
+type CalendarChanges struct {
+	Tasks   []*Task
+	Removed []string
+	Token   string
+}
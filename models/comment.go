@@ -0,0 +1,22 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Comment is a Markdown comment on a task. BodyHTML is the sanitized HTML
+// rendering of Body, and Mentions lists the usernames mentioned in it.
+type Comment struct {
+	ID         uuid.UUID  `json:"id"`
+	TaskID     uuid.UUID  `json:"task_id"`
+	ParentID   *uuid.UUID `json:"parent_id"`
+	Author     *User      `json:"author"`
+	Body       string     `json:"body"`
+	BodyHTML   string     `json:"body_html"`
+	Mentions   []string   `json:"mentions"`
+	ReplyCount int        `json:"reply_count"`
+	CreatedAt  time.Time  `json:"created_at"`
+	EditedAt   *time.Time `json:"edited_at"`
+}
@@ -0,0 +1,37 @@
+package models
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type contextKey int
+
+const (
+	actorKey contextKey = iota
+	requestIDKey
+)
+
+// WithActor returns a context recording the user performing a request.
+func WithActor(ctx context.Context, userID uuid.UUID) context.Context {
+	return context.WithValue(ctx, actorKey, userID)
+}
+
+// ActorFrom returns the user recorded by WithActor, or nil for anonymous
+// requests.
+func ActorFrom(ctx context.Context) *uuid.UUID {
+	if userID, ok := ctx.Value(actorKey).(uuid.UUID); ok {
+		return &userID
+	}
+	return nil
+}
+
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey, requestID)
+}
+
+func RequestIDFrom(ctx context.Context) string {
+	requestID, _ := ctx.Value(requestIDKey).(string)
+	return requestID
+}
@@ -0,0 +1,50 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	ExportCSV    = "csv"
+	ExportJSON   = "json"
+	ExportNDJSON = "ndjson"
+)
+
+const (
+	ExportJobPending   = "pending"
+	ExportJobRunning   = "running"
+	ExportJobSucceeded = "succeeded"
+	ExportJobFailed    = "failed"
+)
+
+// ExportOptions describes a task export: the tasks matching Filter, with
+// the given columns in the given format. Times are written in the filter's
+// location.
+type ExportOptions struct {
+	Format  string
+	Columns []string
+	Filter  TaskFilter
+}
+
+// ExportJob is an export run in the background. Once it has succeeded, its
+// result can be downloaded until it expires.
+type ExportJob struct {
+	ID         uuid.UUID  `json:"id"`
+	UserID     *uuid.UUID `json:"-"`
+	Format     string     `json:"format"`
+	Columns    []string   `json:"columns"`
+	Timezone   string     `json:"timezone"`
+	Status     string     `json:"status"`
+	Error      string     `json:"error,omitempty"`
+	Rows       int        `json:"rows"`
+	CreatedAt  time.Time  `json:"created_at"`
+	StartedAt  *time.Time `json:"started_at"`
+	FinishedAt *time.Time `json:"finished_at"`
+	ExpiresAt  *time.Time `json:"expires_at"`
+	// DownloadURL is set by the handler once the result is ready.
+	DownloadURL string     `json:"download_url,omitempty"`
+	Filter      TaskFilter `json:"-"`
+	ResultKey   string     `json:"-"`
+}
@@ -0,0 +1,28 @@
+package models
+
+import "time"
+
+// IdempotencyKey identifies a request sent with an Idempotency-Key header:
+// the key, who sent it and a fingerprint of the request itself.
+type IdempotencyKey struct {
+	Key         string
+	Caller      string
+	Fingerprint string
+}
+
+// StoredResponse is the first response to an idempotent request, which is
+// replayed to retries of that request. Response is nil while the first
+// request is still being processed.
+type StoredResponse struct {
+	Fingerprint string
+	Response    *RecordedResponse
+	CreatedAt   time.Time
+	ExpiresAt   time.Time
+}
+
+// RecordedResponse is an HTTP response saved for replay.
+type RecordedResponse struct {
+	Status int
+	Header map[string][]string
+	Body   []byte
+}
@@ -0,0 +1,56 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	ImportCSV         = "csv"
+	ImportJSON        = "json"
+	ImportTodoTxt     = "todotxt"
+	ImportTaskwarrior = "taskwarrior"
+)
+
+// Outcomes of an imported line. Valid lines of a dry run would have been
+// created; skipped lines were imported before or describe deleted tasks.
+const (
+	ImportLineCreated = "created"
+	ImportLineValid   = "valid"
+	ImportLineSkipped = "skipped"
+	ImportLineFailed  = "failed"
+)
+
+// ImportOptions describes a task import. Mapping maps task fields to the
+// CSV columns holding them. Dates without a time zone are read in Location,
+// and tasks without a project go to ProjectID, if set.
+type ImportOptions struct {
+	Format    string
+	DryRun    bool
+	Mapping   map[string]string
+	ProjectID *uuid.UUID
+	Location  *time.Location
+}
+
+// ImportLine is the outcome of one imported line or record. Task previews
+// the task to create in a dry run.
+type ImportLine struct {
+	Line       int        `json:"line"`
+	ExternalID string     `json:"external_id"`
+	Status     string     `json:"status"`
+	ID         *uuid.UUID `json:"id,omitempty"`
+	Task       *Task      `json:"task,omitempty"`
+	Error      string     `json:"error,omitempty"`
+}
+
+type ImportResult struct {
+	Format  string        `json:"format"`
+	DryRun  bool          `json:"dry_run"`
+	Total   int           `json:"total"`
+	Created int           `json:"created"`
+	Valid   int           `json:"valid"`
+	Skipped int           `json:"skipped"`
+	Failed  int           `json:"failed"`
+	Lines   []*ImportLine `json:"lines"`
+}
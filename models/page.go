@@ -0,0 +1,9 @@
+package models
+
+// Page is one page of a paginated listing.
+type Page[T any] struct {
+	Items  []T `json:"items"`
+	Total  int `json:"total"`
+	Limit  int `json:"limit"`
+	Offset int `json:"offset"`
+}
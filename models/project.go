@@ -0,0 +1,43 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// WIP policies decide what happens when a move puts more unfinished tasks
+// in a board column than its limit allows.
+const (
+	WIPPolicyWarn    = "warn"
+	WIPPolicyEnforce = "enforce"
+)
+
+type Project struct {
+	ID          uuid.UUID `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	WorkflowID  uuid.UUID `json:"workflow_id"`
+	// WIPLimits caps the unfinished tasks of board columns, keyed by status
+	// key or label.
+	WIPLimits map[string]int `json:"wip_limits"`
+	WIPPolicy string         `json:"wip_policy"`
+	CreatedAt time.Time      `json:"created_at"`
+}
+
+// ProjectUpdate holds the changes to apply to a project. Nil fields are left
+// unchanged.
+type ProjectUpdate struct {
+	ID          uuid.UUID
+	Name        *string
+	Description *string
+	WIPLimits   *map[string]int
+	WIPPolicy   *string
+}
+
+// ProjectTasks groups tasks by the project they belong to. Project is nil
+// for tasks outside any project.
+type ProjectTasks struct {
+	Project *Project `json:"project"`
+	Tasks   []*Task  `json:"tasks"`
+}
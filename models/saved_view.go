@@ -0,0 +1,66 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	ViewVisibilityPrivate = "private"
+	ViewVisibilityShared  = "shared"
+)
+
+// SavedView is a named task listing. Its tasks match both Query, an
+// expression in the filter language of package taskquery, and Filters, and
+// are ordered by Sort. Shared views are visible to every user, but only
+// their owner can change them.
+type SavedView struct {
+	ID         uuid.UUID   `json:"id"`
+	Name       string      `json:"name"`
+	Query      string      `json:"query"`
+	Filters    ViewFilters `json:"filters"`
+	Sort       string      `json:"sort"`
+	Visibility string      `json:"visibility"`
+	OwnerID    uuid.UUID   `json:"owner_id"`
+	CreatedAt  time.Time   `json:"created_at"`
+	UpdatedAt  time.Time   `json:"updated_at"`
+}
+
+// ViewFilters are the list filters of GET /tasks/ that a view can store.
+// Assignee is a user ID or "me", which like Watching refers to whoever runs
+// the view.
+type ViewFilters struct {
+	Due        string     `json:"due,omitempty"`
+	Priority   string     `json:"priority,omitempty"`
+	Status     string     `json:"status,omitempty"`
+	ProjectID  *uuid.UUID `json:"project_id,omitempty"`
+	Assignee   string     `json:"assignee,omitempty"`
+	Unassigned bool       `json:"unassigned,omitempty"`
+	Watching   bool       `json:"watching,omitempty"`
+}
+
+// SavedViewUpdate holds the changes to a view; nil fields are left as they
+// are.
+type SavedViewUpdate struct {
+	ID         uuid.UUID
+	Name       *string
+	Query      *string
+	Filters    *ViewFilters
+	Sort       *string
+	Visibility *string
+}
+
+// SmartList is a built-in view, identified by Key.
+type SmartList struct {
+	Key   string `json:"key"`
+	Name  string `json:"name"`
+	Query string `json:"query"`
+	Sort  string `json:"sort"`
+}
+
+// ViewList is the listing of the views a user can run.
+type ViewList struct {
+	SmartLists []SmartList  `json:"smart_lists"`
+	Views      []*SavedView `json:"views"`
+}
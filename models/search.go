@@ -0,0 +1,16 @@
+package models
+
+// SearchResult is a task matching a full-text search. Snippets hold HTML
+// excerpts of the matching text, with matches wrapped in <mark> tags and
+// everything else escaped.
+type SearchResult struct {
+	Task     *Task          `json:"task"`
+	Rank     float64        `json:"rank"`
+	Snippets SearchSnippets `json:"snippets"`
+}
+
+type SearchSnippets struct {
+	Title       string `json:"title,omitempty"`
+	Description string `json:"description,omitempty"`
+	Comment     string `json:"comment,omitempty"`
+}
@@ -0,0 +1,100 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	SyncOpCreate = "create"
+	SyncOpUpdate = "update"
+	SyncOpDelete = "delete"
+)
+
+// Conflict strategies of a sync push. With SyncLastWriterWins each field a
+// mutation sets is compared with the last time the server changed it, and
+// the later change wins. With SyncVersion a mutation only applies if the
+// task is still at the version the client based it on.
+const (
+	SyncLastWriterWins = "lww"
+	SyncVersion        = "version"
+)
+
+const (
+	SyncMutationApplied  = "applied"
+	SyncMutationConflict = "conflict"
+	SyncMutationFailed   = "failed"
+)
+
+// SyncCursor is a position in the change sequence. Changes are ordered by
+// their sequence number and then by task ID, since the tasks changed by one
+// statement share a sequence number.
+type SyncCursor struct {
+	Seq int64
+	ID  uuid.UUID
+}
+
+// Tombstone reports a task that was deleted. Purged is false for tasks in
+// the trash, which may still be restored.
+type Tombstone struct {
+	ID        uuid.UUID `json:"id"`
+	DeletedAt time.Time `json:"deleted_at"`
+	Purged    bool      `json:"purged"`
+}
+
+// SyncChanges lists the tasks changed and deleted after a cursor, in the
+// order of the change sequence. Token encodes Next for the following pull.
+type SyncChanges struct {
+	Tasks   []*Task     `json:"tasks"`
+	Deleted []Tombstone `json:"deleted"`
+	Token   string      `json:"token"`
+	HasMore bool        `json:"has_more"`
+	Next    SyncCursor  `json:"-"`
+}
+
+// SyncMutation is a change a client made offline. Fields holds the task
+// fields to set, as in a task update; ChangedAt is when the client made the
+// change and defaults to the time of the push. BaseVersion is the version
+// of the task the change was made to.
+type SyncMutation struct {
+	ClientID    string                     `json:"client_id"`
+	Op          string                     `json:"op"`
+	ID          *uuid.UUID                 `json:"id"`
+	BaseVersion *int                       `json:"base_version"`
+	ChangedAt   *time.Time                 `json:"changed_at"`
+	Fields      map[string]json.RawMessage `json:"fields"`
+}
+
+// SyncPush is a batch of client mutations, applied in order.
+type SyncPush struct {
+	Strategy  string         `json:"strategy"`
+	Mutations []SyncMutation `json:"mutations"`
+}
+
+// SyncConflict describes a field whose client value lost to the server.
+type SyncConflict struct {
+	Field       string          `json:"field"`
+	ClientValue json.RawMessage `json:"client_value"`
+	ServerValue any             `json:"server_value"`
+}
+
+// SyncMutationResult is the outcome of one mutation. A mutation with
+// conflicts may still have applied its other fields; Task is then the task
+// after the change, and otherwise the server's current task. Err is turned
+// into the user-facing Error by the handler.
+type SyncMutationResult struct {
+	ClientID  string         `json:"client_id"`
+	ID        *uuid.UUID     `json:"id"`
+	Status    string         `json:"status"`
+	Task      *Task          `json:"task,omitempty"`
+	Conflicts []SyncConflict `json:"conflicts,omitempty"`
+	Error     string         `json:"error,omitempty"`
+	Err       error          `json:"-"`
+}
+
+type SyncPushResult struct {
+	Strategy string                `json:"strategy"`
+	Results  []*SyncMutationResult `json:"results"`
+}
@@ -1,16 +1,150 @@
 package models
 
 import (
+	"encoding/json"
 	"time"
 
 	"github.com/google/uuid"
 )
 
+// Task is the API representation of a task. IsCompleted is kept for older
+// clients and reports whether the task's status is in a closed category.
 type Task struct {
-	ID            uuid.UUID `json:"id"`
-	Title         string    `json:"title"`
-	Description   string    `json:"description"`
-	IsCompleted   bool      `json:"is_completed"`
-	AttachmentURL *string   `json:"attachment_url"`
-	CreatedAt     time.Time `json:"created_at"`
+	ID              uuid.UUID    `json:"id"`
+	Title           string       `json:"title"`
+	Description     string       `json:"description"`
+	Status          string       `json:"status"`
+	StatusCategory  string       `json:"status_category"`
+	StatusID        uuid.UUID    `json:"-"`
+	IsCompleted     bool         `json:"is_completed"`
+	ProjectID       *uuid.UUID   `json:"project_id"`
+	AttachmentURL   *string      `json:"attachment_url"`
+	ParentID        *uuid.UUID   `json:"parent_id"`
+	Position        int          `json:"position"`
+	Progress        TaskProgress `json:"progress"`
+	IsBlocked       bool         `json:"is_blocked"`
+	StartAt         *time.Time   `json:"start_at"`
+	DueAt           *time.Time   `json:"due_at"`
+	Priority        string       `json:"priority"`
+	EstimateMinutes *int         `json:"estimate_minutes"`
+	Recurrence      *Recurrence  `json:"recurrence"`
+	SeriesID        *uuid.UUID   `json:"series_id"`
+	Labels          []string     `json:"labels"`
+	Rank            string       `json:"rank"`
+	Assignees       []*User      `json:"assignees"`
+	Watchers        []*User      `json:"watchers"`
+	CreatedAt       time.Time    `json:"created_at"`
+	CompletedAt     *time.Time   `json:"completed_at"`
+	DeletedAt       *time.Time   `json:"deleted_at,omitempty"`
+	Version         int          `json:"version"`
+	ExternalID      *string      `json:"external_id,omitempty"`
+	ImportKey       *string      `json:"-"`
+	ICalUID         *string      `json:"ical_uid,omitempty"`
+}
+
+// TaskProgress summarises the completion of a task's direct subtasks.
+type TaskProgress struct {
+	Done  int `json:"done"`
+	Total int `json:"total"`
+}
+
+// Recurrence describes how a task repeats. Rule uses RFC 5545 RRULE syntax
+// and is evaluated in Timezone, starting from the due date of the first
+// occurrence in StartsAt.
+type Recurrence struct {
+	Rule     string     `json:"rule"`
+	Timezone string     `json:"timezone"`
+	Paused   bool       `json:"paused"`
+	StartsAt *time.Time `json:"starts_at"`
+}
+
+// TaskUpdate holds the changes to apply to a task. Title and Description are
+// always overwritten; the remaining fields are only changed when present in
+// the request. Status takes precedence over IsCompleted.
+type TaskUpdate struct {
+	ID              uuid.UUID
+	Title           string
+	Description     string
+	Status          *string
+	IsCompleted     *bool
+	StartAt         Optional[time.Time]
+	DueAt           Optional[time.Time]
+	Priority        *string
+	EstimateMinutes Optional[int]
+	Recurrence      Optional[Recurrence]
+	Labels          *[]string
+}
+
+// Optional is a nullable JSON field that remembers whether it was present in
+// the decoded document, so that an explicit null can clear a value while a
+// missing key leaves it untouched.
+type Optional[T any] struct {
+	Set   bool
+	Value *T
+}
+
+func (o *Optional[T]) UnmarshalJSON(data []byte) error {
+	o.Set = true
+	if string(data) == "null" {
+		o.Value = nil
+		return nil
+	}
+	var value T
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	o.Value = &value
+	return nil
+}
+
+// TaskFilter narrows down and orders the result of listing tasks.
+type TaskFilter struct {
+	// Due is one of "overdue", "today" or "week", evaluated in Location.
+	Due       string
+	Priority  string
+	Status    string
+	ProjectID *uuid.UUID
+	// AssigneeID and WatcherID limit results to tasks the user is assigned
+	// to or watching. Unassigned limits them to tasks without assignees.
+	AssigneeID *uuid.UUID
+	WatcherID  *uuid.UUID
+	Unassigned bool
+	// Open limits results to unfinished tasks.
+	Open bool
+	// Label limits results to tasks with the label, and HasDue to tasks
+	// with a due date.
+	Label  string
+	HasDue bool
+	// NoProject limits results to tasks outside of any project.
+	NoProject bool
+	// Query is an expression in the filter language of package taskquery.
+	Query string
+	// Sort is a field name, optionally prefixed with "-" for descending order.
+	Sort     string
+	Location *time.Location
+}
+
+// TaskMove places a task in its column right after After and before Before.
+// When Column is set, the task is first moved to that column of the board
+// grouped by GroupBy, changing its status or labels; otherwise at least one
+// anchor is set.
+type TaskMove struct {
+	ID      uuid.UUID
+	After   *uuid.UUID
+	Before  *uuid.UUID
+	GroupBy string
+	Column  *string
+}
+
+// Dependency records that BlockerID has to be completed before BlockedID.
+type Dependency struct {
+	BlockerID uuid.UUID `json:"blocker_id"`
+	BlockedID uuid.UUID `json:"blocked_id"`
+}
+
+// DependencyGraph lists tasks in topological order, blockers first, along
+// with the dependencies between them.
+type DependencyGraph struct {
+	Tasks        []*Task      `json:"tasks"`
+	Dependencies []Dependency `json:"dependencies"`
 }
@@ -0,0 +1,51 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Task event actions.
+const (
+	TaskEventCreated           = "created"
+	TaskEventUpdated           = "updated"
+	TaskEventDeleted           = "deleted"
+	TaskEventRestored          = "restored"
+	TaskEventPurged            = "purged"
+	TaskEventAttachmentAdded   = "attachment_added"
+	TaskEventAttachmentRemoved = "attachment_removed"
+)
+
+// TaskEvent is an immutable audit record of a change to a task. Events
+// outlive the task they describe, so TaskTitle keeps the title it had.
+type TaskEvent struct {
+	ID        uuid.UUID     `json:"id"`
+	TaskID    uuid.UUID     `json:"task_id"`
+	TaskTitle string        `json:"task_title"`
+	ProjectID *uuid.UUID    `json:"project_id"`
+	Action    string        `json:"action"`
+	Actor     *User         `json:"actor"`
+	Changes   []FieldChange `json:"changes"`
+	RequestID string        `json:"request_id"`
+	CreatedAt time.Time     `json:"created_at"`
+}
+
+// FieldChange describes how one field of a task changed. Edges such as
+// assignees record the IDs added and removed instead of old and new values.
+type FieldChange struct {
+	Field   string   `json:"field"`
+	Old     any      `json:"old,omitempty"`
+	New     any      `json:"new,omitempty"`
+	Added   []string `json:"added,omitempty"`
+	Removed []string `json:"removed,omitempty"`
+}
+
+type TaskEventFilter struct {
+	TaskID    *uuid.UUID
+	ProjectID *uuid.UUID
+	ActorID   *uuid.UUID
+	Action    string
+	Since     *time.Time
+	Until     *time.Time
+}
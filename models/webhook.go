@@ -0,0 +1,106 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Task lifecycle event types.
+const (
+	EventTaskCreated           = "task.created"
+	EventTaskUpdated           = "task.updated"
+	EventTaskCompleted         = "task.completed"
+	EventTaskDeleted           = "task.deleted"
+	EventTaskAttachmentAdded   = "task.attachment_added"
+	EventTaskAttachmentRemoved = "task.attachment_removed"
+)
+
+// EventTypes lists the task lifecycle event types.
+var EventTypes = []string{
+	EventTaskCreated,
+	EventTaskUpdated,
+	EventTaskCompleted,
+	EventTaskDeleted,
+	EventTaskAttachmentAdded,
+	EventTaskAttachmentRemoved,
+}
+
+// Event is a task lifecycle event. Task is the task after the change, or
+// before it for deletions. Seq is the position at which the event was
+// relayed from the outbox, which orders all events; it is zero until the
+// relay numbers the event, which it does after publishing it.
+type Event struct {
+	ID         uuid.UUID  `json:"id"`
+	Seq        int        `json:"seq,omitempty"`
+	Type       string     `json:"type"`
+	TaskID     uuid.UUID  `json:"task_id"`
+	ProjectID  *uuid.UUID `json:"project_id"`
+	Task       *Task      `json:"task"`
+	ActorID    *uuid.UUID `json:"actor_id"`
+	RequestID  string     `json:"request_id,omitempty"`
+	OccurredAt time.Time  `json:"occurred_at"`
+}
+
+// Webhook is an HTTP endpoint receiving the events it subscribes to. The
+// secret is only returned when the webhook is created.
+type Webhook struct {
+	ID         uuid.UUID  `json:"id"`
+	URL        string     `json:"url"`
+	EventTypes []string   `json:"event_types"`
+	Secret     string     `json:"secret,omitempty"`
+	Active     bool       `json:"active"`
+	OwnerID    *uuid.UUID `json:"owner_id"`
+	CreatedAt  time.Time  `json:"created_at"`
+	UpdatedAt  time.Time  `json:"updated_at"`
+}
+
+// WebhookUpdate holds the changes to apply to a webhook. Nil fields are left
+// unchanged.
+type WebhookUpdate struct {
+	ID         uuid.UUID
+	URL        *string
+	EventTypes *[]string
+	Secret     *string
+	Active     *bool
+}
+
+// Webhook delivery statuses. A delivery that keeps failing is retried with
+// growing delays until it runs out of attempts and is dead.
+const (
+	DeliveryPending   = "pending"
+	DeliveryRetrying  = "retrying"
+	DeliverySucceeded = "succeeded"
+	DeliveryDead      = "dead"
+)
+
+// WebhookDelivery is one event sent to one webhook, with the log of its
+// attempts.
+type WebhookDelivery struct {
+	ID             uuid.UUID         `json:"id"`
+	WebhookID      uuid.UUID         `json:"webhook_id"`
+	EventID        uuid.UUID         `json:"event_id"`
+	EventType      string            `json:"event_type"`
+	Payload        []byte            `json:"-"`
+	Status         string            `json:"status"`
+	AttemptCount   int               `json:"attempt_count"`
+	NextAttemptAt  *time.Time        `json:"next_attempt_at"`
+	LastStatusCode *int              `json:"last_status_code"`
+	Attempts       []DeliveryAttempt `json:"attempts"`
+	CreatedAt      time.Time         `json:"created_at"`
+	UpdatedAt      time.Time         `json:"updated_at"`
+}
+
+// DeliveryAttempt records one attempt to deliver an event. StatusCode is
+// zero if no response was received.
+type DeliveryAttempt struct {
+	StatusCode int       `json:"status_code"`
+	Error      string    `json:"error,omitempty"`
+	DurationMS int64     `json:"duration_ms"`
+	At         time.Time `json:"at"`
+}
+
+type WebhookDeliveryFilter struct {
+	WebhookID uuid.UUID
+	Status    string
+}
@@ -0,0 +1,80 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Status categories group workflow statuses by how far along a task is.
+const (
+	StatusCategoryTodo       = "todo"
+	StatusCategoryInProgress = "in_progress"
+	StatusCategoryDone       = "done"
+	StatusCategoryCancelled  = "cancelled"
+)
+
+// IsClosedCategory reports whether tasks in the given status category are
+// finished, either done or cancelled.
+func IsClosedCategory(category string) bool {
+	return category == StatusCategoryDone || category == StatusCategoryCancelled
+}
+
+type Workflow struct {
+	ID        uuid.UUID         `json:"id"`
+	Name      string            `json:"name"`
+	IsDefault bool              `json:"is_default"`
+	Statuses  []*WorkflowStatus `json:"statuses"`
+	CreatedAt time.Time         `json:"created_at"`
+}
+
+type WorkflowStatus struct {
+	ID       uuid.UUID `json:"id"`
+	Key      string    `json:"key"`
+	Name     string    `json:"name"`
+	Category string    `json:"category"`
+	Position int       `json:"position"`
+	// Transitions lists the keys of the statuses a task may move to next.
+	Transitions []string `json:"transitions"`
+}
+
+// Status returns the status with the given key, or nil if there is none.
+func (w *Workflow) Status(key string) *WorkflowStatus {
+	for _, status := range w.Statuses {
+		if status.Key == key {
+			return status
+		}
+	}
+	return nil
+}
+
+// StatusByID returns the status with the given ID, or nil if there is none.
+func (w *Workflow) StatusByID(id uuid.UUID) *WorkflowStatus {
+	for _, status := range w.Statuses {
+		if status.ID == id {
+			return status
+		}
+	}
+	return nil
+}
+
+// InitialStatus returns the first status in the todo category, which new
+// tasks start in.
+func (w *Workflow) InitialStatus() *WorkflowStatus {
+	for _, status := range w.Statuses {
+		if status.Category == StatusCategoryTodo {
+			return status
+		}
+	}
+	return nil
+}
+
+// CanTransition reports whether a task may move from one status to another.
+func (s *WorkflowStatus) CanTransition(to string) bool {
+	for _, key := range s.Transitions {
+		if key == to {
+			return true
+		}
+	}
+	return false
+}
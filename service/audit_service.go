@@ -0,0 +1,58 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/datastore"
+	"github.com/localopsco/go-sample/ent"
+	"github.com/localopsco/go-sample/ent/taskevent"
+	"github.com/localopsco/go-sample/models"
+)
+
+const InvalidAuditFilterError = "Invalid audit filter"
+
+type AuditService struct {
+	store *datastore.TaskEventStore
+	tasks *datastore.TaskStore
+}
+
+func NewAuditService(taskEventStore *datastore.TaskEventStore, taskStore *datastore.TaskStore) *AuditService {
+	return &AuditService{
+		taskEventStore,
+		taskStore,
+	}
+}
+
+// TaskHistory returns a page of the events of a task, newest first. The
+// history of a deleted task stays available.
+func (svc *AuditService) TaskHistory(ctx context.Context, taskID uuid.UUID, limit, offset int) (*models.Page[*models.TaskEvent], error) {
+	limit, offset = pageBounds(limit, offset)
+	page, err := svc.store.ListTaskEvents(ctx, models.TaskEventFilter{TaskID: &taskID}, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	if page.Total == 0 {
+		if _, err := svc.tasks.GetTask(ctx, taskID); err != nil {
+			if ent.IsNotFound(err) {
+				return nil, errors.New(TaskNotFoundError)
+			}
+			return nil, err
+		}
+	}
+	return page, nil
+}
+
+// ListEvents returns a page of the task events across the workspace that
+// match filter, newest first.
+func (svc *AuditService) ListEvents(ctx context.Context, filter models.TaskEventFilter, limit, offset int) (*models.Page[*models.TaskEvent], error) {
+	if filter.Action != "" && taskevent.ActionValidator(taskevent.Action(filter.Action)) != nil {
+		return nil, errors.New(InvalidAuditFilterError)
+	}
+	if filter.Since != nil && filter.Until != nil && !filter.Since.Before(*filter.Until) {
+		return nil, errors.New(InvalidAuditFilterError)
+	}
+	limit, offset = pageBounds(limit, offset)
+	return svc.store.ListTaskEvents(ctx, filter, limit, offset)
+}
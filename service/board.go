@@ -0,0 +1,247 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/ent"
+	"github.com/localopsco/go-sample/models"
+)
+
+const InvalidBoardGroupError = "Boards are grouped by status or by label:<group>"
+const InvalidColumnError = "Column does not belong to the board"
+const TaskNotInProjectError = "Only tasks in a project can be moved between board columns"
+const WIPLimitExceededError = "The column is at its WIP limit"
+
+const groupByStatus = "status"
+const groupByLabelPrefix = "label:"
+
+// boardGroup is how a board is split into columns: by status when label is
+// empty, otherwise by the labels starting with label and a colon.
+type boardGroup struct {
+	label string
+}
+
+func parseBoardGroup(groupBy string) (boardGroup, error) {
+	if groupBy == "" || groupBy == groupByStatus {
+		return boardGroup{}, nil
+	}
+	label, ok := strings.CutPrefix(groupBy, groupByLabelPrefix)
+	if !ok || strings.Contains(label, ":") || !validLabel(label) {
+		return boardGroup{}, errors.New(InvalidBoardGroupError)
+	}
+	return boardGroup{label: label}, nil
+}
+
+func (g boardGroup) String() string {
+	if g.label == "" {
+		return groupByStatus
+	}
+	return groupByLabelPrefix + g.label
+}
+
+// prefix is what the labels of the group's columns start with.
+func (g boardGroup) prefix() string {
+	return g.label + ":"
+}
+
+// columnLabels returns the labels of the group among labels. A task with
+// several labels of the group appears in each of their columns.
+func (g boardGroup) columnLabels(labels []string) []string {
+	var matching []string
+	for _, label := range labels {
+		if strings.HasPrefix(label, g.prefix()) {
+			matching = append(matching, label)
+		}
+	}
+	return matching
+}
+
+// GetBoard returns the board of a project, with a page of tasks of each
+// column in the order of their ranks.
+func (svc *TaskService) GetBoard(ctx context.Context, projectID uuid.UUID, groupBy string, limit, offset int) (*models.Board, error) {
+	group, err := parseBoardGroup(groupBy)
+	if err != nil {
+		return nil, err
+	}
+	project, err := svc.projects.GetProject(ctx, projectID)
+	if err != nil {
+		return nil, projectError(err)
+	}
+	entries, err := svc.store.ListBoardEntries(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	var columns []*models.BoardColumn
+	var columnEntries map[string][]models.BoardEntry
+	if group.label == "" {
+		columns, columnEntries, err = svc.statusColumns(ctx, project, entries)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		columns, columnEntries = labelColumns(group, project, entries)
+	}
+
+	limit, offset = pageBounds(limit, offset)
+	var pageIDs []uuid.UUID
+	for _, column := range columns {
+		for i, entry := range columnEntries[column.Key] {
+			if i >= offset && i < offset+limit {
+				pageIDs = append(pageIDs, entry.ID)
+			}
+		}
+	}
+	tasks, err := svc.store.ListTasksByIDs(ctx, pageIDs)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[uuid.UUID]*models.Task, len(tasks))
+	for _, task := range tasks {
+		byID[task.ID] = task
+	}
+
+	for _, column := range columns {
+		page := &models.Page[*models.Task]{Items: []*models.Task{}, Limit: limit, Offset: offset}
+		for i, entry := range columnEntries[column.Key] {
+			page.Total++
+			if entry.Open {
+				column.OpenCount++
+			}
+			if task := byID[entry.ID]; task != nil && i >= offset && i < offset+limit {
+				page.Items = append(page.Items, task)
+			}
+		}
+		column.Count = page.Total
+		column.Tasks = page
+		if limit, ok := project.WIPLimits[column.Key]; ok && column.Key != "" {
+			column.WIPLimit = &limit
+			column.OverLimit = column.OpenCount > limit
+		}
+	}
+	return &models.Board{Project: project, GroupBy: group.String(), Columns: columns}, nil
+}
+
+// statusColumns splits the entries of a board into one column per status of
+// the project's workflow.
+func (svc *TaskService) statusColumns(ctx context.Context, project *models.Project, entries []models.BoardEntry) ([]*models.BoardColumn, map[string][]models.BoardEntry, error) {
+	w, err := svc.workflows.GetWorkflow(ctx, project.WorkflowID)
+	if err != nil {
+		return nil, nil, err
+	}
+	columns := make([]*models.BoardColumn, 0, len(w.Statuses))
+	for _, status := range w.Statuses {
+		columns = append(columns, &models.BoardColumn{Key: status.Key, Name: status.Name})
+	}
+	columnEntries := make(map[string][]models.BoardEntry, len(columns))
+	for _, entry := range entries {
+		if status := w.StatusByID(entry.StatusID); status != nil {
+			columnEntries[status.Key] = append(columnEntries[status.Key], entry)
+		}
+	}
+	return columns, columnEntries, nil
+}
+
+// labelColumns splits the entries of a board into one column per label of
+// the group, in alphabetical order, followed by a column of the tasks
+// without any. Labels with a WIP limit get a column even if no task has
+// them.
+func labelColumns(group boardGroup, project *models.Project, entries []models.BoardEntry) ([]*models.BoardColumn, map[string][]models.BoardEntry) {
+	columnEntries := make(map[string][]models.BoardEntry)
+	for _, entry := range entries {
+		labels := group.columnLabels(entry.Labels)
+		if len(labels) == 0 {
+			labels = []string{""}
+		}
+		for _, label := range labels {
+			columnEntries[label] = append(columnEntries[label], entry)
+		}
+	}
+	for label := range project.WIPLimits {
+		if _, ok := columnEntries[label]; !ok && strings.HasPrefix(label, group.prefix()) {
+			columnEntries[label] = nil
+		}
+	}
+	labels := make([]string, 0, len(columnEntries))
+	for label := range columnEntries {
+		if label != "" {
+			labels = append(labels, label)
+		}
+	}
+	sort.Strings(labels)
+	columns := make([]*models.BoardColumn, 0, len(labels)+1)
+	for _, label := range labels {
+		columns = append(columns, &models.BoardColumn{Key: label, Name: strings.TrimPrefix(label, group.prefix())})
+	}
+	columns = append(columns, &models.BoardColumn{Name: fmt.Sprintf("No %s", group.label)})
+	return columns, columnEntries
+}
+
+// columnTarget resolves the column a task is moved into on the board of its
+// project. It returns an empty target if the task already is in the column.
+func (svc *TaskService) columnTarget(ctx context.Context, existing *models.Task, group boardGroup, column string, force bool) (models.ColumnTarget, error) {
+	if existing.ProjectID == nil {
+		return models.ColumnTarget{}, errors.New(TaskNotInProjectError)
+	}
+	project, err := svc.projects.GetProject(ctx, *existing.ProjectID)
+	if err != nil {
+		return models.ColumnTarget{}, projectError(err)
+	}
+	var target models.ColumnTarget
+	if group.label == "" {
+		status, err := svc.targetStatus(ctx, existing, models.TaskUpdate{Status: &column})
+		if err != nil {
+			if err.Error() == UnknownStatusError {
+				return models.ColumnTarget{}, errors.New(InvalidColumnError)
+			}
+			return models.ColumnTarget{}, err
+		}
+		if status == nil {
+			return models.ColumnTarget{}, nil
+		}
+		if status.Category == models.StatusCategoryDone && !existing.IsCompleted {
+			if err := svc.checkCompletable(ctx, existing, force); err != nil {
+				return models.ColumnTarget{}, err
+			}
+		}
+		target.Status = status
+	} else {
+		if column != "" && (!strings.HasPrefix(column, group.prefix()) || !validLabel(column)) {
+			return models.ColumnTarget{}, errors.New(InvalidColumnError)
+		}
+		current := group.columnLabels(existing.Labels)
+		if (len(current) == 1 && current[0] == column) || (len(current) == 0 && column == "") {
+			return models.ColumnTarget{}, nil
+		}
+		labels := make([]string, 0, len(existing.Labels)+1)
+		for _, label := range existing.Labels {
+			if !strings.HasPrefix(label, group.prefix()) {
+				labels = append(labels, label)
+			}
+		}
+		if column != "" {
+			labels = append(labels, column)
+		}
+		labels, err := normalizeLabels(labels)
+		if err != nil {
+			return models.ColumnTarget{}, err
+		}
+		target.Labels, target.Label = &labels, column
+	}
+	if limit, ok := project.WIPLimits[column]; ok && column != "" {
+		target.WIPLimit = limit
+		target.EnforceWIP = project.WIPPolicy == models.WIPPolicyEnforce
+	}
+	return target, nil
+}
+
+func projectError(err error) error {
+	if ent.IsNotFound(err) {
+		return errors.New(ProjectNotFoundError)
+	}
+	return err
+}
@@ -0,0 +1,287 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/datastore"
+	"github.com/localopsco/go-sample/ent"
+	"github.com/localopsco/go-sample/models"
+)
+
+const InvalidBulkOperationError = "Operation must be one of create, update, delete, complete, move or label"
+const InvalidBulkModeError = "Mode must be atomic or best_effort"
+const InvalidBulkTargetError = "Create needs tasks, the other operations need either ids or a query"
+const BulkTooLargeError = "A bulk operation can change at most 500 tasks"
+const EmptyBulkLabelsError = "A label operation needs labels to add or remove"
+
+const maxBulkItems = 500
+
+// errBulkRolledBack rolls back an atomic bulk operation with failed items.
+var errBulkRolledBack = errors.New("bulk operation rolled back")
+
+// Bulk applies an operation to many tasks in a single transaction. Each
+// item runs in a savepoint, so a failed item does not affect the others;
+// in atomic mode the whole transaction is then rolled back, while in
+// best-effort mode the items that succeeded are kept. Items go through the
+// same checks as the single-task endpoints, and their events are written to
+// the outbox in the same savepoint.
+func (svc *TaskService) Bulk(ctx context.Context, req models.BulkRequest) (*models.BulkResult, error) {
+	if req.Mode == "" {
+		req.Mode = models.BulkModeAtomic
+	}
+	if err := validateBulkRequest(&req); err != nil {
+		return nil, err
+	}
+	result := &models.BulkResult{Operation: req.Operation, Mode: req.Mode, Items: []*models.BulkItemResult{}}
+	err := svc.store.InTx(ctx, func(client *ent.Client) error {
+		txSvc := svc.withClient(client)
+		if req.Operation == models.BulkCreate {
+			txSvc.bulkCreate(ctx, req, result)
+		} else {
+			ids, err := txSvc.bulkTargets(ctx, req)
+			if err != nil {
+				return err
+			}
+			for i, id := range ids {
+				id := id
+				item := &models.BulkItemResult{Index: i, ID: &id, Status: models.BulkItemSucceeded}
+				err := txSvc.store.Savepoint(ctx, func() error {
+					return txSvc.bulkApply(ctx, req, id, item)
+				})
+				if err != nil {
+					item.Status, item.Task, item.Warnings, item.Err = models.BulkItemFailed, nil, nil, err
+				}
+				result.Items = append(result.Items, item)
+			}
+		}
+		for _, item := range result.Items {
+			if item.Status == models.BulkItemFailed {
+				result.Failed++
+			} else {
+				result.Succeeded++
+			}
+		}
+		if result.Failed > 0 && req.Mode == models.BulkModeAtomic {
+			return errBulkRolledBack
+		}
+		return nil
+	})
+	if errors.Is(err, errBulkRolledBack) {
+		for _, item := range result.Items {
+			if item.Status == models.BulkItemSucceeded {
+				item.Status, item.Task, item.Warnings = models.BulkItemRolledBack, nil, nil
+				if req.Operation == models.BulkCreate {
+					item.ID = nil
+				}
+			}
+		}
+		result.Succeeded = 0
+		return result, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	result.Committed = true
+	return result, nil
+}
+
+func validateBulkRequest(req *models.BulkRequest) error {
+	switch req.Operation {
+	case models.BulkCreate, models.BulkUpdate, models.BulkDelete, models.BulkComplete, models.BulkMove, models.BulkLabel:
+	default:
+		return errors.New(InvalidBulkOperationError)
+	}
+	if req.Mode != models.BulkModeAtomic && req.Mode != models.BulkModeBestEffort {
+		return errors.New(InvalidBulkModeError)
+	}
+	if req.Operation == models.BulkCreate {
+		if len(req.Tasks) == 0 || len(req.IDs) > 0 || req.Query != "" {
+			return errors.New(InvalidBulkTargetError)
+		}
+		if len(req.Tasks) > maxBulkItems {
+			return errors.New(BulkTooLargeError)
+		}
+		return nil
+	}
+	if len(req.Tasks) > 0 || (len(req.IDs) == 0) == (req.Query == "") {
+		return errors.New(InvalidBulkTargetError)
+	}
+	if len(req.IDs) > maxBulkItems {
+		return errors.New(BulkTooLargeError)
+	}
+	if req.Query != "" {
+		if err := validateFilter(models.TaskFilter{Query: req.Query}); err != nil {
+			return err
+		}
+	}
+	switch req.Operation {
+	case models.BulkMove:
+		if _, err := parseBoardGroup(req.GroupBy); err != nil {
+			return err
+		}
+	case models.BulkLabel:
+		if len(req.AddLabels) == 0 && len(req.RemoveLabels) == 0 {
+			return errors.New(EmptyBulkLabelsError)
+		}
+		var err error
+		if req.AddLabels, err = normalizeLabels(req.AddLabels); err != nil {
+			return err
+		}
+		if req.RemoveLabels, err = normalizeLabels(req.RemoveLabels); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// withClient returns a copy of svc whose stores use client, such as one
+// bound to a transaction.
+func (svc *TaskService) withClient(client *ent.Client) *TaskService {
+	return NewTaskService(
+		datastore.NewTaskStore(client),
+		datastore.NewWorkflowStore(client),
+		datastore.NewProjectStore(client),
+		datastore.NewUserStore(client),
+		svc.s3Client,
+		datastore.NewOutboxStore(client),
+	)
+}
+
+// bulkCreate validates the tasks of a create and inserts the valid ones
+// with a single statement.
+func (svc *TaskService) bulkCreate(ctx context.Context, req models.BulkRequest, result *models.BulkResult) {
+	var pending []models.Task
+	var pendingItems []*models.BulkItemResult
+	newSubtasks := make(map[uuid.UUID]int)
+	for i, task := range req.Tasks {
+		item := &models.BulkItemResult{Index: i, Status: models.BulkItemSucceeded}
+		result.Items = append(result.Items, item)
+		task, err := normalizeNewTask(task)
+		if err == nil {
+			task, err = svc.placeNewTask(ctx, task)
+		}
+		if err != nil {
+			item.Status, item.Err = models.BulkItemFailed, err
+			continue
+		}
+		if task.ParentID != nil {
+			task.Position += newSubtasks[*task.ParentID]
+			newSubtasks[*task.ParentID]++
+		}
+		pending = append(pending, task)
+		pendingItems = append(pendingItems, item)
+	}
+	if len(pending) == 0 {
+		return
+	}
+	err := svc.store.Savepoint(ctx, func() error {
+		created, err := svc.store.CreateTasks(ctx, pending)
+		if err != nil {
+			return err
+		}
+		for i, task := range created {
+			pendingItems[i].ID, pendingItems[i].Task = &task.ID, task
+			if err := svc.publish(ctx, models.EventTaskCreated, task); err != nil {
+				return err
+			}
+			if task.IsCompleted && task.ParentID != nil {
+				if err := svc.completeAncestors(ctx, *task.ParentID); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		for _, item := range pendingItems {
+			item.Status, item.ID, item.Task, item.Err = models.BulkItemFailed, nil, nil, err
+		}
+	}
+}
+
+// bulkTargets returns the IDs of the tasks an operation applies to, in the
+// order given or, for a query, by creation.
+func (svc *TaskService) bulkTargets(ctx context.Context, req models.BulkRequest) ([]uuid.UUID, error) {
+	if len(req.IDs) > 0 {
+		ids := make([]uuid.UUID, 0, len(req.IDs))
+		seen := make(map[uuid.UUID]bool, len(req.IDs))
+		for _, id := range req.IDs {
+			if !seen[id] {
+				seen[id] = true
+				ids = append(ids, id)
+			}
+		}
+		return ids, nil
+	}
+	filter := models.TaskFilter{Query: req.Query, Location: req.Location, Sort: "created_at"}
+	ids, err := svc.store.ListTaskIDs(ctx, filter, maxBulkItems+1)
+	if err != nil {
+		return nil, err
+	}
+	if len(ids) > maxBulkItems {
+		return nil, errors.New(BulkTooLargeError)
+	}
+	return ids, nil
+}
+
+// bulkApply applies an operation other than create to one task.
+func (svc *TaskService) bulkApply(ctx context.Context, req models.BulkRequest, id uuid.UUID, item *models.BulkItemResult) error {
+	if req.Operation == models.BulkDelete {
+		return svc.DeleteTask(ctx, id)
+	}
+	if req.Operation == models.BulkMove {
+		column := req.Column
+		moved, err := svc.MoveTask(ctx, models.TaskMove{ID: id, GroupBy: req.GroupBy, Column: &column}, req.Force)
+		if err != nil {
+			return err
+		}
+		item.Task, item.Warnings = moved.Task, moved.Warnings
+		return nil
+	}
+	existing, err := svc.GetTask(ctx, id)
+	if err != nil {
+		return err
+	}
+	update := models.TaskUpdate{ID: id, Title: existing.Title, Description: existing.Description}
+	switch req.Operation {
+	case models.BulkUpdate:
+		u := req.Update
+		if u.Title != nil {
+			update.Title = *u.Title
+		}
+		if u.Description != nil {
+			update.Description = *u.Description
+		}
+		update.Status, update.Priority, update.Labels = u.Status, u.Priority, u.Labels
+		update.StartAt, update.DueAt, update.EstimateMinutes = u.StartAt, u.DueAt, u.EstimateMinutes
+	case models.BulkComplete:
+		completed := true
+		update.IsCompleted = &completed
+	case models.BulkLabel:
+		labels := make([]string, 0, len(existing.Labels)+len(req.AddLabels))
+		for _, label := range existing.Labels {
+			if !containsLabel(req.RemoveLabels, label) {
+				labels = append(labels, label)
+			}
+		}
+		labels = append(labels, req.AddLabels...)
+		update.Labels = &labels
+	}
+	task, err := svc.UpdateTask(ctx, update, req.Force)
+	if err != nil {
+		return err
+	}
+	item.Task = task
+	return nil
+}
+
+func containsLabel(labels []string, label string) bool {
+	for _, l := range labels {
+		if l == label {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,535 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/ent"
+	"github.com/localopsco/go-sample/models"
+)
+
+const InvalidCalendarObjectError = "The body must be an iCalendar object with a VTODO"
+const CalendarObjectNameError = "A new calendar object must be named after its UID"
+const CalendarUIDMismatchError = "The UID of a calendar object cannot change"
+const CalendarUIDTakenError = "Another task has this UID"
+const CalendarObjectChangedError = "The task has changed since it was read"
+const CalendarObjectExistsError = "The task already exists"
+
+const calendarPageSize = 500
+
+// CalendarTasks returns the tasks of a CalDAV collection: those of a
+// project, or those outside of any project if projectID is nil.
+func (svc *TaskService) CalendarTasks(ctx context.Context, projectID *uuid.UUID) ([]*models.Task, error) {
+	filter := models.TaskFilter{ProjectID: projectID, NoProject: projectID == nil}
+	tasks := []*models.Task{}
+	err := svc.store.EachTaskPage(ctx, filter, calendarPageSize, func(page []*models.Task) error {
+		tasks = append(tasks, page...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return tasks, nil
+}
+
+// CalendarObjectName returns the name of a task's resource in its CalDAV
+// collection, without the .ics extension: the UID the task was created with
+// over CalDAV, or its ID.
+func CalendarObjectName(task *models.Task) string {
+	if task.ICalUID != nil {
+		return *task.ICalUID
+	}
+	return task.ID.String()
+}
+
+// GetCalendarObject returns the task stored under a name in a CalDAV
+// collection.
+func (svc *TaskService) GetCalendarObject(ctx context.Context, projectID *uuid.UUID, name string) (*models.Task, error) {
+	task, err := svc.store.GetTaskByICalUID(ctx, name)
+	if err != nil {
+		if !ent.IsNotFound(err) {
+			return nil, err
+		}
+		id, err := uuid.Parse(name)
+		if err != nil {
+			return nil, errors.New(TaskNotFoundError)
+		}
+		if task, err = svc.GetTask(ctx, id); err != nil {
+			return nil, err
+		}
+		// Tasks created over CalDAV are only found by their UID.
+		if task.ICalUID != nil || id.String() != name {
+			return nil, errors.New(TaskNotFoundError)
+		}
+	}
+	if !sameProject(task.ProjectID, projectID) {
+		return nil, errors.New(TaskNotFoundError)
+	}
+	return task, nil
+}
+
+func sameProject(a, b *uuid.UUID) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
+
+// PutCalendarObject creates or replaces the task stored under a name in a
+// CalDAV collection from an iCalendar object. Properties missing from the
+// object are cleared, except for those iCalendar has no place for, like
+// estimates and recurrence, which are left alone. IfMatch, if set, is the
+// version the client expects the task to be at, and IfNoneMatch only lets
+// the task be created. It reports whether the task was created.
+func (svc *TaskService) PutCalendarObject(ctx context.Context, put models.CalendarObjectPut) (*models.Task, bool, error) {
+	todo, err := parseICalendarTodo(put.Data)
+	if err != nil {
+		return nil, false, err
+	}
+	var task *models.Task
+	var created bool
+	err = svc.inTx(ctx, func(tx *TaskService) error {
+		// Keep the task from changing between the version check and the
+		// update.
+		if err := tx.store.LockChanges(ctx); err != nil {
+			return err
+		}
+		existing, err := tx.GetCalendarObject(ctx, put.ProjectID, put.Name)
+		if err != nil && err.Error() != TaskNotFoundError {
+			return err
+		}
+		if existing == nil {
+			if put.IfMatch != nil {
+				return errors.New(CalendarObjectChangedError)
+			}
+			created = true
+			task, err = tx.createCalendarObject(ctx, put, todo)
+			return err
+		}
+		if put.IfNoneMatch {
+			return errors.New(CalendarObjectExistsError)
+		}
+		if put.IfMatch != nil && *put.IfMatch != existing.Version {
+			return errors.New(CalendarObjectChangedError)
+		}
+		if todo.uid != CalendarUID(existing) {
+			return errors.New(CalendarUIDMismatchError)
+		}
+		task, err = tx.updateCalendarObject(ctx, existing, todo)
+		return err
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	return task, created, nil
+}
+
+func (svc *TaskService) createCalendarObject(ctx context.Context, put models.CalendarObjectPut, todo icalTodo) (*models.Task, error) {
+	if todo.uid != put.Name {
+		return nil, errors.New(CalendarObjectNameError)
+	}
+	w, err := svc.workflowForProject(ctx, put.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+	task := models.Task{
+		Title:       todo.title(),
+		Description: todo.description,
+		ProjectID:   put.ProjectID,
+		StartAt:     todo.start,
+		DueAt:       todo.due,
+		Priority:    todo.priority,
+		Labels:      todo.categories,
+		ICalUID:     &todo.uid,
+	}
+	if status := statusInCategory(w, nil, todo.category()); status != nil {
+		task.Status = status.Key
+	}
+	task, err = normalizeNewTask(task)
+	if err != nil {
+		return nil, err
+	}
+	created, err := svc.createTask(ctx, task)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, errors.New(CalendarUIDTakenError)
+		}
+		return nil, err
+	}
+	return created, nil
+}
+
+func (svc *TaskService) updateCalendarObject(ctx context.Context, existing *models.Task, todo icalTodo) (*models.Task, error) {
+	if todo.priority == "" {
+		todo.priority = "none"
+	}
+	update := models.TaskUpdate{
+		ID:          existing.ID,
+		Title:       todo.title(),
+		Description: todo.description,
+		StartAt:     models.Optional[time.Time]{Set: true, Value: todo.start},
+		DueAt:       models.Optional[time.Time]{Set: true, Value: todo.due},
+		Priority:    &todo.priority,
+		Labels:      &todo.categories,
+	}
+	if category := todo.category(); category != existing.StatusCategory {
+		w, err := svc.workflows.GetWorkflowForStatus(ctx, existing.StatusID)
+		if err != nil {
+			return nil, err
+		}
+		if status := statusInCategory(w, w.StatusByID(existing.StatusID), category); status != nil {
+			update.Status = &status.Key
+		}
+	}
+	return svc.updateTask(ctx, update, false)
+}
+
+// statusInCategory picks the status of a workflow in the given category
+// that a task in the current status may move to, or the first one in the
+// category if it can move to none. It returns nil if the workflow has no
+// status in the category.
+func statusInCategory(w *models.Workflow, current *models.WorkflowStatus, category string) *models.WorkflowStatus {
+	var fallback *models.WorkflowStatus
+	for _, status := range w.Statuses {
+		if status.Category != category {
+			continue
+		}
+		if current == nil || current.CanTransition(status.Key) {
+			return status
+		}
+		if fallback == nil {
+			fallback = status
+		}
+	}
+	return fallback
+}
+
+// DeleteCalendarObject moves the task stored under a name in a CalDAV
+// collection to the trash. IfMatch, if set, is the version the client
+// expects the task to be at.
+func (svc *TaskService) DeleteCalendarObject(ctx context.Context, projectID *uuid.UUID, name string, ifMatch *int) error {
+	return svc.inTx(ctx, func(tx *TaskService) error {
+		if err := tx.store.LockChanges(ctx); err != nil {
+			return err
+		}
+		existing, err := tx.GetCalendarObject(ctx, projectID, name)
+		if err != nil {
+			return err
+		}
+		if ifMatch != nil && *ifMatch != existing.Version {
+			return errors.New(CalendarObjectChangedError)
+		}
+		return tx.DeleteTask(ctx, existing.ID)
+	})
+}
+
+// CalendarSyncToken returns the sync token of the latest task change, which
+// CalDAV collections share.
+func (svc *TaskService) CalendarSyncToken(ctx context.Context) (string, error) {
+	cursor, err := svc.store.LatestChange(ctx)
+	if err != nil {
+		return "", err
+	}
+	return encodeSyncToken(cursor), nil
+}
+
+// CalendarChanges lists the changes to a CalDAV collection after a sync
+// token, as in Sync. Tasks that were deleted or moved to another collection
+// are listed by name as removed, except on the first sync, when the client
+// has nothing to remove.
+func (svc *TaskService) CalendarChanges(ctx context.Context, projectID *uuid.UUID, token string) (*models.CalendarChanges, error) {
+	result := &models.CalendarChanges{Tasks: []*models.Task{}, Removed: []string{}}
+	initial := token == ""
+	var deleted []uuid.UUID
+	for {
+		changes, err := svc.Sync(ctx, token, maxSyncPageSize)
+		if err != nil {
+			return nil, err
+		}
+		for _, task := range changes.Tasks {
+			if sameProject(task.ProjectID, projectID) {
+				result.Tasks = append(result.Tasks, task)
+			} else if !initial {
+				result.Removed = append(result.Removed, CalendarObjectName(task))
+			}
+		}
+		for _, tombstone := range changes.Deleted {
+			if !initial {
+				deleted = append(deleted, tombstone.ID)
+			}
+		}
+		token, result.Token = changes.Token, changes.Token
+		if !changes.HasMore {
+			break
+		}
+	}
+	if len(deleted) == 0 {
+		return result, nil
+	}
+	uids, err := svc.store.ICalUIDs(ctx, deleted)
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range deleted {
+		if uid, ok := uids[id]; ok {
+			result.Removed = append(result.Removed, uid)
+		} else {
+			result.Removed = append(result.Removed, id.String())
+		}
+	}
+	return result, nil
+}
+
+// icalTodo holds the properties of a VTODO that map onto task fields.
+type icalTodo struct {
+	uid         string
+	summary     string
+	description string
+	status      string
+	start       *time.Time
+	due         *time.Time
+	completed   *time.Time
+	priority    string
+	categories  []string
+}
+
+func (todo icalTodo) title() string {
+	if title := strings.TrimSpace(todo.summary); title != "" {
+		return title
+	}
+	return "Untitled"
+}
+
+// category returns the status category the VTODO's status maps to.
+func (todo icalTodo) category() string {
+	switch todo.status {
+	case "COMPLETED":
+		return models.StatusCategoryDone
+	case "CANCELLED":
+		return models.StatusCategoryCancelled
+	case "IN-PROCESS":
+		return models.StatusCategoryInProgress
+	case "NEEDS-ACTION":
+		return models.StatusCategoryTodo
+	}
+	if todo.completed != nil {
+		return models.StatusCategoryDone
+	}
+	return models.StatusCategoryTodo
+}
+
+// icalProperty is a content line of an iCalendar object. Parameter values
+// are kept as written, without quotes.
+type icalProperty struct {
+	name   string
+	params map[string]string
+	value  string
+}
+
+// parseICalendarTodo reads the first VTODO of an iCalendar object. Later
+// VTODOs, such as overrides of single occurrences, are ignored.
+func parseICalendarTodo(data []byte) (icalTodo, error) {
+	var todo icalTodo
+	var stack []string
+	found, inTodo := false, false
+	for _, line := range unfoldICalendar(string(data)) {
+		if line == "" {
+			continue
+		}
+		prop, ok := parseICalendarLine(line)
+		if !ok {
+			return icalTodo{}, errors.New(InvalidCalendarObjectError)
+		}
+		switch prop.name {
+		case "BEGIN":
+			stack = append(stack, strings.ToUpper(prop.value))
+			if len(stack) == 1 && stack[0] != "VCALENDAR" {
+				return icalTodo{}, errors.New(InvalidCalendarObjectError)
+			}
+			if len(stack) == 2 {
+				inTodo = stack[1] == "VTODO" && !found
+			}
+		case "END":
+			if len(stack) == 0 || stack[len(stack)-1] != strings.ToUpper(prop.value) {
+				return icalTodo{}, errors.New(InvalidCalendarObjectError)
+			}
+			if inTodo && len(stack) == 2 {
+				inTodo, found = false, true
+			}
+			stack = stack[:len(stack)-1]
+		default:
+			// Properties of nested components such as alarms are skipped.
+			if inTodo && len(stack) == 2 {
+				if err := todo.set(prop); err != nil {
+					return icalTodo{}, err
+				}
+			}
+		}
+	}
+	if !found || len(stack) != 0 || todo.uid == "" {
+		return icalTodo{}, errors.New(InvalidCalendarObjectError)
+	}
+	return todo, nil
+}
+
+func (todo *icalTodo) set(prop icalProperty) error {
+	var err error
+	switch prop.name {
+	case "UID":
+		todo.uid = icalUnescape(prop.value)
+	case "SUMMARY":
+		todo.summary = icalUnescape(prop.value)
+	case "DESCRIPTION":
+		todo.description = icalUnescape(prop.value)
+	case "STATUS":
+		todo.status = strings.ToUpper(prop.value)
+	case "DTSTART":
+		todo.start, err = parseICalendarTime(prop)
+	case "DUE":
+		todo.due, err = parseICalendarTime(prop)
+	case "COMPLETED":
+		todo.completed, err = parseICalendarTime(prop)
+	case "PRIORITY":
+		switch strings.TrimSpace(prop.value) {
+		case "1", "2":
+			todo.priority = "urgent"
+		case "3", "4":
+			todo.priority = "high"
+		case "5":
+			todo.priority = "medium"
+		case "6", "7", "8", "9":
+			todo.priority = "low"
+		default:
+			todo.priority = "none"
+		}
+	case "CATEGORIES":
+		// Categories are free text, while labels are single words.
+		for _, category := range icalSplitList(prop.value) {
+			label := strings.ToLower(strings.Join(strings.Fields(category), "-"))
+			if validLabel(label) && !containsLabel(todo.categories, label) {
+				todo.categories = append(todo.categories, label)
+			}
+		}
+	}
+	if err != nil {
+		return errors.New(InvalidCalendarObjectError)
+	}
+	return nil
+}
+
+// unfoldICalendar splits an iCalendar object into its content lines,
+// joining folded lines back together.
+func unfoldICalendar(data string) []string {
+	var lines []string
+	for _, line := range strings.Split(strings.ReplaceAll(data, "\r\n", "\n"), "\n") {
+		if (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// parseICalendarLine parses a content line of the form
+// NAME;PARAM=value;PARAM="quoted value":value.
+func parseICalendarLine(line string) (icalProperty, bool) {
+	i := strings.IndexAny(line, ";:")
+	if i <= 0 {
+		return icalProperty{}, false
+	}
+	prop := icalProperty{name: strings.ToUpper(line[:i]), params: make(map[string]string)}
+	rest := line[i:]
+	for strings.HasPrefix(rest, ";") {
+		rest = rest[1:]
+		eq := strings.IndexByte(rest, '=')
+		if eq <= 0 {
+			return icalProperty{}, false
+		}
+		name := strings.ToUpper(rest[:eq])
+		rest = rest[eq+1:]
+		var value string
+		if strings.HasPrefix(rest, `"`) {
+			end := strings.IndexByte(rest[1:], '"')
+			if end < 0 {
+				return icalProperty{}, false
+			}
+			value, rest = rest[1:end+1], rest[end+2:]
+		} else {
+			end := strings.IndexAny(rest, ";:")
+			if end < 0 {
+				return icalProperty{}, false
+			}
+			value, rest = rest[:end], rest[end:]
+		}
+		prop.params[name] = value
+	}
+	if !strings.HasPrefix(rest, ":") {
+		return icalProperty{}, false
+	}
+	prop.value = rest[1:]
+	return prop, true
+}
+
+// parseICalendarTime reads a DATE or DATE-TIME value. Times with a TZID the
+// time zone database does not know, and floating times, are read as UTC.
+func parseICalendarTime(prop icalProperty) (*time.Time, error) {
+	value := strings.TrimSpace(prop.value)
+	if value == "" {
+		return nil, nil
+	}
+	if strings.EqualFold(prop.params["VALUE"], "DATE") || len(value) == len("20060102") {
+		t, err := time.ParseInLocation("20060102", value, time.UTC)
+		return &t, err
+	}
+	if strings.HasSuffix(value, "Z") {
+		t, err := time.Parse("20060102T150405Z", value)
+		return &t, err
+	}
+	loc := time.UTC
+	if tzid := prop.params["TZID"]; tzid != "" {
+		if l, err := time.LoadLocation(strings.TrimPrefix(tzid, "/")); err == nil {
+			loc = l
+		}
+	}
+	t, err := time.ParseInLocation("20060102T150405", value, loc)
+	return &t, err
+}
+
+func icalUnescape(value string) string {
+	var b strings.Builder
+	for i := 0; i < len(value); i++ {
+		if value[i] != '\\' || i == len(value)-1 {
+			b.WriteByte(value[i])
+			continue
+		}
+		i++
+		switch value[i] {
+		case 'n', 'N':
+			b.WriteByte('\n')
+		default:
+			b.WriteByte(value[i])
+		}
+	}
+	return b.String()
+}
+
+// icalSplitList splits a list of text values on the commas that are not
+// escaped, and unescapes the values.
+func icalSplitList(value string) []string {
+	var values []string
+	start := 0
+	for i := 0; i < len(value); i++ {
+		switch value[i] {
+		case '\\':
+			i++
+		case ',':
+			values = append(values, icalUnescape(value[start:i]))
+			start = i + 1
+		}
+	}
+	return append(values, icalUnescape(value[start:]))
+}
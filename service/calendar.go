@@ -0,0 +1,338 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/localopsco/go-sample/models"
+)
+
+const InvalidCalendarComponentsError = "Components must be among vevent and vtodo"
+
+const calendarProductID = "-//localopsco//go-sample//EN"
+
+// calendarUIDDomain qualifies the UIDs of feed components, which are
+// derived from task IDs so that they stay the same across refreshes.
+const calendarUIDDomain = "go-sample"
+
+// calendarRefresh is how often calendar apps are asked to refresh a feed.
+const calendarRefresh = "PT15M"
+
+// icalLineLength is the longest a content line may be before it is folded,
+// in octets and without the line break.
+const icalLineLength = 75
+
+// Calendar renders the tasks with a due date as an iCalendar (RFC 5545)
+// feed. Each task becomes a VEVENT at its due time, lasting its estimate if
+// it has one, a VTODO due then, or both. Unless the feed is in UTC, times are
+// written in the filter's time zone, which the feed describes for the years
+// its tasks span.
+func (svc *ExportService) Calendar(ctx context.Context, opts models.CalendarOptions) ([]byte, error) {
+	if len(opts.Components) == 0 {
+		opts.Components = []string{models.CalendarEvents}
+	}
+	for _, component := range opts.Components {
+		if component != models.CalendarEvents && component != models.CalendarTodos {
+			return nil, errors.New(InvalidCalendarComponentsError)
+		}
+	}
+	if err := validateFilter(opts.Filter); err != nil {
+		return nil, err
+	}
+	filter := opts.Filter
+	filter.HasDue = true
+	if filter.Location == nil {
+		filter.Location = time.UTC
+	}
+	var tasks []*models.Task
+	err := svc.tasks.EachTaskPage(ctx, filter, exportPageSize, func(page []*models.Task) error {
+		tasks = append(tasks, page...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	w := &icalWriter{loc: filter.Location, stamp: time.Now()}
+	w.line("BEGIN", "VCALENDAR")
+	w.line("VERSION", "2.0")
+	w.line("PRODID", calendarProductID)
+	w.line("CALSCALE", "GREGORIAN")
+	w.line("METHOD", "PUBLISH")
+	w.text("X-WR-CALNAME", "Tasks")
+	w.line("X-WR-TIMEZONE", filter.Location.String())
+	w.line("REFRESH-INTERVAL;VALUE=DURATION", calendarRefresh)
+	w.line("X-PUBLISHED-TTL", calendarRefresh)
+	if filter.Location != time.UTC && len(tasks) > 0 {
+		first, last := *tasks[0].DueAt, *tasks[0].DueAt
+		for _, task := range tasks {
+			for _, t := range []*time.Time{task.StartAt, task.DueAt} {
+				if t != nil && t.Before(first) {
+					first = *t
+				}
+				if t != nil && t.After(last) {
+					last = *t
+				}
+			}
+		}
+		w.timezone(first, last)
+	}
+	for _, task := range tasks {
+		for _, component := range opts.Components {
+			if component == models.CalendarEvents {
+				w.event(task)
+			} else {
+				w.todo(task)
+			}
+		}
+	}
+	w.line("END", "VCALENDAR")
+	return w.buf.Bytes(), nil
+}
+
+// TaskICalendar renders a task as an iCalendar object holding a single
+// VTODO, as served over CalDAV. Times are written in UTC.
+func TaskICalendar(task *models.Task) []byte {
+	w := &icalWriter{loc: time.UTC}
+	w.line("BEGIN", "VCALENDAR")
+	w.line("VERSION", "2.0")
+	w.line("PRODID", calendarProductID)
+	w.todo(task)
+	w.line("END", "VCALENDAR")
+	return w.buf.Bytes()
+}
+
+// CalendarUID returns the UID of a task's VTODO: the UID it was created with
+// over CalDAV, or one derived from its ID.
+func CalendarUID(task *models.Task) string {
+	if task.ICalUID != nil {
+		return *task.ICalUID
+	}
+	return fmt.Sprintf("%s@%s", task.ID, calendarUIDDomain)
+}
+
+// icalWriter writes the content lines of an iCalendar object, folding long
+// lines and writing times in loc. Components are stamped with stamp, or with
+// the creation time of their task if it is zero, so that the object only
+// changes along with the task.
+type icalWriter struct {
+	buf   bytes.Buffer
+	loc   *time.Location
+	stamp time.Time
+}
+
+func (w *icalWriter) event(task *models.Task) {
+	due := *task.DueAt
+	w.line("BEGIN", "VEVENT")
+	w.line("UID", fmt.Sprintf("%s-due@%s", task.ID, calendarUIDDomain))
+	w.common(task)
+	if task.EstimateMinutes != nil && *task.EstimateMinutes > 0 {
+		w.time("DTSTART", due.Add(-time.Duration(*task.EstimateMinutes)*time.Minute))
+		w.time("DTEND", due)
+	} else {
+		w.time("DTSTART", due)
+	}
+	if task.StatusCategory == models.StatusCategoryCancelled {
+		w.line("STATUS", "CANCELLED")
+	} else {
+		w.line("STATUS", "CONFIRMED")
+	}
+	// Deadlines do not make anyone busy.
+	w.line("TRANSP", "TRANSPARENT")
+	w.line("END", "VEVENT")
+}
+
+func (w *icalWriter) todo(task *models.Task) {
+	w.line("BEGIN", "VTODO")
+	w.text("UID", CalendarUID(task))
+	w.common(task)
+	if task.StartAt != nil && (task.DueAt == nil || task.StartAt.Before(*task.DueAt)) {
+		w.time("DTSTART", *task.StartAt)
+	}
+	if task.DueAt != nil {
+		w.time("DUE", *task.DueAt)
+	}
+	w.line("STATUS", CalendarTodoStatus(task))
+	if task.StatusCategory == models.StatusCategoryDone && task.CompletedAt != nil {
+		w.utcTime("COMPLETED", *task.CompletedAt)
+	}
+	w.line("END", "VTODO")
+}
+
+// CalendarTodoStatus returns the STATUS of a task's VTODO.
+func CalendarTodoStatus(task *models.Task) string {
+	switch task.StatusCategory {
+	case models.StatusCategoryInProgress:
+		return "IN-PROCESS"
+	case models.StatusCategoryDone:
+		return "COMPLETED"
+	case models.StatusCategoryCancelled:
+		return "CANCELLED"
+	}
+	return "NEEDS-ACTION"
+}
+
+// common writes the properties events and to-dos share.
+func (w *icalWriter) common(task *models.Task) {
+	if w.stamp.IsZero() {
+		w.utcTime("DTSTAMP", task.CreatedAt)
+	} else {
+		w.utcTime("DTSTAMP", w.stamp)
+	}
+	w.utcTime("CREATED", task.CreatedAt)
+	w.line("SEQUENCE", fmt.Sprint(task.Version))
+	w.text("SUMMARY", task.Title)
+	if task.Description != "" {
+		w.text("DESCRIPTION", task.Description)
+	}
+	if priority, ok := icalPriorities[task.Priority]; ok {
+		w.line("PRIORITY", fmt.Sprint(priority))
+	}
+	if len(task.Labels) > 0 {
+		categories := make([]string, len(task.Labels))
+		for i, label := range task.Labels {
+			categories[i] = icalEscape(label)
+		}
+		w.line("CATEGORIES", strings.Join(categories, ","))
+	}
+}
+
+// icalPriorities maps task priorities to iCalendar ones, where 1 is the
+// highest and 9 the lowest.
+var icalPriorities = map[string]int{
+	"urgent": 1,
+	"high":   3,
+	"medium": 5,
+	"low":    7,
+}
+
+// timezone writes a VTIMEZONE for the feed's location that covers the year
+// before first to the year after last. Rather than recurrence rules, which
+// the time zone database does not expose, it lists every change of UTC
+// offset in that period.
+func (w *icalWriter) timezone(first, last time.Time) {
+	type observance struct {
+		start    time.Time
+		dst      bool
+		from, to int
+	}
+	start := time.Date(first.In(w.loc).Year()-1, 1, 1, 0, 0, 0, 0, w.loc)
+	end := time.Date(last.In(w.loc).Year()+2, 1, 1, 0, 0, 0, 0, w.loc)
+	_, offset := start.Zone()
+	observances := []observance{{start: start, dst: start.IsDST(), from: offset, to: offset}}
+	for t := start; t.Before(end); {
+		next := t.AddDate(0, 0, 1)
+		_, before := t.Zone()
+		if _, after := next.Zone(); after != before || next.IsDST() != t.IsDST() {
+			// Find the first second of the new offset.
+			lo, hi := t.Unix(), next.Unix()
+			for hi-lo > 1 {
+				mid := lo + (hi-lo)/2
+				if m := time.Unix(mid, 0).In(w.loc); m.IsDST() == t.IsDST() && zoneOffset(m) == before {
+					lo = mid
+				} else {
+					hi = mid
+				}
+			}
+			change := time.Unix(hi, 0).In(w.loc)
+			observances = append(observances, observance{start: change, dst: change.IsDST(), from: before, to: zoneOffset(change)})
+		}
+		t = next
+	}
+
+	w.line("BEGIN", "VTIMEZONE")
+	w.line("TZID", w.loc.String())
+	for _, o := range observances {
+		kind := "STANDARD"
+		if o.dst {
+			kind = "DAYLIGHT"
+		}
+		name, _ := o.start.Zone()
+		w.line("BEGIN", kind)
+		// Observances start at the local time in effect before the change.
+		w.line("DTSTART", o.start.UTC().Add(time.Duration(o.from)*time.Second).Format("20060102T150405"))
+		w.line("TZOFFSETFROM", icalOffset(o.from))
+		w.line("TZOFFSETTO", icalOffset(o.to))
+		w.text("TZNAME", name)
+		w.line("END", kind)
+	}
+	w.line("END", "VTIMEZONE")
+}
+
+func zoneOffset(t time.Time) int {
+	_, offset := t.Zone()
+	return offset
+}
+
+// icalOffset formats a UTC offset in seconds as ±hhmm, or ±hhmmss when it
+// is not a whole number of minutes.
+func icalOffset(offset int) string {
+	sign := "+"
+	if offset < 0 {
+		sign, offset = "-", -offset
+	}
+	s := fmt.Sprintf("%s%02d%02d", sign, offset/3600, offset/60%60)
+	if offset%60 != 0 {
+		s += fmt.Sprintf("%02d", offset%60)
+	}
+	return s
+}
+
+// time writes a date-time property in the feed's time zone.
+func (w *icalWriter) time(name string, t time.Time) {
+	if w.loc == time.UTC {
+		w.utcTime(name, t)
+		return
+	}
+	w.line(name+";TZID="+w.loc.String(), t.In(w.loc).Format("20060102T150405"))
+}
+
+func (w *icalWriter) utcTime(name string, t time.Time) {
+	w.line(name, t.UTC().Format("20060102T150405Z"))
+}
+
+// text writes a property with a text value.
+func (w *icalWriter) text(name, value string) {
+	w.line(name, icalEscape(value))
+}
+
+// line writes a content line, folding it into lines of at most
+// icalLineLength octets without splitting characters.
+func (w *icalWriter) line(name, value string) {
+	line := name + ":" + value
+	for width := icalLineLength; len(line) > width; width = icalLineLength - 1 {
+		cut := width
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		w.buf.WriteString(line[:cut])
+		w.buf.WriteString("\r\n ")
+		line = line[cut:]
+	}
+	w.buf.WriteString(line)
+	w.buf.WriteString("\r\n")
+}
+
+// icalEscape escapes a text value. Control characters other than tabs are
+// not allowed in iCalendar and are dropped.
+func icalEscape(value string) string {
+	var b strings.Builder
+	value = strings.ReplaceAll(value, "\r\n", "\n")
+	for _, r := range value {
+		switch {
+		case r == '\\' || r == ';' || r == ',':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r == '\n':
+			b.WriteString(`\n`)
+		case r == '\t' || r >= ' ' && r != 0x7f:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,145 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/datastore"
+	"github.com/localopsco/go-sample/ent"
+	"github.com/localopsco/go-sample/models"
+)
+
+const CommentNotFoundError = "Comment not found"
+const ParentCommentNotFoundError = "Parent comment not found"
+const EmptyCommentError = "Comment body is required"
+const CommentTooLongError = "Comment body must be at most 10000 characters"
+const NotCommentAuthorError = "Only the author can change a comment"
+
+const maxCommentLength = 10000
+
+type CommentService struct {
+	store *datastore.CommentStore
+	tasks *datastore.TaskStore
+	users *datastore.UserStore
+}
+
+func NewCommentService(commentStore *datastore.CommentStore, taskStore *datastore.TaskStore, userStore *datastore.UserStore) *CommentService {
+	return &CommentService{
+		commentStore,
+		taskStore,
+		userStore,
+	}
+}
+
+// CreateComment adds a comment to a task, or a reply to one of its comments
+// if parentID is set. Replies always attach to the top-level comment so that
+// threads stay one level deep.
+func (svc *CommentService) CreateComment(ctx context.Context, taskID uuid.UUID, author *models.User, body string, parentID *uuid.UUID) (*models.Comment, error) {
+	if _, err := svc.tasks.GetTask(ctx, taskID); err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New(TaskNotFoundError)
+		}
+		return nil, err
+	}
+	if parentID != nil {
+		parent, err := svc.store.GetComment(ctx, *parentID)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return nil, errors.New(ParentCommentNotFoundError)
+			}
+			return nil, err
+		}
+		if parent.TaskID != taskID {
+			return nil, errors.New(ParentCommentNotFoundError)
+		}
+		if parent.ParentID != nil {
+			parentID = parent.ParentID
+		}
+	}
+	c := models.Comment{TaskID: taskID, ParentID: parentID, Body: body}
+	mentionIDs, err := svc.render(ctx, &c)
+	if err != nil {
+		return nil, err
+	}
+	return svc.store.CreateComment(ctx, c, author.ID, mentionIDs)
+}
+
+// ListComments returns a page of a task's top-level comments, or of the
+// replies to parentID.
+func (svc *CommentService) ListComments(ctx context.Context, taskID uuid.UUID, parentID *uuid.UUID, limit, offset int) (*models.Page[*models.Comment], error) {
+	if _, err := svc.tasks.GetTask(ctx, taskID); err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New(TaskNotFoundError)
+		}
+		return nil, err
+	}
+	limit, offset = pageBounds(limit, offset)
+	return svc.store.ListComments(ctx, taskID, parentID, limit, offset)
+}
+
+func (svc *CommentService) UpdateComment(ctx context.Context, taskID, commentID uuid.UUID, author *models.User, body string) (*models.Comment, error) {
+	c, err := svc.authoredComment(ctx, taskID, commentID, author)
+	if err != nil {
+		return nil, err
+	}
+	c.Body = body
+	mentionIDs, err := svc.render(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	return svc.store.UpdateComment(ctx, *c, mentionIDs)
+}
+
+func (svc *CommentService) DeleteComment(ctx context.Context, taskID, commentID uuid.UUID, author *models.User) error {
+	if _, err := svc.authoredComment(ctx, taskID, commentID, author); err != nil {
+		return err
+	}
+	return svc.store.DeleteComment(ctx, commentID)
+}
+
+// MentionedTasks returns the tasks the user has been mentioned on, most
+// recently mentioned first.
+func (svc *CommentService) MentionedTasks(ctx context.Context, user *models.User) ([]*models.Task, error) {
+	taskIDs, err := svc.store.MentionedTaskIDs(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	return svc.tasks.ListTasksByIDs(ctx, taskIDs)
+}
+
+func (svc *CommentService) authoredComment(ctx context.Context, taskID, commentID uuid.UUID, author *models.User) (*models.Comment, error) {
+	c, err := svc.store.GetComment(ctx, commentID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New(CommentNotFoundError)
+		}
+		return nil, err
+	}
+	if c.TaskID != taskID {
+		return nil, errors.New(CommentNotFoundError)
+	}
+	if c.Author == nil || c.Author.ID != author.ID {
+		return nil, errors.New(NotCommentAuthorError)
+	}
+	return c, nil
+}
+
+// render validates the comment body, fills in its HTML and returns the IDs
+// of the existing users it mentions.
+func (svc *CommentService) render(ctx context.Context, c *models.Comment) ([]uuid.UUID, error) {
+	c.Body = strings.TrimSpace(c.Body)
+	if c.Body == "" {
+		return nil, errors.New(EmptyCommentError)
+	}
+	if len([]rune(c.Body)) > maxCommentLength {
+		return nil, errors.New(CommentTooLongError)
+	}
+	html, usernames, err := renderMarkdown(c.Body)
+	if err != nil {
+		return nil, err
+	}
+	c.BodyHTML = html
+	return svc.users.FindUserIDs(ctx, usernames)
+}
@@ -0,0 +1,76 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/ent"
+	"github.com/localopsco/go-sample/models"
+)
+
+// EventPublisher receives the task lifecycle events relayed from the
+// outbox. Delivery is at least once: an event may be published again if
+// the relay fails before recording that it was published.
+type EventPublisher interface {
+	Publish(ctx context.Context, event models.Event) error
+}
+
+// inTx runs fn with a copy of svc bound to a transaction, so that the
+// changes fn makes and the events it emits are committed together. It
+// joins the transaction svc is already bound to, if any.
+func (svc *TaskService) inTx(ctx context.Context, fn func(tx *TaskService) error) error {
+	return svc.store.InTx(ctx, func(client *ent.Client) error {
+		return fn(svc.withClient(client))
+	})
+}
+
+// publish writes an event about task to the outbox. It must be called from
+// a service bound to the transaction of the change, see inTx.
+func (svc *TaskService) publish(ctx context.Context, eventType string, task *models.Task) error {
+	if svc.outbox == nil || task == nil {
+		return nil
+	}
+	return svc.outbox.Publish(ctx, models.Event{
+		ID:         uuid.New(),
+		Type:       eventType,
+		TaskID:     task.ID,
+		ProjectID:  task.ProjectID,
+		Task:       task,
+		ActorID:    models.ActorFrom(ctx),
+		RequestID:  models.RequestIDFrom(ctx),
+		OccurredAt: time.Now().UTC(),
+	})
+}
+
+// publishUpdate emits task.completed if a change completed task and
+// task.updated otherwise.
+func (svc *TaskService) publishUpdate(ctx context.Context, wasCompleted bool, task *models.Task) error {
+	if task != nil && task.IsCompleted && !wasCompleted {
+		return svc.publish(ctx, models.EventTaskCompleted, task)
+	}
+	return svc.publish(ctx, models.EventTaskUpdated, task)
+}
+
+// Publishers publishes each event to all of its publishers in turn.
+type Publishers []EventPublisher
+
+func (publishers Publishers) Publish(ctx context.Context, event models.Event) error {
+	var errs []error
+	for _, publisher := range publishers {
+		if err := publisher.Publish(ctx, event); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// LogPublisher logs each event.
+type LogPublisher struct{}
+
+func (LogPublisher) Publish(ctx context.Context, event models.Event) error {
+	log.Printf("event %s %s task=%s request=%s", event.ID, event.Type, event.TaskID, event.RequestID)
+	return nil
+}
@@ -0,0 +1,545 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/datastore"
+	"github.com/localopsco/go-sample/ent"
+	"github.com/localopsco/go-sample/models"
+)
+
+const InvalidExportFormatError = "Format must be csv, json or ndjson"
+const InvalidExportColumnsError = "Columns must be among id, title, description, status, status_category, is_completed, priority, project_id, project, parent_id, labels, assignees, start_at, due_at, estimate_minutes, attachment_url, created_at and completed_at"
+const ExportJobNotFoundError = "Export job not found"
+const ExportNotReadyError = "Export job has not succeeded"
+const ExportJobsNotEnabledError = "Export jobs are not enabled"
+
+const exportPageSize = 500
+const exportPollInterval = 5 * time.Second
+
+// exportJobTimeout is how long a job may run before it is taken to belong
+// to a worker that died, and is run again.
+const exportJobTimeout = time.Hour
+
+const defaultExportRetention = 24 * time.Hour
+
+// exportDownloadExpiry is how long the link to download a result is valid.
+const exportDownloadExpiry = 15 * time.Minute
+
+// ExportColumns are the columns an export can have, in their default order.
+var ExportColumns = []string{
+	"id",
+	"title",
+	"description",
+	"status",
+	"status_category",
+	"is_completed",
+	"priority",
+	"project_id",
+	"project",
+	"parent_id",
+	"labels",
+	"assignees",
+	"start_at",
+	"due_at",
+	"estimate_minutes",
+	"attachment_url",
+	"created_at",
+	"completed_at",
+}
+
+// ExportService runs exports. The results of export jobs are kept in the
+// S3 bucket of attachments, so that any instance can serve them, and are
+// downloaded from there with presigned URLs.
+type ExportService struct {
+	tasks    *datastore.TaskStore
+	projects *datastore.ProjectStore
+	jobs     *datastore.ExportJobStore
+	s3Client *s3.Client
+	// wake tells the worker that new jobs are waiting.
+	wake chan struct{}
+}
+
+func NewExportService(taskStore *datastore.TaskStore, projectStore *datastore.ProjectStore, exportJobStore *datastore.ExportJobStore, s3Client *s3.Client) *ExportService {
+	return &ExportService{
+		taskStore,
+		projectStore,
+		exportJobStore,
+		s3Client,
+		make(chan struct{}, 1),
+	}
+}
+
+// CheckExport validates the options of an export and fills in the default
+// columns, so that problems are reported before any output is written.
+func (svc *ExportService) CheckExport(opts models.ExportOptions) (models.ExportOptions, error) {
+	switch opts.Format {
+	case models.ExportCSV, models.ExportJSON, models.ExportNDJSON:
+	default:
+		return opts, errors.New(InvalidExportFormatError)
+	}
+	if len(opts.Columns) == 0 {
+		opts.Columns = ExportColumns
+	}
+	for _, column := range opts.Columns {
+		if !containsLabel(ExportColumns, column) {
+			return opts, errors.New(InvalidExportColumnsError)
+		}
+	}
+	if err := validateFilter(opts.Filter); err != nil {
+		return opts, err
+	}
+	return opts, nil
+}
+
+// ExportFilename returns the name of an export made at a given time.
+func ExportFilename(format string, at time.Time) string {
+	return fmt.Sprintf("tasks-%s.%s", at.UTC().Format("20060102-150405"), format)
+}
+
+// ExportContentType returns the media type of an export format.
+func ExportContentType(format string) string {
+	switch format {
+	case models.ExportCSV:
+		return "text/csv; charset=utf-8"
+	case models.ExportNDJSON:
+		return "application/x-ndjson"
+	}
+	return "application/json; charset=utf-8"
+}
+
+// Export writes the tasks matching the options to w and returns how many it
+// wrote. Tasks are read a page at a time and each page is flushed to w
+// before the next one is read, if w can be flushed.
+func (svc *ExportService) Export(ctx context.Context, w io.Writer, opts models.ExportOptions) (int, error) {
+	opts, err := svc.CheckExport(opts)
+	if err != nil {
+		return 0, err
+	}
+	loc := opts.Filter.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+	projects, err := svc.projects.ListProjects(ctx)
+	if err != nil {
+		return 0, err
+	}
+	projectNames := make(map[uuid.UUID]string, len(projects))
+	for _, p := range projects {
+		projectNames[p.ID] = p.Name
+	}
+
+	out := newExportWriter(w, opts.Format, opts.Columns)
+	if err := out.begin(); err != nil {
+		return 0, err
+	}
+	rows := 0
+	values := make([]any, len(opts.Columns))
+	err = svc.tasks.EachTaskPage(ctx, opts.Filter, exportPageSize, func(tasks []*models.Task) error {
+		for _, task := range tasks {
+			for i, column := range opts.Columns {
+				values[i] = exportValue(task, column, projectNames, loc)
+			}
+			if err := out.row(values); err != nil {
+				return err
+			}
+			rows++
+		}
+		return out.flush()
+	})
+	if err != nil {
+		return rows, err
+	}
+	return rows, out.end()
+}
+
+// exportValue returns the value of a column for a task as a string, bool,
+// int, string slice or nil. Times are formatted in loc.
+func exportValue(task *models.Task, column string, projectNames map[uuid.UUID]string, loc *time.Location) any {
+	optionalTime := func(t *time.Time) any {
+		if t == nil {
+			return nil
+		}
+		return t.In(loc).Format(time.RFC3339)
+	}
+	optionalID := func(id *uuid.UUID) any {
+		if id == nil {
+			return nil
+		}
+		return id.String()
+	}
+	switch column {
+	case "id":
+		return task.ID.String()
+	case "title":
+		return task.Title
+	case "description":
+		return task.Description
+	case "status":
+		return task.Status
+	case "status_category":
+		return task.StatusCategory
+	case "is_completed":
+		return task.IsCompleted
+	case "priority":
+		return task.Priority
+	case "project_id":
+		return optionalID(task.ProjectID)
+	case "project":
+		if task.ProjectID == nil {
+			return nil
+		}
+		return projectNames[*task.ProjectID]
+	case "parent_id":
+		return optionalID(task.ParentID)
+	case "labels":
+		return append([]string{}, task.Labels...)
+	case "assignees":
+		usernames := make([]string, 0, len(task.Assignees))
+		for _, assignee := range task.Assignees {
+			usernames = append(usernames, assignee.Username)
+		}
+		return usernames
+	case "start_at":
+		return optionalTime(task.StartAt)
+	case "due_at":
+		return optionalTime(task.DueAt)
+	case "estimate_minutes":
+		if task.EstimateMinutes == nil {
+			return nil
+		}
+		return *task.EstimateMinutes
+	case "attachment_url":
+		if task.AttachmentURL == nil {
+			return nil
+		}
+		return *task.AttachmentURL
+	case "created_at":
+		return optionalTime(&task.CreatedAt)
+	case "completed_at":
+		return optionalTime(task.CompletedAt)
+	}
+	return nil
+}
+
+// exportWriter writes the rows of an export in one format.
+type exportWriter struct {
+	w       io.Writer
+	format  string
+	columns []string
+	csv     *csv.Writer
+	rows    int
+}
+
+func newExportWriter(w io.Writer, format string, columns []string) *exportWriter {
+	out := &exportWriter{w: w, format: format, columns: columns}
+	if format == models.ExportCSV {
+		out.csv = csv.NewWriter(w)
+	}
+	return out
+}
+
+func (out *exportWriter) begin() error {
+	switch out.format {
+	case models.ExportCSV:
+		return out.csv.Write(out.columns)
+	case models.ExportJSON:
+		_, err := io.WriteString(out.w, "[")
+		return err
+	}
+	return nil
+}
+
+func (out *exportWriter) row(values []any) error {
+	defer func() { out.rows++ }()
+	if out.format == models.ExportCSV {
+		record := make([]string, len(values))
+		for i, value := range values {
+			record[i] = csvValue(value)
+		}
+		return out.csv.Write(record)
+	}
+	var b bytes.Buffer
+	if out.format == models.ExportJSON {
+		if out.rows > 0 {
+			b.WriteString(",")
+		}
+		b.WriteString("\n")
+	}
+	// Objects are written by hand to keep the columns in order.
+	b.WriteString("{")
+	for i, column := range out.columns {
+		if i > 0 {
+			b.WriteString(",")
+		}
+		key, _ := json.Marshal(column)
+		value, err := json.Marshal(values[i])
+		if err != nil {
+			return err
+		}
+		b.Write(key)
+		b.WriteString(":")
+		b.Write(value)
+	}
+	b.WriteString("}")
+	if out.format == models.ExportNDJSON {
+		b.WriteString("\n")
+	}
+	_, err := out.w.Write(b.Bytes())
+	return err
+}
+
+// flush sends the rows written so far on to the client.
+func (out *exportWriter) flush() error {
+	if out.csv != nil {
+		out.csv.Flush()
+		if err := out.csv.Error(); err != nil {
+			return err
+		}
+	}
+	if f, ok := out.w.(interface{ Flush() }); ok {
+		f.Flush()
+	}
+	return nil
+}
+
+func (out *exportWriter) end() error {
+	if out.format == models.ExportJSON {
+		closing := "]\n"
+		if out.rows > 0 {
+			closing = "\n]\n"
+		}
+		if _, err := io.WriteString(out.w, closing); err != nil {
+			return err
+		}
+	}
+	return out.flush()
+}
+
+func csvValue(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case bool:
+		return strconv.FormatBool(v)
+	case int:
+		return strconv.Itoa(v)
+	case []string:
+		return strings.Join(v, ",")
+	}
+	return fmt.Sprint(value)
+}
+
+// CreateJob queues an export to run in the background on behalf of userID.
+// Jobs need S3 to keep their results.
+func (svc *ExportService) CreateJob(ctx context.Context, opts models.ExportOptions, userID *uuid.UUID) (*models.ExportJob, error) {
+	if s3Enabled, _ := strconv.ParseBool(os.Getenv("S3_ENABLED")); !s3Enabled {
+		return nil, errors.New(ExportJobsNotEnabledError)
+	}
+	opts, err := svc.CheckExport(opts)
+	if err != nil {
+		return nil, err
+	}
+	timezone := "UTC"
+	if opts.Filter.Location != nil {
+		timezone = opts.Filter.Location.String()
+	}
+	job, err := svc.jobs.CreateJob(ctx, models.ExportJob{
+		UserID:   userID,
+		Format:   opts.Format,
+		Columns:  opts.Columns,
+		Filter:   opts.Filter,
+		Timezone: timezone,
+	})
+	if err != nil {
+		return nil, err
+	}
+	select {
+	case svc.wake <- struct{}{}:
+	default:
+	}
+	return withExpiry(job), nil
+}
+
+// GetJob returns an export job of userID. Jobs of other users are reported
+// as not found.
+func (svc *ExportService) GetJob(ctx context.Context, jobID uuid.UUID, userID *uuid.UUID) (*models.ExportJob, error) {
+	job, err := svc.jobs.GetJob(ctx, jobID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New(ExportJobNotFoundError)
+		}
+		return nil, err
+	}
+	if job.UserID != nil && (userID == nil || *job.UserID != *userID) {
+		return nil, errors.New(ExportJobNotFoundError)
+	}
+	return withExpiry(job), nil
+}
+
+// ResultURL returns a presigned URL to download the result of an export
+// job of userID, valid for exportDownloadExpiry.
+func (svc *ExportService) ResultURL(ctx context.Context, jobID uuid.UUID, userID *uuid.UUID) (string, error) {
+	job, err := svc.GetJob(ctx, jobID, userID)
+	if err != nil {
+		return "", err
+	}
+	if job.Status != models.ExportJobSucceeded {
+		return "", errors.New(ExportNotReadyError)
+	}
+	// Jobs that succeeded before results were kept in S3 have no result
+	// there.
+	if job.ResultKey == "" {
+		return "", errors.New(ExportJobNotFoundError)
+	}
+	request, err := s3.NewPresignClient(svc.s3Client).PresignGetObject(ctx, &s3.GetObjectInput{
+		Bucket:                     aws.String(os.Getenv("S3_BUCKET_NAME")),
+		Key:                        aws.String(job.ResultKey),
+		ResponseContentType:        aws.String(ExportContentType(job.Format)),
+		ResponseContentDisposition: aws.String(fmt.Sprintf(`attachment; filename="%s"`, ExportFilename(job.Format, *job.FinishedAt))),
+	}, s3.WithPresignExpires(exportDownloadExpiry))
+	if err != nil {
+		return "", err
+	}
+	return request.URL, nil
+}
+
+func withExpiry(job *models.ExportJob) *models.ExportJob {
+	if job.FinishedAt != nil {
+		expiresAt := job.FinishedAt.Add(exportRetention())
+		job.ExpiresAt = &expiresAt
+	}
+	return job
+}
+
+// RunWorker runs queued export jobs until ctx is cancelled, and removes
+// the jobs and results that expired. It wakes up when jobs are created, and
+// polls for jobs created by other instances.
+func (svc *ExportService) RunWorker(ctx context.Context) {
+	ticker := time.NewTicker(exportPollInterval)
+	defer ticker.Stop()
+	for {
+		if _, err := svc.RunPending(ctx); err != nil {
+			log.Printf("error running export jobs: %v", err)
+		}
+		if err := svc.PurgeExpired(ctx); err != nil {
+			log.Printf("error purging export jobs: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-svc.wake:
+		}
+	}
+}
+
+// RunPending runs queued export jobs one at a time until none are left,
+// and returns how many it ran. Failing jobs are recorded as failed.
+func (svc *ExportService) RunPending(ctx context.Context) (int, error) {
+	ran := 0
+	for {
+		now := time.Now()
+		job, err := svc.jobs.ClaimJob(ctx, now, now.Add(-exportJobTimeout))
+		if err != nil || job == nil {
+			return ran, err
+		}
+		job.ResultKey, job.Rows, err = svc.runJob(ctx, job)
+		if err != nil {
+			job.Status, job.Error, job.ResultKey = models.ExportJobFailed, err.Error(), ""
+		} else {
+			job.Status = models.ExportJobSucceeded
+		}
+		if err := svc.jobs.FinishJob(ctx, *job); err != nil {
+			return ran, err
+		}
+		ran++
+	}
+}
+
+// runJob exports the result of a job to S3 and returns its key. The result
+// is written to a temporary file first, so that its size is known when it
+// is uploaded.
+func (svc *ExportService) runJob(ctx context.Context, job *models.ExportJob) (string, int, error) {
+	loc, err := time.LoadLocation(job.Timezone)
+	if err != nil {
+		return "", 0, err
+	}
+	filter := job.Filter
+	filter.Location = loc
+	if job.UserID != nil {
+		ctx = models.WithActor(ctx, *job.UserID)
+	}
+	f, err := os.CreateTemp("", "task-export-*."+job.Format)
+	if err != nil {
+		return "", 0, err
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+	rows, err := svc.Export(ctx, f, models.ExportOptions{Format: job.Format, Columns: job.Columns, Filter: filter})
+	if err != nil {
+		return "", 0, err
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return "", 0, err
+	}
+	key := "export_" + job.ID.String() + "." + job.Format
+	_, err = svc.s3Client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:      aws.String(os.Getenv("S3_BUCKET_NAME")),
+		ContentType: aws.String(ExportContentType(job.Format)),
+		Body:        f,
+		Key:         aws.String(key),
+	})
+	if err != nil {
+		return "", 0, fmt.Errorf("uploading export to s3: %w", err)
+	}
+	return key, rows, nil
+}
+
+// PurgeExpired deletes the export jobs that finished longer ago than the
+// retention period, along with their results.
+func (svc *ExportService) PurgeExpired(ctx context.Context) error {
+	jobs, err := svc.jobs.ListFinishedBefore(ctx, time.Now().Add(-exportRetention()))
+	if err != nil {
+		return err
+	}
+	for _, job := range jobs {
+		if job.ResultKey != "" {
+			_, err := svc.s3Client.DeleteObject(ctx, &s3.DeleteObjectInput{
+				Bucket: aws.String(os.Getenv("S3_BUCKET_NAME")),
+				Key:    aws.String(job.ResultKey),
+			})
+			if err != nil {
+				return err
+			}
+		}
+		if err := svc.jobs.DeleteJob(ctx, job.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func exportRetention() time.Duration {
+	retention, err := time.ParseDuration(os.Getenv("EXPORT_RETENTION"))
+	if err != nil || retention <= 0 {
+		return defaultExportRetention
+	}
+	return retention
+}
@@ -0,0 +1,75 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/datastore"
+	"github.com/localopsco/go-sample/ent"
+)
+
+// GraphService reads tasks and the entities around them for the GraphQL
+// API. Changes go through the other services, which apply the rules of
+// workflows and publish events, and are read back from here.
+type GraphService struct {
+	store *datastore.GraphStore
+}
+
+func NewGraphService(graphStore *datastore.GraphStore) *GraphService {
+	return &GraphService{
+		graphStore,
+	}
+}
+
+// PaginateTasks returns a page of the tasks matching where, in the order
+// orderBy gives or by ID.
+func (svc *GraphService) PaginateTasks(ctx context.Context, after *ent.Cursor, first *int, before *ent.Cursor, last *int, orderBy *ent.TaskOrder, where *ent.TaskWhereInput) (*ent.TaskConnection, error) {
+	return svc.store.PaginateTasks(ctx, after, first, before, last, orderBy, where)
+}
+
+func (svc *GraphService) PaginateProjects(ctx context.Context, after *ent.Cursor, first *int, before *ent.Cursor, last *int, orderBy *ent.ProjectOrder, where *ent.ProjectWhereInput) (*ent.ProjectConnection, error) {
+	return svc.store.PaginateProjects(ctx, after, first, before, last, orderBy, where)
+}
+
+func (svc *GraphService) GetTask(ctx context.Context, taskID uuid.UUID) (*ent.Task, error) {
+	task, err := svc.store.GetTask(ctx, taskID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New(TaskNotFoundError)
+		}
+		return nil, err
+	}
+	return task, nil
+}
+
+func (svc *GraphService) GetProject(ctx context.Context, projectID uuid.UUID) (*ent.Project, error) {
+	project, err := svc.store.GetProject(ctx, projectID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New(ProjectNotFoundError)
+		}
+		return nil, err
+	}
+	return project, nil
+}
+
+func (svc *GraphService) GetUser(ctx context.Context, userID uuid.UUID) (*ent.User, error) {
+	user, err := svc.store.GetUser(ctx, userID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New(UserNotFoundError)
+		}
+		return nil, err
+	}
+	return user, nil
+}
+
+// GetNode returns the task, project, user or workflow status with an ID.
+func (svc *GraphService) GetNode(ctx context.Context, id uuid.UUID) (ent.Noder, error) {
+	return svc.store.GetNode(ctx, id)
+}
+
+func (svc *GraphService) GetNodes(ctx context.Context, ids []uuid.UUID) ([]ent.Noder, error) {
+	return svc.store.GetNodes(ctx, ids)
+}
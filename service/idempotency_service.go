@@ -0,0 +1,140 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log"
+	"os"
+	"time"
+
+	"github.com/localopsco/go-sample/datastore"
+	"github.com/localopsco/go-sample/ent"
+	"github.com/localopsco/go-sample/models"
+)
+
+const InvalidIdempotencyKeyError = "Idempotency-Key must be 1 to 255 printable ASCII characters"
+const IdempotencyKeyReusedError = "Idempotency-Key was already used for a different request"
+const IdempotencyKeyInProgressError = "A request with this Idempotency-Key is still being processed"
+
+const defaultIdempotencyKeyTTL = 24 * time.Hour
+
+// A retry arriving while the first request is processed waits up to
+// idempotencyWait for its response. A request still unanswered after
+// idempotencyLockTimeout is assumed to have died, and its key is freed.
+const (
+	idempotencyWait          = 5 * time.Second
+	idempotencyPollInterval  = 100 * time.Millisecond
+	idempotencyLockTimeout   = time.Minute
+	idempotencyPurgeInterval = time.Hour
+)
+
+const maxIdempotencyKeyLength = 255
+
+type IdempotencyService struct {
+	store *datastore.IdempotencyStore
+}
+
+func NewIdempotencyService(idempotencyStore *datastore.IdempotencyStore) *IdempotencyService {
+	return &IdempotencyService{
+		idempotencyStore,
+	}
+}
+
+// Begin claims key for a request. It returns nil if the caller should go on
+// to process the request, then call Complete or Release. If the key was
+// already used for the same request, it returns the response to replay,
+// waiting for it while the first request is in progress. Reusing a key for
+// a different request is rejected.
+func (svc *IdempotencyService) Begin(ctx context.Context, key models.IdempotencyKey) (*models.RecordedResponse, error) {
+	if !validIdempotencyKey(key.Key) {
+		return nil, errors.New(InvalidIdempotencyKeyError)
+	}
+	deadline := time.Now().Add(idempotencyWait)
+	for {
+		now := time.Now()
+		err := svc.store.ReserveKey(ctx, key, now.Add(idempotencyKeyTTL()))
+		if err == nil {
+			return nil, nil
+		}
+		if !ent.IsConstraintError(err) {
+			return nil, err
+		}
+		stored, err := svc.store.GetKey(ctx, key)
+		if ent.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		abandoned := stored.Response == nil && stored.CreatedAt.Before(now.Add(-idempotencyLockTimeout))
+		switch {
+		case stored.ExpiresAt.Before(now) || abandoned:
+			if err := svc.store.DeleteKey(ctx, key, stored.CreatedAt); err != nil {
+				return nil, err
+			}
+			continue
+		case stored.Fingerprint != key.Fingerprint:
+			return nil, errors.New(IdempotencyKeyReusedError)
+		case stored.Response != nil:
+			return stored.Response, nil
+		case now.After(deadline):
+			return nil, errors.New(IdempotencyKeyInProgressError)
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(idempotencyPollInterval):
+		}
+	}
+}
+
+// Complete stores the response to the request that claimed key.
+func (svc *IdempotencyService) Complete(ctx context.Context, key models.IdempotencyKey, response models.RecordedResponse) error {
+	return svc.store.SaveResponse(ctx, key, response)
+}
+
+// Release frees key without storing a response, so that the request can be
+// retried, for example after a server error.
+func (svc *IdempotencyService) Release(ctx context.Context, key models.IdempotencyKey) error {
+	return svc.store.DeleteKey(ctx, key, time.Now())
+}
+
+// RunKeyPurger deletes expired idempotency keys periodically until ctx is
+// cancelled.
+func (svc *IdempotencyService) RunKeyPurger(ctx context.Context) {
+	ticker := time.NewTicker(idempotencyPurgeInterval)
+	defer ticker.Stop()
+	for {
+		count, err := svc.store.DeleteExpiredKeys(ctx, time.Now())
+		if err != nil {
+			log.Printf("error purging idempotency keys: %v", err)
+		} else if count > 0 {
+			log.Printf("purged %d expired idempotency keys", count)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func validIdempotencyKey(key string) bool {
+	if key == "" || len(key) > maxIdempotencyKeyLength {
+		return false
+	}
+	for i := 0; i < len(key); i++ {
+		if key[i] < 0x20 || key[i] > 0x7e {
+			return false
+		}
+	}
+	return true
+}
+
+func idempotencyKeyTTL() time.Duration {
+	ttl, err := time.ParseDuration(os.Getenv("IDEMPOTENCY_KEY_TTL"))
+	if err != nil || ttl <= 0 {
+		return defaultIdempotencyKeyTTL
+	}
+	return ttl
+}
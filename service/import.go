@@ -0,0 +1,186 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/models"
+)
+
+const InvalidImportFormatError = "Format must be csv, json, todotxt or taskwarrior"
+const InvalidImportFileError = "The file could not be read in the given format"
+const InvalidImportMappingError = "Mapping keys must be among external_id, title, description, status, is_completed, priority, labels, start_at, due_at, estimate_minutes, project and project_id"
+const ImportColumnNotFoundError = "The CSV needs a title column and every column the mapping names"
+const ImportTooLargeError = "An import can hold at most 5000 tasks"
+const UnknownImportProjectError = "Unknown project"
+
+const maxImportRecords = 5000
+const importBatchSize = 100
+
+// Import creates tasks from a file exported by this or another tool. Each
+// task is identified by an external ID, taken from the file or derived from
+// the line's content, and tasks whose external ID the same user imported
+// before in the same format are skipped, so importing a file again only
+// adds what is new. Lines that
+// cannot be read or fail validation are reported and the others imported.
+//
+// The tasks are inserted in batches within a single transaction. A dry run
+// goes through the same checks without creating anything and previews the
+// tasks that would be created.
+func (svc *TaskService) Import(ctx context.Context, r io.Reader, opts models.ImportOptions) (*models.ImportResult, error) {
+	if opts.Location == nil {
+		opts.Location = time.UTC
+	}
+	records, err := parseImport(r, opts)
+	if err != nil {
+		return nil, err
+	}
+	if len(records) > maxImportRecords {
+		return nil, errors.New(ImportTooLargeError)
+	}
+	result := &models.ImportResult{Format: opts.Format, DryRun: opts.DryRun, Total: len(records), Lines: []*models.ImportLine{}}
+	if opts.DryRun {
+		err = svc.importRecords(ctx, records, opts, result)
+	} else {
+		err = svc.inTx(ctx, func(tx *TaskService) error {
+			return tx.importRecords(ctx, records, opts, result)
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
+	for _, line := range result.Lines {
+		switch line.Status {
+		case models.ImportLineCreated:
+			result.Created++
+		case models.ImportLineValid:
+			result.Valid++
+		case models.ImportLineSkipped:
+			result.Skipped++
+		case models.ImportLineFailed:
+			result.Failed++
+		}
+	}
+	return result, nil
+}
+
+func (svc *TaskService) importRecords(ctx context.Context, records []importRecord, opts models.ImportOptions, result *models.ImportResult) error {
+	projects, err := svc.projects.ListProjects(ctx)
+	if err != nil {
+		return err
+	}
+	projectIDs := make(map[string]uuid.UUID, len(projects))
+	for _, p := range projects {
+		projectIDs[strings.ToLower(p.Name)] = p.ID
+	}
+	externalIDs := make(map[string]string, len(records))
+	for i := range records {
+		records[i].importKey = importKey(ctx, opts.Format, records[i].externalID)
+		externalIDs[records[i].importKey] = records[i].externalID
+	}
+	imported, err := svc.store.ExistingImports(ctx, externalIDs)
+	if err != nil {
+		return err
+	}
+
+	var pending []models.Task
+	var pendingLines []*models.ImportLine
+	seen := make(map[string]bool, len(records))
+	for _, record := range records {
+		line := &models.ImportLine{Line: record.line, ExternalID: record.externalID, Status: models.ImportLineFailed}
+		result.Lines = append(result.Lines, line)
+		switch {
+		case record.err != nil:
+			line.Error = record.err.Error()
+			continue
+		case record.skip != "":
+			line.Status, line.Error = models.ImportLineSkipped, record.skip
+			continue
+		case imported[record.importKey]:
+			line.Status, line.Error = models.ImportLineSkipped, "Already imported"
+			continue
+		case seen[record.importKey]:
+			line.Status, line.Error = models.ImportLineSkipped, "Repeats an earlier line"
+			continue
+		}
+		seen[record.importKey] = true
+		task, err := svc.prepareImport(ctx, record, opts, projectIDs)
+		if err != nil {
+			var transitionErr *TransitionError
+			if !isImportLineError(err) && !errors.As(err, &transitionErr) {
+				return err
+			}
+			line.Error = err.Error()
+			continue
+		}
+		if opts.DryRun {
+			task.IsCompleted = models.IsClosedCategory(task.StatusCategory)
+			line.Status, line.Task = models.ImportLineValid, &task
+			continue
+		}
+		pending = append(pending, task)
+		pendingLines = append(pendingLines, line)
+	}
+
+	for start := 0; start < len(pending); start += importBatchSize {
+		end := start + importBatchSize
+		if end > len(pending) {
+			end = len(pending)
+		}
+		created, err := svc.store.CreateTasks(ctx, pending[start:end])
+		if err != nil {
+			return err
+		}
+		for i, task := range created {
+			line := pendingLines[start+i]
+			line.Status, line.ID = models.ImportLineCreated, &task.ID
+			if err := svc.publish(ctx, models.EventTaskCreated, task); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// prepareImport validates an imported task, resolves its project and
+// status, and returns it ready to be created.
+func (svc *TaskService) prepareImport(ctx context.Context, record importRecord, opts models.ImportOptions, projectIDs map[string]uuid.UUID) (models.Task, error) {
+	task := record.task
+	task.Title = strings.TrimSpace(task.Title)
+	if task.Title == "" {
+		return models.Task{}, errors.New(ImportTitleRequiredError)
+	}
+	externalID, key := record.externalID, record.importKey
+	task.ExternalID, task.ImportKey = &externalID, &key
+	if task.ProjectID == nil && record.project != "" {
+		projectID, ok := projectIDs[strings.ToLower(record.project)]
+		if !ok {
+			return models.Task{}, errors.New(UnknownImportProjectError)
+		}
+		task.ProjectID = &projectID
+	}
+	if task.ProjectID == nil {
+		task.ProjectID = opts.ProjectID
+	}
+	task, err := normalizeNewTask(task)
+	if err != nil {
+		return models.Task{}, err
+	}
+	return svc.placeNewTask(ctx, task)
+}
+
+// isImportLineError reports whether err is a problem with one imported
+// line, as opposed to one that should stop the import.
+func isImportLineError(err error) bool {
+	switch err.Error() {
+	case ImportTitleRequiredError, UnknownImportProjectError, ProjectNotFoundError,
+		InvalidPriorityError, InvalidScheduleError, InvalidEstimateError, UnknownStatusError,
+		InvalidLabelError, TooManyLabelsError:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,489 @@
+package service
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/models"
+)
+
+const ImportTitleRequiredError = "Title is required"
+
+// importFields are the task fields an import can set, and the CSV columns
+// read for them unless mapped to other columns.
+var importFields = []string{
+	"external_id",
+	"title",
+	"description",
+	"status",
+	"is_completed",
+	"priority",
+	"labels",
+	"start_at",
+	"due_at",
+	"estimate_minutes",
+	"project",
+	"project_id",
+}
+
+// importRecord is a task read from an import, before it is validated.
+// project is the name of its project, if it has one. Records with skip set
+// are not imported, for the reason it gives.
+type importRecord struct {
+	line       int
+	externalID string
+	importKey  string
+	task       models.Task
+	project    string
+	skip       string
+	err        error
+}
+
+// importTimeLayouts are the accepted date formats, tried in order. Times
+// without a zone are read in the import's location.
+var importTimeLayouts = []string{
+	time.RFC3339,
+	"2006-01-02T15:04:05",
+	"2006-01-02T15:04",
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+	"2006-01-02",
+	// Taskwarrior, always in UTC
+	"20060102T150405Z",
+}
+
+func parseImportTime(value string, loc *time.Location) (*time.Time, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil, nil
+	}
+	for _, layout := range importTimeLayouts {
+		in := loc
+		if strings.HasSuffix(layout, "Z") {
+			in = time.UTC
+		}
+		if t, err := time.ParseInLocation(layout, value, in); err == nil {
+			return &t, nil
+		}
+	}
+	return nil, errors.New("not a date")
+}
+
+// fallbackExternalID derives an external ID from the raw content of a
+// record without one, so that importing the same record again is still
+// recognised.
+func fallbackExternalID(format string, raw []byte) string {
+	sum := sha256.Sum256(raw)
+	return fmt.Sprintf("%s:%x", format, sum[:12])
+}
+
+// importKey scopes an external ID to the user importing it, or to
+// anonymous imports, and to the format it is imported from.
+func importKey(ctx context.Context, format, externalID string) string {
+	importer := "anonymous"
+	if actorID := models.ActorFrom(ctx); actorID != nil {
+		importer = actorID.String()
+	}
+	return importer + "/" + format + "/" + externalID
+}
+
+// parseImport reads the records of an import. Errors in single records are
+// kept with them; an error is only returned if the input as a whole cannot
+// be read.
+func parseImport(r io.Reader, opts models.ImportOptions) ([]importRecord, error) {
+	switch opts.Format {
+	case models.ImportCSV:
+		return parseCSVImport(r, opts)
+	case models.ImportJSON:
+		return parseJSONImport(r, opts)
+	case models.ImportTodoTxt:
+		return parseTodoTxtImport(r, opts)
+	case models.ImportTaskwarrior:
+		return parseTaskwarriorImport(r, opts)
+	}
+	return nil, errors.New(InvalidImportFormatError)
+}
+
+func parseCSVImport(r io.Reader, opts models.ImportOptions) ([]importRecord, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	header, err := reader.Read()
+	if err != nil {
+		return nil, errors.New(InvalidImportFileError)
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		name = strings.TrimSpace(name)
+		if i == 0 {
+			name = strings.TrimPrefix(name, "\ufeff")
+		}
+		columns[name] = i
+	}
+	// Fields are read from the column of the same name unless mapped, and
+	// exports name their external ID column id.
+	fieldColumns := make(map[string]int)
+	for _, field := range importFields {
+		if i, ok := columns[field]; ok {
+			fieldColumns[field] = i
+		}
+	}
+	if _, ok := fieldColumns["external_id"]; !ok {
+		if i, ok := columns["id"]; ok {
+			fieldColumns["external_id"] = i
+		}
+	}
+	for field, column := range opts.Mapping {
+		if !containsLabel(importFields, field) {
+			return nil, errors.New(InvalidImportMappingError)
+		}
+		i, ok := columns[column]
+		if !ok {
+			return nil, errors.New(ImportColumnNotFoundError)
+		}
+		fieldColumns[field] = i
+	}
+	if _, ok := fieldColumns["title"]; !ok {
+		return nil, errors.New(ImportColumnNotFoundError)
+	}
+
+	var records []importRecord
+	for {
+		row, err := reader.Read()
+		if err == io.EOF {
+			return records, nil
+		}
+		if err != nil {
+			var parseErr *csv.ParseError
+			if !errors.As(err, &parseErr) {
+				return nil, err
+			}
+			records = append(records, importRecord{line: parseErr.StartLine, err: errors.New("Malformed CSV line")})
+			continue
+		}
+		line, _ := reader.FieldPos(0)
+		values := make(map[string]string, len(fieldColumns))
+		for field, i := range fieldColumns {
+			if i < len(row) {
+				values[field] = strings.TrimSpace(row[i])
+			}
+		}
+		record := importRecord{line: line, externalID: values["external_id"], project: values["project"]}
+		if record.externalID == "" {
+			record.externalID = fallbackExternalID(models.ImportCSV, []byte(strings.Join(row, "\x1f")))
+		}
+		record.task, record.err = csvTask(values, opts.Location)
+		records = append(records, record)
+	}
+}
+
+// csvTask builds a task from the values of a CSV line, keyed by field.
+func csvTask(values map[string]string, loc *time.Location) (models.Task, error) {
+	task := models.Task{
+		Title:       values["title"],
+		Description: values["description"],
+		Status:      values["status"],
+		Priority:    strings.ToLower(values["priority"]),
+	}
+	if s := values["is_completed"]; s != "" {
+		completed, err := parseImportBool(s)
+		if err != nil {
+			return task, errors.New("Invalid is_completed")
+		}
+		task.IsCompleted = completed
+	}
+	if s := values["labels"]; s != "" {
+		task.Labels = strings.Split(s, ",")
+	}
+	var err error
+	if task.StartAt, err = parseImportTime(values["start_at"], loc); err != nil {
+		return task, errors.New("Invalid start_at")
+	}
+	if task.DueAt, err = parseImportTime(values["due_at"], loc); err != nil {
+		return task, errors.New("Invalid due_at")
+	}
+	if s := values["estimate_minutes"]; s != "" {
+		minutes, err := strconv.Atoi(s)
+		if err != nil {
+			return task, errors.New("Invalid estimate_minutes")
+		}
+		task.EstimateMinutes = &minutes
+	}
+	if s := values["project_id"]; s != "" {
+		projectID, err := uuid.Parse(s)
+		if err != nil {
+			return task, errors.New("Invalid project_id")
+		}
+		task.ProjectID = &projectID
+	}
+	return task, nil
+}
+
+func parseImportBool(value string) (bool, error) {
+	switch strings.ToLower(value) {
+	case "true", "1", "yes", "y", "x":
+		return true, nil
+	case "false", "0", "no", "n":
+		return false, nil
+	}
+	return false, errors.New("not a boolean")
+}
+
+// jsonImportTask is a task in a JSON import, with the fields of a task
+// create plus its external ID and project name. Without an external ID the
+// ID is used, as in exports. Dates are strings so that they can be given
+// in any of the accepted formats.
+type jsonImportTask struct {
+	ExternalID      string     `json:"external_id"`
+	ID              string     `json:"id"`
+	Title           string     `json:"title"`
+	Description     string     `json:"description"`
+	Status          string     `json:"status"`
+	IsCompleted     bool       `json:"is_completed"`
+	Priority        string     `json:"priority"`
+	Labels          []string   `json:"labels"`
+	StartAt         string     `json:"start_at"`
+	DueAt           string     `json:"due_at"`
+	EstimateMinutes *int       `json:"estimate_minutes"`
+	Project         string     `json:"project"`
+	ProjectID       *uuid.UUID `json:"project_id"`
+}
+
+// parseJSONImport reads a JSON array of tasks. Records are numbered by
+// their position in the array.
+func parseJSONImport(r io.Reader, opts models.ImportOptions) ([]importRecord, error) {
+	var records []importRecord
+	err := eachJSONElement(r, func(n int, raw json.RawMessage) {
+		record := importRecord{line: n}
+		var t jsonImportTask
+		if err := json.Unmarshal(raw, &t); err != nil {
+			record.err = errors.New("Invalid data")
+			records = append(records, record)
+			return
+		}
+		record.externalID, record.project = t.ExternalID, t.Project
+		if record.externalID == "" {
+			record.externalID = t.ID
+		}
+		if record.externalID == "" {
+			record.externalID = fallbackExternalID(models.ImportJSON, raw)
+		}
+		record.task = models.Task{
+			Title:           t.Title,
+			Description:     t.Description,
+			Status:          t.Status,
+			IsCompleted:     t.IsCompleted,
+			Priority:        t.Priority,
+			Labels:          t.Labels,
+			EstimateMinutes: t.EstimateMinutes,
+			ProjectID:       t.ProjectID,
+		}
+		var err error
+		if record.task.StartAt, err = parseImportTime(t.StartAt, opts.Location); err != nil {
+			record.err = errors.New("Invalid start_at")
+		} else if record.task.DueAt, err = parseImportTime(t.DueAt, opts.Location); err != nil {
+			record.err = errors.New("Invalid due_at")
+		}
+		records = append(records, record)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+// eachJSONElement calls fn with each element of a JSON array, numbered
+// from 1, without decoding the array as a whole.
+func eachJSONElement(r io.Reader, fn func(n int, raw json.RawMessage)) error {
+	decoder := json.NewDecoder(r)
+	if token, err := decoder.Token(); err != nil || token != json.Delim('[') {
+		return errors.New(InvalidImportFileError)
+	}
+	for n := 1; decoder.More(); n++ {
+		var raw json.RawMessage
+		if err := decoder.Decode(&raw); err != nil {
+			return errors.New(InvalidImportFileError)
+		}
+		fn(n, raw)
+	}
+	if _, err := decoder.Token(); err != nil {
+		return errors.New(InvalidImportFileError)
+	}
+	return nil
+}
+
+var todoTxtDate = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`)
+
+// todoTxtPriorities maps todo.txt priorities to task priorities. Letters
+// after D are low too.
+var todoTxtPriorities = map[byte]string{
+	'A': "urgent",
+	'B': "high",
+	'C': "medium",
+}
+
+// parseTodoTxtImport reads todo.txt lines: an optional "x " for done
+// tasks, a priority like "(A) ", completion and creation dates, then the
+// text. +project names the project and @context words become labels; the
+// due: and t: (threshold) tags set the due and start dates. Other key:value
+// tags stay in the title.
+func parseTodoTxtImport(r io.Reader, opts models.ImportOptions) ([]importRecord, error) {
+	var records []importRecord
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" {
+			continue
+		}
+		record := importRecord{line: line, externalID: fallbackExternalID(models.ImportTodoTxt, []byte(text))}
+		record.task, record.project, record.err = todoTxtTask(text, opts.Location)
+		records = append(records, record)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.New(InvalidImportFileError)
+	}
+	return records, nil
+}
+
+func todoTxtTask(text string, loc *time.Location) (models.Task, string, error) {
+	var task models.Task
+	var project string
+	words := strings.Fields(text)
+	if len(words) > 0 && words[0] == "x" {
+		task.IsCompleted = true
+		words = words[1:]
+	}
+	if len(words) > 0 && len(words[0]) == 3 && words[0][0] == '(' && words[0][2] == ')' && words[0][1] >= 'A' && words[0][1] <= 'Z' {
+		task.Priority = todoTxtPriorities[words[0][1]]
+		if task.Priority == "" {
+			task.Priority = "low"
+		}
+		words = words[1:]
+	}
+	// A done task may have a completion date before its creation date.
+	// Neither is kept, since tasks are created and completed on import.
+	for i := 0; i < 2 && len(words) > 0 && todoTxtDate.MatchString(words[0]); i++ {
+		words = words[1:]
+	}
+	var title []string
+	for _, word := range words {
+		key, value, hasValue := strings.Cut(word, ":")
+		switch {
+		case len(word) > 1 && word[0] == '+':
+			if project == "" {
+				project = word[1:]
+			}
+		case len(word) > 1 && word[0] == '@':
+			task.Labels = append(task.Labels, word[1:])
+		case hasValue && key == "due":
+			due, err := parseImportTime(value, loc)
+			if err != nil {
+				return task, project, errors.New("Invalid due date")
+			}
+			task.DueAt = due
+		case hasValue && key == "t":
+			start, err := parseImportTime(value, loc)
+			if err != nil {
+				return task, project, errors.New("Invalid threshold date")
+			}
+			task.StartAt = start
+		default:
+			title = append(title, word)
+		}
+	}
+	task.Title = strings.Join(title, " ")
+	return task, project, nil
+}
+
+// taskwarriorTask is a task in the JSON output of "task export".
+type taskwarriorTask struct {
+	UUID        string   `json:"uuid"`
+	Description string   `json:"description"`
+	Status      string   `json:"status"`
+	Due         string   `json:"due"`
+	Scheduled   string   `json:"scheduled"`
+	Priority    string   `json:"priority"`
+	Project     string   `json:"project"`
+	Tags        []string `json:"tags"`
+	Annotations []struct {
+		Description string `json:"description"`
+	} `json:"annotations"`
+}
+
+var taskwarriorPriorities = map[string]string{
+	"H": "high",
+	"M": "medium",
+	"L": "low",
+}
+
+// parseTaskwarriorImport reads a Taskwarrior export, either a JSON array
+// or, as older versions write it, one JSON object per line. Deleted tasks
+// and recurrence templates are skipped.
+func parseTaskwarriorImport(r io.Reader, opts models.ImportOptions) ([]importRecord, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	var records []importRecord
+	add := func(n int, raw json.RawMessage) {
+		record := importRecord{line: n}
+		var t taskwarriorTask
+		if err := json.Unmarshal(raw, &t); err != nil {
+			record.err = errors.New("Invalid data")
+			records = append(records, record)
+			return
+		}
+		record.externalID, record.project = "taskwarrior:"+t.UUID, t.Project
+		if t.UUID == "" {
+			record.externalID = fallbackExternalID(models.ImportTaskwarrior, raw)
+		}
+		switch t.Status {
+		case "deleted":
+			record.skip = "Deleted in Taskwarrior"
+		case "recurring":
+			record.skip = "Recurrence templates are not imported"
+		}
+		record.task = models.Task{
+			Title:       t.Description,
+			IsCompleted: t.Status == "completed",
+			Priority:    taskwarriorPriorities[t.Priority],
+			Labels:      t.Tags,
+		}
+		notes := make([]string, 0, len(t.Annotations))
+		for _, annotation := range t.Annotations {
+			notes = append(notes, annotation.Description)
+		}
+		record.task.Description = strings.Join(notes, "\n")
+		if record.task.DueAt, err = parseImportTime(t.Due, time.UTC); err != nil {
+			record.err = errors.New("Invalid due")
+		} else if record.task.StartAt, err = parseImportTime(t.Scheduled, time.UTC); err != nil {
+			record.err = errors.New("Invalid scheduled")
+		}
+		records = append(records, record)
+	}
+	trimmed := bytes.TrimSpace(data)
+	if bytes.HasPrefix(trimmed, []byte("[")) {
+		if err := eachJSONElement(bytes.NewReader(trimmed), add); err != nil {
+			return nil, err
+		}
+		return records, nil
+	}
+	for n, line := range bytes.Split(data, []byte("\n")) {
+		if line = bytes.TrimSpace(line); len(line) > 0 {
+			add(n+1, json.RawMessage(line))
+		}
+	}
+	return records, nil
+}
@@ -0,0 +1,52 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/localopsco/go-sample/models"
+)
+
+func TestImportSkipsWhatTheSameUserImported(t *testing.T) {
+	svc := newTestTaskService(t)
+	actor := func(username string) context.Context {
+		user, _, err := NewUserService(svc.users).CreateUser(context.Background(), username, username)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return models.WithActor(context.Background(), user.ID)
+	}
+	ann, bob := actor("ann"), actor("bob")
+	const file = "title,external_id\nBuy milk,42\nCall the bank,43\n"
+	csv := models.ImportOptions{Format: models.ImportCSV}
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		opts    models.ImportOptions
+		created int
+	}{
+		{name: "first import", ctx: ann, opts: csv, created: 2},
+		{name: "same user again", ctx: ann, opts: csv, created: 0},
+		{name: "another user", ctx: bob, opts: csv, created: 2},
+		{name: "anonymous", ctx: context.Background(), opts: csv, created: 2},
+		{name: "anonymous again", ctx: context.Background(), opts: csv, created: 0},
+		{name: "same IDs from another format", ctx: ann, opts: models.ImportOptions{Format: models.ImportJSON}, created: 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body := file
+			if tt.opts.Format == models.ImportJSON {
+				body = `[{"title": "Buy milk", "external_id": "42"}, {"title": "Call the bank", "external_id": "43"}]`
+			}
+			result, err := svc.Import(tt.ctx, strings.NewReader(body), tt.opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if result.Created != tt.created || result.Skipped != 2-tt.created {
+				t.Fatalf("Import() created %d and skipped %d tasks, want %d created", result.Created, result.Skipped, tt.created)
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package service
+
+import (
+	"errors"
+	"strings"
+)
+
+const InvalidLabelError = "Labels must be 1 to 50 letters, digits, '-', '_', '.' or ':'"
+const TooManyLabelsError = "A task can have at most 20 labels"
+
+const (
+	maxLabelLength = 50
+	maxLabels      = 20
+)
+
+// normalizeLabels lower-cases labels and drops duplicates, keeping the
+// order in which they were first given.
+func normalizeLabels(labels []string) ([]string, error) {
+	normalized := make([]string, 0, len(labels))
+	seen := make(map[string]bool, len(labels))
+	for _, label := range labels {
+		label = strings.ToLower(strings.TrimSpace(label))
+		if !validLabel(label) {
+			return nil, errors.New(InvalidLabelError)
+		}
+		if seen[label] {
+			continue
+		}
+		seen[label] = true
+		normalized = append(normalized, label)
+	}
+	if len(normalized) > maxLabels {
+		return nil, errors.New(TooManyLabelsError)
+	}
+	return normalized, nil
+}
+
+// validLabel reports whether label can be used as a bare word in task
+// queries, as in label:area:backend.
+func validLabel(label string) bool {
+	if label == "" || len(label) > maxLabelLength {
+		return false
+	}
+	for _, r := range label {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+		case r == '-', r == '_', r == '.', r == ':':
+		default:
+			return false
+		}
+	}
+	return true
+}
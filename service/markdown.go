@@ -0,0 +1,69 @@
+package service
+
+import (
+	"bytes"
+	"regexp"
+	"strings"
+
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension"
+	"github.com/yuin/goldmark/text"
+)
+
+var (
+	markdown    = goldmark.New(goldmark.WithExtensions(extension.GFM))
+	htmlPolicy  = bluemonday.UGCPolicy()
+	mentionExpr = regexp.MustCompile(`(?:^|[^\w@.])@([a-z0-9_][a-z0-9_.-]*[a-z0-9_]|[a-z0-9_])`)
+)
+
+// renderMarkdown converts a Markdown body into sanitized HTML and returns the
+// usernames it mentions. Mentions inside code and links are ignored.
+func renderMarkdown(body string) (string, []string, error) {
+	source := []byte(body)
+	doc := markdown.Parser().Parse(text.NewReader(source))
+
+	var plain strings.Builder
+	err := ast.Walk(doc, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+		switch n.Kind() {
+		case ast.KindCodeSpan, ast.KindCodeBlock, ast.KindFencedCodeBlock, ast.KindLink, ast.KindAutoLink, ast.KindHTMLBlock, ast.KindRawHTML:
+			return ast.WalkSkipChildren, nil
+		case ast.KindText:
+			t := n.(*ast.Text)
+			plain.Write(t.Segment.Value(source))
+			if t.SoftLineBreak() || t.HardLineBreak() {
+				plain.WriteByte('\n')
+			}
+		default:
+			if n.Type() == ast.TypeBlock {
+				plain.WriteByte('\n')
+			}
+		}
+		return ast.WalkContinue, nil
+	})
+	if err != nil {
+		return "", nil, err
+	}
+
+	var html bytes.Buffer
+	if err := markdown.Renderer().Render(&html, source, doc); err != nil {
+		return "", nil, err
+	}
+	return htmlPolicy.Sanitize(html.String()), parseMentions(plain.String()), nil
+}
+
+func parseMentions(plain string) []string {
+	var usernames []string
+	seen := make(map[string]bool)
+	for _, match := range mentionExpr.FindAllStringSubmatch(strings.ToLower(plain), -1) {
+		if username := match[1]; !seen[username] {
+			seen[username] = true
+			usernames = append(usernames, username)
+		}
+	}
+	return usernames
+}
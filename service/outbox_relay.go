@@ -0,0 +1,105 @@
+package service
+
+import (
+	"context"
+	"log"
+	"os"
+	"time"
+
+	"github.com/localopsco/go-sample/datastore"
+	"github.com/localopsco/go-sample/models"
+)
+
+const defaultOutboxPollInterval = time.Second
+const defaultOutboxRetention = 7 * 24 * time.Hour
+const outboxBatchSize = 100
+const outboxCleanupInterval = time.Hour
+
+// OutboxRelay publishes the events of the outbox and marks them processed.
+// Several relays may run at once, in one process or across replicas. Once
+// a batch of events is published and numbered, announcer, if not nil, is
+// given them with their relayed position.
+type OutboxRelay struct {
+	store     *datastore.OutboxStore
+	publisher EventPublisher
+	announcer EventPublisher
+}
+
+func NewOutboxRelay(outboxStore *datastore.OutboxStore, publisher, announcer EventPublisher) *OutboxRelay {
+	return &OutboxRelay{
+		outboxStore,
+		publisher,
+		announcer,
+	}
+}
+
+// RelayPending publishes unprocessed events until none are left that can
+// be published, and returns how many were.
+func (relay *OutboxRelay) RelayPending(ctx context.Context) (int, error) {
+	total := 0
+	for {
+		n, err := relay.store.RelayBatch(ctx, outboxBatchSize, relay.publish, relay.announce)
+		total += n
+		if err != nil || n == 0 {
+			return total, err
+		}
+	}
+}
+
+func (relay *OutboxRelay) publish(ctx context.Context, event models.Event) error {
+	err := relay.publisher.Publish(ctx, event)
+	if err != nil {
+		log.Printf("error publishing %s event %s for task %s: %v", event.Type, event.ID, event.TaskID, err)
+	}
+	return err
+}
+
+func (relay *OutboxRelay) announce(ctx context.Context, event models.Event) error {
+	if relay.announcer == nil {
+		return nil
+	}
+	return relay.announcer.Publish(ctx, event)
+}
+
+// Run relays events every OUTBOX_POLL_INTERVAL (default 1s) and deletes
+// processed events older than OUTBOX_RETENTION (default 7 days) until ctx
+// is cancelled.
+func (relay *OutboxRelay) Run(ctx context.Context) {
+	ticker := time.NewTicker(outboxPollInterval())
+	defer ticker.Stop()
+	lastCleanup := time.Time{}
+	for {
+		if _, err := relay.RelayPending(ctx); err != nil {
+			log.Printf("error relaying outbox: %v", err)
+		}
+		if time.Since(lastCleanup) >= outboxCleanupInterval {
+			lastCleanup = time.Now()
+			if n, err := relay.store.DeleteProcessed(ctx, time.Now().Add(-outboxRetention())); err != nil {
+				log.Printf("error cleaning up outbox: %v", err)
+			} else if n > 0 {
+				log.Printf("deleted %d processed outbox events", n)
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func outboxPollInterval() time.Duration {
+	interval, err := time.ParseDuration(os.Getenv("OUTBOX_POLL_INTERVAL"))
+	if err != nil || interval <= 0 {
+		return defaultOutboxPollInterval
+	}
+	return interval
+}
+
+func outboxRetention() time.Duration {
+	retention, err := time.ParseDuration(os.Getenv("OUTBOX_RETENTION"))
+	if err != nil || retention <= 0 {
+		return defaultOutboxRetention
+	}
+	return retention
+}
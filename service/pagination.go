@@ -0,0 +1,18 @@
+package service
+
+const defaultPageSize = 20
+const maxPageSize = 100
+
+// pageBounds applies the default and maximum page size to a requested page.
+func pageBounds(limit, offset int) (int, int) {
+	if limit <= 0 {
+		limit = defaultPageSize
+	}
+	if limit > maxPageSize {
+		limit = maxPageSize
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	return limit, offset
+}
@@ -0,0 +1,101 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/datastore"
+	"github.com/localopsco/go-sample/ent"
+	"github.com/localopsco/go-sample/models"
+)
+
+const ProjectNotFoundError = "Project not found"
+const InvalidProjectError = "Invalid project"
+const InvalidWIPLimitsError = "WIP limits must be whole numbers from 1 to 1000, keyed by status key or label"
+const InvalidWIPPolicyError = "WIP policy must be warn or enforce"
+
+const maxWIPLimit = 1000
+
+type ProjectService struct {
+	store     *datastore.ProjectStore
+	workflows *datastore.WorkflowStore
+}
+
+func NewProjectService(projectStore *datastore.ProjectStore, workflowStore *datastore.WorkflowStore) *ProjectService {
+	return &ProjectService{
+		projectStore,
+		workflowStore,
+	}
+}
+
+// CreateProject creates a project using the given workflow, or the default
+// workflow if workflowID is nil.
+func (svc *ProjectService) CreateProject(ctx context.Context, name, desc string, workflowID *uuid.UUID) (*models.Project, error) {
+	if name == "" {
+		return nil, errors.New(InvalidProjectError)
+	}
+	var w *models.Workflow
+	var err error
+	if workflowID != nil {
+		w, err = svc.workflows.GetWorkflow(ctx, *workflowID)
+	} else {
+		w, err = svc.workflows.GetDefaultWorkflow(ctx)
+	}
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New(WorkflowNotFoundError)
+		}
+		return nil, err
+	}
+	return svc.store.CreateProject(ctx, models.Project{
+		Name:        name,
+		Description: desc,
+		WorkflowID:  w.ID,
+	})
+}
+
+func (svc *ProjectService) GetProject(ctx context.Context, projectID uuid.UUID) (*models.Project, error) {
+	project, err := svc.store.GetProject(ctx, projectID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New(ProjectNotFoundError)
+		}
+		return nil, err
+	}
+	return project, nil
+}
+
+func (svc *ProjectService) ListProjects(ctx context.Context) ([]*models.Project, error) {
+	return svc.store.ListProjects(ctx)
+}
+
+// GetProjects returns the given projects, skipping IDs that do not exist.
+func (svc *ProjectService) GetProjects(ctx context.Context, projectIDs []uuid.UUID) ([]*models.Project, error) {
+	return svc.store.ListProjectsByIDs(ctx, projectIDs)
+}
+
+// UpdateProject renames a project or changes the WIP limits of its board.
+func (svc *ProjectService) UpdateProject(ctx context.Context, update models.ProjectUpdate) (*models.Project, error) {
+	if update.Name != nil && *update.Name == "" {
+		return nil, errors.New(InvalidProjectError)
+	}
+	if update.WIPLimits != nil {
+		for key, limit := range *update.WIPLimits {
+			if !validLabel(key) || limit < 1 || limit > maxWIPLimit {
+				return nil, errors.New(InvalidWIPLimitsError)
+			}
+		}
+	}
+	if p := update.WIPPolicy; p != nil && *p != models.WIPPolicyWarn && *p != models.WIPPolicyEnforce {
+		return nil, errors.New(InvalidWIPPolicyError)
+	}
+	project, err := svc.store.UpdateProject(ctx, update)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New(ProjectNotFoundError)
+		}
+		return nil, err
+	}
+	return project, nil
+}
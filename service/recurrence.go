@@ -0,0 +1,114 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/models"
+	"github.com/teambition/rrule-go"
+)
+
+const InvalidRecurrenceError = "Invalid recurrence rule"
+const RecurrenceNeedsDueDateError = "Recurring tasks need a due date"
+
+// normalizeRecurrence validates a recurrence rule and timezone and anchors the
+// series at dueAt. Rules may be given with or without the "RRULE:" prefix.
+func normalizeRecurrence(r models.Recurrence, dueAt *time.Time) (*models.Recurrence, error) {
+	if dueAt == nil {
+		return nil, errors.New(RecurrenceNeedsDueDateError)
+	}
+	r.Rule = strings.TrimPrefix(strings.TrimSpace(r.Rule), "RRULE:")
+	if r.Timezone == "" {
+		r.Timezone = "UTC"
+	}
+	if r.Rule == "" || strings.Contains(strings.ToUpper(r.Rule), "DTSTART") {
+		return nil, errors.New(InvalidRecurrenceError)
+	}
+	startsAt := dueAt.UTC()
+	r.StartsAt = &startsAt
+	if _, err := recurrenceRule(r); err != nil {
+		return nil, errors.New(InvalidRecurrenceError)
+	}
+	return &r, nil
+}
+
+// recurrenceRule builds the rule of a series. The series start is expressed
+// in the recurrence timezone so that occurrences keep their wall-clock time
+// across DST changes.
+func recurrenceRule(r models.Recurrence) (*rrule.RRule, error) {
+	loc, err := time.LoadLocation(r.Timezone)
+	if err != nil {
+		return nil, err
+	}
+	opt, err := rrule.StrToROptionInLocation(r.Rule, loc)
+	if err != nil {
+		return nil, err
+	}
+	if r.StartsAt != nil {
+		opt.Dtstart = r.StartsAt.In(loc)
+	}
+	return rrule.NewRRule(*opt)
+}
+
+// nextOccurrence returns the first occurrence of the series strictly after
+// the given time, and false once the series has ended.
+func nextOccurrence(r models.Recurrence, after time.Time) (time.Time, bool, error) {
+	rule, err := recurrenceRule(r)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	next := rule.After(after, false)
+	if next.IsZero() {
+		return time.Time{}, false, nil
+	}
+	return next, true, nil
+}
+
+// advanceRecurrence creates the next occurrence of a closed recurring task,
+// with its dates moved forward, and hands the recurrence over to it. The
+// closed task stops recurring either way.
+func (svc *TaskService) advanceRecurrence(ctx context.Context, closed *models.Task) error {
+	r := closed.Recurrence
+	if r == nil || r.Paused || closed.DueAt == nil {
+		return nil
+	}
+	nextDue, ok, err := nextOccurrence(*r, *closed.DueAt)
+	if err != nil {
+		return err
+	}
+	if ok {
+		seriesID := seriesIDFor(closed)
+		next := models.Task{
+			Title:           closed.Title,
+			Description:     closed.Description,
+			ProjectID:       closed.ProjectID,
+			ParentID:        closed.ParentID,
+			DueAt:           &nextDue,
+			Priority:        closed.Priority,
+			EstimateMinutes: closed.EstimateMinutes,
+			Labels:          closed.Labels,
+			Recurrence:      r,
+			SeriesID:        &seriesID,
+		}
+		if closed.StartAt != nil {
+			nextStart := closed.StartAt.Add(nextDue.Sub(*closed.DueAt))
+			next.StartAt = &nextStart
+		}
+		if _, err := svc.createTask(ctx, next); err != nil {
+			return err
+		}
+	}
+	return svc.store.ClearRecurrence(ctx, closed.ID)
+}
+
+// seriesIDFor returns the series a task belongs to, which is named after its
+// first occurrence.
+func seriesIDFor(t *models.Task) uuid.UUID {
+	if t.SeriesID != nil {
+		return *t.SeriesID
+	}
+	return t.ID
+}
@@ -0,0 +1,291 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+	_ "time/tzdata"
+
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/models"
+)
+
+func mustLoadLocation(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return loc
+}
+
+func TestNextOccurrence(t *testing.T) {
+	newYork := mustLoadLocation(t, "America/New_York")
+	berlin := mustLoadLocation(t, "Europe/Berlin")
+	date := func(loc *time.Location, year int, month time.Month, day, hour, min int) time.Time {
+		return time.Date(year, month, day, hour, min, 0, 0, loc)
+	}
+
+	tests := []struct {
+		name     string
+		rule     string
+		timezone string
+		start    time.Time
+		want     []time.Time
+		// ends is whether the series ends after the wanted occurrences.
+		ends bool
+	}{
+		{
+			name:     "daily across US spring forward",
+			rule:     "FREQ=DAILY",
+			timezone: "America/New_York",
+			start:    date(newYork, 2024, 3, 9, 9, 0),
+			want: []time.Time{
+				date(newYork, 2024, 3, 10, 9, 0),
+				date(newYork, 2024, 3, 11, 9, 0),
+			},
+		},
+		{
+			name:     "daily across US fall back",
+			rule:     "FREQ=DAILY",
+			timezone: "America/New_York",
+			start:    date(newYork, 2024, 11, 2, 9, 0),
+			want: []time.Time{
+				date(newYork, 2024, 11, 3, 9, 0),
+				date(newYork, 2024, 11, 4, 9, 0),
+			},
+		},
+		{
+			name:     "weekly across EU spring forward",
+			rule:     "FREQ=WEEKLY;BYDAY=SA",
+			timezone: "Europe/Berlin",
+			start:    date(berlin, 2024, 3, 23, 8, 30),
+			want: []time.Time{
+				date(berlin, 2024, 3, 30, 8, 30),
+				date(berlin, 2024, 4, 6, 8, 30),
+			},
+		},
+		{
+			name:     "weekly across EU fall back",
+			rule:     "FREQ=WEEKLY",
+			timezone: "Europe/Berlin",
+			start:    date(berlin, 2024, 10, 21, 18, 0),
+			want: []time.Time{
+				date(berlin, 2024, 10, 28, 18, 0),
+				date(berlin, 2024, 11, 4, 18, 0),
+			},
+		},
+		{
+			name:     "weekly in New York across EU change",
+			rule:     "FREQ=WEEKLY",
+			timezone: "America/New_York",
+			start:    date(newYork, 2024, 3, 25, 12, 0),
+			want: []time.Time{
+				date(newYork, 2024, 4, 1, 12, 0),
+			},
+		},
+		{
+			name:     "31st of the month skips shorter months",
+			rule:     "FREQ=MONTHLY;BYMONTHDAY=31",
+			timezone: "UTC",
+			start:    date(time.UTC, 2024, 1, 31, 10, 0),
+			want: []time.Time{
+				date(time.UTC, 2024, 3, 31, 10, 0),
+				date(time.UTC, 2024, 5, 31, 10, 0),
+				date(time.UTC, 2024, 7, 31, 10, 0),
+			},
+		},
+		{
+			name:     "last day of the month in a leap year",
+			rule:     "FREQ=MONTHLY;BYMONTHDAY=-1",
+			timezone: "UTC",
+			start:    date(time.UTC, 2024, 1, 31, 10, 0),
+			want: []time.Time{
+				date(time.UTC, 2024, 2, 29, 10, 0),
+				date(time.UTC, 2024, 3, 31, 10, 0),
+				date(time.UTC, 2024, 4, 30, 10, 0),
+			},
+		},
+		{
+			name:     "last day of the month in a common year",
+			rule:     "FREQ=MONTHLY;BYMONTHDAY=-1",
+			timezone: "UTC",
+			start:    date(time.UTC, 2023, 1, 31, 10, 0),
+			want: []time.Time{
+				date(time.UTC, 2023, 2, 28, 10, 0),
+				date(time.UTC, 2023, 3, 31, 10, 0),
+			},
+		},
+		{
+			name:     "last day of February across DST in Berlin",
+			rule:     "FREQ=MONTHLY;BYMONTHDAY=-1",
+			timezone: "Europe/Berlin",
+			start:    date(berlin, 2028, 2, 29, 9, 0),
+			want: []time.Time{
+				date(berlin, 2028, 3, 31, 9, 0),
+				date(berlin, 2028, 4, 30, 9, 0),
+			},
+		},
+		{
+			name:     "count ends the series",
+			rule:     "FREQ=DAILY;COUNT=3",
+			timezone: "UTC",
+			start:    date(time.UTC, 2024, 1, 1, 9, 0),
+			want: []time.Time{
+				date(time.UTC, 2024, 1, 2, 9, 0),
+				date(time.UTC, 2024, 1, 3, 9, 0),
+			},
+			ends: true,
+		},
+		{
+			name:     "until ends the series",
+			rule:     "FREQ=WEEKLY;UNTIL=20240115T090000Z",
+			timezone: "UTC",
+			start:    date(time.UTC, 2024, 1, 1, 9, 0),
+			want: []time.Time{
+				date(time.UTC, 2024, 1, 8, 9, 0),
+				date(time.UTC, 2024, 1, 15, 9, 0),
+			},
+			ends: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := normalizeRecurrence(models.Recurrence{Rule: tt.rule, Timezone: tt.timezone}, &tt.start)
+			if err != nil {
+				t.Fatalf("normalizeRecurrence: %v", err)
+			}
+			after := tt.start
+			for _, want := range tt.want {
+				next, ok, err := nextOccurrence(*r, after)
+				if err != nil {
+					t.Fatalf("nextOccurrence(%v): %v", after, err)
+				}
+				if !ok {
+					t.Fatalf("nextOccurrence(%v): series ended, want %v", after, want)
+				}
+				if !next.Equal(want) {
+					t.Fatalf("nextOccurrence(%v) = %v, want %v", after, next, want)
+				}
+				after = next
+			}
+			next, ok, err := nextOccurrence(*r, after)
+			if err != nil {
+				t.Fatalf("nextOccurrence(%v): %v", after, err)
+			}
+			if ok == tt.ends {
+				t.Fatalf("nextOccurrence(%v) = %v, %v; want series to end: %v", after, next, ok, tt.ends)
+			}
+		})
+	}
+}
+
+func TestNormalizeRecurrence(t *testing.T) {
+	due := time.Date(2024, 1, 31, 10, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name    string
+		r       models.Recurrence
+		dueAt   *time.Time
+		wantErr string
+	}{
+		{name: "prefixed rule", r: models.Recurrence{Rule: "RRULE:FREQ=DAILY"}, dueAt: &due},
+		{name: "no due date", r: models.Recurrence{Rule: "FREQ=DAILY"}, wantErr: RecurrenceNeedsDueDateError},
+		{name: "empty rule", r: models.Recurrence{}, dueAt: &due, wantErr: InvalidRecurrenceError},
+		{name: "rule with DTSTART", r: models.Recurrence{Rule: "DTSTART:20240101T000000Z\nRRULE:FREQ=DAILY"}, dueAt: &due, wantErr: InvalidRecurrenceError},
+		{name: "unknown frequency", r: models.Recurrence{Rule: "FREQ=SOMETIMES"}, dueAt: &due, wantErr: InvalidRecurrenceError},
+		{name: "unknown timezone", r: models.Recurrence{Rule: "FREQ=DAILY", Timezone: "Mars/Olympus"}, dueAt: &due, wantErr: InvalidRecurrenceError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := normalizeRecurrence(tt.r, tt.dueAt)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("normalizeRecurrence() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("normalizeRecurrence() error = %v", err)
+			}
+			if r.Rule != "FREQ=DAILY" || r.Timezone != "UTC" || !r.StartsAt.Equal(due) {
+				t.Fatalf("normalizeRecurrence() = %+v", r)
+			}
+		})
+	}
+}
+
+func TestRecurrencePauseAndResume(t *testing.T) {
+	ctx := context.Background()
+	svc := newTestTaskService(t)
+	due := time.Date(2024, 3, 9, 14, 0, 0, 0, time.UTC)
+	rule := models.Recurrence{Rule: "FREQ=DAILY", Timezone: "America/New_York"}
+	first, err := svc.CreateTask(ctx, models.Task{Title: "Water plants", DueAt: &due, Recurrence: &rule})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	paused := rule
+	paused.Paused = true
+	_, err = svc.UpdateTask(ctx, models.TaskUpdate{
+		ID:         first.ID,
+		Title:      first.Title,
+		Recurrence: models.Optional[models.Recurrence]{Set: true, Value: &paused},
+	}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	done := "done"
+	closed, err := svc.UpdateTask(ctx, models.TaskUpdate{ID: first.ID, Title: first.Title, Status: &done}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if closed.Recurrence == nil || !closed.Recurrence.Paused {
+		t.Fatalf("completed paused task has recurrence %+v, want it kept paused", closed.Recurrence)
+	}
+	if n := len(listSeries(t, svc, first.ID)); n != 0 {
+		t.Fatalf("paused series has %d next occurrences, want 0", n)
+	}
+
+	// Resuming a closed task schedules the occurrence it skipped.
+	resumed, err := svc.UpdateTask(ctx, models.TaskUpdate{
+		ID:         first.ID,
+		Title:      first.Title,
+		Recurrence: models.Optional[models.Recurrence]{Set: true, Value: &rule},
+	}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resumed.Recurrence != nil {
+		t.Fatalf("resumed closed task has recurrence %+v, want it handed over", resumed.Recurrence)
+	}
+	next := listSeries(t, svc, first.ID)
+	if len(next) != 1 {
+		t.Fatalf("resumed series has %d next occurrences, want 1", len(next))
+	}
+	// 09:00 in New York on the day after, which is after the spring
+	// forward.
+	want := time.Date(2024, 3, 10, 13, 0, 0, 0, time.UTC)
+	if next[0].DueAt == nil || !next[0].DueAt.Equal(want) {
+		t.Fatalf("next occurrence is due %v, want %v", next[0].DueAt, want)
+	}
+	if next[0].Recurrence == nil || next[0].Recurrence.Paused {
+		t.Fatalf("next occurrence has recurrence %+v, want it active", next[0].Recurrence)
+	}
+}
+
+// listSeries returns the tasks of the series of the task seriesID other than
+// itself.
+func listSeries(t *testing.T, svc *TaskService, seriesID uuid.UUID) []*models.Task {
+	t.Helper()
+	tasks, err := svc.ListTasks(context.Background(), models.TaskFilter{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var series []*models.Task
+	for _, task := range tasks {
+		if task.SeriesID != nil && *task.SeriesID == seriesID && task.ID != seriesID {
+			series = append(series, task)
+		}
+	}
+	return series
+}
@@ -0,0 +1,235 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/datastore"
+	"github.com/localopsco/go-sample/ent"
+	"github.com/localopsco/go-sample/models"
+	"github.com/localopsco/go-sample/taskquery"
+)
+
+const SavedViewNotFoundError = "View not found"
+const SavedViewNameTakenError = "A view with this name already exists"
+const InvalidSavedViewError = "Views need a name of at most 100 characters"
+const InvalidVisibilityError = "Visibility must be private or shared"
+const NotViewOwnerError = "Only the owner can change a view"
+
+const maxSavedViewNameLength = 100
+
+// smartLists are the built-in views available to everyone.
+var smartLists = []models.SmartList{
+	{Key: "today", Name: "Today", Query: "status:open AND due:today", Sort: "-priority"},
+	{Key: "overdue", Name: "Overdue", Query: "status:open AND due<now", Sort: "due_at"},
+	{Key: "upcoming", Name: "Upcoming 7 days", Query: "status:open AND due>today AND due<=+7d", Sort: "due_at"},
+	{Key: "unassigned", Name: "Unassigned", Query: "status:open AND assignee:none", Sort: "-created_at"},
+	{Key: "recently-completed", Name: "Recently completed", Query: "status:closed AND completed>=-7d", Sort: "-completed_at"},
+}
+
+type SavedViewService struct {
+	store *datastore.SavedViewStore
+	tasks *datastore.TaskStore
+}
+
+func NewSavedViewService(savedViewStore *datastore.SavedViewStore, taskStore *datastore.TaskStore) *SavedViewService {
+	return &SavedViewService{
+		savedViewStore,
+		taskStore,
+	}
+}
+
+// CreateSavedView saves a view for its owner. The query and filters must be
+// valid, but values depending on when or by whom the view runs, such as
+// due<today or assignee:me, are evaluated each time it is used.
+func (svc *SavedViewService) CreateSavedView(ctx context.Context, owner *models.User, view models.SavedView) (*models.SavedView, error) {
+	view.Name, view.Query = strings.TrimSpace(view.Name), strings.TrimSpace(view.Query)
+	if view.Visibility == "" {
+		view.Visibility = models.ViewVisibilityPrivate
+	}
+	if err := validateSavedView(view); err != nil {
+		return nil, err
+	}
+	view.OwnerID = owner.ID
+	created, err := svc.store.CreateSavedView(ctx, view)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, errors.New(SavedViewNameTakenError)
+		}
+		return nil, err
+	}
+	return created, nil
+}
+
+// GetSavedView returns a view owned by user or shared with everyone. Private
+// views of other users are reported as not found.
+func (svc *SavedViewService) GetSavedView(ctx context.Context, user *models.User, viewID uuid.UUID) (*models.SavedView, error) {
+	view, err := svc.store.GetSavedView(ctx, viewID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New(SavedViewNotFoundError)
+		}
+		return nil, err
+	}
+	if view.OwnerID != user.ID && view.Visibility != models.ViewVisibilityShared {
+		return nil, errors.New(SavedViewNotFoundError)
+	}
+	return view, nil
+}
+
+// ListSavedViews returns the smart lists, followed by the user's views and
+// the views shared by others.
+func (svc *SavedViewService) ListSavedViews(ctx context.Context, user *models.User) (*models.ViewList, error) {
+	views, err := svc.store.ListSavedViews(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &models.ViewList{SmartLists: smartLists, Views: views}, nil
+}
+
+func (svc *SavedViewService) UpdateSavedView(ctx context.Context, user *models.User, update models.SavedViewUpdate) (*models.SavedView, error) {
+	view, err := svc.ownedView(ctx, user, update.ID)
+	if err != nil {
+		return nil, err
+	}
+	if update.Name != nil {
+		name := strings.TrimSpace(*update.Name)
+		update.Name, view.Name = &name, name
+	}
+	if update.Query != nil {
+		query := strings.TrimSpace(*update.Query)
+		update.Query, view.Query = &query, query
+	}
+	if update.Filters != nil {
+		view.Filters = *update.Filters
+	}
+	if update.Sort != nil {
+		view.Sort = *update.Sort
+	}
+	if update.Visibility != nil {
+		view.Visibility = *update.Visibility
+	}
+	if err := validateSavedView(*view); err != nil {
+		return nil, err
+	}
+	updated, err := svc.store.UpdateSavedView(ctx, update)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, errors.New(SavedViewNameTakenError)
+		}
+		return nil, err
+	}
+	return updated, nil
+}
+
+func (svc *SavedViewService) DeleteSavedView(ctx context.Context, user *models.User, viewID uuid.UUID) error {
+	if _, err := svc.ownedView(ctx, user, viewID); err != nil {
+		return err
+	}
+	return svc.store.DeleteSavedView(ctx, viewID)
+}
+
+// ListViewTasks runs a saved view, or the smart list with the key viewID,
+// and returns a page of its tasks. user may be nil for smart lists.
+func (svc *SavedViewService) ListViewTasks(ctx context.Context, user *models.User, viewID string, loc *time.Location, limit, offset int) (*models.Page[*models.Task], error) {
+	var filter models.TaskFilter
+	if smartList, ok := findSmartList(viewID); ok {
+		filter = models.TaskFilter{Query: smartList.Query, Sort: smartList.Sort}
+	} else {
+		id, err := uuid.Parse(viewID)
+		if err != nil {
+			return nil, errors.New(SavedViewNotFoundError)
+		}
+		if user == nil {
+			return nil, errors.New(AuthenticationRequiredError)
+		}
+		view, err := svc.GetSavedView(ctx, user, id)
+		if err != nil {
+			return nil, err
+		}
+		if filter, err = viewFilter(*view, user); err != nil {
+			return nil, err
+		}
+	}
+	filter.Location = loc
+	if err := validateFilter(filter); err != nil {
+		return nil, err
+	}
+	limit, offset = pageBounds(limit, offset)
+	return svc.tasks.ListTasksPage(ctx, filter, limit, offset)
+}
+
+// ownedView returns a view that user may change.
+func (svc *SavedViewService) ownedView(ctx context.Context, user *models.User, viewID uuid.UUID) (*models.SavedView, error) {
+	view, err := svc.GetSavedView(ctx, user, viewID)
+	if err != nil {
+		return nil, err
+	}
+	if view.OwnerID != user.ID {
+		return nil, errors.New(NotViewOwnerError)
+	}
+	return view, nil
+}
+
+// viewFilter builds the task filter of a view run by user.
+func viewFilter(view models.SavedView, user *models.User) (models.TaskFilter, error) {
+	f := view.Filters
+	filter := models.TaskFilter{
+		Due:        f.Due,
+		Priority:   f.Priority,
+		Status:     f.Status,
+		ProjectID:  f.ProjectID,
+		Unassigned: f.Unassigned,
+		Query:      view.Query,
+		Sort:       view.Sort,
+	}
+	if f.Assignee == "me" {
+		filter.AssigneeID = &user.ID
+	} else if f.Assignee != "" {
+		id, err := uuid.Parse(f.Assignee)
+		if err != nil {
+			return models.TaskFilter{}, errors.New(InvalidFilterError)
+		}
+		filter.AssigneeID = &id
+	}
+	if f.Watching {
+		filter.WatcherID = &user.ID
+	}
+	return filter, nil
+}
+
+func validateSavedView(view models.SavedView) error {
+	if view.Name == "" || len(view.Name) > maxSavedViewNameLength {
+		return errors.New(InvalidSavedViewError)
+	}
+	if view.Visibility != models.ViewVisibilityPrivate && view.Visibility != models.ViewVisibilityShared {
+		return errors.New(InvalidVisibilityError)
+	}
+	if view.Query != "" {
+		if _, err := taskquery.Parse(view.Query); err != nil {
+			return err
+		}
+	}
+	if a := view.Filters.Assignee; a != "" && a != "me" {
+		if _, err := uuid.Parse(a); err != nil {
+			return errors.New(InvalidFilterError)
+		}
+	}
+	return validateFilter(models.TaskFilter{
+		Due:      view.Filters.Due,
+		Priority: view.Filters.Priority,
+		Sort:     view.Sort,
+	})
+}
+
+func findSmartList(key string) (models.SmartList, bool) {
+	for _, smartList := range smartLists {
+		if smartList.Key == key {
+			return smartList, true
+		}
+	}
+	return models.SmartList{}, false
+}
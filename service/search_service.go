@@ -0,0 +1,36 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/localopsco/go-sample/datastore"
+	"github.com/localopsco/go-sample/models"
+)
+
+const EmptySearchQueryError = "Search query is required"
+
+type SearchService struct {
+	store *datastore.SearchStore
+}
+
+func NewSearchService(searchStore *datastore.SearchStore) *SearchService {
+	return &SearchService{
+		searchStore,
+	}
+}
+
+// Search returns a page of the tasks whose title, description or comments
+// contain words starting with every word of query, restricted by filter and
+// ordered by relevance. The filter's sort order is ignored.
+func (svc *SearchService) Search(ctx context.Context, query string, filter models.TaskFilter, limit, offset int) (*models.Page[*models.SearchResult], error) {
+	if strings.TrimSpace(query) == "" {
+		return nil, errors.New(EmptySearchQueryError)
+	}
+	if err := validateFilter(filter); err != nil {
+		return nil, err
+	}
+	limit, offset = pageBounds(limit, offset)
+	return svc.store.Search(ctx, query, filter, limit, offset)
+}
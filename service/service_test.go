@@ -0,0 +1,21 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/localopsco/go-sample/datastore"
+	"github.com/localopsco/go-sample/datastore/datastoretest"
+)
+
+func newTestTaskService(t *testing.T) *TaskService {
+	t.Helper()
+	client := datastoretest.NewClient(t)
+	return NewTaskService(
+		datastore.NewTaskStore(client),
+		datastore.NewWorkflowStore(client),
+		datastore.NewProjectStore(client),
+		datastore.NewUserStore(client),
+		nil,
+		datastore.NewOutboxStore(client),
+	)
+}
@@ -0,0 +1,220 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/datastore"
+	"github.com/localopsco/go-sample/ent"
+	"github.com/localopsco/go-sample/models"
+)
+
+const InvalidLastEventIDError = "Invalid Last-Event-ID"
+
+// Each subscriber buffers up to streamBuffer events. A subscriber that falls
+// further behind is dropped and has to resume from its last event. Resuming
+// replays at most maxStreamReplay events.
+const (
+	streamBuffer    = 256
+	maxStreamReplay = 1000
+)
+
+// StreamFilter narrows down the events of a stream. Events are visible to
+// every caller, like the tasks of GET /tasks/; UserID limits them to the
+// tasks the user is assigned to or watching.
+type StreamFilter struct {
+	ProjectIDs []uuid.UUID
+	UserID     *uuid.UUID
+}
+
+// Subscription receives the events published while it is open.
+type Subscription struct {
+	events chan models.Event
+	filter StreamFilter
+	lagged bool
+}
+
+// Events returns the channel of the subscription's events, which is closed
+// if the subscriber falls behind.
+func (sub *Subscription) Events() <-chan models.Event {
+	return sub.events
+}
+
+// Lagged reports whether the subscription was dropped for falling behind.
+// It is only meaningful once Events is closed.
+func (sub *Subscription) Lagged() bool {
+	return sub.lagged
+}
+
+// StreamService fans the task events of all replicas out to the streams of
+// this one. Replicas announce relayed events with NOTIFY, and each loads
+// them from the outbox, which also serves streams that resume.
+type StreamService struct {
+	outbox *datastore.OutboxStore
+
+	mu          sync.Mutex
+	subscribers map[*Subscription]struct{}
+	lastSeq     int
+}
+
+func NewStreamService(outboxStore *datastore.OutboxStore) *StreamService {
+	return &StreamService{
+		outbox:      outboxStore,
+		subscribers: make(map[*Subscription]struct{}),
+	}
+}
+
+// Subscribe opens a subscription to the events matching filter. It must be
+// closed with Unsubscribe.
+func (svc *StreamService) Subscribe(filter StreamFilter) *Subscription {
+	sub := &Subscription{
+		events: make(chan models.Event, streamBuffer),
+		filter: filter,
+	}
+	svc.mu.Lock()
+	defer svc.mu.Unlock()
+	svc.subscribers[sub] = struct{}{}
+	return sub
+}
+
+func (svc *StreamService) Unsubscribe(sub *Subscription) {
+	svc.mu.Lock()
+	defer svc.mu.Unlock()
+	if _, ok := svc.subscribers[sub]; ok {
+		delete(svc.subscribers, sub)
+		close(sub.events)
+	}
+}
+
+// Publish hands an event to the matching subscribers without waiting for
+// them, dropping those whose buffer is full.
+func (svc *StreamService) Publish(ctx context.Context, event models.Event) error {
+	svc.mu.Lock()
+	defer svc.mu.Unlock()
+	if event.Seq > svc.lastSeq {
+		svc.lastSeq = event.Seq
+	}
+	for sub := range svc.subscribers {
+		if !sub.filter.matches(event) {
+			continue
+		}
+		select {
+		case sub.events <- event:
+		default:
+			sub.lagged = true
+			delete(svc.subscribers, sub)
+			close(sub.events)
+		}
+	}
+	return nil
+}
+
+// Replay returns the events matching filter that were relayed after the
+// event at position seq. complete is false if there were too many to
+// replay, or if some of them were already deleted from the outbox, in which
+// case the caller should reload its data instead.
+func (svc *StreamService) Replay(ctx context.Context, seq int, filter StreamFilter) (events []models.Event, complete bool, err error) {
+	if seq < 0 {
+		return nil, false, errors.New(InvalidLastEventIDError)
+	}
+	// Read before the events, so that events relayed in between are not
+	// taken for deleted ones.
+	lastSeq, err := svc.outbox.LastRelayedSeq(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	for first := true; ; first = false {
+		batch, err := svc.outbox.ListRelayedAfter(ctx, seq, maxStreamReplay+1)
+		if err != nil {
+			return nil, false, err
+		}
+		// Positions are consecutive, so a gap after seq means the events
+		// in it were deleted once the outbox retention ran out. Events
+		// relayed before positions existed were numbered by their outbox
+		// ID and may have gaps too, which only costs a reload.
+		if first {
+			deleted := seq < lastSeq
+			if len(batch) > 0 {
+				deleted = batch[0].Seq != seq+1
+			}
+			if deleted {
+				return nil, false, nil
+			}
+		}
+		for _, event := range batch {
+			seq = event.Seq
+			if filter.matches(*event) {
+				if len(events) == maxStreamReplay {
+					return events, false, nil
+				}
+				events = append(events, *event)
+			}
+		}
+		if len(batch) <= maxStreamReplay {
+			return events, true, nil
+		}
+	}
+}
+
+// RunListener publishes the events announced by every replica to the
+// streams of this one until ctx is cancelled. Events missed while the
+// connection to the database was down are caught up from the outbox.
+func (svc *StreamService) RunListener(ctx context.Context, dsn string) error {
+	return datastore.ListenEvents(ctx, dsn, func(seq int) {
+		event, err := svc.outbox.GetEvent(ctx, seq)
+		if err != nil {
+			if !ent.IsNotFound(err) {
+				log.Printf("error loading event %d: %v", seq, err)
+			}
+			return
+		}
+		svc.Publish(ctx, *event)
+	}, func() {
+		svc.mu.Lock()
+		lastSeq := svc.lastSeq
+		svc.mu.Unlock()
+		if lastSeq == 0 {
+			return
+		}
+		events, _, err := svc.Replay(ctx, lastSeq, StreamFilter{})
+		if err != nil {
+			log.Printf("error catching up on events: %v", err)
+			return
+		}
+		for _, event := range events {
+			svc.Publish(ctx, event)
+		}
+	})
+}
+
+func (filter StreamFilter) matches(event models.Event) bool {
+	if len(filter.ProjectIDs) > 0 {
+		found := false
+		for _, projectID := range filter.ProjectIDs {
+			if event.ProjectID != nil && *event.ProjectID == projectID {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if filter.UserID != nil {
+		if event.Task == nil {
+			return false
+		}
+		for _, users := range [][]*models.User{event.Task.Assignees, event.Task.Watchers} {
+			for _, user := range users {
+				if user.ID == *filter.UserID {
+					return true
+				}
+			}
+		}
+		return false
+	}
+	return true
+}
@@ -0,0 +1,135 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/datastore"
+	"github.com/localopsco/go-sample/datastore/datastoretest"
+	"github.com/localopsco/go-sample/ent/outboxevent"
+	"github.com/localopsco/go-sample/models"
+)
+
+func TestReplayIncludesEventsRelayedLate(t *testing.T) {
+	ctx := context.Background()
+	client := datastoretest.NewClient(t)
+	outbox := datastore.NewOutboxStore(client)
+	svc := NewStreamService(outbox)
+	held, other := uuid.New(), uuid.New()
+	for _, eventID := range []uuid.UUID{held, other} {
+		err := outbox.Publish(ctx, models.Event{ID: eventID, Type: models.EventTaskCreated, TaskID: uuid.New()})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// The older event fails to publish and is held up, while the newer
+	// one goes out and is seen by a stream.
+	var seen []models.Event
+	relay := func(fail uuid.UUID) {
+		t.Helper()
+		_, err := outbox.RelayBatch(ctx, 10, func(_ context.Context, event models.Event) error {
+			if event.ID == fail {
+				return errors.New("publisher is down")
+			}
+			return nil
+		}, func(_ context.Context, event models.Event) error {
+			seen = append(seen, event)
+			return nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	relay(held)
+	if len(seen) != 1 || seen[0].ID != other || seen[0].Seq != 1 {
+		t.Fatalf("relayed %+v, want the newer event at position 1", seen)
+	}
+	if _, err := client.OutboxEvent.Update().SetAvailableAt(time.Now()).Save(ctx); err != nil {
+		t.Fatal(err)
+	}
+	relay(uuid.Nil)
+	if len(seen) != 2 || seen[1].ID != held || seen[1].Seq != 2 {
+		t.Fatalf("relayed %+v, want the held up event at position 2", seen)
+	}
+
+	// A stream resuming after the newer event gets the held up one.
+	replayed, complete, err := svc.Replay(ctx, seen[0].Seq, StreamFilter{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !complete || len(replayed) != 1 || replayed[0].ID != held || replayed[0].Seq != 2 {
+		t.Fatalf("Replay() = %+v, %v, want the held up event", replayed, complete)
+	}
+	event, err := outbox.GetEvent(ctx, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if event.ID != held {
+		t.Fatalf("GetEvent(2) = %s, want %s", event.ID, held)
+	}
+}
+
+// TestReplayIsIncompleteAfterRetention checks that a stream resuming from
+// before the events the outbox still holds is told to reload, rather than
+// silently skipping the deleted ones.
+func TestReplayIsIncompleteAfterRetention(t *testing.T) {
+	ctx := context.Background()
+	client := datastoretest.NewClient(t)
+	outbox := datastore.NewOutboxStore(client)
+	svc := NewStreamService(outbox)
+	for i := 0; i < 3; i++ {
+		err := outbox.Publish(ctx, models.Event{ID: uuid.New(), Type: models.EventTaskCreated, TaskID: uuid.New()})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	relay := NewOutboxRelay(outbox, Publishers{}, nil)
+	if n, err := relay.RelayPending(ctx); err != nil || n != 3 {
+		t.Fatalf("RelayPending() = %d, %v, want 3 events relayed", n, err)
+	}
+	// purge deletes the events relayed up to position seq.
+	purge := func(seq int) {
+		t.Helper()
+		err := client.OutboxEvent.Update().
+			Where(outboxevent.RelayedSeqLTE(seq)).
+			SetProcessedAt(time.Now().Add(-time.Hour)).
+			Exec(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := outbox.DeleteProcessed(ctx, time.Now().Add(-time.Minute)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		name     string
+		purged   int
+		seq      int
+		complete bool
+		replayed int
+	}{
+		{"from the start", 2, 0, false, 0},
+		{"from a deleted event", 2, 1, false, 0},
+		{"from the last deleted event", 2, 2, true, 1},
+		{"from the last event", 2, 3, true, 0},
+		{"from a deleted event with none left", 3, 1, false, 0},
+		{"from the last event with none left", 3, 3, true, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			purge(tt.purged)
+			replayed, complete, err := svc.Replay(ctx, tt.seq, StreamFilter{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if complete != tt.complete || len(replayed) != tt.replayed {
+				t.Errorf("Replay(%d) = %d events, %v, want %d, %v", tt.seq, len(replayed), complete, tt.replayed, tt.complete)
+			}
+		})
+	}
+}
@@ -0,0 +1,379 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/ent"
+	"github.com/localopsco/go-sample/models"
+)
+
+const InvalidSyncTokenError = "Invalid sync token"
+const InvalidSyncStrategyError = "Strategy must be lww or version"
+const SyncTooLargeError = "A sync push can hold at most 500 mutations"
+const InvalidSyncOpError = "Op must be one of create, update or delete"
+const MissingSyncIDError = "Update and delete mutations need an id"
+const MissingBaseVersionError = "The version strategy needs a base_version"
+const UnknownSyncFieldError = "Mutations can only set title, description, status, is_completed, priority, start_at, due_at, estimate_minutes and labels"
+const InvalidSyncFieldsError = "Invalid field values"
+
+const defaultSyncPageSize = 500
+const maxSyncPageSize = 1000
+const maxSyncMutations = 500
+
+// syncFields maps the task fields a sync mutation may set to the fields
+// their changes are recorded under in task events.
+var syncFields = map[string]string{
+	"title":            "title",
+	"description":      "description",
+	"status":           "status",
+	"is_completed":     "status",
+	"priority":         "priority",
+	"start_at":         "start_at",
+	"due_at":           "due_at",
+	"estimate_minutes": "estimate_minutes",
+	"labels":           "labels",
+}
+
+// syncTaskFields decodes the fields of a sync mutation.
+type syncTaskFields struct {
+	Title           *string                    `json:"title"`
+	Description     *string                    `json:"description"`
+	Status          *string                    `json:"status"`
+	IsCompleted     *bool                      `json:"is_completed"`
+	Priority        *string                    `json:"priority"`
+	StartAt         models.Optional[time.Time] `json:"start_at"`
+	DueAt           models.Optional[time.Time] `json:"due_at"`
+	EstimateMinutes models.Optional[int]       `json:"estimate_minutes"`
+	Labels          *[]string                  `json:"labels"`
+}
+
+// Sync returns the task changes made after token, which is empty for a
+// client's first pull. The returned token resumes from the last change
+// listed; while HasMore is set, the client should pull again right away.
+func (svc *TaskService) Sync(ctx context.Context, token string, limit int) (*models.SyncChanges, error) {
+	cursor, err := decodeSyncToken(token)
+	if err != nil {
+		return nil, err
+	}
+	if limit <= 0 {
+		limit = defaultSyncPageSize
+	}
+	if limit > maxSyncPageSize {
+		limit = maxSyncPageSize
+	}
+	changes, err := svc.store.ListChanges(ctx, cursor, limit)
+	if err != nil {
+		return nil, err
+	}
+	changes.Token = encodeSyncToken(changes.Next)
+	return changes, nil
+}
+
+// encodeSyncToken turns a cursor into the opaque token handed to clients.
+func encodeSyncToken(cursor models.SyncCursor) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf("%d:%s", cursor.Seq, cursor.ID)))
+}
+
+func decodeSyncToken(token string) (models.SyncCursor, error) {
+	if token == "" {
+		return models.SyncCursor{}, nil
+	}
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return models.SyncCursor{}, errors.New(InvalidSyncTokenError)
+	}
+	seq, id, ok := strings.Cut(string(b), ":")
+	if !ok {
+		return models.SyncCursor{}, errors.New(InvalidSyncTokenError)
+	}
+	var cursor models.SyncCursor
+	if cursor.Seq, err = strconv.ParseInt(seq, 10, 64); err != nil || cursor.Seq < 0 {
+		return models.SyncCursor{}, errors.New(InvalidSyncTokenError)
+	}
+	if cursor.ID, err = uuid.Parse(id); err != nil {
+		return models.SyncCursor{}, errors.New(InvalidSyncTokenError)
+	}
+	return cursor, nil
+}
+
+// Push applies the mutations a client made offline, in order, in a single
+// transaction that keeps other writers out until it commits. Each mutation
+// runs in a savepoint and goes through the same checks as the task
+// endpoints; one that fails or conflicts leaves the others alone.
+//
+// Conflicts are resolved according to the push's strategy. With the
+// version strategy, a mutation based on an outdated version of its task is
+// not applied. With last-writer-wins, only the fields the server changed
+// after the client did are dropped, and a delete is dropped if the task
+// changed after it. Dropped changes are returned as conflicts along with
+// the server's values.
+//
+// Creates may carry the ID the client gave the task, which makes pushing
+// them again harmless.
+func (svc *TaskService) Push(ctx context.Context, push models.SyncPush) (*models.SyncPushResult, error) {
+	if push.Strategy == "" {
+		push.Strategy = models.SyncLastWriterWins
+	}
+	if push.Strategy != models.SyncLastWriterWins && push.Strategy != models.SyncVersion {
+		return nil, errors.New(InvalidSyncStrategyError)
+	}
+	if len(push.Mutations) > maxSyncMutations {
+		return nil, errors.New(SyncTooLargeError)
+	}
+	result := &models.SyncPushResult{Strategy: push.Strategy, Results: []*models.SyncMutationResult{}}
+	now := time.Now()
+	err := svc.store.InTx(ctx, func(client *ent.Client) error {
+		txSvc := svc.withClient(client)
+		if err := txSvc.store.LockChanges(ctx); err != nil {
+			return err
+		}
+		for _, mutation := range push.Mutations {
+			mutation := mutation
+			if mutation.ChangedAt == nil {
+				mutation.ChangedAt = &now
+			}
+			item := &models.SyncMutationResult{ClientID: mutation.ClientID, ID: mutation.ID, Status: models.SyncMutationApplied}
+			err := txSvc.store.Savepoint(ctx, func() error {
+				return txSvc.applyMutation(ctx, push.Strategy, mutation, item)
+			})
+			if err != nil {
+				item.Status, item.Task, item.Conflicts, item.Err = models.SyncMutationFailed, nil, nil, err
+			}
+			result.Results = append(result.Results, item)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func validateMutation(strategy string, mutation models.SyncMutation) error {
+	switch mutation.Op {
+	case models.SyncOpCreate:
+	case models.SyncOpUpdate, models.SyncOpDelete:
+		if mutation.ID == nil {
+			return errors.New(MissingSyncIDError)
+		}
+		if strategy == models.SyncVersion && mutation.BaseVersion == nil {
+			return errors.New(MissingBaseVersionError)
+		}
+	default:
+		return errors.New(InvalidSyncOpError)
+	}
+	for name := range mutation.Fields {
+		if _, ok := syncFields[name]; !ok {
+			return errors.New(UnknownSyncFieldError)
+		}
+	}
+	return nil
+}
+
+// applyMutation applies one sync mutation, recording its outcome in item.
+func (svc *TaskService) applyMutation(ctx context.Context, strategy string, mutation models.SyncMutation, item *models.SyncMutationResult) error {
+	if err := validateMutation(strategy, mutation); err != nil {
+		return err
+	}
+	fields, err := decodeSyncFields(mutation.Fields)
+	if err != nil {
+		return err
+	}
+	if mutation.Op == models.SyncOpCreate {
+		return svc.syncCreate(ctx, mutation, fields, item)
+	}
+
+	existing, err := svc.GetTask(ctx, *mutation.ID)
+	if err != nil {
+		if err.Error() == TaskNotFoundError && mutation.Op == models.SyncOpDelete {
+			return nil
+		}
+		return err
+	}
+	if strategy == models.SyncVersion && *mutation.BaseVersion != existing.Version {
+		item.Status, item.Task = models.SyncMutationConflict, existing
+		for _, name := range fieldNames(mutation.Fields) {
+			item.Conflicts = append(item.Conflicts, models.SyncConflict{Field: name, ClientValue: mutation.Fields[name], ServerValue: syncFieldValue(existing, name)})
+		}
+		return nil
+	}
+
+	var lastChanges map[string]time.Time
+	if strategy == models.SyncLastWriterWins {
+		if lastChanges, err = svc.store.LastFieldChanges(ctx, existing.ID); err != nil {
+			return err
+		}
+	}
+	if mutation.Op == models.SyncOpDelete {
+		for _, changedAt := range lastChanges {
+			if changedAt.After(*mutation.ChangedAt) {
+				item.Status, item.Task = models.SyncMutationConflict, existing
+				return nil
+			}
+		}
+		return svc.DeleteTask(ctx, existing.ID)
+	}
+
+	for _, name := range fieldNames(mutation.Fields) {
+		if changedAt, ok := lastChanges[syncFields[name]]; ok && changedAt.After(*mutation.ChangedAt) {
+			item.Conflicts = append(item.Conflicts, models.SyncConflict{Field: name, ClientValue: mutation.Fields[name], ServerValue: syncFieldValue(existing, name)})
+			fields.drop(name)
+		}
+	}
+	if len(item.Conflicts) > 0 {
+		item.Status = models.SyncMutationConflict
+	}
+	if len(item.Conflicts) == len(mutation.Fields) {
+		item.Task = existing
+		return nil
+	}
+	update := models.TaskUpdate{
+		ID:              existing.ID,
+		Title:           existing.Title,
+		Description:     existing.Description,
+		Status:          fields.Status,
+		IsCompleted:     fields.IsCompleted,
+		StartAt:         fields.StartAt,
+		DueAt:           fields.DueAt,
+		Priority:        fields.Priority,
+		EstimateMinutes: fields.EstimateMinutes,
+		Labels:          fields.Labels,
+	}
+	if fields.Title != nil {
+		update.Title = *fields.Title
+	}
+	if fields.Description != nil {
+		update.Description = *fields.Description
+	}
+	if item.Task, err = svc.updateTask(ctx, update, false); err != nil {
+		return err
+	}
+	return nil
+}
+
+// syncCreate creates the task of a create mutation, unless a task with
+// the client's ID already exists because the mutation was pushed before.
+func (svc *TaskService) syncCreate(ctx context.Context, mutation models.SyncMutation, fields syncTaskFields, item *models.SyncMutationResult) error {
+	task := models.Task{StartAt: fields.StartAt.Value, DueAt: fields.DueAt.Value, EstimateMinutes: fields.EstimateMinutes.Value}
+	if mutation.ID != nil {
+		existing, err := svc.GetTask(ctx, *mutation.ID)
+		if err == nil {
+			item.Task = existing
+			return nil
+		}
+		if err.Error() != TaskNotFoundError {
+			return err
+		}
+		task.ID = *mutation.ID
+	}
+	if fields.Title != nil {
+		task.Title = *fields.Title
+	}
+	if fields.Description != nil {
+		task.Description = *fields.Description
+	}
+	if fields.Status != nil {
+		task.Status = *fields.Status
+	}
+	if fields.IsCompleted != nil {
+		task.IsCompleted = *fields.IsCompleted
+	}
+	if fields.Priority != nil {
+		task.Priority = *fields.Priority
+	}
+	if fields.Labels != nil {
+		task.Labels = *fields.Labels
+	}
+	task, err := normalizeNewTask(task)
+	if err != nil {
+		return err
+	}
+	created, err := svc.createTask(ctx, task)
+	if err != nil {
+		return err
+	}
+	item.ID, item.Task = &created.ID, created
+	return nil
+}
+
+func decodeSyncFields(values map[string]json.RawMessage) (syncTaskFields, error) {
+	var fields syncTaskFields
+	if len(values) == 0 {
+		return fields, nil
+	}
+	b, err := json.Marshal(values)
+	if err != nil {
+		return fields, errors.New(InvalidSyncFieldsError)
+	}
+	if err := json.Unmarshal(b, &fields); err != nil {
+		return fields, errors.New(InvalidSyncFieldsError)
+	}
+	return fields, nil
+}
+
+func fieldNames(values map[string]json.RawMessage) []string {
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// drop leaves a field out of the update.
+func (fields *syncTaskFields) drop(name string) {
+	switch name {
+	case "title":
+		fields.Title = nil
+	case "description":
+		fields.Description = nil
+	case "status":
+		fields.Status = nil
+	case "is_completed":
+		fields.IsCompleted = nil
+	case "priority":
+		fields.Priority = nil
+	case "start_at":
+		fields.StartAt = models.Optional[time.Time]{}
+	case "due_at":
+		fields.DueAt = models.Optional[time.Time]{}
+	case "estimate_minutes":
+		fields.EstimateMinutes = models.Optional[int]{}
+	case "labels":
+		fields.Labels = nil
+	}
+}
+
+// syncFieldValue returns the server's value of a field a mutation sets.
+func syncFieldValue(task *models.Task, name string) any {
+	switch name {
+	case "title":
+		return task.Title
+	case "description":
+		return task.Description
+	case "status":
+		return task.Status
+	case "is_completed":
+		return task.IsCompleted
+	case "priority":
+		return task.Priority
+	case "start_at":
+		return task.StartAt
+	case "due_at":
+		return task.DueAt
+	case "estimate_minutes":
+		return task.EstimateMinutes
+	case "labels":
+		return task.Labels
+	}
+	return nil
+}
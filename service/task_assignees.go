@@ -0,0 +1,144 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/ent"
+	"github.com/localopsco/go-sample/models"
+)
+
+const UserNotFoundError = "User not found"
+
+// AssignUser makes a user responsible for a task. Assigning a user who is
+// already assigned is a no-op.
+func (svc *TaskService) AssignUser(ctx context.Context, taskID, userID uuid.UUID) (*models.Task, error) {
+	task, err := svc.taskAndUser(ctx, taskID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if containsUser(task.Assignees, userID) {
+		return task, nil
+	}
+	if err := svc.store.AddAssignee(ctx, taskID, userID); err != nil {
+		return nil, err
+	}
+	return svc.store.GetTask(ctx, taskID)
+}
+
+func (svc *TaskService) UnassignUser(ctx context.Context, taskID, userID uuid.UUID) (*models.Task, error) {
+	task, err := svc.GetTask(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+	if !containsUser(task.Assignees, userID) {
+		return task, nil
+	}
+	if err := svc.store.RemoveAssignee(ctx, taskID, userID); err != nil {
+		return nil, err
+	}
+	return svc.store.GetTask(ctx, taskID)
+}
+
+// Watch subscribes a user to a task. Watching a task twice is a no-op.
+func (svc *TaskService) Watch(ctx context.Context, taskID, userID uuid.UUID) (*models.Task, error) {
+	task, err := svc.taskAndUser(ctx, taskID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if containsUser(task.Watchers, userID) {
+		return task, nil
+	}
+	if err := svc.store.AddWatcher(ctx, taskID, userID); err != nil {
+		return nil, err
+	}
+	return svc.store.GetTask(ctx, taskID)
+}
+
+func (svc *TaskService) Unwatch(ctx context.Context, taskID, userID uuid.UUID) (*models.Task, error) {
+	task, err := svc.GetTask(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+	if !containsUser(task.Watchers, userID) {
+		return task, nil
+	}
+	if err := svc.store.RemoveWatcher(ctx, taskID, userID); err != nil {
+		return nil, err
+	}
+	return svc.store.GetTask(ctx, taskID)
+}
+
+// MyTasks returns the open tasks assigned to a user across all projects,
+// grouped by project in name order, with tasks outside any project last.
+// Within a group tasks are ordered by due date.
+func (svc *TaskService) MyTasks(ctx context.Context, userID uuid.UUID) ([]*models.ProjectTasks, error) {
+	tasks, err := svc.store.ListTasks(ctx, models.TaskFilter{
+		AssigneeID: &userID,
+		Open:       true,
+		Sort:       "due_at",
+	})
+	if err != nil {
+		return nil, err
+	}
+	projects, err := svc.projects.ListProjects(ctx)
+	if err != nil {
+		return nil, err
+	}
+	projectsByID := make(map[uuid.UUID]*models.Project, len(projects))
+	for _, project := range projects {
+		projectsByID[project.ID] = project
+	}
+
+	groups := make(map[uuid.UUID]*models.ProjectTasks)
+	noProject := &models.ProjectTasks{Tasks: []*models.Task{}}
+	for _, task := range tasks {
+		if task.ProjectID == nil {
+			noProject.Tasks = append(noProject.Tasks, task)
+			continue
+		}
+		group, ok := groups[*task.ProjectID]
+		if !ok {
+			group = &models.ProjectTasks{Project: projectsByID[*task.ProjectID]}
+			groups[*task.ProjectID] = group
+		}
+		group.Tasks = append(group.Tasks, task)
+	}
+
+	result := make([]*models.ProjectTasks, 0, len(groups)+1)
+	for _, group := range groups {
+		result = append(result, group)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Project.Name < result[j].Project.Name
+	})
+	if len(noProject.Tasks) > 0 {
+		result = append(result, noProject)
+	}
+	return result, nil
+}
+
+func (svc *TaskService) taskAndUser(ctx context.Context, taskID, userID uuid.UUID) (*models.Task, error) {
+	task, err := svc.GetTask(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := svc.users.GetUser(ctx, userID); err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New(UserNotFoundError)
+		}
+		return nil, err
+	}
+	return task, nil
+}
+
+func containsUser(users []*models.User, userID uuid.UUID) bool {
+	for _, user := range users {
+		if user.ID == userID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,178 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/ent"
+	"github.com/localopsco/go-sample/models"
+)
+
+const BlockerTaskNotFoundError = "Blocking task not found"
+const SelfDependencyError = "A task cannot block itself"
+const DependencyCycleError = "Dependency would create a cycle"
+const TaskBlockedError = "Task is blocked by open tasks"
+
+// AddBlocker records that blockerID has to be completed before taskID. It is
+// rejected if taskID already blocks blockerID, directly or transitively.
+func (svc *TaskService) AddBlocker(ctx context.Context, taskID, blockerID uuid.UUID) (*models.Task, error) {
+	if taskID == blockerID {
+		return nil, errors.New(SelfDependencyError)
+	}
+	if _, err := svc.GetTask(ctx, taskID); err != nil {
+		return nil, err
+	}
+	if _, err := svc.store.GetTask(ctx, blockerID); err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New(BlockerTaskNotFoundError)
+		}
+		return nil, err
+	}
+	err := svc.inTx(ctx, func(tx *TaskService) error {
+		// Keep a concurrent request from adding the reverse dependency
+		// between the cycle check and the insert.
+		if err := tx.store.LockChanges(ctx); err != nil {
+			return err
+		}
+		reachesBlocker, err := tx.blocksTransitively(ctx, taskID, blockerID)
+		if err != nil {
+			return err
+		}
+		if reachesBlocker {
+			return errors.New(DependencyCycleError)
+		}
+		return tx.store.AddBlocker(ctx, taskID, blockerID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return svc.store.GetTask(ctx, taskID)
+}
+
+func (svc *TaskService) RemoveBlocker(ctx context.Context, taskID, blockerID uuid.UUID) (*models.Task, error) {
+	if _, err := svc.GetTask(ctx, taskID); err != nil {
+		return nil, err
+	}
+	if err := svc.store.RemoveBlocker(ctx, taskID, blockerID); err != nil {
+		return nil, err
+	}
+	return svc.store.GetTask(ctx, taskID)
+}
+
+// blocksTransitively reports whether fromID blocks toID through a chain of
+// dependencies, walking the graph one level per query.
+func (svc *TaskService) blocksTransitively(ctx context.Context, fromID, toID uuid.UUID) (bool, error) {
+	visited := map[uuid.UUID]bool{fromID: true}
+	frontier := []uuid.UUID{fromID}
+	for len(frontier) > 0 {
+		blockedIDs, err := svc.store.BlockedIDs(ctx, frontier...)
+		if err != nil {
+			return false, err
+		}
+		frontier = frontier[:0]
+		for _, id := range blockedIDs {
+			if id == toID {
+				return true, nil
+			}
+			if !visited[id] {
+				visited[id] = true
+				frontier = append(frontier, id)
+			}
+		}
+	}
+	return false, nil
+}
+
+// GetDependencyGraph returns every task connected to taskID through
+// dependencies, in either direction, in topological order.
+func (svc *TaskService) GetDependencyGraph(ctx context.Context, taskID uuid.UUID) (*models.DependencyGraph, error) {
+	if _, err := svc.GetTask(ctx, taskID); err != nil {
+		return nil, err
+	}
+	visited := map[uuid.UUID]bool{taskID: true}
+	ids := []uuid.UUID{taskID}
+	frontier := []uuid.UUID{taskID}
+	for len(frontier) > 0 {
+		_, deps, err := svc.store.ListDependencies(ctx, frontier)
+		if err != nil {
+			return nil, err
+		}
+		frontier = nil
+		for _, dep := range deps {
+			for _, id := range []uuid.UUID{dep.BlockerID, dep.BlockedID} {
+				if !visited[id] {
+					visited[id] = true
+					ids = append(ids, id)
+					frontier = append(frontier, id)
+				}
+			}
+		}
+	}
+	tasks, deps, err := svc.store.ListDependencies(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	return sortDependencyGraph(tasks, deps), nil
+}
+
+// GetFullDependencyGraph returns every task that takes part in a dependency,
+// optionally limited to a project, in topological order.
+func (svc *TaskService) GetFullDependencyGraph(ctx context.Context, projectID *uuid.UUID) (*models.DependencyGraph, error) {
+	tasks, deps, err := svc.store.ListAllDependencies(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	return sortDependencyGraph(tasks, deps), nil
+}
+
+// sortDependencyGraph orders tasks so that every blocker comes before the
+// tasks it blocks. Tasks that are otherwise unordered keep their input order.
+func sortDependencyGraph(tasks []*models.Task, deps []models.Dependency) *models.DependencyGraph {
+	inGraph := make(map[uuid.UUID]bool, len(tasks))
+	for _, t := range tasks {
+		inGraph[t.ID] = true
+	}
+	graphDeps := make([]models.Dependency, 0, len(deps))
+	inDegree := make(map[uuid.UUID]int, len(tasks))
+	blocks := make(map[uuid.UUID][]uuid.UUID, len(tasks))
+	for _, dep := range deps {
+		if !inGraph[dep.BlockerID] || !inGraph[dep.BlockedID] {
+			continue
+		}
+		graphDeps = append(graphDeps, dep)
+		inDegree[dep.BlockedID]++
+		blocks[dep.BlockerID] = append(blocks[dep.BlockerID], dep.BlockedID)
+	}
+
+	sorted := make([]*models.Task, 0, len(tasks))
+	placed := make(map[uuid.UUID]bool, len(tasks))
+	for len(sorted) < len(tasks) {
+		progressed := false
+		for _, t := range tasks {
+			if placed[t.ID] || inDegree[t.ID] > 0 {
+				continue
+			}
+			placed[t.ID] = true
+			sorted = append(sorted, t)
+			for _, blockedID := range blocks[t.ID] {
+				inDegree[blockedID]--
+			}
+			progressed = true
+		}
+		if !progressed {
+			// Cycles are rejected when dependencies are added, but keep any
+			// leftovers rather than dropping them from the response.
+			for _, t := range tasks {
+				if !placed[t.ID] {
+					placed[t.ID] = true
+					sorted = append(sorted, t)
+				}
+			}
+		}
+	}
+	return &models.DependencyGraph{
+		Tasks:        sorted,
+		Dependencies: graphDeps,
+	}
+}
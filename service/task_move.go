@@ -0,0 +1,92 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/localopsco/go-sample/datastore"
+	"github.com/localopsco/go-sample/models"
+)
+
+const InvalidMoveError = "A move needs a column, or a before or after task other than the task itself"
+const AnchorNotFoundError = "Anchor task not found"
+const AnchorColumnError = "Anchor tasks must have the same project and status as the moved task"
+const AnchorOrderError = "The after task must come before the before task"
+
+// MoveTask changes the manual order of a task within its project and status
+// column, placing it between the anchor tasks of move. If move names a board
+// column, the task's status or labels are changed to put it in that column
+// in the same transaction, and it is placed last there unless anchors are
+// given. Status changes follow the same rules as UpdateTask. Going over the
+// column's WIP limit fails if the project enforces its limits and is
+// reported as a warning otherwise.
+func (svc *TaskService) MoveTask(ctx context.Context, move models.TaskMove, force bool) (*models.TaskMoveResult, error) {
+	var result *models.TaskMoveResult
+	err := svc.inTx(ctx, func(tx *TaskService) error {
+		var err error
+		result, err = tx.moveTask(ctx, move, force)
+		return err
+	})
+	return result, err
+}
+
+func (svc *TaskService) moveTask(ctx context.Context, move models.TaskMove, force bool) (*models.TaskMoveResult, error) {
+	if move.Column == nil && move.After == nil && move.Before == nil {
+		return nil, errors.New(InvalidMoveError)
+	}
+	if (move.After != nil && *move.After == move.ID) || (move.Before != nil && *move.Before == move.ID) {
+		return nil, errors.New(InvalidMoveError)
+	}
+	existing, err := svc.GetTask(ctx, move.ID)
+	if err != nil {
+		return nil, err
+	}
+	var target models.ColumnTarget
+	if move.Column != nil {
+		group, err := parseBoardGroup(move.GroupBy)
+		if err != nil {
+			return nil, err
+		}
+		if target, err = svc.columnTarget(ctx, existing, group, *move.Column, force); err != nil {
+			return nil, err
+		}
+	}
+	task, err := svc.store.MoveTask(ctx, move, target)
+	if err != nil {
+		return nil, moveError(err)
+	}
+	if err := svc.publishUpdate(ctx, existing.IsCompleted, task); err != nil {
+		return nil, err
+	}
+	if target.Status != nil {
+		if task, err = svc.afterStatusChange(ctx, task); err != nil {
+			return nil, err
+		}
+	}
+	result := &models.TaskMoveResult{Task: task}
+	if target.WIPLimit > 0 && !target.EnforceWIP {
+		count, err := svc.store.CountColumnWIP(ctx, *task.ProjectID, target)
+		if err != nil {
+			return nil, err
+		}
+		if count > target.WIPLimit {
+			result.Warnings = append(result.Warnings, fmt.Sprintf("Column %s has %d unfinished tasks, over its WIP limit of %d", *move.Column, count, target.WIPLimit))
+		}
+	}
+	return result, nil
+}
+
+func moveError(err error) error {
+	switch {
+	case errors.Is(err, datastore.ErrAnchorNotFound):
+		return errors.New(AnchorNotFoundError)
+	case errors.Is(err, datastore.ErrAnchorColumn):
+		return errors.New(AnchorColumnError)
+	case errors.Is(err, datastore.ErrAnchorOrder):
+		return errors.New(AnchorOrderError)
+	case errors.Is(err, datastore.ErrWIPLimitExceeded):
+		return errors.New(WIPLimitExceededError)
+	}
+	return err
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"mime/multipart"
 	"os"
 	"strconv"
@@ -15,86 +16,432 @@ import (
 	"github.com/google/uuid"
 	"github.com/localopsco/go-sample/datastore"
 	"github.com/localopsco/go-sample/ent"
+	"github.com/localopsco/go-sample/ent/task"
 	"github.com/localopsco/go-sample/models"
+	"github.com/localopsco/go-sample/taskquery"
 )
 
 const TaskNotFoundError = "Task not found"
 const AttachmentsNotEnabledError = "Attachments feature not enabled"
+const ParentTaskNotFoundError = "Parent task not found"
+const MaxDepthExceededError = "Maximum subtask depth exceeded"
+const OpenSubtasksError = "Task has open subtasks"
+const InvalidSubtaskOrderError = "Subtask order must list every subtask exactly once"
+const InvalidPriorityError = "Invalid priority"
+const InvalidScheduleError = "Start date must not be after due date"
+const InvalidEstimateError = "Estimate must not be negative"
+const InvalidFilterError = "Invalid filter"
+
+const defaultSubtaskMaxDepth = 3
 
 type TaskService struct {
-	store    *datastore.TaskStore
-	s3Client *s3.Client
+	store     *datastore.TaskStore
+	workflows *datastore.WorkflowStore
+	projects  *datastore.ProjectStore
+	users     *datastore.UserStore
+	s3Client  *s3.Client
+	outbox    *datastore.OutboxStore
 }
 
-func NewTaskService(taskStore *datastore.TaskStore, s3Client *s3.Client) *TaskService {
+func NewTaskService(taskStore *datastore.TaskStore, workflowStore *datastore.WorkflowStore, projectStore *datastore.ProjectStore, userStore *datastore.UserStore, s3Client *s3.Client, outboxStore *datastore.OutboxStore) *TaskService {
 	return &TaskService{
 		taskStore,
+		workflowStore,
+		projectStore,
+		userStore,
 		s3Client,
+		outboxStore,
+	}
+}
+
+func (svc *TaskService) CreateTask(ctx context.Context, task models.Task) (*models.Task, error) {
+	task, err := normalizeNewTask(task)
+	if err != nil {
+		return nil, err
+	}
+	var createdTask *models.Task
+	err = svc.inTx(ctx, func(tx *TaskService) error {
+		createdTask, err = tx.createTask(ctx, task)
+		return err
+	})
+	return createdTask, err
+}
+
+// normalizeNewTask validates the fields of a task to create and returns it
+// with its labels and recurrence normalized.
+func normalizeNewTask(task models.Task) (models.Task, error) {
+	if err := validateTaskFields(task.Priority, task.StartAt, task.DueAt, task.EstimateMinutes); err != nil {
+		return models.Task{}, err
+	}
+	labels, err := normalizeLabels(task.Labels)
+	if err != nil {
+		return models.Task{}, err
+	}
+	task.Labels = labels
+	if task.Recurrence != nil {
+		recurrence, err := normalizeRecurrence(*task.Recurrence, task.DueAt)
+		if err != nil {
+			return models.Task{}, err
+		}
+		task.Recurrence = recurrence
+	}
+	return task, nil
+}
+
+func (svc *TaskService) createTask(ctx context.Context, task models.Task) (*models.Task, error) {
+	task, err := svc.placeNewTask(ctx, task)
+	if err != nil {
+		return nil, err
+	}
+	createdTask, err := svc.store.CreateTask(ctx, task)
+	if err != nil {
+		return nil, err
+	}
+	if err := svc.publish(ctx, models.EventTaskCreated, createdTask); err != nil {
+		return nil, err
+	}
+	if createdTask.IsCompleted && createdTask.ParentID != nil {
+		if err := svc.completeAncestors(ctx, *createdTask.ParentID); err != nil {
+			return nil, err
+		}
+	}
+	return createdTask, nil
+}
+
+// placeNewTask checks the parent of a task to create, places it last among
+// its siblings and resolves its initial status.
+func (svc *TaskService) placeNewTask(ctx context.Context, task models.Task) (models.Task, error) {
+	if parentID := task.ParentID; parentID != nil {
+		maxDepth := subtaskMaxDepth()
+		depth, err := svc.store.Depth(ctx, *parentID, maxDepth)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return models.Task{}, errors.New(ParentTaskNotFoundError)
+			}
+			return models.Task{}, err
+		}
+		if depth > maxDepth {
+			return models.Task{}, errors.New(MaxDepthExceededError)
+		}
+		total, _, err := svc.store.CountChildren(ctx, *parentID)
+		if err != nil {
+			return models.Task{}, err
+		}
+		task.Position = total
+	}
+	w, err := svc.workflowForProject(ctx, task.ProjectID)
+	if err != nil {
+		return models.Task{}, err
+	}
+	var status *models.WorkflowStatus
+	switch {
+	case task.Status != "":
+		status = w.Status(task.Status)
+	case task.IsCompleted:
+		status = legacyStatus(w, nil, true)
+	default:
+		status = w.InitialStatus()
+	}
+	if status == nil {
+		return models.Task{}, errors.New(UnknownStatusError)
+	}
+	task.Status, task.StatusID, task.StatusCategory = status.Key, status.ID, status.Category
+	return task, nil
+}
+
+func (svc *TaskService) ListTasks(ctx context.Context, filter models.TaskFilter) ([]*models.Task, error) {
+	if err := validateFilter(filter); err != nil {
+		return nil, err
+	}
+	return svc.store.ListTasks(ctx, filter)
+}
+
+// ListTasksPage returns a page of the tasks matching filter.
+func (svc *TaskService) ListTasksPage(ctx context.Context, filter models.TaskFilter, limit, offset int) (*models.Page[*models.Task], error) {
+	if err := validateFilter(filter); err != nil {
+		return nil, err
 	}
+	limit, offset = pageBounds(limit, offset)
+	return svc.store.ListTasksPage(ctx, filter, limit, offset)
 }
 
-func (svc *TaskService) CreateTask(title, desc string, isCompleted bool) (*models.Task, error) {
-	task := models.Task{
-		Title:       title,
-		Description: desc,
-		IsCompleted: isCompleted,
+// CountTasks returns the number of tasks matching filter.
+func (svc *TaskService) CountTasks(ctx context.Context, filter models.TaskFilter) (int, error) {
+	if err := validateFilter(filter); err != nil {
+		return 0, err
 	}
-	return svc.store.CreateTask(task)
+	return svc.store.CountTasks(ctx, filter)
+}
+
+// GetTasks returns the given tasks in the order of taskIDs, skipping IDs
+// that do not exist.
+func (svc *TaskService) GetTasks(ctx context.Context, taskIDs []uuid.UUID) ([]*models.Task, error) {
+	return svc.store.ListTasksByIDs(ctx, taskIDs)
 }
 
-func (svc *TaskService) ListTasks() ([]*models.Task, error) {
-	return svc.store.ListTasks()
+// UpdateTask applies update to a task. Status changes must follow the task's
+// workflow, and completing a task that is blocked by open tasks is rejected
+// unless force is set.
+func (svc *TaskService) UpdateTask(ctx context.Context, update models.TaskUpdate, force bool) (*models.Task, error) {
+	var updatedTask *models.Task
+	err := svc.inTx(ctx, func(tx *TaskService) error {
+		var err error
+		updatedTask, err = tx.updateTask(ctx, update, force)
+		return err
+	})
+	return updatedTask, err
 }
 
-func (svc *TaskService) UpdateTask(taskID uuid.UUID, title, desc string, isCompleted bool) (*models.Task, error) {
-	task := models.Task{
-		ID:          taskID,
-		Title:       title,
-		Description: desc,
-		IsCompleted: isCompleted,
+func (svc *TaskService) updateTask(ctx context.Context, update models.TaskUpdate, force bool) (*models.Task, error) {
+	existing, err := svc.GetTask(ctx, update.ID)
+	if err != nil {
+		return nil, err
 	}
-	updatedTask, err := svc.store.UpdateTask(task)
+	target, err := svc.targetStatus(ctx, existing, update)
+	if err != nil {
+		return nil, err
+	}
+	completing := target != nil && target.Category == models.StatusCategoryDone && !existing.IsCompleted
+	priority, startAt, dueAt := existing.Priority, existing.StartAt, existing.DueAt
+	if update.Priority != nil {
+		priority = *update.Priority
+	}
+	if update.StartAt.Set {
+		startAt = update.StartAt.Value
+	}
+	if update.DueAt.Set {
+		dueAt = update.DueAt.Value
+	}
+	if err := validateTaskFields(priority, startAt, dueAt, update.EstimateMinutes.Value); err != nil {
+		return nil, err
+	}
+	if update.Labels != nil {
+		labels, err := normalizeLabels(*update.Labels)
+		if err != nil {
+			return nil, err
+		}
+		update.Labels = &labels
+	}
+	if update.Recurrence.Set && update.Recurrence.Value != nil {
+		recurrence, err := normalizeRecurrence(*update.Recurrence.Value, dueAt)
+		if err != nil {
+			return nil, err
+		}
+		update.Recurrence.Value = recurrence
+	} else if !update.Recurrence.Set && existing.Recurrence != nil && dueAt == nil {
+		return nil, errors.New(RecurrenceNeedsDueDateError)
+	}
+	if completing {
+		if err := svc.checkCompletable(ctx, existing, force); err != nil {
+			return nil, err
+		}
+	}
+	updatedTask, err := svc.store.UpdateTask(ctx, update, target)
 	if err != nil {
 		if ent.IsNotFound(err) {
 			return nil, errors.New(TaskNotFoundError)
 		}
 		return nil, err
 	}
+	if err := svc.publishUpdate(ctx, existing.IsCompleted, updatedTask); err != nil {
+		return nil, err
+	}
+	return svc.afterStatusChange(ctx, updatedTask)
+}
+
+// checkCompletable rejects completing a task that is blocked by open tasks,
+// unless force is set, or that has open subtasks when those are required to
+// be done first.
+func (svc *TaskService) checkCompletable(ctx context.Context, existing *models.Task, force bool) error {
+	if existing.IsBlocked && !force {
+		return errors.New(TaskBlockedError)
+	}
+	if requireSubtasksDone() {
+		_, open, err := svc.store.CountChildren(ctx, existing.ID)
+		if err != nil {
+			return err
+		}
+		if open > 0 {
+			return errors.New(OpenSubtasksError)
+		}
+	}
+	return nil
+}
+
+// afterStatusChange completes the ancestors of a task that was just
+// completed and schedules its next occurrence. It returns the task as it is
+// afterwards.
+func (svc *TaskService) afterStatusChange(ctx context.Context, updatedTask *models.Task) (*models.Task, error) {
+	if updatedTask.IsCompleted && updatedTask.ParentID != nil {
+		if err := svc.completeAncestors(ctx, *updatedTask.ParentID); err != nil {
+			return nil, err
+		}
+	}
+	if updatedTask.IsCompleted && updatedTask.Recurrence != nil && !updatedTask.Recurrence.Paused {
+		if err := svc.advanceRecurrence(ctx, updatedTask); err != nil {
+			return nil, err
+		}
+		return svc.store.GetTask(ctx, updatedTask.ID)
+	}
 	return updatedTask, nil
 }
 
-func (svc *TaskService) GetTask(taskID uuid.UUID) (*models.Task, error) {
-	task, err := svc.store.GetTask(taskID)
+// targetStatus resolves the status an update moves a task to, or nil if the
+// status is left unchanged. is_completed from older clients is mapped onto
+// the task's workflow.
+func (svc *TaskService) targetStatus(ctx context.Context, existing *models.Task, update models.TaskUpdate) (*models.WorkflowStatus, error) {
+	if update.Status == nil && (update.IsCompleted == nil || *update.IsCompleted == existing.IsCompleted) {
+		return nil, nil
+	}
+	w, err := svc.workflows.GetWorkflowForStatus(ctx, existing.StatusID)
+	if err != nil {
+		return nil, err
+	}
+	current := w.StatusByID(existing.StatusID)
+	var target *models.WorkflowStatus
+	if update.Status != nil {
+		target = w.Status(*update.Status)
+	} else {
+		target = legacyStatus(w, current, *update.IsCompleted)
+	}
+	if target == nil {
+		return nil, errors.New(UnknownStatusError)
+	}
+	if target.ID == current.ID {
+		return nil, nil
+	}
+	if !current.CanTransition(target.Key) {
+		return nil, &TransitionError{From: current.Key, To: target.Key, Allowed: current.Transitions}
+	}
+	return target, nil
+}
+
+// workflowForProject returns the workflow of a project, or the default
+// workflow for tasks outside of any project.
+func (svc *TaskService) workflowForProject(ctx context.Context, projectID *uuid.UUID) (*models.Workflow, error) {
+	if projectID == nil {
+		return svc.workflows.GetDefaultWorkflow(ctx)
+	}
+	project, err := svc.projects.GetProject(ctx, *projectID)
 	if err != nil {
 		if ent.IsNotFound(err) {
-			return nil, errors.New(TaskNotFoundError)
+			return nil, errors.New(ProjectNotFoundError)
 		}
 		return nil, err
 	}
-	return task, nil
+	return svc.workflows.GetWorkflow(ctx, project.WorkflowID)
 }
 
-func (svc *TaskService) AddAttachment(taskID uuid.UUID, file *multipart.FileHeader) (*models.Task, error) {
-	if s3Enabled, _ := strconv.ParseBool(os.Getenv("S3_ENABLED")); !s3Enabled {
-		return nil, errors.New(AttachmentsNotEnabledError)
+// completeAncestors moves parentID and, in turn, its own ancestors to a done
+// status once all of their subtasks are closed. It is a no-op unless
+// SUBTASK_AUTO_COMPLETE_PARENT is enabled.
+func (svc *TaskService) completeAncestors(ctx context.Context, parentID uuid.UUID) error {
+	if !autoCompleteParent() {
+		return nil
+	}
+	for id := &parentID; id != nil; {
+		total, open, err := svc.store.CountChildren(ctx, *id)
+		if err != nil {
+			return err
+		}
+		if total == 0 || open > 0 {
+			return nil
+		}
+		parent, err := svc.store.GetTask(ctx, *id)
+		if err != nil {
+			return err
+		}
+		if parent.IsCompleted {
+			return nil
+		}
+		w, err := svc.workflows.GetWorkflowForStatus(ctx, parent.StatusID)
+		if err != nil {
+			return err
+		}
+		current := w.StatusByID(parent.StatusID)
+		done := legacyStatus(w, current, true)
+		if done == nil || !current.CanTransition(done.Key) {
+			return nil
+		}
+		if err := svc.store.SetStatus(ctx, parent.ID, *done); err != nil {
+			return err
+		}
+		completed, err := svc.store.GetTask(ctx, parent.ID)
+		if err != nil {
+			return err
+		}
+		if err := svc.publish(ctx, models.EventTaskCompleted, completed); err != nil {
+			return err
+		}
+		id = parent.ParentID
+	}
+	return nil
+}
+
+func (svc *TaskService) ListChildren(ctx context.Context, taskID uuid.UUID) ([]*models.Task, error) {
+	if _, err := svc.GetTask(ctx, taskID); err != nil {
+		return nil, err
+	}
+	return svc.store.ListChildren(ctx, taskID)
+}
+
+// ListChildrenOf returns the direct subtasks of several tasks at once.
+func (svc *TaskService) ListChildrenOf(ctx context.Context, taskIDs []uuid.UUID) ([]*models.Task, error) {
+	return svc.store.ListChildrenOf(ctx, taskIDs)
+}
+
+func (svc *TaskService) ReorderChildren(ctx context.Context, taskID uuid.UUID, childIDs []uuid.UUID) ([]*models.Task, error) {
+	if _, err := svc.GetTask(ctx, taskID); err != nil {
+		return nil, err
+	}
+	err := svc.store.ReorderChildren(ctx, taskID, childIDs)
+	if err != nil {
+		if errors.Is(err, datastore.ErrChildrenMismatch) {
+			return nil, errors.New(InvalidSubtaskOrderError)
+		}
+		return nil, err
 	}
-	_, err := svc.store.GetTask(taskID)
+	return svc.store.ListChildren(ctx, taskID)
+}
+
+func (svc *TaskService) GetTask(ctx context.Context, taskID uuid.UUID) (*models.Task, error) {
+	task, err := svc.store.GetTask(ctx, taskID)
 	if err != nil {
 		if ent.IsNotFound(err) {
 			return nil, errors.New(TaskNotFoundError)
 		}
 		return nil, err
 	}
+	return task, nil
+}
+
+func (svc *TaskService) AddAttachment(ctx context.Context, taskID uuid.UUID, file *multipart.FileHeader) (*models.Task, error) {
 	src, err := file.Open()
 	if err != nil {
 		return nil, fmt.Errorf("Error reading attachment file: %w", err)
 	}
 	defer src.Close()
+	return svc.AttachFile(ctx, taskID, src, file.Header.Get("Content-Type"))
+}
+
+// AttachFile uploads the content of a file and attaches it to a task,
+// replacing any attachment it had.
+func (svc *TaskService) AttachFile(ctx context.Context, taskID uuid.UUID, src io.Reader, contentType string) (*models.Task, error) {
+	if s3Enabled, _ := strconv.ParseBool(os.Getenv("S3_ENABLED")); !s3Enabled {
+		return nil, errors.New(AttachmentsNotEnabledError)
+	}
+	_, err := svc.store.GetTask(ctx, taskID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New(TaskNotFoundError)
+		}
+		return nil, err
+	}
 	bucketName := os.Getenv("S3_BUCKET_NAME")
 	key := "attachment_" + strconv.FormatInt(time.Now().UnixNano(), 10)
-	_, err = svc.s3Client.PutObject(context.Background(), &s3.PutObjectInput{
+	_, err = svc.s3Client.PutObject(ctx, &s3.PutObjectInput{
 		Bucket:      aws.String(bucketName),
-		ContentType: aws.String(file.Header.Get("Content-Type")),
+		ContentType: aws.String(contentType),
 		Body:        src,
 		Key:         aws.String(key),
 	})
@@ -102,18 +449,25 @@ func (svc *TaskService) AddAttachment(taskID uuid.UUID, file *multipart.FileHead
 		return nil, fmt.Errorf("Error uploading attachment to s3: %w", err)
 	}
 	attachmentURL := fmt.Sprintf("https://%s.s3.amazonaws.com/%s", bucketName, key)
-	updatedTask, err := svc.store.UpdateAttachmentURL(taskID, attachmentURL)
+	var updatedTask *models.Task
+	err = svc.inTx(ctx, func(tx *TaskService) error {
+		updatedTask, err = tx.store.UpdateAttachmentURL(ctx, taskID, attachmentURL)
+		if err != nil {
+			return fmt.Errorf("Error while updating task's attachment URL: %w", err)
+		}
+		return tx.publish(ctx, models.EventTaskAttachmentAdded, updatedTask)
+	})
 	if err != nil {
-		return nil, fmt.Errorf("Error while updating task's attachment URL: %w", err)
+		return nil, err
 	}
 	return updatedTask, nil
 }
 
-func (svc *TaskService) DeleteAttachment(taskID uuid.UUID) (*models.Task, error) {
+func (svc *TaskService) DeleteAttachment(ctx context.Context, taskID uuid.UUID) (*models.Task, error) {
 	if s3Enabled, _ := strconv.ParseBool(os.Getenv("S3_ENABLED")); !s3Enabled {
 		return nil, errors.New(AttachmentsNotEnabledError)
 	}
-	task, err := svc.store.GetTask(taskID)
+	task, err := svc.store.GetTask(ctx, taskID)
 	if err != nil {
 		if ent.IsNotFound(err) {
 			return nil, errors.New(TaskNotFoundError)
@@ -123,32 +477,51 @@ func (svc *TaskService) DeleteAttachment(taskID uuid.UUID) (*models.Task, error)
 	if task.AttachmentURL == nil {
 		return task, nil
 	}
-	key := *task.AttachmentURL
-	key = key[strings.LastIndex(key, "/")+1:]
-	bucketName := os.Getenv("S3_BUCKET_NAME")
-	_, err = svc.s3Client.DeleteObject(context.Background(), &s3.DeleteObjectInput{
-		Key:    aws.String(key),
-		Bucket: aws.String(bucketName),
-	})
-	if err != nil {
+	if err := svc.deleteAttachmentObject(ctx, *task.AttachmentURL); err != nil {
 		return nil, fmt.Errorf("Error while deleting attachment: %w", err)
 	}
-	updatedTask, err := svc.store.UpdateAttachmentURL(taskID, "")
+	var updatedTask *models.Task
+	err = svc.inTx(ctx, func(tx *TaskService) error {
+		updatedTask, err = tx.store.UpdateAttachmentURL(ctx, taskID, "")
+		if err != nil {
+			return fmt.Errorf("Error while clearing task's attachment URL: %w", err)
+		}
+		return tx.publish(ctx, models.EventTaskAttachmentRemoved, updatedTask)
+	})
 	if err != nil {
-		return nil, fmt.Errorf("Error while clearing task's attachment URL: %w", err)
+		return nil, err
 	}
 	return updatedTask, nil
 }
 
-func (svc *TaskService) DeleteTask(taskID uuid.UUID) error {
-	err := svc.store.DeleteTask(taskID)
-	if err != nil {
-		if ent.IsNotFound(err) {
-			return errors.New(TaskNotFoundError)
+func (svc *TaskService) deleteAttachmentObject(ctx context.Context, attachmentURL string) error {
+	key := attachmentURL[strings.LastIndex(attachmentURL, "/")+1:]
+	bucketName := os.Getenv("S3_BUCKET_NAME")
+	_, err := svc.s3Client.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Key:    aws.String(key),
+		Bucket: aws.String(bucketName),
+	})
+	return err
+}
+
+// DeleteTask moves a task and its subtasks to the trash. A single
+// task.deleted event is emitted for the task, with its state before the
+// deletion.
+func (svc *TaskService) DeleteTask(ctx context.Context, taskID uuid.UUID) error {
+	return svc.inTx(ctx, func(tx *TaskService) error {
+		task, err := tx.GetTask(ctx, taskID)
+		if err != nil {
+			return err
 		}
-		return err
-	}
-	return nil
+		err = tx.store.DeleteTask(ctx, taskID)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return errors.New(TaskNotFoundError)
+			}
+			return err
+		}
+		return tx.publish(ctx, models.EventTaskDeleted, task)
+	})
 }
 
 func (svc *TaskService) GetMetaInfo() map[string]interface{} {
@@ -163,5 +536,60 @@ func (svc *TaskService) GetMetaInfo() map[string]interface{} {
 		"stack":                "go, postgres, React.JS",
 		"cloud-dependencies":   cloudDeps,
 		"attachment_supported": s3Enabled,
+		"subtask_max_depth":    subtaskMaxDepth(),
+	}
+}
+
+func validateTaskFields(priority string, startAt, dueAt *time.Time, estimateMinutes *int) error {
+	if priority != "" && task.PriorityValidator(task.Priority(priority)) != nil {
+		return errors.New(InvalidPriorityError)
+	}
+	if estimateMinutes != nil && *estimateMinutes < 0 {
+		return errors.New(InvalidEstimateError)
+	}
+	if startAt != nil && dueAt != nil && startAt.After(*dueAt) {
+		return errors.New(InvalidScheduleError)
+	}
+	return nil
+}
+
+func validateFilter(filter models.TaskFilter) error {
+	if filter.Query != "" {
+		if _, err := taskquery.Parse(filter.Query); err != nil {
+			return err
+		}
 	}
+	switch filter.Due {
+	case "", "overdue", "today", "week":
+	default:
+		return errors.New(InvalidFilterError)
+	}
+	if filter.Priority != "" && task.PriorityValidator(task.Priority(filter.Priority)) != nil {
+		return errors.New(InvalidFilterError)
+	}
+	switch strings.TrimPrefix(filter.Sort, "-") {
+	case "", task.FieldCreatedAt, task.FieldDueAt, task.FieldStartAt, task.FieldPriority, task.FieldTitle, task.FieldCompletedAt, task.FieldRank:
+	default:
+		return errors.New(InvalidFilterError)
+	}
+	return nil
+}
+
+// subtaskMaxDepth is the number of subtask levels allowed below a top-level task.
+func subtaskMaxDepth() int {
+	maxDepth, err := strconv.Atoi(os.Getenv("SUBTASK_MAX_DEPTH"))
+	if err != nil || maxDepth < 1 {
+		return defaultSubtaskMaxDepth
+	}
+	return maxDepth
+}
+
+func autoCompleteParent() bool {
+	enabled, _ := strconv.ParseBool(os.Getenv("SUBTASK_AUTO_COMPLETE_PARENT"))
+	return enabled
+}
+
+func requireSubtasksDone() bool {
+	enabled, _ := strconv.ParseBool(os.Getenv("SUBTASK_REQUIRE_DONE"))
+	return enabled
 }
@@ -0,0 +1,143 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/ent"
+	"github.com/localopsco/go-sample/models"
+)
+
+const TaskNotInTrashError = "Task is not in the trash"
+const ParentTaskDeletedError = "Parent task is in the trash and must be restored first"
+
+const defaultTrashRetentionDays = 30
+const defaultTrashPurgeInterval = time.Hour
+
+// ListTrash returns a page of soft-deleted tasks, most recently deleted
+// first.
+func (svc *TaskService) ListTrash(ctx context.Context, limit, offset int) (*models.Page[*models.Task], error) {
+	limit, offset = pageBounds(limit, offset)
+	return svc.store.ListTrash(ctx, limit, offset)
+}
+
+// RestoreTask takes a task and the subtasks deleted with it out of the
+// trash. A subtask cannot be restored while its parent is still deleted.
+func (svc *TaskService) RestoreTask(ctx context.Context, taskID uuid.UUID) (*models.Task, error) {
+	deleted, err := svc.store.GetDeletedTask(ctx, taskID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New(TaskNotInTrashError)
+		}
+		return nil, err
+	}
+	if deleted.ParentID != nil {
+		if _, err := svc.store.GetTask(ctx, *deleted.ParentID); err != nil {
+			if ent.IsNotFound(err) {
+				return nil, errors.New(ParentTaskDeletedError)
+			}
+			return nil, err
+		}
+	}
+	var restored *models.Task
+	err = svc.inTx(ctx, func(tx *TaskService) error {
+		if err := tx.store.RestoreTask(ctx, taskID); err != nil {
+			if ent.IsNotFound(err) {
+				return errors.New(TaskNotInTrashError)
+			}
+			return err
+		}
+		restored, err = tx.store.GetTask(ctx, taskID)
+		if err != nil {
+			return err
+		}
+		return tx.publish(ctx, models.EventTaskUpdated, restored)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return restored, nil
+}
+
+// PurgeTask permanently deletes a task in the trash, the subtasks deleted
+// with it and their attachments.
+func (svc *TaskService) PurgeTask(ctx context.Context, taskID uuid.UUID) error {
+	purged, err := svc.store.PurgeTask(ctx, taskID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return errors.New(TaskNotInTrashError)
+		}
+		return err
+	}
+	svc.deleteAttachments(ctx, purged)
+	return nil
+}
+
+// PurgeExpiredTrash permanently deletes the tasks that have been in the
+// trash for longer than the retention period and returns how many there were.
+func (svc *TaskService) PurgeExpiredTrash(ctx context.Context) (int, error) {
+	cutoff := time.Now().AddDate(0, 0, -trashRetentionDays())
+	purged, err := svc.store.PurgeDeletedBefore(ctx, cutoff)
+	if err != nil {
+		return 0, err
+	}
+	svc.deleteAttachments(ctx, purged)
+	return len(purged), nil
+}
+
+// RunTrashPurger purges expired tasks from the trash periodically until ctx
+// is cancelled.
+func (svc *TaskService) RunTrashPurger(ctx context.Context) {
+	ticker := time.NewTicker(trashPurgeInterval())
+	defer ticker.Stop()
+	for {
+		count, err := svc.PurgeExpiredTrash(ctx)
+		if err != nil {
+			log.Printf("error purging trash: %v", err)
+		} else if count > 0 {
+			log.Printf("purged %d tasks from the trash", count)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// deleteAttachments removes the attachment blobs of purged tasks. The tasks
+// are already gone, so failures are logged rather than returned.
+func (svc *TaskService) deleteAttachments(ctx context.Context, purged []*models.Task) {
+	if s3Enabled, _ := strconv.ParseBool(os.Getenv("S3_ENABLED")); !s3Enabled {
+		return
+	}
+	for _, task := range purged {
+		if task.AttachmentURL == nil {
+			continue
+		}
+		if err := svc.deleteAttachmentObject(ctx, *task.AttachmentURL); err != nil {
+			log.Printf("error deleting attachment of purged task %s: %v", task.ID, err)
+		}
+	}
+}
+
+func trashRetentionDays() int {
+	days, err := strconv.Atoi(os.Getenv("TRASH_RETENTION_DAYS"))
+	if err != nil || days < 0 {
+		return defaultTrashRetentionDays
+	}
+	return days
+}
+
+func trashPurgeInterval() time.Duration {
+	interval, err := time.ParseDuration(os.Getenv("TRASH_PURGE_INTERVAL"))
+	if err != nil || interval <= 0 {
+		return defaultTrashPurgeInterval
+	}
+	return interval
+}
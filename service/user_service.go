@@ -0,0 +1,132 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"regexp"
+
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/datastore"
+	"github.com/localopsco/go-sample/ent"
+	"github.com/localopsco/go-sample/models"
+)
+
+const InvalidUsernameError = "Username must be 1-32 lowercase letters, digits, '_', '.' or '-'"
+const UsernameTakenError = "Username is already taken"
+const AuthenticationRequiredError = "Authentication required"
+const CalendarFeedNotFoundError = "Calendar feed not found"
+
+var usernamePattern = regexp.MustCompile(`^([a-z0-9_][a-z0-9_.-]{0,30}[a-z0-9_]|[a-z0-9_])$`)
+
+type UserService struct {
+	store *datastore.UserStore
+}
+
+func NewUserService(userStore *datastore.UserStore) *UserService {
+	return &UserService{
+		userStore,
+	}
+}
+
+// CreateUser registers a user and returns the API token they authenticate
+// with. Only a hash of the token is stored, so it cannot be shown again.
+func (svc *UserService) CreateUser(ctx context.Context, username, displayName string) (*models.User, string, error) {
+	if !usernamePattern.MatchString(username) {
+		return nil, "", errors.New(InvalidUsernameError)
+	}
+	token, err := newAPIToken()
+	if err != nil {
+		return nil, "", err
+	}
+	user, err := svc.store.CreateUser(ctx, models.User{
+		Username:    username,
+		DisplayName: displayName,
+	}, hashAPIToken(token))
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, "", errors.New(UsernameTakenError)
+		}
+		return nil, "", err
+	}
+	return user, token, nil
+}
+
+func (svc *UserService) GetUser(ctx context.Context, userID uuid.UUID) (*models.User, error) {
+	user, err := svc.store.GetUser(ctx, userID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New(UserNotFoundError)
+		}
+		return nil, err
+	}
+	return user, nil
+}
+
+func (svc *UserService) GetUserByUsername(ctx context.Context, username string) (*models.User, error) {
+	user, err := svc.store.GetUserByUsername(ctx, username)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New(UserNotFoundError)
+		}
+		return nil, err
+	}
+	return user, nil
+}
+
+// Authenticate returns the user an API token belongs to.
+func (svc *UserService) Authenticate(ctx context.Context, token string) (*models.User, error) {
+	user, err := svc.store.GetUserByTokenHash(ctx, hashAPIToken(token))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New(AuthenticationRequiredError)
+		}
+		return nil, err
+	}
+	return user, nil
+}
+
+// CreateCalendarToken issues the token of the user's calendar feed,
+// replacing any previous one, which stops working.
+func (svc *UserService) CreateCalendarToken(ctx context.Context, userID uuid.UUID) (string, error) {
+	token, err := newAPIToken()
+	if err != nil {
+		return "", err
+	}
+	hash := hashAPIToken(token)
+	if err := svc.store.SetCalendarTokenHash(ctx, userID, &hash); err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+func (svc *UserService) RevokeCalendarToken(ctx context.Context, userID uuid.UUID) error {
+	return svc.store.SetCalendarTokenHash(ctx, userID, nil)
+}
+
+// AuthenticateCalendar returns the user a calendar feed token belongs to.
+func (svc *UserService) AuthenticateCalendar(ctx context.Context, token string) (*models.User, error) {
+	user, err := svc.store.GetUserByCalendarTokenHash(ctx, hashAPIToken(token))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New(CalendarFeedNotFoundError)
+		}
+		return nil, err
+	}
+	return user, nil
+}
+
+func newAPIToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func hashAPIToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,59 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"syscall"
+	"time"
+)
+
+// sharedAddressSpace is the carrier-grade NAT range, which is not private
+// by RFC 1918 but is not reachable from the internet either.
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+// errNonPublicAddress is recorded as the error of attempts to deliver to a
+// webhook whose host resolves to an internal address.
+var errNonPublicAddress = errors.New("webhook host resolves to a non-public address")
+
+// NewWebhookClient returns the HTTP client used to deliver webhooks. Webhook
+// URLs are chosen by users, so the client only connects to public
+// addresses, checked after the host is resolved so that a name cannot
+// point it at the internal network. It does not follow redirects, which
+// could lead it there too, nor go through the proxy of the environment.
+func NewWebhookClient() *http.Client {
+	dialer := &net.Dialer{
+		Timeout: webhookTimeout,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			addrPort, err := netip.ParseAddrPort(address)
+			if err != nil {
+				return err
+			}
+			if !publicAddress(addrPort.Addr()) {
+				return fmt.Errorf("%w: %s", errNonPublicAddress, addrPort.Addr())
+			}
+			return nil
+		},
+	}
+	return &http.Client{
+		Transport: &http.Transport{
+			DialContext:           dialer.DialContext,
+			TLSHandshakeTimeout:   webhookTimeout,
+			ResponseHeaderTimeout: webhookTimeout,
+			IdleConnTimeout:       90 * time.Second,
+			MaxIdleConnsPerHost:   2,
+		},
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// publicAddress reports whether addr is a global unicast address outside
+// the private, loopback, link-local and shared ranges.
+func publicAddress(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	return addr.IsGlobalUnicast() && !addr.IsPrivate() && !sharedAddressSpace.Contains(addr)
+}
@@ -0,0 +1,411 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	mathrand "math/rand"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/datastore"
+	"github.com/localopsco/go-sample/ent"
+	"github.com/localopsco/go-sample/models"
+)
+
+const WebhookNotFoundError = "Webhook not found"
+const DeliveryNotFoundError = "Delivery not found"
+const InvalidWebhookURLError = "Webhook URL must be an absolute http or https URL"
+const InvalidEventTypesError = "Event types must list at least one known task event type"
+const InvalidWebhookSecretError = "Webhook secrets must be 16 to 256 characters"
+const InvalidDeliveryStatusError = "Delivery status must be pending, retrying, succeeded or dead"
+
+// Webhook requests time out after webhookTimeout. Failed deliveries are
+// retried after webhookRetryBase, doubling up to webhookRetryMax, and are
+// dead after webhookMaxAttempts attempts. A worker claims a batch of
+// deliveries for webhookLease, and renews the lease before each attempt,
+// so the lease must exceed the timeout.
+const (
+	webhookTimeout      = 10 * time.Second
+	webhookRetryBase    = 30 * time.Second
+	webhookRetryMax     = 6 * time.Hour
+	webhookMaxAttempts  = 8
+	webhookLease        = time.Minute
+	webhookPollInterval = 5 * time.Second
+	webhookBatchSize    = 20
+)
+
+const (
+	minWebhookSecretLength = 16
+	maxWebhookSecretLength = 256
+	maxWebhookResponseRead = 64 << 10
+)
+
+// Headers of webhook requests. The signature is "sha256=" followed by the
+// hex HMAC-SHA256 of the timestamp, a dot and the body, keyed with the
+// webhook's secret.
+const (
+	WebhookIDHeader        = "X-Webhook-ID"
+	WebhookDeliveryHeader  = "X-Webhook-Delivery"
+	WebhookEventHeader     = "X-Webhook-Event"
+	WebhookTimestampHeader = "X-Webhook-Timestamp"
+	WebhookSignatureHeader = "X-Webhook-Signature"
+)
+
+type WebhookService struct {
+	store  *datastore.WebhookStore
+	client *http.Client
+	// wake tells the dispatcher that new deliveries are waiting.
+	wake chan struct{}
+}
+
+func NewWebhookService(webhookStore *datastore.WebhookStore, httpClient *http.Client) *WebhookService {
+	return &WebhookService{
+		webhookStore,
+		httpClient,
+		make(chan struct{}, 1),
+	}
+}
+
+// CreateWebhook registers a webhook for its owner, generating a secret if
+// none is given. The returned webhook is the only one to include the
+// secret.
+func (svc *WebhookService) CreateWebhook(ctx context.Context, owner *models.User, w models.Webhook) (*models.Webhook, error) {
+	if w.Secret == "" {
+		secret, err := newWebhookSecret()
+		if err != nil {
+			return nil, err
+		}
+		w.Secret = secret
+	}
+	if err := validateWebhook(w); err != nil {
+		return nil, err
+	}
+	w.OwnerID = &owner.ID
+	return svc.store.CreateWebhook(ctx, w)
+}
+
+func (svc *WebhookService) GetWebhook(ctx context.Context, user *models.User, webhookID uuid.UUID) (*models.Webhook, error) {
+	w, err := svc.ownedWebhook(ctx, user, webhookID)
+	if err != nil {
+		return nil, err
+	}
+	w.Secret = ""
+	return w, nil
+}
+
+// ListWebhooks returns the webhooks a user registered.
+func (svc *WebhookService) ListWebhooks(ctx context.Context, user *models.User) ([]*models.Webhook, error) {
+	webhooks, err := svc.store.ListWebhooks(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, w := range webhooks {
+		w.Secret = ""
+	}
+	return webhooks, nil
+}
+
+func (svc *WebhookService) UpdateWebhook(ctx context.Context, user *models.User, update models.WebhookUpdate) (*models.Webhook, error) {
+	w, err := svc.ownedWebhook(ctx, user, update.ID)
+	if err != nil {
+		return nil, err
+	}
+	if update.URL != nil {
+		w.URL = *update.URL
+	}
+	if update.EventTypes != nil {
+		w.EventTypes = *update.EventTypes
+	}
+	if update.Secret != nil {
+		w.Secret = *update.Secret
+	}
+	if err := validateWebhook(*w); err != nil {
+		return nil, err
+	}
+	updated, err := svc.store.UpdateWebhook(ctx, update)
+	if err != nil {
+		return nil, webhookError(err)
+	}
+	updated.Secret = ""
+	return updated, nil
+}
+
+func (svc *WebhookService) DeleteWebhook(ctx context.Context, user *models.User, webhookID uuid.UUID) error {
+	if _, err := svc.ownedWebhook(ctx, user, webhookID); err != nil {
+		return err
+	}
+	return svc.store.DeleteWebhook(ctx, webhookID)
+}
+
+// ListDeliveries returns a page of the deliveries of a webhook, newest
+// first, with the log of their attempts.
+func (svc *WebhookService) ListDeliveries(ctx context.Context, user *models.User, filter models.WebhookDeliveryFilter, limit, offset int) (*models.Page[*models.WebhookDelivery], error) {
+	switch filter.Status {
+	case "", models.DeliveryPending, models.DeliveryRetrying, models.DeliverySucceeded, models.DeliveryDead:
+	default:
+		return nil, errors.New(InvalidDeliveryStatusError)
+	}
+	if _, err := svc.ownedWebhook(ctx, user, filter.WebhookID); err != nil {
+		return nil, err
+	}
+	limit, offset = pageBounds(limit, offset)
+	return svc.store.ListDeliveries(ctx, filter, limit, offset)
+}
+
+// Redeliver sends a delivery again, whatever its status, with a fresh set
+// of attempts.
+func (svc *WebhookService) Redeliver(ctx context.Context, user *models.User, webhookID, deliveryID uuid.UUID) (*models.WebhookDelivery, error) {
+	if _, err := svc.ownedWebhook(ctx, user, webhookID); err != nil {
+		return nil, err
+	}
+	delivery, err := svc.store.GetDelivery(ctx, deliveryID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New(DeliveryNotFoundError)
+		}
+		return nil, err
+	}
+	if delivery.WebhookID != webhookID {
+		return nil, errors.New(DeliveryNotFoundError)
+	}
+	if err := svc.store.Redeliver(ctx, deliveryID); err != nil {
+		return nil, err
+	}
+	svc.notify()
+	return svc.store.GetDelivery(ctx, deliveryID)
+}
+
+// ownedWebhook returns a webhook if user registered it. Other users are
+// told it does not exist, so that they cannot learn its ID is taken.
+func (svc *WebhookService) ownedWebhook(ctx context.Context, user *models.User, webhookID uuid.UUID) (*models.Webhook, error) {
+	w, err := svc.store.GetWebhook(ctx, webhookID)
+	if err != nil {
+		return nil, webhookError(err)
+	}
+	if w.OwnerID == nil || *w.OwnerID != user.ID {
+		return nil, errors.New(WebhookNotFoundError)
+	}
+	return w, nil
+}
+
+// Publish queues a delivery of event to every active webhook subscribed to
+// its type. Within the transaction of the outbox relay, the deliveries are
+// committed with it, and only once for an event relayed again. They are
+// made by RunDispatcher.
+func (svc *WebhookService) Publish(ctx context.Context, event models.Event) error {
+	webhooks, err := svc.store.ListSubscribedWebhooks(ctx, event.Type)
+	if err != nil || len(webhooks) == 0 {
+		return err
+	}
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	deliveries := make([]models.WebhookDelivery, 0, len(webhooks))
+	for _, w := range webhooks {
+		deliveries = append(deliveries, models.WebhookDelivery{
+			WebhookID: w.ID,
+			EventID:   event.ID,
+			EventType: event.Type,
+			Payload:   payload,
+		})
+	}
+	if err := svc.store.CreateDeliveries(ctx, deliveries); err != nil {
+		return err
+	}
+	svc.notify()
+	return nil
+}
+
+func (svc *WebhookService) notify() {
+	select {
+	case svc.wake <- struct{}{}:
+	default:
+	}
+}
+
+// RunDispatcher makes the deliveries that are due until ctx is cancelled.
+// It wakes up when events are published, and polls for retries that come
+// due and for deliveries queued by other instances.
+func (svc *WebhookService) RunDispatcher(ctx context.Context) {
+	ticker := time.NewTicker(webhookPollInterval)
+	defer ticker.Stop()
+	for {
+		if _, err := svc.DeliverDue(ctx); err != nil {
+			log.Printf("error delivering webhooks: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-svc.wake:
+		}
+	}
+}
+
+// DeliverDue attempts the deliveries whose next attempt is due and returns
+// how many it attempted.
+func (svc *WebhookService) DeliverDue(ctx context.Context) (int, error) {
+	attempted := 0
+	for {
+		deliveries, err := svc.store.ClaimDueDeliveries(ctx, time.Now(), webhookLease, webhookBatchSize)
+		if err != nil {
+			return attempted, err
+		}
+		for _, delivery := range deliveries {
+			// The attempts before this one may have used up the lease.
+			claimed, err := svc.store.RenewLease(ctx, delivery, time.Now(), webhookLease)
+			if err != nil {
+				return attempted, err
+			}
+			if !claimed {
+				continue
+			}
+			if err := svc.attempt(ctx, delivery); err != nil {
+				return attempted, err
+			}
+			attempted++
+		}
+		if len(deliveries) < webhookBatchSize {
+			return attempted, nil
+		}
+	}
+}
+
+// attempt sends a delivery once and records the outcome. Deliveries to
+// webhooks that were deactivated in the meantime are dead right away.
+func (svc *WebhookService) attempt(ctx context.Context, delivery *models.WebhookDelivery) error {
+	w, err := svc.store.GetWebhook(ctx, delivery.WebhookID)
+	if ent.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	start := time.Now()
+	attempt := models.DeliveryAttempt{At: start.UTC()}
+	if w.Active {
+		attempt.StatusCode, err = svc.send(ctx, w, delivery, start)
+		if err != nil {
+			attempt.Error = err.Error()
+		}
+	} else {
+		attempt.Error = "webhook is inactive"
+	}
+	attempt.DurationMS = time.Since(start).Milliseconds()
+
+	if attempt.Error == "" && attempt.StatusCode >= 200 && attempt.StatusCode < 300 {
+		return svc.store.RecordAttempt(ctx, delivery.ID, attempt, models.DeliverySucceeded, nil)
+	}
+	if attempt.Error == "" {
+		attempt.Error = fmt.Sprintf("unexpected status %d", attempt.StatusCode)
+	}
+	attempts := delivery.AttemptCount + 1
+	if !w.Active || attempts >= webhookMaxAttempts {
+		return svc.store.RecordAttempt(ctx, delivery.ID, attempt, models.DeliveryDead, nil)
+	}
+	next := time.Now().Add(retryDelay(attempts))
+	return svc.store.RecordAttempt(ctx, delivery.ID, attempt, models.DeliveryRetrying, &next)
+}
+
+// send posts a delivery to its webhook and returns the response status.
+func (svc *WebhookService) send(ctx context.Context, w *models.Webhook, delivery *models.WebhookDelivery, now time.Time) (int, error) {
+	ctx, cancel := context.WithTimeout(ctx, webhookTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, err
+	}
+	timestamp := strconv.FormatInt(now.Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(WebhookIDHeader, w.ID.String())
+	req.Header.Set(WebhookDeliveryHeader, delivery.ID.String())
+	req.Header.Set(WebhookEventHeader, delivery.EventType)
+	req.Header.Set(WebhookTimestampHeader, timestamp)
+	req.Header.Set(WebhookSignatureHeader, SignWebhookPayload(w.Secret, timestamp, delivery.Payload))
+	resp, err := svc.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(resp.Body, maxWebhookResponseRead))
+	return resp.StatusCode, nil
+}
+
+// SignWebhookPayload returns the signature header value of a webhook
+// request, which receivers can compute to check that a request is genuine.
+func SignWebhookPayload(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// retryDelay returns how long to wait before the attempt following the
+// given number of failed attempts, with up to 10% of jitter so that
+// deliveries failing together are not retried together.
+func retryDelay(attempts int) time.Duration {
+	delay := webhookRetryMax
+	if shift := attempts - 1; shift < 20 {
+		if d := webhookRetryBase << shift; d < webhookRetryMax {
+			delay = d
+		}
+	}
+	return delay + time.Duration(mathrand.Int63n(int64(delay/10)+1))
+}
+
+func validateWebhook(w models.Webhook) error {
+	u, err := url.Parse(w.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return errors.New(InvalidWebhookURLError)
+	}
+	if len(w.EventTypes) == 0 {
+		return errors.New(InvalidEventTypesError)
+	}
+	for _, eventType := range w.EventTypes {
+		if !knownEventType(eventType) {
+			return errors.New(InvalidEventTypesError)
+		}
+	}
+	if len(w.Secret) < minWebhookSecretLength || len(w.Secret) > maxWebhookSecretLength {
+		return errors.New(InvalidWebhookSecretError)
+	}
+	return nil
+}
+
+func knownEventType(eventType string) bool {
+	for _, known := range models.EventTypes {
+		if known == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+func newWebhookSecret() (string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(secret), nil
+}
+
+func webhookError(err error) error {
+	if ent.IsNotFound(err) {
+		return errors.New(WebhookNotFoundError)
+	}
+	return err
+}
@@ -0,0 +1,422 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/datastore"
+	"github.com/localopsco/go-sample/datastore/datastoretest"
+	"github.com/localopsco/go-sample/ent"
+	"github.com/localopsco/go-sample/ent/webhookdelivery"
+	"github.com/localopsco/go-sample/models"
+)
+
+const testWebhookSecret = "0123456789abcdef0123456789abcdef"
+
+// webhookReceiver is a webhook endpoint answering with status and recording
+// the requests it gets.
+type webhookReceiver struct {
+	*httptest.Server
+	mu       sync.Mutex
+	status   int
+	requests []receivedWebhook
+}
+
+type receivedWebhook struct {
+	header http.Header
+	body   []byte
+}
+
+func newWebhookReceiver(t *testing.T) *webhookReceiver {
+	t.Helper()
+	receiver := &webhookReceiver{status: http.StatusNoContent}
+	receiver.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		receiver.mu.Lock()
+		defer receiver.mu.Unlock()
+		receiver.requests = append(receiver.requests, receivedWebhook{r.Header.Clone(), body})
+		w.WriteHeader(receiver.status)
+	}))
+	t.Cleanup(receiver.Close)
+	return receiver
+}
+
+func (r *webhookReceiver) respondWith(status int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.status = status
+}
+
+func (r *webhookReceiver) received() []receivedWebhook {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]receivedWebhook(nil), r.requests...)
+}
+
+// webhookTest holds a webhook service delivering to a receiver with the
+// receiver's own client, since the service's client refuses loopback
+// addresses.
+type webhookTest struct {
+	svc      *WebhookService
+	client   *ent.Client
+	receiver *webhookReceiver
+	owner    *models.User
+	webhook  *models.Webhook
+}
+
+func newWebhookTest(t *testing.T) *webhookTest {
+	t.Helper()
+	ctx := context.Background()
+	client := datastoretest.NewClient(t)
+	receiver := newWebhookReceiver(t)
+	svc := NewWebhookService(datastore.NewWebhookStore(client), receiver.Client())
+	owner, _, err := NewUserService(datastore.NewUserStore(client)).CreateUser(ctx, "ann", "Ann")
+	if err != nil {
+		t.Fatal(err)
+	}
+	w, err := svc.CreateWebhook(ctx, owner, models.Webhook{
+		URL:        receiver.URL + "/hooks",
+		EventTypes: []string{models.EventTaskCreated},
+		Secret:     testWebhookSecret,
+		Active:     true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &webhookTest{svc, client, receiver, owner, w}
+}
+
+// publish publishes a task event and returns the delivery queued for it.
+func (wt *webhookTest) publish(t *testing.T) *models.WebhookDelivery {
+	t.Helper()
+	ctx := context.Background()
+	event := models.Event{
+		ID:         uuid.New(),
+		Type:       models.EventTaskCreated,
+		TaskID:     uuid.New(),
+		OccurredAt: time.Now().UTC(),
+	}
+	if err := wt.svc.Publish(ctx, event); err != nil {
+		t.Fatal(err)
+	}
+	page, err := wt.svc.ListDeliveries(ctx, wt.owner, models.WebhookDeliveryFilter{WebhookID: wt.webhook.ID}, 10, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(page.Items) == 0 || page.Items[0].EventID != event.ID {
+		t.Fatalf("no delivery queued for event %s", event.ID)
+	}
+	return page.Items[0]
+}
+
+// deliverDue runs DeliverDue and checks how many deliveries it attempted.
+func (wt *webhookTest) deliverDue(t *testing.T, want int) {
+	t.Helper()
+	attempted, err := wt.svc.DeliverDue(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if attempted != want {
+		t.Fatalf("DeliverDue() attempted %d deliveries, want %d", attempted, want)
+	}
+}
+
+func (wt *webhookTest) delivery(t *testing.T, deliveryID uuid.UUID) *models.WebhookDelivery {
+	t.Helper()
+	delivery, err := wt.svc.store.GetDelivery(context.Background(), deliveryID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return delivery
+}
+
+// makeDue moves the next attempt of a delivery into the past.
+func (wt *webhookTest) makeDue(t *testing.T, deliveryID uuid.UUID) {
+	t.Helper()
+	err := wt.client.WebhookDelivery.UpdateOneID(deliveryID).
+		SetNextAttemptAt(time.Now().Add(-time.Second)).
+		Exec(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDeliverDueSignsRequests(t *testing.T) {
+	wt := newWebhookTest(t)
+	delivery := wt.publish(t)
+	wt.deliverDue(t, 1)
+
+	requests := wt.receiver.received()
+	if len(requests) != 1 {
+		t.Fatalf("receiver got %d requests, want 1", len(requests))
+	}
+	req := requests[0]
+	timestamp := req.header.Get(WebhookTimestampHeader)
+	if want := SignWebhookPayload(testWebhookSecret, timestamp, req.body); req.header.Get(WebhookSignatureHeader) != want {
+		t.Errorf("signature = %q, want %q", req.header.Get(WebhookSignatureHeader), want)
+	}
+	if SignWebhookPayload("another secret value", timestamp, req.body) == req.header.Get(WebhookSignatureHeader) {
+		t.Error("signature does not depend on the secret")
+	}
+	headers := map[string]string{
+		WebhookIDHeader:       wt.webhook.ID.String(),
+		WebhookDeliveryHeader: delivery.ID.String(),
+		WebhookEventHeader:    models.EventTaskCreated,
+		"Content-Type":        "application/json",
+	}
+	for name, want := range headers {
+		if got := req.header.Get(name); got != want {
+			t.Errorf("%s = %q, want %q", name, got, want)
+		}
+	}
+	if string(req.body) != string(delivery.Payload) {
+		t.Errorf("body = %s, want %s", req.body, delivery.Payload)
+	}
+
+	delivered := wt.delivery(t, delivery.ID)
+	if delivered.Status != models.DeliverySucceeded || delivered.NextAttemptAt != nil || delivered.AttemptCount != 1 {
+		t.Fatalf("delivery = %+v, want succeeded after one attempt", delivered)
+	}
+	wt.deliverDue(t, 0)
+}
+
+func TestDeliverDueRetriesUntilDead(t *testing.T) {
+	ctx := context.Background()
+	wt := newWebhookTest(t)
+	wt.receiver.respondWith(http.StatusServiceUnavailable)
+	delivery := wt.publish(t)
+
+	for attempts := 1; attempts < webhookMaxAttempts; attempts++ {
+		before := time.Now()
+		wt.deliverDue(t, 1)
+		retrying := wt.delivery(t, delivery.ID)
+		if retrying.Status != models.DeliveryRetrying || retrying.AttemptCount != attempts {
+			t.Fatalf("after %d attempts, delivery = %+v, want retrying", attempts, retrying)
+		}
+		if retrying.LastStatusCode == nil || *retrying.LastStatusCode != http.StatusServiceUnavailable {
+			t.Fatalf("after %d attempts, last status = %v, want 503", attempts, retrying.LastStatusCode)
+		}
+		// The delay doubles from webhookRetryBase, with up to 10% of
+		// jitter.
+		delay := webhookRetryBase << (attempts - 1)
+		if delay > webhookRetryMax {
+			delay = webhookRetryMax
+		}
+		earliest, latest := before.Add(delay), time.Now().Add(delay+delay/10)
+		if retrying.NextAttemptAt == nil || retrying.NextAttemptAt.Before(earliest) || retrying.NextAttemptAt.After(latest) {
+			t.Fatalf("after %d attempts, next attempt at %v, want between %v and %v", attempts, retrying.NextAttemptAt, earliest, latest)
+		}
+		// Retries are not attempted before they are due.
+		wt.deliverDue(t, 0)
+		wt.makeDue(t, delivery.ID)
+	}
+	wt.deliverDue(t, 1)
+	dead := wt.delivery(t, delivery.ID)
+	if dead.Status != models.DeliveryDead || dead.NextAttemptAt != nil || dead.AttemptCount != webhookMaxAttempts {
+		t.Fatalf("delivery = %+v, want dead after %d attempts", dead, webhookMaxAttempts)
+	}
+	if len(dead.Attempts) != webhookMaxAttempts || dead.Attempts[0].Error != "unexpected status 503" {
+		t.Fatalf("attempt log = %+v", dead.Attempts)
+	}
+	if n := len(wt.receiver.received()); n != webhookMaxAttempts {
+		t.Fatalf("receiver got %d requests, want %d", n, webhookMaxAttempts)
+	}
+	wt.makeDue(t, delivery.ID)
+	wt.deliverDue(t, 0)
+
+	// Redelivering starts over with a fresh set of attempts, keeping the
+	// log of the earlier ones.
+	wt.receiver.respondWith(http.StatusOK)
+	redelivered, err := wt.svc.Redeliver(ctx, wt.owner, wt.webhook.ID, delivery.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if redelivered.Status != models.DeliveryPending || redelivered.AttemptCount != 0 || redelivered.NextAttemptAt == nil || redelivered.NextAttemptAt.After(time.Now()) {
+		t.Fatalf("redelivered delivery = %+v, want pending and due", redelivered)
+	}
+	wt.deliverDue(t, 1)
+	succeeded := wt.delivery(t, delivery.ID)
+	if succeeded.Status != models.DeliverySucceeded || succeeded.AttemptCount != 1 || len(succeeded.Attempts) != webhookMaxAttempts+1 {
+		t.Fatalf("delivery = %+v, want succeeded after one more attempt", succeeded)
+	}
+}
+
+func TestDeliverDueSkipsLostLeases(t *testing.T) {
+	ctx := context.Background()
+	wt := newWebhookTest(t)
+	delivery := wt.publish(t)
+	claimed, err := wt.svc.store.ClaimDueDeliveries(ctx, time.Now(), webhookLease, webhookBatchSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(claimed) != 1 {
+		t.Fatalf("claimed %d deliveries, want 1", len(claimed))
+	}
+	renewed, err := wt.svc.store.RenewLease(ctx, claimed[0], time.Now(), webhookLease)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !renewed {
+		t.Fatalf("could not renew the lease on delivery %s", delivery.ID)
+	}
+	// Another worker claims the delivery once the lease runs out.
+	if _, err := wt.svc.store.ClaimDueDeliveries(ctx, time.Now().Add(2*webhookLease), webhookLease, webhookBatchSize); err != nil {
+		t.Fatal(err)
+	}
+	renewed, err = wt.svc.store.RenewLease(ctx, claimed[0], time.Now(), webhookLease)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if renewed {
+		t.Fatalf("renewed the lease on delivery %s, which another worker claimed", delivery.ID)
+	}
+}
+
+// failingPublisher fails to publish events with err, if it is not nil.
+type failingPublisher struct {
+	err error
+}
+
+func (p *failingPublisher) Publish(context.Context, models.Event) error {
+	return p.err
+}
+
+// TestRelayQueuesDeliveriesOnce relays an event that another publisher
+// fails, so that it is relayed again, and one whose batch is rolled back.
+// The first is queued for delivery once, and the second not at all.
+func TestRelayQueuesDeliveriesOnce(t *testing.T) {
+	wt := newWebhookTest(t)
+	ctx := context.Background()
+	outbox := datastore.NewOutboxStore(wt.client)
+	deliveries := func(eventID uuid.UUID) int {
+		t.Helper()
+		n, err := wt.client.WebhookDelivery.Query().Where(webhookdelivery.EventID(eventID)).Count(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return n
+	}
+	failing := &failingPublisher{errors.New("publisher is down")}
+	relay := NewOutboxRelay(outbox, Publishers{wt.svc, failing}, nil)
+
+	retried := models.Event{ID: uuid.New(), Type: models.EventTaskCreated, TaskID: uuid.New()}
+	if err := outbox.Publish(ctx, retried); err != nil {
+		t.Fatal(err)
+	}
+	if n, err := relay.RelayPending(ctx); err != nil || n != 0 {
+		t.Fatalf("RelayPending() = %d, %v, want the event to fail", n, err)
+	}
+	failing.err = nil
+	if _, err := wt.client.OutboxEvent.Update().SetAvailableAt(time.Now()).Save(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if n, err := relay.RelayPending(ctx); err != nil || n != 1 {
+		t.Fatalf("RelayPending() = %d, %v, want the event relayed", n, err)
+	}
+	if n := deliveries(retried.ID); n != 1 {
+		t.Errorf("event relayed again has %d deliveries, want 1", n)
+	}
+
+	rolledBack := models.Event{ID: uuid.New(), Type: models.EventTaskCreated, TaskID: uuid.New()}
+	if err := outbox.Publish(ctx, rolledBack); err != nil {
+		t.Fatal(err)
+	}
+	relay = NewOutboxRelay(outbox, Publishers{wt.svc}, &failingPublisher{errors.New("notifier is down")})
+	if _, err := relay.RelayPending(ctx); err == nil {
+		t.Fatal("RelayPending() succeeded, want the batch rolled back")
+	}
+	if n := deliveries(rolledBack.ID); n != 0 {
+		t.Errorf("event of a rolled back batch has %d deliveries, want 0", n)
+	}
+}
+
+func TestWebhooksAreScopedToTheirOwner(t *testing.T) {
+	ctx := context.Background()
+	wt := newWebhookTest(t)
+	delivery := wt.publish(t)
+	other, _, err := NewUserService(datastore.NewUserStore(wt.client)).CreateUser(ctx, "bob", "Bob")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	webhooks, err := wt.svc.ListWebhooks(ctx, other)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(webhooks) != 0 {
+		t.Errorf("another user lists %d webhooks, want 0", len(webhooks))
+	}
+	checks := map[string]error{}
+	_, checks["GetWebhook"] = wt.svc.GetWebhook(ctx, other, wt.webhook.ID)
+	url := "https://example.com/hooks"
+	_, checks["UpdateWebhook"] = wt.svc.UpdateWebhook(ctx, other, models.WebhookUpdate{ID: wt.webhook.ID, URL: &url})
+	checks["DeleteWebhook"] = wt.svc.DeleteWebhook(ctx, other, wt.webhook.ID)
+	_, checks["ListDeliveries"] = wt.svc.ListDeliveries(ctx, other, models.WebhookDeliveryFilter{WebhookID: wt.webhook.ID}, 10, 0)
+	_, checks["Redeliver"] = wt.svc.Redeliver(ctx, other, wt.webhook.ID, delivery.ID)
+	for method, err := range checks {
+		if err == nil || err.Error() != WebhookNotFoundError {
+			t.Errorf("%s by another user: error = %v, want %q", method, err, WebhookNotFoundError)
+		}
+	}
+
+	webhooks, err = wt.svc.ListWebhooks(ctx, wt.owner)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(webhooks) != 1 || webhooks[0].Secret != "" || webhooks[0].OwnerID == nil || *webhooks[0].OwnerID != wt.owner.ID {
+		t.Fatalf("owner lists %+v, want their webhook without its secret", webhooks)
+	}
+}
+
+func TestWebhookClientRefusesInternalAddresses(t *testing.T) {
+	receiver := newWebhookReceiver(t)
+	_, err := NewWebhookClient().Post(receiver.URL, "application/json", nil)
+	if !errors.Is(err, errNonPublicAddress) {
+		t.Fatalf("posting to %s: error = %v, want %v", receiver.URL, err, errNonPublicAddress)
+	}
+	if n := len(receiver.received()); n != 0 {
+		t.Fatalf("receiver got %d requests, want 0", n)
+	}
+}
+
+func TestWebhookClientDoesNotFollowRedirects(t *testing.T) {
+	client := NewWebhookClient()
+	req := httptest.NewRequest(http.MethodPost, "https://example.com/hooks", nil)
+	if err := client.CheckRedirect(req, []*http.Request{req}); !errors.Is(err, http.ErrUseLastResponse) {
+		t.Fatalf("CheckRedirect() = %v, want %v", err, http.ErrUseLastResponse)
+	}
+}
+
+func TestPublicAddress(t *testing.T) {
+	tests := map[string]bool{
+		"93.184.216.34":        true,
+		"2606:2800:220:1::248": true,
+		"127.0.0.1":            false,
+		"::1":                  false,
+		"::ffff:127.0.0.1":     false,
+		"0.0.0.0":              false,
+		"::":                   false,
+		"10.1.2.3":             false,
+		"172.16.0.1":           false,
+		"192.168.1.1":          false,
+		"fd00::1":              false,
+		"169.254.169.254":      false,
+		"fe80::1":              false,
+		"100.64.0.1":           false,
+		"224.0.0.1":            false,
+		"ff02::1":              false,
+	}
+	for address, want := range tests {
+		if got := publicAddress(netip.MustParseAddr(address)); got != want {
+			t.Errorf("publicAddress(%s) = %v, want %v", address, got, want)
+		}
+	}
+}
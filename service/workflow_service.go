@@ -0,0 +1,125 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/google/uuid"
+	"github.com/localopsco/go-sample/datastore"
+	"github.com/localopsco/go-sample/ent"
+	"github.com/localopsco/go-sample/models"
+)
+
+const WorkflowNotFoundError = "Workflow not found"
+const InvalidWorkflowError = "Invalid workflow"
+const UnknownStatusError = "Unknown status"
+
+var statusKeyPattern = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+// TransitionError is returned when a task's workflow does not allow moving it
+// from its current status to the requested one.
+type TransitionError struct {
+	From    string
+	To      string
+	Allowed []string
+}
+
+func (e *TransitionError) Error() string {
+	return fmt.Sprintf("Cannot move task from status %q to %q", e.From, e.To)
+}
+
+type WorkflowService struct {
+	store *datastore.WorkflowStore
+}
+
+func NewWorkflowService(workflowStore *datastore.WorkflowStore) *WorkflowService {
+	return &WorkflowService{
+		workflowStore,
+	}
+}
+
+func (svc *WorkflowService) CreateWorkflow(ctx context.Context, w models.Workflow) (*models.Workflow, error) {
+	if err := validateWorkflow(w); err != nil {
+		return nil, err
+	}
+	w.IsDefault = false
+	return svc.store.CreateWorkflow(ctx, w)
+}
+
+func (svc *WorkflowService) GetWorkflow(ctx context.Context, workflowID uuid.UUID) (*models.Workflow, error) {
+	w, err := svc.store.GetWorkflow(ctx, workflowID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New(WorkflowNotFoundError)
+		}
+		return nil, err
+	}
+	return w, nil
+}
+
+func (svc *WorkflowService) ListWorkflows(ctx context.Context) ([]*models.Workflow, error) {
+	return svc.store.ListWorkflows(ctx)
+}
+
+// validateWorkflow checks that status keys are unique, transitions point at
+// statuses of the same workflow, and that tasks can both start and finish.
+func validateWorkflow(w models.Workflow) error {
+	if w.Name == "" || len(w.Statuses) == 0 {
+		return errors.New(InvalidWorkflowError)
+	}
+	keys := make(map[string]bool, len(w.Statuses))
+	hasTodo, hasDone := false, false
+	for _, status := range w.Statuses {
+		if !statusKeyPattern.MatchString(status.Key) || status.Name == "" || keys[status.Key] {
+			return errors.New(InvalidWorkflowError)
+		}
+		keys[status.Key] = true
+		switch status.Category {
+		case models.StatusCategoryTodo:
+			hasTodo = true
+		case models.StatusCategoryDone:
+			hasDone = true
+		case models.StatusCategoryInProgress, models.StatusCategoryCancelled:
+		default:
+			return errors.New(InvalidWorkflowError)
+		}
+	}
+	for _, status := range w.Statuses {
+		for _, key := range status.Transitions {
+			if !keys[key] || key == status.Key {
+				return errors.New(InvalidWorkflowError)
+			}
+		}
+	}
+	if !hasTodo || !hasDone {
+		return errors.New(InvalidWorkflowError)
+	}
+	return nil
+}
+
+// legacyStatus picks the status that setting is_completed should move a task
+// to: the first done status for completed tasks, or the first todo or
+// in-progress status otherwise. Statuses reachable from current are preferred.
+func legacyStatus(w *models.Workflow, current *models.WorkflowStatus, completed bool) *models.WorkflowStatus {
+	matches := func(status *models.WorkflowStatus) bool {
+		if completed {
+			return status.Category == models.StatusCategoryDone
+		}
+		return !models.IsClosedCategory(status.Category)
+	}
+	var fallback *models.WorkflowStatus
+	for _, status := range w.Statuses {
+		if !matches(status) {
+			continue
+		}
+		if current == nil || current.CanTransition(status.Key) {
+			return status
+		}
+		if fallback == nil {
+			fallback = status
+		}
+	}
+	return fallback
+}
@@ -0,0 +1,67 @@
+// Package taskquery parses the filter language accepted by the q parameter
+// of the task listing, for example:
+//
+//	status:open AND (label:bug OR priority:high) AND due<2026-11-01 AND title~"login"
+//
+// Terms compare a field with a value. They can be combined with AND, OR and
+// NOT (or a leading "-"), and grouped with parentheses. Adjacent terms
+// without an operator between them are joined with AND, and AND binds more
+// tightly than OR.
+package taskquery
+
+// Expr is a node of a parsed query.
+type Expr interface {
+	// Pos is the byte offset of the node in the query.
+	Pos() int
+}
+
+// And matches tasks matching both operands.
+type And struct {
+	Left, Right Expr
+}
+
+// Or matches tasks matching either operand.
+type Or struct {
+	Left, Right Expr
+}
+
+// Not matches tasks not matching Expr.
+type Not struct {
+	Expr   Expr
+	Offset int
+}
+
+// Comparison matches tasks whose Field compares to Value with Op.
+type Comparison struct {
+	Field       string
+	Op          Operator
+	Value       string
+	Offset      int
+	ValueOffset int
+}
+
+func (e *And) Pos() int        { return e.Left.Pos() }
+func (e *Or) Pos() int         { return e.Left.Pos() }
+func (e *Not) Pos() int        { return e.Offset }
+func (e *Comparison) Pos() int { return e.Offset }
+
+// Operator compares a field with a value.
+type Operator string
+
+const (
+	// OpMatch is written as ":" and means equality for most fields and
+	// a case-insensitive substring match for text fields.
+	OpMatch    Operator = ":"
+	OpEqual    Operator = "="
+	OpNotEqual Operator = "!="
+	OpLess     Operator = "<"
+	OpLessEq   Operator = "<="
+	OpGreater  Operator = ">"
+	OpGreatEq  Operator = ">="
+	// OpContains is written as "~" and is a case-insensitive substring
+	// match.
+	OpContains Operator = "~"
+)
+
+// operators is ordered so that two-character operators are tried first.
+var operators = []Operator{OpNotEqual, OpLessEq, OpGreatEq, OpMatch, OpEqual, OpLess, OpGreater, OpContains}
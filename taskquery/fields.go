@@ -0,0 +1,165 @@
+package taskquery
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// None is the value matching tasks where an optional field is not set, as in
+// due:none or assignee:none.
+const None = "none"
+
+// Me is the value of assignee and watcher matching the calling user.
+const Me = "me"
+
+// Priorities lists the task priorities from least to most urgent.
+var Priorities = []string{"none", "low", "medium", "high", "urgent"}
+
+type valueKind int
+
+const (
+	kindText valueKind = iota
+	kindKey
+	kindPriority
+	kindDate
+	kindID
+	kindUser
+	kindBool
+	kindNumber
+)
+
+type fieldSpec struct {
+	kind     valueKind
+	ops      []Operator
+	nullable bool
+}
+
+var (
+	equality = []Operator{OpMatch, OpEqual, OpNotEqual}
+	ordered  = []Operator{OpMatch, OpEqual, OpNotEqual, OpLess, OpLessEq, OpGreater, OpGreatEq}
+	textual  = []Operator{OpMatch, OpEqual, OpNotEqual, OpContains}
+)
+
+// fields is the whitelist of queryable fields and the operators they accept.
+var fields = map[string]fieldSpec{
+	"status":      {kind: kindKey, ops: equality},
+	"priority":    {kind: kindPriority, ops: ordered},
+	"label":       {kind: kindKey, ops: equality},
+	"due":         {kind: kindDate, ops: ordered, nullable: true},
+	"start":       {kind: kindDate, ops: ordered, nullable: true},
+	"created":     {kind: kindDate, ops: ordered},
+	"completed":   {kind: kindDate, ops: ordered, nullable: true},
+	"title":       {kind: kindText, ops: textual},
+	"description": {kind: kindText, ops: textual},
+	"project":     {kind: kindID, ops: equality, nullable: true},
+	"parent":      {kind: kindID, ops: equality, nullable: true},
+	"assignee":    {kind: kindUser, ops: equality, nullable: true},
+	"watcher":     {kind: kindUser, ops: equality, nullable: true},
+	"blocked":     {kind: kindBool, ops: equality},
+	"estimate":    {kind: kindNumber, ops: ordered, nullable: true},
+}
+
+func (spec fieldSpec) allows(op Operator) bool {
+	for _, allowed := range spec.ops {
+		if allowed == op {
+			return true
+		}
+	}
+	return false
+}
+
+// checkValue reports why value is not valid for the field, or returns nil.
+func (spec fieldSpec) checkValue(op Operator, value string) error {
+	if spec.nullable && value == None {
+		if op != OpMatch && op != OpEqual && op != OpNotEqual {
+			return fmt.Errorf("%q cannot be compared with %s", None, op)
+		}
+		return nil
+	}
+	switch spec.kind {
+	case kindKey:
+		if value == "" || strings.ContainsAny(value, " \t\r\n") {
+			return fmt.Errorf("invalid value %q", value)
+		}
+	case kindPriority:
+		if PriorityRank(value) < 0 {
+			return fmt.Errorf("unknown priority %q", value)
+		}
+	case kindDate:
+		if _, _, err := ParseDate(value, time.UTC); err != nil {
+			return err
+		}
+	case kindID:
+		if _, err := uuid.Parse(value); err != nil {
+			return fmt.Errorf("invalid id %q", value)
+		}
+	case kindUser:
+		if value == "" {
+			return fmt.Errorf("invalid user %q", value)
+		}
+	case kindBool:
+		if _, err := strconv.ParseBool(value); err != nil {
+			return fmt.Errorf("expected true or false, got %q", value)
+		}
+	case kindNumber:
+		if n, err := strconv.Atoi(value); err != nil || n < 0 {
+			return fmt.Errorf("expected a whole number, got %q", value)
+		}
+	}
+	return nil
+}
+
+// PriorityRank returns the position of priority in Priorities, or -1.
+func PriorityRank(priority string) int {
+	for rank, p := range Priorities {
+		if p == priority {
+			return rank
+		}
+	}
+	return -1
+}
+
+// ParseDate parses a date value: a calendar date such as 2026-11-01, an
+// RFC 3339 timestamp, now, one of today, tomorrow and yesterday, or a number
+// of days from today such as +7d or -30d. Calendar dates and days relative
+// to today are whole days in loc, reported by day being true.
+func ParseDate(value string, loc *time.Location) (t time.Time, day bool, err error) {
+	now := time.Now().In(loc)
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
+	switch value {
+	case "now":
+		return now, false, nil
+	case "today":
+		return today, true, nil
+	case "tomorrow":
+		return today.AddDate(0, 0, 1), true, nil
+	case "yesterday":
+		return today.AddDate(0, 0, -1), true, nil
+	}
+	if days, ok := relativeDays(value); ok {
+		return today.AddDate(0, 0, days), true, nil
+	}
+	if t, err := time.ParseInLocation("2006-01-02", value, loc); err == nil {
+		return t, true, nil
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, false, nil
+	}
+	return time.Time{}, false, fmt.Errorf("invalid date %q, expected YYYY-MM-DD", value)
+}
+
+// relativeDays parses a signed number of days such as +7d or -30d.
+func relativeDays(value string) (int, bool) {
+	if len(value) < 3 || (value[0] != '+' && value[0] != '-') || !strings.HasSuffix(value, "d") {
+		return 0, false
+	}
+	days, err := strconv.Atoi(strings.TrimSuffix(value, "d"))
+	if err != nil || days < -3660 || days > 3660 {
+		return 0, false
+	}
+	return days, true
+}
@@ -0,0 +1,263 @@
+package taskquery
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// MaxLength is the longest query Parse accepts, in bytes.
+const MaxLength = 2000
+
+// maxDepth limits the nesting of parentheses and negations.
+const maxDepth = 32
+
+// ParseError describes why a query could not be parsed. Position is the
+// 1-based character position of the offending input.
+type ParseError struct {
+	Position int
+	Message  string
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("%s at position %d", e.Message, e.Position)
+}
+
+// Parse parses a query into an expression tree. Field names, operators and
+// value formats are checked against the whitelist of queryable fields.
+func Parse(query string) (Expr, error) {
+	p := &parser{input: query}
+	if len(query) > MaxLength {
+		// Point at the character that crosses the limit, even if it
+		// starts before it.
+		offset := MaxLength
+		for offset > 0 && !utf8.RuneStart(query[offset]) {
+			offset--
+		}
+		return nil, p.errorf(offset, "query is longer than %d characters", MaxLength)
+	}
+	p.skipSpace()
+	if p.eof() {
+		return nil, p.errorf(p.pos, "query is empty")
+	}
+	expr, err := p.parseOr(0)
+	if err != nil {
+		return nil, err
+	}
+	p.skipSpace()
+	if !p.eof() {
+		if p.input[p.pos] == ')' {
+			return nil, p.errorf(p.pos, "unexpected %q", ")")
+		}
+		return nil, p.errorf(p.pos, "expected AND or OR")
+	}
+	return expr, nil
+}
+
+type parser struct {
+	input string
+	pos   int
+}
+
+func (p *parser) parseOr(depth int) (Expr, error) {
+	left, err := p.parseAnd(depth)
+	if err != nil {
+		return nil, err
+	}
+	for p.keyword("OR") {
+		right, err := p.parseAnd(depth)
+		if err != nil {
+			return nil, err
+		}
+		left = &Or{Left: left, Right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseAnd(depth int) (Expr, error) {
+	left, err := p.parseUnary(depth)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		explicit := p.keyword("AND")
+		p.skipSpace()
+		if !explicit && (p.eof() || p.input[p.pos] == ')' || p.peekKeyword("OR")) {
+			return left, nil
+		}
+		right, err := p.parseUnary(depth)
+		if err != nil {
+			return nil, err
+		}
+		left = &And{Left: left, Right: right}
+	}
+}
+
+func (p *parser) parseUnary(depth int) (Expr, error) {
+	p.skipSpace()
+	if depth >= maxDepth {
+		return nil, p.errorf(p.pos, "query is nested too deeply")
+	}
+	start := p.pos
+	if p.keyword("NOT") || p.consume("-") {
+		expr, err := p.parseUnary(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		return &Not{Expr: expr, Offset: start}, nil
+	}
+	if p.consume("(") {
+		expr, err := p.parseOr(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		p.skipSpace()
+		if !p.consume(")") {
+			return nil, p.errorf(start, "unclosed %q", "(")
+		}
+		return expr, nil
+	}
+	return p.parseComparison()
+}
+
+func (p *parser) parseComparison() (Expr, error) {
+	start := p.pos
+	for !p.eof() && isFieldChar(p.input[p.pos]) {
+		p.pos++
+	}
+	name := p.input[start:p.pos]
+	if name == "" {
+		if p.eof() {
+			return nil, p.errorf(p.pos, "expected a field name")
+		}
+		r, _ := utf8.DecodeRuneInString(p.input[p.pos:])
+		return nil, p.errorf(p.pos, "unexpected %q, expected a field name", r)
+	}
+	spec, ok := fields[strings.ToLower(name)]
+	if !ok {
+		return nil, p.errorf(start, "unknown field %q", name)
+	}
+	opStart := p.pos
+	op, ok := p.operator()
+	if !ok {
+		return nil, p.errorf(opStart, "expected an operator after %q", name)
+	}
+	if !spec.allows(op) {
+		return nil, p.errorf(opStart, "operator %q is not supported for field %q", op, name)
+	}
+	valueStart := p.pos
+	value, err := p.value()
+	if err != nil {
+		return nil, err
+	}
+	if err := spec.checkValue(op, value); err != nil {
+		return nil, p.errorf(valueStart, "%s", err)
+	}
+	return &Comparison{
+		Field:       strings.ToLower(name),
+		Op:          op,
+		Value:       value,
+		Offset:      start,
+		ValueOffset: valueStart,
+	}, nil
+}
+
+func (p *parser) operator() (Operator, bool) {
+	for _, op := range operators {
+		if p.consume(string(op)) {
+			return op, true
+		}
+	}
+	return "", false
+}
+
+// value reads a double-quoted string, in which \" and \\ are escapes, or a
+// bare word running up to the next space or parenthesis.
+func (p *parser) value() (string, error) {
+	start := p.pos
+	if !p.consume(`"`) {
+		for !p.eof() && !isSpace(p.input[p.pos]) && p.input[p.pos] != '(' && p.input[p.pos] != ')' {
+			p.pos++
+		}
+		if p.pos == start {
+			return "", p.errorf(start, "expected a value")
+		}
+		return p.input[start:p.pos], nil
+	}
+	var b strings.Builder
+	for !p.eof() {
+		c := p.input[p.pos]
+		p.pos++
+		switch {
+		case c == '"':
+			return b.String(), nil
+		case c == '\\' && !p.eof() && (p.input[p.pos] == '"' || p.input[p.pos] == '\\'):
+			b.WriteByte(p.input[p.pos])
+			p.pos++
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return "", p.errorf(start, "unterminated string")
+}
+
+// keyword consumes word if it comes next as a whole word, in any case.
+func (p *parser) keyword(word string) bool {
+	p.skipSpace()
+	if !p.peekKeyword(word) {
+		return false
+	}
+	p.pos += len(word)
+	return true
+}
+
+func (p *parser) peekKeyword(word string) bool {
+	end := p.pos + len(word)
+	if end > len(p.input) || !strings.EqualFold(p.input[p.pos:end], word) {
+		return false
+	}
+	return end == len(p.input) || isSpace(p.input[end]) || p.input[end] == '('
+}
+
+func (p *parser) consume(s string) bool {
+	if !strings.HasPrefix(p.input[p.pos:], s) {
+		return false
+	}
+	p.pos += len(s)
+	return true
+}
+
+func (p *parser) skipSpace() {
+	for !p.eof() && isSpace(p.input[p.pos]) {
+		p.pos++
+	}
+}
+
+func (p *parser) eof() bool {
+	return p.pos >= len(p.input)
+}
+
+// position converts a byte offset into a 1-based character position.
+func (p *parser) position(offset int) int {
+	return utf8.RuneCountInString(p.input[:offset]) + 1
+}
+
+func (p *parser) errorf(offset int, format string, args ...any) *ParseError {
+	return &ParseError{Position: p.position(offset), Message: fmt.Sprintf(format, args...)}
+}
+
+func isFieldChar(c byte) bool {
+	return c == '_' || c < utf8.RuneSelf && unicode.IsLetter(rune(c))
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\r' || c == '\n'
+}
+
+// ErrorAt returns a ParseError for the byte offset of query, for problems
+// found after parsing, such as while compiling an expression.
+func ErrorAt(query string, offset int, format string, args ...any) *ParseError {
+	p := &parser{input: query}
+	return p.errorf(offset, format, args...)
+}
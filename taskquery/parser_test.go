@@ -0,0 +1,182 @@
+package taskquery
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// format prints an expression with every operation parenthesized.
+func format(e Expr) string {
+	switch e := e.(type) {
+	case *And:
+		return "(" + format(e.Left) + " AND " + format(e.Right) + ")"
+	case *Or:
+		return "(" + format(e.Left) + " OR " + format(e.Right) + ")"
+	case *Not:
+		return "NOT " + format(e.Expr)
+	case *Comparison:
+		return e.Field + string(e.Op) + strconv.Quote(e.Value)
+	}
+	return fmt.Sprintf("%T", e)
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		query string
+		want  string
+	}{
+		{`status:open`, `status:"open"`},
+		{`Status:open`, `status:"open"`},
+		{`status:open label:bug`, `(status:"open" AND label:"bug")`},
+		{`status:open AND label:bug`, `(status:"open" AND label:"bug")`},
+		{`status:open label:bug OR priority:high`, `((status:"open" AND label:"bug") OR priority:"high")`},
+		{`status:open OR label:bug priority:high`, `(status:"open" OR (label:"bug" AND priority:"high"))`},
+		{`status:open OR label:bug AND priority:high`, `(status:"open" OR (label:"bug" AND priority:"high"))`},
+		{`status:a OR status:b OR status:c`, `((status:"a" OR status:"b") OR status:"c")`},
+		{`(status:open OR label:bug) priority:high`, `((status:"open" OR label:"bug") AND priority:"high")`},
+		{`status:a or status:b and label:c`, `(status:"a" OR (status:"b" AND label:"c"))`},
+		{`NOT label:bug`, `NOT label:"bug"`},
+		{`not label:bug status:open`, `(NOT label:"bug" AND status:"open")`},
+		{`-label:bug`, `NOT label:"bug"`},
+		{`-label:bug OR status:open`, `(NOT label:"bug" OR status:"open")`},
+		{`NOT(label:bug OR label:ui)`, `NOT (label:"bug" OR label:"ui")`},
+		{`- -label:bug`, `NOT NOT label:"bug"`},
+		{`due>=2026-01-01`, `due>="2026-01-01"`},
+		{`due<+7d`, `due<"+7d"`},
+		{`due:none`, `due:"none"`},
+		{`priority!=low`, `priority!="low"`},
+		{`estimate<=30`, `estimate<="30"`},
+		{`title~login`, `title~"login"`},
+		{`title:"fix the login page"`, `title:"fix the login page"`},
+		{`title:"say \"hi\""`, `title:"say \"hi\""`},
+		{`title:"back\\slash"`, `title:"back\\slash"`},
+		{`title:"keep \n and \x"`, `title:"keep \\n and \\x"`},
+		{`title:"(not a group)"`, `title:"(not a group)"`},
+		{`title:""`, `title:""`},
+		{`title:"Müller"`, `title:"Müller"`},
+		{"\tstatus:open\n", `status:"open"`},
+		{`(((status:open)))`, `status:"open"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			expr, err := Parse(tt.query)
+			if err != nil {
+				t.Fatalf("Parse(%q) error = %v", tt.query, err)
+			}
+			if got := format(expr); got != tt.want {
+				t.Fatalf("Parse(%q) = %s, want %s", tt.query, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseOffsets(t *testing.T) {
+	query := `title:"Müller" -label:bug`
+	expr, err := Parse(query)
+	if err != nil {
+		t.Fatal(err)
+	}
+	and := expr.(*And)
+	title, not := and.Left.(*Comparison), and.Right.(*Not)
+	label := not.Expr.(*Comparison)
+	if title.Offset != 0 || title.ValueOffset != 6 {
+		t.Errorf("title offsets = %d, %d, want 0, 6", title.Offset, title.ValueOffset)
+	}
+	// Offsets are in bytes, and ü takes two.
+	if not.Offset != 16 || label.Offset != 17 || label.ValueOffset != 23 {
+		t.Errorf("negated label offsets = %d, %d, %d, want 16, 17, 23", not.Offset, label.Offset, label.ValueOffset)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		query    string
+		position int
+		message  string
+	}{
+		{``, 1, `query is empty`},
+		{`   `, 4, `query is empty`},
+		{`foo:bar`, 1, `unknown field "foo"`},
+		{`status:open foo:bar`, 13, `unknown field "foo"`},
+		{`status open`, 7, `expected an operator after "status"`},
+		{`status`, 7, `expected an operator after "status"`},
+		{`title<abc`, 6, `operator "<" is not supported for field "title"`},
+		{`label~bug`, 6, `operator "~" is not supported for field "label"`},
+		{`status:`, 8, `expected a value`},
+		{`status:)`, 8, `expected a value`},
+		{`priority:extreme`, 10, `unknown priority "extreme"`},
+		{`due:someday`, 5, `invalid date "someday", expected YYYY-MM-DD`},
+		{`due<none`, 5, `"none" cannot be compared with <`},
+		{`project:abc`, 9, `invalid id "abc"`},
+		{`blocked:maybe`, 9, `expected true or false, got "maybe"`},
+		{`estimate>-5`, 10, `expected a whole number, got "-5"`},
+		{`(status:open`, 1, `unclosed "("`},
+		{`status:open (label:a OR label:b`, 13, `unclosed "("`},
+		{`status:open)`, 12, `unexpected ")"`},
+		{`title:"abc`, 7, `unterminated string`},
+		{`status:open OR`, 15, `expected a field name`},
+		{`status:open AND`, 16, `expected a field name`},
+		{`NOT`, 4, `expected a field name`},
+		{`status:open "x"`, 13, `unexpected '"', expected a field name`},
+		{`:open`, 1, `unexpected ':', expected a field name`},
+		{`NOTE:x`, 1, `unknown field "NOTE"`},
+		// Positions count characters, not bytes.
+		{`ü:1`, 1, `unexpected 'ü', expected a field name`},
+		{`title:"Müller" foo:1`, 16, `unknown field "foo"`},
+		{`title:"日本語" priority:top`, 22, `unknown priority "top"`},
+		{`title:"naïve"  (label:a`, 16, `unclosed "("`},
+		{`title~"€€€`, 7, `unterminated string`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			checkParseError(t, tt.query, tt.position, tt.message)
+		})
+	}
+}
+
+func TestParseLimits(t *testing.T) {
+	nested := func(depth int) string {
+		return strings.Repeat("(", depth) + "status:open" + strings.Repeat(")", depth)
+	}
+	if _, err := Parse(nested(maxDepth - 1)); err != nil {
+		t.Errorf("Parse at depth %d: %v", maxDepth-1, err)
+	}
+	checkParseError(t, nested(maxDepth), maxDepth+1, "query is nested too deeply")
+	checkParseError(t, strings.Repeat("-", maxDepth)+"status:open", maxDepth+1, "query is nested too deeply")
+	checkParseError(t, strings.Repeat("NOT ", maxDepth)+"status:open", 4*maxDepth+1, "query is nested too deeply")
+
+	// A long query fails before it is parsed, at the first character
+	// past the limit.
+	atLimit := `title:"` + strings.Repeat("x", MaxLength-8) + `"`
+	if _, err := Parse(atLimit); err != nil {
+		t.Errorf("Parse of %d bytes: %v", len(atLimit), err)
+	}
+	overLimit := atLimit + " "
+	checkParseError(t, overLimit, MaxLength+1, fmt.Sprintf("query is longer than %d characters", MaxLength))
+	// The limit is in bytes, and the position is of the character that
+	// crosses it.
+	multiByte := `title:"` + strings.Repeat("é", MaxLength) + `"`
+	checkParseError(t, multiByte, 7+(MaxLength-7)/2+1, fmt.Sprintf("query is longer than %d characters", MaxLength))
+}
+
+func TestErrorAt(t *testing.T) {
+	err := ErrorAt(`title:"é" due:x`, 11, "bad %s", "thing")
+	if err.Position != 11 || err.Error() != "bad thing at position 11" {
+		t.Errorf("ErrorAt() = %+v", err)
+	}
+}
+
+func checkParseError(t *testing.T, query string, position int, message string) {
+	t.Helper()
+	_, err := Parse(query)
+	var parseErr *ParseError
+	if !errors.As(err, &parseErr) {
+		t.Fatalf("Parse(%q) error = %v, want a ParseError", query, err)
+	}
+	if parseErr.Position != position || parseErr.Message != message {
+		t.Fatalf("Parse(%q) error = %q at %d, want %q at %d", query, parseErr.Message, parseErr.Position, message, position)
+	}
+}